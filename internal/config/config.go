@@ -35,6 +35,17 @@ import (
 //   - Cache: Cache sistem ayarları (Phase 3)
 //   - RateLimit: Rate limiting ayarları
 //   - Mail: Mail gönderim ayarları (Phase 3)
+//   - Payment: Ödeme sağlayıcı webhook ayarları
+//   - Idempotency: Idempotency-Key cevap saklama ayarları
+//   - WaitingList: Bekleme listesi teklif ayarları
+//   - WaitingRoom: Sanal bekleme odası (yüksek talepli satışlar) ayarları
+//   - Settlement: Organizatör ödeme ekstresi ücret ayarları
+//   - AttendeeExport: Katılımcı listesi indirme bağlantısı ayarları
+//   - Seats: Koltuk özelliği (kısıtlı görüş) fiyat ayarları
+//   - Images: Etkinlik / mekan görseli yükleme sınırları
+//   - Audit: Denetim kaydı (audit log) saklama ayarları
+//   - Trash: Silinen etkinlik / mekanların çöp kutusunda kalma süresi
+//   - Inventory: Boş koltuk sayacı (available_seats) uzlaştırma ayarları
 type Config struct {
 	App struct {
 		Name string // Uygulama adı
@@ -96,6 +107,72 @@ type Config struct {
 		RetryAfter  int    // Retry after seconds
 		MaxAttempts int    // Maximum attempts
 	} `json:"queue"`
+
+	// Payment Webhooks
+	Payment struct {
+		WebhookSecret    string        // HMAC imza secret'ı (sağlayıcı ile paylaşılır)
+		WebhookTolerance time.Duration // İmza timestamp kabul penceresi
+	}
+
+	// Idempotency-Key
+	Idempotency struct {
+		TTL time.Duration // Saklanan cevabın geçerlilik süresi
+	}
+
+	// Waiting List Offers
+	WaitingList struct {
+		ClaimWindow time.Duration // Teklifin claim edilebileceği süre
+	}
+
+	// Virtual Waiting Room
+	WaitingRoom struct {
+		Driver        string        // redis, memory
+		TokenSecret   string        // Admission token HMAC secret'ı
+		TokenTTL      time.Duration // Admission token geçerlilik süresi
+		RatePerMinute int           // Varsayılan kabul hızı (dakikada kişi)
+		Burst         int           // Açılışta tek seferde kabul edilen kişi sayısı
+	}
+
+	// Organizer Settlements
+	Settlement struct {
+		FeePercent    float64 // Satış başına platform komisyonu (yüzde)
+		FeeFixed      float64 // Satış başına sabit ücret
+		ChargebackFee float64 // Ters ibraz başına sabit ücret
+	}
+
+	// Attendee Exports
+	AttendeeExport struct {
+		LinkSecret string        // İndirme bağlantısı HMAC secret'ı
+		LinkTTL    time.Duration // İndirme bağlantısının geçerlilik süresi
+		Queue      string        // Dışa aktarım job'larının kuyruğu
+	}
+
+	// Seat Attributes
+	Seats struct {
+		RestrictedViewDiscount float64 // Kısıtlı / engelli görüşlü koltuklarda otomatik indirim (yüzde)
+	}
+
+	// Image Uploads
+	Images struct {
+		MaxUploadSize int64 // Yüklenen dosyanın en fazla boyutu (byte)
+		MaxPixels     int   // Decode edilecek en büyük görsel (genişlik × yükseklik)
+	}
+
+	// Audit Log
+	Audit struct {
+		Retention time.Duration // Denetim kayıtlarının saklanma süresi (0 = süresiz)
+	}
+
+	// Trash (soft delete)
+	Trash struct {
+		Retention time.Duration // Silinen kayıtların kalıcı silinmeden önce çöp kutusunda kalma süresi (0 = süresiz)
+	}
+
+	// Inventory Reconciliation
+	Inventory struct {
+		ReconcileInterval time.Duration // Zamanlanmış uzlaştırma aralığı (0 = kapalı, sadece admin tetikler)
+		AutoCorrect       bool          // false ise zamanlanmış çalıştırma sadece raporlar (dry-run)
+	}
 }
 
 // Load, ortam değişkenlerini okuyarak Config nesnesini döndürür.
@@ -156,6 +233,22 @@ func Load() *Config {
 		return value
 	}
 
+	// Helper function: Float ortam değişkeni
+	getEnvAsFloat := func(key string, defaultValue float64) float64 {
+		valueStr := os.Getenv(key)
+		if valueStr == "" {
+			return defaultValue
+		}
+
+		value, err := strconv.ParseFloat(valueStr, 64)
+		if err != nil {
+			log.Printf("⚠️  Uyarı: %s için geçersiz değer: %s, varsayılan (%.2f) kullanılıyor.", key, valueStr, defaultValue)
+			return defaultValue
+		}
+
+		return value
+	}
+
 	// Helper function: Duration ortam değişkeni (saniye cinsinden)
 	getEnvAsDuration := func(key string, defaultSeconds int) time.Duration {
 		seconds := getEnvAsInt(key, defaultSeconds)
@@ -208,6 +301,50 @@ func Load() *Config {
 	cfg.Queue.RetryAfter = getEnvAsInt("QUEUE_RETRY_AFTER", 90)
 	cfg.Queue.MaxAttempts = getEnvAsInt("QUEUE_MAX_ATTEMPTS", 3)
 
+	// Payment Webhook Configuration
+	cfg.Payment.WebhookSecret = getEnv("PAYMENT_WEBHOOK_SECRET", "")
+	cfg.Payment.WebhookTolerance = getEnvAsDuration("PAYMENT_WEBHOOK_TOLERANCE", 300) // 5 dakika
+
+	// Idempotency Configuration
+	cfg.Idempotency.TTL = getEnvAsDuration("IDEMPOTENCY_TTL", 86400) // 24 saat
+
+	// Waiting List Configuration
+	cfg.WaitingList.ClaimWindow = getEnvAsDuration("WAITING_LIST_CLAIM_WINDOW", 900) // 15 dakika
+
+	// Waiting Room Configuration
+	cfg.WaitingRoom.Driver = getEnv("WAITING_ROOM_DRIVER", "redis") // redis, memory
+	cfg.WaitingRoom.TokenSecret = getEnv("WAITING_ROOM_TOKEN_SECRET", "")
+	cfg.WaitingRoom.TokenTTL = getEnvAsDuration("WAITING_ROOM_TOKEN_TTL", 600) // 10 dakika
+	cfg.WaitingRoom.RatePerMinute = getEnvAsInt("WAITING_ROOM_RATE_PER_MINUTE", 300)
+	cfg.WaitingRoom.Burst = getEnvAsInt("WAITING_ROOM_BURST", 100)
+
+	// Settlement Configuration
+	cfg.Settlement.FeePercent = getEnvAsFloat("SETTLEMENT_FEE_PERCENT", 5)
+	cfg.Settlement.FeeFixed = getEnvAsFloat("SETTLEMENT_FEE_FIXED", 0)
+	cfg.Settlement.ChargebackFee = getEnvAsFloat("SETTLEMENT_CHARGEBACK_FEE", 0)
+
+	// Attendee Export Configuration
+	cfg.AttendeeExport.LinkSecret = getEnv("ATTENDEE_EXPORT_LINK_SECRET", "")
+	cfg.AttendeeExport.LinkTTL = getEnvAsDuration("ATTENDEE_EXPORT_LINK_TTL", 86400) // 24 saat
+	cfg.AttendeeExport.Queue = getEnv("ATTENDEE_EXPORT_QUEUE", "exports")
+
+	// Seat Attribute Configuration
+	cfg.Seats.RestrictedViewDiscount = getEnvAsFloat("SEAT_RESTRICTED_VIEW_DISCOUNT", 0)
+
+	// Image Upload Configuration
+	cfg.Images.MaxUploadSize = int64(getEnvAsInt("IMAGE_MAX_UPLOAD_SIZE", 10<<20)) // 10 MB
+	cfg.Images.MaxPixels = getEnvAsInt("IMAGE_MAX_PIXELS", 40_000_000)
+
+	// Audit Log Configuration
+	cfg.Audit.Retention = getEnvAsDuration("AUDIT_RETENTION", 63072000) // 2 yıl
+
+	// Trash Configuration
+	cfg.Trash.Retention = getEnvAsDuration("TRASH_RETENTION", 2592000) // 30 gün
+
+	// Inventory Reconciliation Configuration
+	cfg.Inventory.ReconcileInterval = getEnvAsDuration("INVENTORY_RECONCILE_INTERVAL", 3600) // 1 saat
+	cfg.Inventory.AutoCorrect = getEnvAsBool("INVENTORY_RECONCILE_AUTOCORRECT", false)
+
 	// Validation
 	if err := cfg.Validate(); err != nil {
 		log.Printf("❌ Config validation hatası: %v", err)
@@ -236,6 +373,21 @@ func (c *Config) Validate() error {
 		if c.JWT.Secret == "your-super-secret-jwt-key-change-this-in-production" {
 			return fmt.Errorf("JWT_SECRET production'da değiştirilmelidir")
 		}
+
+		// Webhook secret kontrolü
+		if c.Payment.WebhookSecret == "" {
+			return fmt.Errorf("PAYMENT_WEBHOOK_SECRET production'da tanımlanmalıdır")
+		}
+
+		// Waiting room secret kontrolü
+		if c.WaitingRoom.TokenSecret == "" {
+			return fmt.Errorf("WAITING_ROOM_TOKEN_SECRET production'da tanımlanmalıdır")
+		}
+
+		// Dışa aktarım bağlantı secret kontrolü
+		if c.AttendeeExport.LinkSecret == "" {
+			return fmt.Errorf("ATTENDEE_EXPORT_LINK_SECRET production'da tanımlanmalıdır")
+		}
 	}
 
 	// Cache driver kontrolü
@@ -248,6 +400,44 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("geçersiz CACHE_DRIVER: %s (redis, file veya memory olmalı)", c.Cache.Driver)
 	}
 
+	// Settlement ücret kontrolü
+	if c.Settlement.FeePercent < 0 || c.Settlement.FeePercent > 100 {
+		return fmt.Errorf("SETTLEMENT_FEE_PERCENT 0 ile 100 arasında olmalı")
+	}
+	if c.Settlement.FeeFixed < 0 || c.Settlement.ChargebackFee < 0 {
+		return fmt.Errorf("settlement ücretleri negatif olamaz")
+	}
+
+	// Dışa aktarım bağlantı süresi kontrolü
+	if c.AttendeeExport.LinkTTL <= 0 {
+		return fmt.Errorf("ATTENDEE_EXPORT_LINK_TTL pozitif olmalı")
+	}
+
+	// Kısıtlı görüş indirimi kontrolü
+	if c.Seats.RestrictedViewDiscount < 0 || c.Seats.RestrictedViewDiscount >= 100 {
+		return fmt.Errorf("SEAT_RESTRICTED_VIEW_DISCOUNT 0 ile 100 arasında olmalı")
+	}
+
+	// Görsel yükleme sınırları kontrolü
+	if c.Images.MaxUploadSize <= 0 || c.Images.MaxPixels <= 0 {
+		return fmt.Errorf("IMAGE_MAX_UPLOAD_SIZE ve IMAGE_MAX_PIXELS pozitif olmalı")
+	}
+
+	// Denetim kaydı saklama süresi kontrolü
+	if c.Audit.Retention < 0 {
+		return fmt.Errorf("AUDIT_RETENTION negatif olamaz")
+	}
+
+	// Çöp kutusu saklama süresi kontrolü
+	if c.Trash.Retention < 0 {
+		return fmt.Errorf("TRASH_RETENTION negatif olamaz")
+	}
+
+	// Envanter uzlaştırma aralığı kontrolü
+	if c.Inventory.ReconcileInterval < 0 {
+		return fmt.Errorf("INVENTORY_RECONCILE_INTERVAL negatif olamaz")
+	}
+
 	// Production uyarıları
 	if c.IsProduction() {
 		if c.Cache.Driver == "memory" {
@@ -0,0 +1,251 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// AddOnController handles HTTP requests for add-on products, orders and vouchers
+type AddOnController struct {
+	addOnService *services.AddOnService
+}
+
+func NewAddOnController(addOnService *services.AddOnService) *AddOnController {
+	return &AddOnController{
+		addOnService: addOnService,
+	}
+}
+
+// addOnRequest is the request body for creating or updating an add-on
+type addOnRequest struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Type        models.AddOnType  `json:"type"`
+	Price       float64           `json:"price"`
+	Inventory   int               `json:"inventory"`
+	Scope       models.AddOnScope `json:"scope"`
+	MaxQuantity *int              `json:"max_quantity"`
+	Scannable   bool              `json:"scannable"`
+	IsActive    *bool             `json:"is_active"`
+}
+
+func (req addOnRequest) toInput() services.AddOnInput {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	return services.AddOnInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Type:        req.Type,
+		Price:       req.Price,
+		Inventory:   req.Inventory,
+		Scope:       req.Scope,
+		MaxQuantity: req.MaxQuantity,
+		Scannable:   req.Scannable,
+		IsActive:    isActive,
+	}
+}
+
+// Create handles POST /events/:id/addons (admin)
+func (c *AddOnController) Create(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req addOnRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	addOn, err := c.addOnService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).CreateAddOn(eventID, req.toInput())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, addOn)
+}
+
+// Update handles PUT /addons/:id (admin)
+func (c *AddOnController) Update(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	addOnID, err := parseIDFromPath(r.URL.Path, "/addons/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req addOnRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	addOn, err := c.addOnService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).UpdateAddOn(addOnID, req.toInput())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, addOn)
+}
+
+// List handles GET /events/:id/addons
+func (c *AddOnController) List(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	addOns, err := c.addOnService.ListEventAddOns(eventID, true)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, addOns)
+}
+
+// AddToTicket handles POST /tickets/:id/addons
+func (c *AddOnController) AddToTicket(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	ticketID, err := parseIDFromPath(r.URL.Path, "/tickets/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		AddOnID  int64 `json:"addon_id"`
+		Quantity int   `json:"quantity"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+	if req.Quantity == 0 {
+		req.Quantity = 1
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	item, err := c.addOnService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).AddToTicket(userID, ticketID, req.AddOnID, req.Quantity)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, item)
+}
+
+// Refund handles POST /addon-items/:id/refund
+func (c *AddOnController) Refund(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	itemID, err := parseIDFromPath(r.URL.Path, "/addon-items/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	item, err := c.addOnService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).RefundItem(userID, itemID)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, item)
+}
+
+// OrderSummary handles GET /events/:id/order-summary (pricing breakdown of the user's order)
+func (c *AddOnController) OrderSummary(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	summary, err := c.addOnService.ForOrganizer(getOrganizerScope(r)).GetOrderSummary(userID, eventID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, summary)
+}
+
+// ValidateVoucher handles POST /addons/vouchers/validate
+func (c *AddOnController) ValidateVoucher(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	var req struct {
+		VoucherCode      string `json:"voucher_code"`
+		VerificationCode string `json:"verification_code"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	item, err := c.addOnService.ForOrganizer(getOrganizerScope(r)).ValidateVoucher(req.VoucherCode, req.VerificationCode)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, item)
+}
+
+// UseVoucher handles POST /addons/vouchers/use
+func (c *AddOnController) UseVoucher(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	var req struct {
+		VoucherCode      string `json:"voucher_code"`
+		VerificationCode string `json:"verification_code"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	item, err := c.addOnService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).UseVoucher(req.VoucherCode, req.VerificationCode)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, item)
+}
@@ -0,0 +1,182 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// AnalyticsController handles HTTP requests for sales analytics reports
+type AnalyticsController struct {
+	analyticsService *services.AnalyticsService
+}
+
+func NewAnalyticsController(analyticsService *services.AnalyticsService) *AnalyticsController {
+	return &AnalyticsController{
+		analyticsService: analyticsService,
+	}
+}
+
+// parseReportRange reads the optional from / to query parameters (RFC3339)
+func parseReportRange(r *http.Request) (time.Time, time.Time, error) {
+	parse := func(name string) (time.Time, error) {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			return time.Time{}, nil
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%s RFC3339 formatında olmalı", name)
+		}
+		return parsed, nil
+	}
+
+	from, err := parse("from")
+	if err != nil {
+		return from, time.Time{}, err
+	}
+	to, err := parse("to")
+	if err != nil {
+		return from, to, err
+	}
+
+	return from, to, nil
+}
+
+// parseSalesReportQuery reads the time-series parameters: from, to, granularity and tz
+func parseSalesReportQuery(r *http.Request, eventID int64) (models.SalesReportQuery, error) {
+	query := models.SalesReportQuery{
+		EventID:     eventID,
+		Granularity: models.AnalyticsGranularity(r.URL.Query().Get("granularity")),
+	}
+
+	from, to, err := parseReportRange(r)
+	if err != nil {
+		return query, err
+	}
+	query.From, query.To = from, to
+
+	if tz := r.URL.Query().Get("tz"); tz != "" {
+		location, err := time.LoadLocation(tz)
+		if err != nil {
+			return query, fmt.Errorf("geçersiz tz: %s", tz)
+		}
+		query.Location = location
+	}
+
+	return query, nil
+}
+
+// GetSalesTimeSeries handles GET /events/:id/analytics/sales?from=&to=&granularity=&tz=
+func (c *AnalyticsController) GetSalesTimeSeries(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	query, err := parseSalesReportQuery(r, eventID)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 2. Call service
+	buckets, err := c.analyticsService.ForOrganizer(getOrganizerScope(r)).GetSalesTimeSeries(query)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, buckets)
+}
+
+// GetSellThrough handles GET /events/:id/analytics/sell-through?from=&to=&granularity=&tz=
+func (c *AnalyticsController) GetSellThrough(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	query, err := parseSalesReportQuery(r, eventID)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 2. Call service
+	points, err := c.analyticsService.ForOrganizer(getOrganizerScope(r)).GetSellThrough(query)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, points)
+}
+
+// GetSalesBreakdown handles GET /events/:id/analytics/breakdown?dimension=&from=&to=
+func (c *AnalyticsController) GetSalesBreakdown(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	from, to, err := parseReportRange(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dimension := models.AnalyticsDimension(r.URL.Query().Get("dimension"))
+
+	// 2. Call service
+	breakdown, err := c.analyticsService.ForOrganizer(getOrganizerScope(r)).GetSalesBreakdown(eventID, dimension, from, to)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, breakdown)
+}
+
+// TrackPageView handles POST /events/:id/views
+func (c *AnalyticsController) TrackPageView(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Channel string `json:"channel"`
+	}
+
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondError(w, http.StatusBadRequest, "geçersiz istek")
+			return
+		}
+	}
+
+	// 2. Call service
+	if err := c.analyticsService.TrackPageView(eventID, getUserIDFromContext(r), req.Channel); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// AttendeeExportController handles HTTP requests for attendee list exports
+type AttendeeExportController struct {
+	exportService *services.AttendeeExportService
+}
+
+func NewAttendeeExportController(exportService *services.AttendeeExportService) *AttendeeExportController {
+	return &AttendeeExportController{
+		exportService: exportService,
+	}
+}
+
+// Request handles POST /events/:id/attendee-exports
+func (c *AttendeeExportController) Request(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Format string `json:"format"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service (the download link goes only to the requester's own address)
+	export, err := c.exportService.ForOrganizer(getOrganizerScope(r)).
+		RequestExport(eventID, getUserIDFromContext(r), getUserEmailFromContext(r), models.AttendeeExportFormat(req.Format))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusAccepted, export)
+}
+
+// List handles GET /events/:id/attendee-exports
+func (c *AttendeeExportController) List(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	exports, err := c.exportService.ForOrganizer(getOrganizerScope(r)).ListExports(eventID)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, exports)
+}
+
+// Get handles GET /attendee-exports/:id
+func (c *AttendeeExportController) Get(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	id, err := parseIDFromPath(r.URL.Path, "/attendee-exports/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	export, err := c.exportService.ForOrganizer(getOrganizerScope(r)).GetExport(id)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, export)
+}
+
+// Download handles GET /attendee-exports/:id/download?expires=&signature=
+// The signed link is the credential; it is sent by e-mail to the requester.
+func (c *AttendeeExportController) Download(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	id, err := parseIDFromPath(r.URL.Path, "/attendee-exports/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	export, stream, err := c.exportService.OpenDownload(id, r.URL.Query().Get("expires"), r.URL.Query().Get("signature"))
+	if err != nil {
+		respondError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	defer stream.Close()
+
+	// 3. Stream file
+	w.Header().Set("Content-Type", export.Format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.FileName()))
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, stream)
+}
@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// AuditController handles HTTP requests for the admin audit trail
+type AuditController struct {
+	auditService *services.AuditService
+}
+
+func NewAuditController(auditService *services.AuditService) *AuditController {
+	return &AuditController{
+		auditService: auditService,
+	}
+}
+
+// List handles GET /admin/audit-logs?entity_type=&entity_id=&actor_type=&actor_id=&action=&from=&to=&before_id=&limit=
+func (c *AuditController) List(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	params := r.URL.Query()
+	filter := &models.AuditLogFilter{
+		EntityType: models.AuditEntityType(params.Get("entity_type")),
+		ActorType:  models.AuditActorType(params.Get("actor_type")),
+		ActorID:    params.Get("actor_id"),
+		Action:     models.AuditAction(params.Get("action")),
+	}
+
+	if value := params.Get("entity_id"); value != "" {
+		entityID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "geçersiz entity_id")
+			return
+		}
+		filter.EntityID = entityID
+	}
+
+	if value := params.Get("before_id"); value != "" {
+		beforeID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "geçersiz before_id")
+			return
+		}
+		filter.BeforeID = beforeID
+	}
+
+	if value := params.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "geçersiz limit")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	from, to, err := parseReportRange(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !from.IsZero() {
+		filter.From = &from
+	}
+	if !to.IsZero() {
+		filter.To = &to
+	}
+
+	// 2. Call service
+	entries, err := c.auditService.ForOrganizer(getOrganizerScope(r)).Query(filter)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, entries)
+}
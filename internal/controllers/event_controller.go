@@ -2,10 +2,14 @@ package controllers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/biyonik/event-ticketing-api/internal/http/response"
 	"github.com/biyonik/event-ticketing-api/internal/models"
 	"github.com/biyonik/event-ticketing-api/internal/services"
 )
@@ -46,7 +50,7 @@ func (c *EventController) Create(w http.ResponseWriter, r *http.Request) {
 	endTime, _ := time.Parse(time.RFC3339, req.EndTime)
 
 	// 2. Call service (ALL LOGIC HERE!)
-	event, err := c.eventService.CreateEvent(
+	event, err := c.eventService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).CreateEvent(
 		req.Name, req.Description, req.Type, req.VenueID,
 		startTime, endTime, req.BasePrice, req.ImageURL, req.Featured, req.Metadata,
 	)
@@ -75,37 +79,34 @@ func (c *EventController) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 3. Return response
+	// 3. Return response (ETag = version, sent back as If-Match on update)
+	setETag(w, event.Version)
 	respondJSON(w, http.StatusOK, event)
 }
 
-// List handles GET /events
+// List handles GET /events?type=&status=&venue_id=&city=&start_date=&end_date=&min_price=&max_price=
+// &available=&featured=&sort=&limit=&cursor=&include_total=
 func (c *EventController) List(w http.ResponseWriter, r *http.Request) {
 	// 1. Parse query parameters
-	query := r.URL.Query()
-	page, _ := strconv.Atoi(query.Get("page"))
-	pageSize, _ := strconv.Atoi(query.Get("page_size"))
-
-	filters := make(map[string]interface{})
-	if status := query.Get("status"); status != "" {
-		filters["status"] = models.EventStatus(status)
-	}
-	if eventType := query.Get("type"); eventType != "" {
-		filters["type"] = models.EventType(eventType)
+	query, err := parseEventQuery(r.URL.Query())
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
 	}
 
 	// 2. Call service
-	events, err := c.eventService.ListEvents(filters, page, pageSize)
+	page, err := c.eventService.ListEvents(query)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, err.Error())
+		response.Error(w, http.StatusBadRequest, err)
 		return
 	}
 
-	// 3. Return response
-	respondJSON(w, http.StatusOK, events)
+	// 3. Return response (next_cursor / has_more / total in meta)
+	response.Success(w, http.StatusOK, page.Events, page.Meta)
 }
 
 // Update handles PUT /events/:id
+// Honours If-Match: a stale ETag returns 409 Conflict instead of overwriting a concurrent update
 func (c *EventController) Update(w http.ResponseWriter, r *http.Request) {
 	// 1. Parse ID and request
 	id, err := parseIDFromPath(r.URL.Path, "/events/")
@@ -114,6 +115,12 @@ func (c *EventController) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		respondIfMatchError(w, err)
+		return
+	}
+
 	var updates map[string]interface{}
 	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
 		respondError(w, http.StatusBadRequest, "geçersiz istek")
@@ -121,13 +128,14 @@ func (c *EventController) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	event, err := c.eventService.UpdateEvent(id, updates)
+	event, err := c.eventService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).UpdateEvent(id, expectedVersion, updates)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, err.Error())
+		respondServiceError(w, err)
 		return
 	}
 
 	// 3. Return response
+	setETag(w, event.Version)
 	respondJSON(w, http.StatusOK, event)
 }
 
@@ -141,7 +149,7 @@ func (c *EventController) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	if err := c.eventService.DeleteEvent(id); err != nil {
+	if err := c.eventService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).DeleteEvent(id); err != nil {
 		respondError(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -160,7 +168,7 @@ func (c *EventController) Publish(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	if err := c.eventService.PublishEvent(id); err != nil {
+	if err := c.eventService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).PublishEvent(id); err != nil {
 		respondError(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -179,7 +187,7 @@ func (c *EventController) ActivateSale(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	if err := c.eventService.ActivateSale(id); err != nil {
+	if err := c.eventService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).ActivateSale(id); err != nil {
 		respondError(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -198,7 +206,7 @@ func (c *EventController) Cancel(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	if err := c.eventService.CancelEvent(id); err != nil {
+	if err := c.eventService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).CancelEvent(id); err != nil {
 		respondError(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -207,6 +215,25 @@ func (c *EventController) Cancel(w http.ResponseWriter, r *http.Request) {
 	respondJSON(w, http.StatusOK, map[string]string{"message": "etkinlik iptal edildi"})
 }
 
+// Complete handles POST /events/:id/complete
+func (c *EventController) Complete(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	id, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	if err := c.eventService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).CompleteEvent(id); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "etkinlik tamamlandı"})
+}
+
 // GetUpcoming handles GET /events/upcoming
 func (c *EventController) GetUpcoming(w http.ResponseWriter, r *http.Request) {
 	// 1. Parse query parameters
@@ -277,3 +304,238 @@ func (c *EventController) CalculatePrice(w http.ResponseWriter, r *http.Request)
 	// 3. Return response
 	respondJSON(w, http.StatusOK, map[string]float64{"price": price})
 }
+
+// parseEventQuery reads the typed List filters. type and status accept comma-separated
+// values; dates are RFC3339.
+func parseEventQuery(values url.Values) (*models.EventQuery, error) {
+	query := &models.EventQuery{
+		City:   strings.TrimSpace(values.Get("city")),
+		Sort:   models.EventSort(values.Get("sort")),
+		Cursor: values.Get("cursor"),
+	}
+
+	for _, value := range splitQueryList(values.Get("type")) {
+		query.Types = append(query.Types, models.EventType(value))
+	}
+	for _, value := range splitQueryList(values.Get("status")) {
+		query.Statuses = append(query.Statuses, models.EventStatus(value))
+	}
+
+	if value := values.Get("venue_id"); value != "" {
+		venueID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz venue_id değeri")
+		}
+		query.VenueID = venueID
+	}
+
+	if value := values.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz limit değeri")
+		}
+		query.Limit = limit
+	}
+
+	for name, target := range map[string]**time.Time{"start_date": &query.StartFrom, "end_date": &query.StartTo} {
+		if value := values.Get(name); value != "" {
+			date, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s RFC3339 formatında olmalı", name)
+			}
+			*target = &date
+		}
+	}
+
+	for name, target := range map[string]**float64{"min_price": &query.MinPrice, "max_price": &query.MaxPrice} {
+		if value := values.Get(name); value != "" {
+			price, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("geçersiz %s değeri", name)
+			}
+			*target = &price
+		}
+	}
+
+	for name, target := range map[string]**bool{"available": &query.Available, "featured": &query.Featured} {
+		if value := values.Get(name); value != "" {
+			flag, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("geçersiz %s değeri", name)
+			}
+			*target = &flag
+		}
+	}
+
+	if value := values.Get("include_total"); value != "" {
+		includeTotal, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz include_total değeri")
+		}
+		query.IncludeTotal = includeTotal
+	}
+
+	return query, nil
+}
+
+// splitQueryList splits a comma-separated query value, skipping blanks
+func splitQueryList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseEventFilters reads the event filters of the geo searches:
+// status, type, featured and start_date / end_date (RFC3339)
+func parseEventFilters(query url.Values) (map[string]interface{}, error) {
+	filters := make(map[string]interface{})
+	if status := query.Get("status"); status != "" {
+		filters["status"] = models.EventStatus(status)
+	}
+	if eventType := query.Get("type"); eventType != "" {
+		filters["type"] = models.EventType(eventType)
+	}
+	if value := query.Get("featured"); value != "" {
+		featured, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz featured değeri")
+		}
+		filters["featured"] = featured
+	}
+	for _, name := range []string{"start_date", "end_date"} {
+		if value := query.Get(name); value != "" {
+			date, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s RFC3339 formatında olmalı", name)
+			}
+			filters[name] = date
+		}
+	}
+
+	return filters, nil
+}
+
+// parseGeoFloats reads the named float query parameters in order
+func parseGeoFloats(query url.Values, names ...string) ([]float64, error) {
+	values := make([]float64, len(names))
+	for i, name := range names {
+		value, err := strconv.ParseFloat(query.Get(name), 64)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz %s değeri", name)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// Nearby handles GET /events/nearby?lat=&lng=&radius_km= (plus the List filters)
+func (c *EventController) Nearby(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse query parameters
+	query := r.URL.Query()
+	values, err := parseGeoFloats(query, "lat", "lng", "radius_km")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filters, err := parseEventFilters(query)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+
+	// 2. Call service
+	events, err := c.eventService.FindNearbyEvents(models.GeoPoint{Lat: values[0], Lng: values[1]}, values[2], filters, page, pageSize)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, events)
+}
+
+// Within handles GET /events/within?min_lat=&min_lng=&max_lat=&max_lng= (plus the List filters)
+func (c *EventController) Within(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse query parameters
+	query := r.URL.Query()
+	values, err := parseGeoFloats(query, "min_lat", "min_lng", "max_lat", "max_lng")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filters, err := parseEventFilters(query)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+
+	// 2. Call service
+	bounds := models.GeoBounds{MinLat: values[0], MinLng: values[1], MaxLat: values[2], MaxLng: values[3]}
+	events, err := c.eventService.FindEventsInBounds(bounds, filters, page, pageSize)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, events)
+}
+
+// NearbyVenues handles GET /venues/nearby?lat=&lng=&radius_km=
+func (c *EventController) NearbyVenues(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse query parameters
+	query := r.URL.Query()
+	values, err := parseGeoFloats(query, "lat", "lng", "radius_km")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	// 2. Call service
+	venues, err := c.eventService.FindNearbyVenues(models.GeoPoint{Lat: values[0], Lng: values[1]}, values[2], limit)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, venues)
+}
+
+// VenuesWithin handles GET /venues/within?min_lat=&min_lng=&max_lat=&max_lng=
+func (c *EventController) VenuesWithin(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse query parameters
+	query := r.URL.Query()
+	values, err := parseGeoFloats(query, "min_lat", "min_lng", "max_lat", "max_lng")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	// 2. Call service
+	bounds := models.GeoBounds{MinLat: values[0], MinLng: values[1], MaxLat: values[2], MaxLng: values[3]}
+	venues, err := c.eventService.FindVenuesInBounds(bounds, limit)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, venues)
+}
@@ -0,0 +1,235 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// EventSeriesController handles HTTP requests for recurring event series and performances
+type EventSeriesController struct {
+	seriesService *services.EventSeriesService
+}
+
+func NewEventSeriesController(seriesService *services.EventSeriesService) *EventSeriesController {
+	return &EventSeriesController{
+		seriesService: seriesService,
+	}
+}
+
+// Create handles POST /event-series (admin)
+func (c *EventSeriesController) Create(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	var req struct {
+		Name            string           `json:"name"`
+		Description     string           `json:"description"`
+		Type            models.EventType `json:"type"`
+		VenueID         int64            `json:"venue_id"`
+		BasePrice       float64          `json:"base_price"`
+		ImageURL        string           `json:"image_url"`
+		DurationMinutes int              `json:"duration_minutes"`
+		RecurrenceRule  string           `json:"recurrence_rule"`
+		FirstStartTime  string           `json:"first_start_time"`
+		Timezone        string           `json:"timezone"`
+		Exceptions      []string         `json:"exceptions"` // YYYY-MM-DD
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	firstStartTime, err := time.Parse(time.RFC3339, req.FirstStartTime)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "first_start_time RFC3339 formatında olmalı")
+		return
+	}
+
+	exceptions := make([]time.Time, 0, len(req.Exceptions))
+	for _, value := range req.Exceptions {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "exceptions YYYY-MM-DD formatında olmalı")
+			return
+		}
+		exceptions = append(exceptions, date)
+	}
+
+	// 2. Call service
+	series, events, err := c.seriesService.ForOrganizer(getOrganizerScope(r)).CreateSeries(services.EventSeriesInput{
+		Name:            req.Name,
+		Description:     req.Description,
+		Type:            req.Type,
+		VenueID:         req.VenueID,
+		BasePrice:       req.BasePrice,
+		ImageURL:        req.ImageURL,
+		DurationMinutes: req.DurationMinutes,
+		RecurrenceRule:  req.RecurrenceRule,
+		FirstStartTime:  firstStartTime,
+		Timezone:        req.Timezone,
+		Exceptions:      exceptions,
+	})
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, map[string]interface{}{
+		"series": series,
+		"events": events,
+	})
+}
+
+// Get handles GET /event-series/:id
+func (c *EventSeriesController) Get(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	seriesID, err := parseIDFromPath(r.URL.Path, "/event-series/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	series, err := c.seriesService.GetSeries(seriesID)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, series)
+}
+
+// BulkUpdate handles PATCH /event-series/:id (admin, applies to all future performances)
+func (c *EventSeriesController) BulkUpdate(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	seriesID, err := parseIDFromPath(r.URL.Path, "/event-series/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Name            *string  `json:"name"`
+		Description     *string  `json:"description"`
+		BasePrice       *float64 `json:"base_price"`
+		ImageURL        *string  `json:"image_url"`
+		DurationMinutes *int     `json:"duration_minutes"`
+		Publish         bool     `json:"publish"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	result, err := c.seriesService.ForOrganizer(getOrganizerScope(r)).BulkUpdate(seriesID, services.EventSeriesUpdate{
+		Name:            req.Name,
+		Description:     req.Description,
+		BasePrice:       req.BasePrice,
+		ImageURL:        req.ImageURL,
+		DurationMinutes: req.DurationMinutes,
+		Publish:         req.Publish,
+	})
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, result)
+}
+
+// Generate handles POST /event-series/:id/generate (admin, creates missing performances)
+func (c *EventSeriesController) Generate(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	seriesID, err := parseIDFromPath(r.URL.Path, "/event-series/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	events, err := c.seriesService.ForOrganizer(getOrganizerScope(r)).GenerateInstances(seriesID)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, events)
+}
+
+// AddException handles POST /event-series/:id/exceptions (admin)
+func (c *EventSeriesController) AddException(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	seriesID, err := parseIDFromPath(r.URL.Path, "/event-series/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Date string `json:"date"` // YYYY-MM-DD
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "date YYYY-MM-DD formatında olmalı")
+		return
+	}
+
+	// 2. Call service
+	series, err := c.seriesService.ForOrganizer(getOrganizerScope(r)).AddException(seriesID, date)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, series)
+}
+
+// Performances handles GET /event-series/:id/performances?from=&to= ("select a performance")
+func (c *EventSeriesController) Performances(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	seriesID, err := parseIDFromPath(r.URL.Path, "/event-series/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var from, to time.Time
+	if value := r.URL.Query().Get("from"); value != "" {
+		if from, err = time.Parse(time.RFC3339, value); err != nil {
+			respondError(w, http.StatusBadRequest, "from RFC3339 formatında olmalı")
+			return
+		}
+	}
+	if value := r.URL.Query().Get("to"); value != "" {
+		if to, err = time.Parse(time.RFC3339, value); err != nil {
+			respondError(w, http.StatusBadRequest, "to RFC3339 formatında olmalı")
+			return
+		}
+	}
+
+	// 2. Call service
+	performances, err := c.seriesService.ListPerformances(seriesID, from, to)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, performances)
+}
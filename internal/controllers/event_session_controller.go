@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// EventSessionController handles HTTP requests for festival days / sessions and ticket entitlements
+type EventSessionController struct {
+	sessionService *services.EventSessionService
+}
+
+func NewEventSessionController(sessionService *services.EventSessionService) *EventSessionController {
+	return &EventSessionController{
+		sessionService: sessionService,
+	}
+}
+
+// sessionRequest is the request body for creating or updating a session
+type sessionRequest struct {
+	Name         string `json:"name"`
+	DoorsOpenAt  string `json:"doors_open_at"`
+	StartsAt     string `json:"starts_at"`
+	EndsAt       string `json:"ends_at"`
+	Capacity     int    `json:"capacity"`
+	AllowReentry bool   `json:"allow_reentry"`
+	IsActive     *bool  `json:"is_active"`
+}
+
+// toInput parses the session times; the returned string is the user-facing error
+func (req sessionRequest) toInput() (services.EventSessionInput, string) {
+	startsAt, err := time.Parse(time.RFC3339, req.StartsAt)
+	if err != nil {
+		return services.EventSessionInput{}, "starts_at RFC3339 formatında olmalı"
+	}
+	endsAt, err := time.Parse(time.RFC3339, req.EndsAt)
+	if err != nil {
+		return services.EventSessionInput{}, "ends_at RFC3339 formatında olmalı"
+	}
+
+	var doorsOpenAt *time.Time
+	if req.DoorsOpenAt != "" {
+		opens, err := time.Parse(time.RFC3339, req.DoorsOpenAt)
+		if err != nil {
+			return services.EventSessionInput{}, "doors_open_at RFC3339 formatında olmalı"
+		}
+		doorsOpenAt = &opens
+	}
+
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	return services.EventSessionInput{
+		Name:         req.Name,
+		DoorsOpenAt:  doorsOpenAt,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		Capacity:     req.Capacity,
+		AllowReentry: req.AllowReentry,
+		IsActive:     isActive,
+	}, ""
+}
+
+// Create handles POST /events/:id/sessions (admin)
+func (c *EventSessionController) Create(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req sessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	input, message := req.toInput()
+	if message != "" {
+		respondError(w, http.StatusBadRequest, message)
+		return
+	}
+
+	// 2. Call service
+	session, err := c.sessionService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).CreateSession(eventID, input)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, session)
+}
+
+// Update handles PUT /event-sessions/:id (admin)
+func (c *EventSessionController) Update(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	sessionID, err := parseIDFromPath(r.URL.Path, "/event-sessions/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req sessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	input, message := req.toInput()
+	if message != "" {
+		respondError(w, http.StatusBadRequest, message)
+		return
+	}
+
+	// 2. Call service
+	session, err := c.sessionService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).UpdateSession(sessionID, input)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, session)
+}
+
+// List handles GET /events/:id/sessions
+func (c *EventSessionController) List(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	sessions, err := c.sessionService.ListEventSessions(eventID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, sessions)
+}
+
+// Grant handles POST /tickets/:id/sessions (empty session_ids = full pass)
+func (c *EventSessionController) Grant(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	ticketID, err := parseIDFromPath(r.URL.Path, "/tickets/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		SessionIDs []int64 `json:"session_ids"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	entitlements, err := c.sessionService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).GrantEntitlements(userID, ticketID, req.SessionIDs)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, entitlements)
+}
+
+// Entitlements handles GET /tickets/:id/sessions
+func (c *EventSessionController) Entitlements(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	ticketID, err := parseIDFromPath(r.URL.Path, "/tickets/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	entitlements, err := c.sessionService.ForOrganizer(getOrganizerScope(r)).GetTicketEntitlements(ticketID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, entitlements)
+}
@@ -2,10 +2,16 @@ package controllers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+
+	conduitReq "github.com/biyonik/event-ticketing-api/internal/http/request"
+	"github.com/biyonik/event-ticketing-api/internal/http/response"
+	"github.com/biyonik/event-ticketing-api/internal/middleware"
+	"github.com/biyonik/event-ticketing-api/internal/models"
 )
 
 // respondJSON sends a JSON response
@@ -34,9 +40,98 @@ func parseIDFromPath(path, prefix string) (int64, error) {
 	return id, nil
 }
 
-// getUserIDFromContext extracts user ID from request context
-// In a real app, this would come from JWT middleware
+// getUserIDFromContext returns the authenticated user's ID set by middleware.Auth
+// (0 for unauthenticated requests)
 func getUserIDFromContext(r *http.Request) int64 {
-	// Placeholder: In real app, extract from JWT token
-	return 1 // Hardcoded for demo
+	return middleware.GetUserID(r.Context())
+}
+
+// getUserEmailFromContext returns the authenticated user's email set by middleware.Auth
+// (empty for unauthenticated requests). Never take the buyer's email from the request body.
+func getUserEmailFromContext(r *http.Request) string {
+	return middleware.GetUserEmail(r.Context())
+}
+
+// getOrganizerScope builds the scope of the request: the organizer member set by
+// middleware.Organizer, the platform admin or the authenticated customer.
+// Unauthenticated requests get the zero scope, which services refuse.
+func getOrganizerScope(r *http.Request) models.OrganizerScope {
+	userID := getUserIDFromContext(r)
+	if organizerID := middleware.GetOrganizerID(r.Context()); organizerID > 0 {
+		return models.MemberScope(organizerID, userID, models.OrganizerRole(middleware.GetOrganizerRole(r.Context())))
+	}
+
+	switch {
+	case userID == 0:
+		return models.OrganizerScope{}
+	case middleware.GetUserRole(r.Context()) == "admin":
+		return models.PlatformScope(userID)
+	default:
+		return models.CustomerScope(userID)
+	}
+}
+
+// getAuditActor builds the audit actor of the request: the user, client IP and
+// the request ID set by middleware.RequestID
+func getAuditActor(r *http.Request) models.AuditActor {
+	return models.UserActor(getUserIDFromContext(r), conduitReq.New(r).GetIP(), middleware.GetRequestID(r.Context()))
+}
+
+// respondServiceError maps service errors to HTTP responses:
+// optimistic locking conflicts become 409, everything else 400
+func respondServiceError(w http.ResponseWriter, err error) {
+	var conflictErr *models.ConflictError
+	if errors.As(err, &conflictErr) {
+		response.Conflict(w, err.Error())
+		return
+	}
+
+	respondError(w, http.StatusBadRequest, err.Error())
+}
+
+// setETag sets the strong ETag of a versioned resource
+// Example: version 3 → ETag: "3"
+func setETag(w http.ResponseWriter, version int64) {
+	w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// errWeakIfMatch is returned by parseIfMatch for a weak entity tag: If-Match uses the
+// strong comparison (RFC 9110 §13.1.1), so a weak tag never matches
+var errWeakIfMatch = errors.New("If-Match zayıf ETag (W/) ile eşleşmez")
+
+// parseIfMatch reads the expected version from the If-Match header.
+// Returns 0 (no precondition) when the header is missing or "*".
+// Accepts only the strong ETag set by setETag ("3"); a weak tag (W/"3") returns errWeakIfMatch.
+func parseIfMatch(r *http.Request) (int64, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	if strings.HasPrefix(header, "W/") {
+		return 0, errWeakIfMatch
+	}
+
+	unquoted, err := strconv.Unquote(header)
+	if err != nil {
+		return 0, fmt.Errorf("invalid If-Match header")
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("invalid If-Match header")
+	}
+
+	return version, nil
+}
+
+// respondIfMatchError maps parseIfMatch errors: a weak tag fails the precondition (412),
+// a malformed header is rejected (400)
+func respondIfMatchError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errWeakIfMatch) {
+		respondError(w, http.StatusPreconditionFailed, err.Error())
+		return
+	}
+
+	respondError(w, http.StatusBadRequest, "geçersiz If-Match başlığı")
 }
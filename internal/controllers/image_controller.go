@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// multipartOverhead - dosya dışındaki multipart alanları ve sınırlar için pay (1 MB)
+const multipartOverhead = 1 << 20
+
+// ImageController handles HTTP requests for event and venue image uploads
+type ImageController struct {
+	imageService *services.ImageService
+}
+
+func NewImageController(imageService *services.ImageService) *ImageController {
+	return &ImageController{
+		imageService: imageService,
+	}
+}
+
+// UploadEventImage handles POST /events/:id/image (multipart/form-data, field "image")
+func (c *ImageController) UploadEventImage(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	service := c.imageService.ForOrganizer(getOrganizerScope(r))
+	filename, data, status, err := readImageUpload(w, r, service.MaxUploadSize())
+	if err != nil {
+		respondError(w, status, err.Error())
+		return
+	}
+
+	// 2. Call service
+	variants, err := service.UploadEventImage(eventID, filename, data)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, variants)
+}
+
+// UploadVenueImage handles POST /venues/:id/image (multipart/form-data, field "image")
+func (c *ImageController) UploadVenueImage(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	venueID, err := parseIDFromPath(r.URL.Path, "/venues/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	service := c.imageService.ForOrganizer(getOrganizerScope(r))
+	filename, data, status, err := readImageUpload(w, r, service.MaxUploadSize())
+	if err != nil {
+		respondError(w, status, err.Error())
+		return
+	}
+
+	// 2. Call service
+	variants, err := service.UploadVenueImage(venueID, filename, data)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, variants)
+}
+
+// GetEventImages handles GET /events/:id/images
+func (c *ImageController) GetEventImages(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	variants, err := c.imageService.ForOrganizer(getOrganizerScope(r)).GetEventImages(eventID)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, variants)
+}
+
+// GetVenueImages handles GET /venues/:id/images
+func (c *ImageController) GetVenueImages(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	venueID, err := parseIDFromPath(r.URL.Path, "/venues/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	variants, err := c.imageService.ForOrganizer(getOrganizerScope(r)).GetVenueImages(venueID)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, variants)
+}
+
+// readImageUpload reads the "image" file of a multipart request, enforcing the size limit
+// before the body is buffered. Returns the HTTP status to use on error.
+func readImageUpload(w http.ResponseWriter, r *http.Request, maxSize int64) (string, []byte, int, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxSize+multipartOverhead)
+
+	if err := r.ParseMultipartForm(multipartOverhead); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return "", nil, http.StatusRequestEntityTooLarge, errors.New("dosya çok büyük")
+		}
+		return "", nil, http.StatusBadRequest, errors.New("multipart/form-data bekleniyor")
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("image")
+	if err != nil {
+		return "", nil, http.StatusBadRequest, errors.New("image alanı zorunlu")
+	}
+	defer file.Close()
+
+	if header.Size > maxSize {
+		return "", nil, http.StatusRequestEntityTooLarge, errors.New("dosya çok büyük")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, maxSize+1))
+	if err != nil {
+		return "", nil, http.StatusBadRequest, errors.New("dosya okunamadı")
+	}
+	if int64(len(data)) > maxSize {
+		return "", nil, http.StatusRequestEntityTooLarge, errors.New("dosya çok büyük")
+	}
+
+	return header.Filename, data, http.StatusOK, nil
+}
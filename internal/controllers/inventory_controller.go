@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// InventoryController handles admin-triggered available_seats reconciliation
+type InventoryController struct {
+	inventoryService *services.InventoryService
+}
+
+func NewInventoryController(inventoryService *services.InventoryService) *InventoryController {
+	return &InventoryController{
+		inventoryService: inventoryService,
+	}
+}
+
+// Reconcile handles POST /admin/inventory/reconcile?dry_run=false
+// Reports drifted counters of all events; corrects them only with dry_run=false.
+func (c *InventoryController) Reconcile(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	dryRun, err := parseReconcileDryRun(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz dry_run değeri")
+		return
+	}
+
+	// 2. Call service
+	report, err := c.inventoryService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).Reconcile(dryRun)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, report)
+}
+
+// ReconcileEvent handles POST /admin/inventory/reconcile/events/:id?dry_run=false
+func (c *InventoryController) ReconcileEvent(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	id, err := parseIDFromPath(r.URL.Path, "/admin/inventory/reconcile/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	dryRun, err := parseReconcileDryRun(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz dry_run değeri")
+		return
+	}
+
+	// 2. Call service
+	report, err := c.inventoryService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).ReconcileEvent(id, dryRun)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, report)
+}
+
+// parseReconcileDryRun reads ?dry_run=; reconciliation only reports unless dry_run=false is sent
+func parseReconcileDryRun(r *http.Request) (bool, error) {
+	value := r.URL.Query().Get("dry_run")
+	if value == "" {
+		return true, nil
+	}
+
+	return strconv.ParseBool(value)
+}
@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// OrganizerController handles HTTP requests for organizer accounts and their members
+type OrganizerController struct {
+	organizerService *services.OrganizerService
+}
+
+func NewOrganizerController(organizerService *services.OrganizerService) *OrganizerController {
+	return &OrganizerController{
+		organizerService: organizerService,
+	}
+}
+
+// parseMemberPath extracts organizer and user IDs from /organizers/:id/members/:userId
+func parseMemberPath(path string) (int64, int64, error) {
+	organizerID, err := parseIDFromPath(path, "/organizers/")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	idx := strings.Index(path, "/members/")
+	if idx < 0 {
+		return 0, 0, fmt.Errorf("invalid ID")
+	}
+
+	userID, err := parseIDFromPath(path[idx:], "/members/")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return organizerID, userID, nil
+}
+
+// Create handles POST /organizers
+func (c *OrganizerController) Create(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	var req struct {
+		Name         string `json:"name"`
+		Slug         string `json:"slug"`
+		ContactEmail string `json:"contact_email"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	organizer, err := c.organizerService.CreateOrganizer(userID, req.Name, req.Slug, req.ContactEmail)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, organizer)
+}
+
+// MyOrganizers handles GET /organizers/mine
+func (c *OrganizerController) MyOrganizers(w http.ResponseWriter, r *http.Request) {
+	// 1. Get user ID
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	memberships, err := c.organizerService.GetUserOrganizers(userID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, memberships)
+}
+
+// ListMembers handles GET /organizers/:id/members (owner)
+func (c *OrganizerController) ListMembers(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	organizerID, err := parseIDFromPath(r.URL.Path, "/organizers/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	members, err := c.organizerService.ListMembers(getOrganizerScope(r), organizerID)
+	if err != nil {
+		respondError(w, http.StatusForbidden, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, members)
+}
+
+// AddMember handles POST /organizers/:id/members (owner)
+func (c *OrganizerController) AddMember(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	organizerID, err := parseIDFromPath(r.URL.Path, "/organizers/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		UserID int64                `json:"user_id"`
+		Role   models.OrganizerRole `json:"role"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	member, err := c.organizerService.AddMember(getOrganizerScope(r), organizerID, req.UserID, req.Role)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, member)
+}
+
+// UpdateMember handles PUT /organizers/:id/members/:userId (owner)
+func (c *OrganizerController) UpdateMember(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	organizerID, userID, err := parseMemberPath(r.URL.Path)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Role models.OrganizerRole `json:"role"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	member, err := c.organizerService.UpdateMemberRole(getOrganizerScope(r), organizerID, userID, req.Role)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, member)
+}
+
+// RemoveMember handles DELETE /organizers/:id/members/:userId (owner)
+func (c *OrganizerController) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse IDs
+	organizerID, userID, err := parseMemberPath(r.URL.Path)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	if err := c.organizerService.RemoveMember(getOrganizerScope(r), organizerID, userID); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "üye çıkarıldı"})
+}
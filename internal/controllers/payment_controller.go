@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// PaymentController handles HTTP requests for payments (admin / organizer)
+type PaymentController struct {
+	reservationService *services.ReservationService
+}
+
+func NewPaymentController(reservationService *services.ReservationService) *PaymentController {
+	return &PaymentController{
+		reservationService: reservationService,
+	}
+}
+
+// GetByID handles GET /payments/:id
+func (c *PaymentController) GetByID(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	id, err := parseIDFromPath(r.URL.Path, "/payments/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	payment, err := c.reservationService.ForOrganizer(getOrganizerScope(r)).GetPaymentByID(id)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	setETag(w, payment.Version)
+	respondJSON(w, http.StatusOK, payment)
+}
+
+// Refund handles POST /payments/:id/refund
+// Honours If-Match: a stale ETag returns 409 Conflict instead of refunding a payment that changed
+func (c *PaymentController) Refund(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	id, err := parseIDFromPath(r.URL.Path, "/payments/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		respondIfMatchError(w, err)
+		return
+	}
+
+	var req struct {
+		UserEmail string `json:"user_email"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	payment, err := c.reservationService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).RefundPayment(id, expectedVersion, req.UserEmail)
+	if err != nil {
+		respondServiceError(w, err)
+		return
+	}
+
+	// 3. Return response
+	setETag(w, payment.Version)
+	respondJSON(w, http.StatusOK, payment)
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// PurchaseLimitController handles HTTP requests for per-event purchase limits (admin)
+type PurchaseLimitController struct {
+	purchaseLimitService *services.PurchaseLimitService
+}
+
+func NewPurchaseLimitController(purchaseLimitService *services.PurchaseLimitService) *PurchaseLimitController {
+	return &PurchaseLimitController{
+		purchaseLimitService: purchaseLimitService,
+	}
+}
+
+// Get handles GET /events/:id/purchase-limits
+func (c *PurchaseLimitController) Get(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	limit, err := c.purchaseLimitService.GetLimits(eventID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if limit == nil {
+		respondError(w, http.StatusNotFound, "bu etkinlik için alım limiti tanımlı değil")
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, limit)
+}
+
+// Set handles PUT /events/:id/purchase-limits
+func (c *PurchaseLimitController) Set(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID and request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		MaxPerUser    *int `json:"max_per_user"`
+		MaxPerCard    *int `json:"max_per_card"`
+		MaxPerAddress *int `json:"max_per_address"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	limit, err := c.purchaseLimitService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).SetLimits(eventID, req.MaxPerUser, req.MaxPerCard, req.MaxPerAddress)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, limit)
+}
+
+// Delete handles DELETE /events/:id/purchase-limits
+func (c *PurchaseLimitController) Delete(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	if err := c.purchaseLimitService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).RemoveLimits(eventID); err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "alım limitleri kaldırıldı"})
+}
@@ -0,0 +1,219 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// SalePhaseController handles HTTP requests for sale phases and presale access
+type SalePhaseController struct {
+	salePhaseService *services.SalePhaseService
+}
+
+func NewSalePhaseController(salePhaseService *services.SalePhaseService) *SalePhaseController {
+	return &SalePhaseController{
+		salePhaseService: salePhaseService,
+	}
+}
+
+// Create handles POST /events/:id/sale-phases (admin)
+func (c *SalePhaseController) Create(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Name       string                     `json:"name"`
+		AccessType models.SalePhaseAccessType `json:"access_type"`
+		Segment    string                     `json:"segment"`
+		Allocation *int                       `json:"allocation"`
+		StartsAt   string                     `json:"starts_at"`
+		EndsAt     string                     `json:"ends_at"`
+		SortOrder  int                        `json:"sort_order"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	startsAt, err := time.Parse(time.RFC3339, req.StartsAt)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "starts_at RFC3339 formatında olmalı")
+		return
+	}
+	endsAt, err := time.Parse(time.RFC3339, req.EndsAt)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ends_at RFC3339 formatında olmalı")
+		return
+	}
+
+	// 2. Call service
+	phase, err := c.salePhaseService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).CreatePhase(
+		eventID, req.Name, req.AccessType, req.Segment, req.Allocation,
+		startsAt, endsAt, req.SortOrder,
+	)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, phase)
+}
+
+// List handles GET /events/:id/sale-phases
+func (c *SalePhaseController) List(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	phases, err := c.salePhaseService.ListPhases(eventID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, phases)
+}
+
+// Delete handles DELETE /sale-phases/:id (admin)
+func (c *SalePhaseController) Delete(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	phaseID, err := parseIDFromPath(r.URL.Path, "/sale-phases/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	if err := c.salePhaseService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).DeletePhase(phaseID); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "satış aşaması silindi"})
+}
+
+// GenerateCodes handles POST /sale-phases/:id/codes (admin)
+func (c *SalePhaseController) GenerateCodes(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	phaseID, err := parseIDFromPath(r.URL.Path, "/sale-phases/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Count   int `json:"count"`
+		MaxUses int `json:"max_uses"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	codes, err := c.salePhaseService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).GenerateCodes(phaseID, req.Count, req.MaxUses)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, map[string]interface{}{"codes": codes})
+}
+
+// AddToAllowList handles POST /sale-phases/:id/allow-list (admin)
+func (c *SalePhaseController) AddToAllowList(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	phaseID, err := parseIDFromPath(r.URL.Path, "/sale-phases/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Emails []string `json:"emails"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	added, err := c.salePhaseService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).AddToAllowList(phaseID, req.Emails)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]int{"added": added})
+}
+
+// AssignSegment handles POST /users/:id/segments (admin)
+func (c *SalePhaseController) AssignSegment(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	userID, err := parseIDFromPath(r.URL.Path, "/users/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Segment string `json:"segment"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	if err := c.salePhaseService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).AssignSegment(userID, req.Segment); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "segment atandı"})
+}
+
+// RemoveSegment handles DELETE /users/:id/segments/:segment (admin)
+func (c *SalePhaseController) RemoveSegment(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	userID, err := parseIDFromPath(r.URL.Path, "/users/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	segment := parts[len(parts)-1]
+
+	// 2. Call service
+	if err := c.salePhaseService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).RemoveSegment(userID, segment); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "segment kaldırıldı"})
+}
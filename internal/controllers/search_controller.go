@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// SearchController handles HTTP requests for full-text event search
+type SearchController struct {
+	searchService *services.SearchService
+}
+
+func NewSearchController(searchService *services.SearchService) *SearchController {
+	return &SearchController{
+		searchService: searchService,
+	}
+}
+
+// Events handles GET /search/events?q=&type=&city=&date=&price=&page=&page_size=
+func (c *SearchController) Events(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse query parameters
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+
+	req := services.SearchRequest{
+		Text:       query.Get("q"),
+		Type:       query.Get("type"),
+		City:       query.Get("city"),
+		DateBucket: query.Get("date"),
+		PriceRange: query.Get("price"),
+		Page:       page,
+		PageSize:   pageSize,
+	}
+
+	// 2. Call service
+	result, err := c.searchService.Search(req)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, result)
+}
+
+// Reindex handles POST /admin/search/reindex (platform admin)
+func (c *SearchController) Reindex(w http.ResponseWriter, r *http.Request) {
+	// 1. Call service
+	indexed, err := c.searchService.ForOrganizer(getOrganizerScope(r)).ReindexAll()
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 2. Return response
+	respondJSON(w, http.StatusOK, map[string]int{"indexed": indexed})
+}
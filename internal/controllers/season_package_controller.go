@@ -0,0 +1,252 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// SeasonPackageController handles HTTP requests for season packages and memberships
+type SeasonPackageController struct {
+	seasonPackageService *services.SeasonPackageService
+}
+
+func NewSeasonPackageController(seasonPackageService *services.SeasonPackageService) *SeasonPackageController {
+	return &SeasonPackageController{
+		seasonPackageService: seasonPackageService,
+	}
+}
+
+// Create handles POST /season-packages (admin)
+func (c *SeasonPackageController) Create(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	var req struct {
+		VenueID           int64   `json:"venue_id"`
+		Name              string  `json:"name"`
+		Season            string  `json:"season"`
+		Price             float64 `json:"price"`
+		EventIDs          []int64 `json:"event_ids"`
+		SaleStartsAt      string  `json:"sale_starts_at"`
+		SaleEndsAt        string  `json:"sale_ends_at"`
+		PreviousPackageID *int64  `json:"previous_package_id"`
+		RenewalDeadline   string  `json:"renewal_deadline"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	saleStartsAt, err := time.Parse(time.RFC3339, req.SaleStartsAt)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "sale_starts_at RFC3339 formatında olmalı")
+		return
+	}
+	saleEndsAt, err := time.Parse(time.RFC3339, req.SaleEndsAt)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "sale_ends_at RFC3339 formatında olmalı")
+		return
+	}
+
+	var renewalDeadline *time.Time
+	if req.RenewalDeadline != "" {
+		deadline, err := time.Parse(time.RFC3339, req.RenewalDeadline)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "renewal_deadline RFC3339 formatında olmalı")
+			return
+		}
+		renewalDeadline = &deadline
+	}
+
+	// 2. Call service
+	pkg, err := c.seasonPackageService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).CreatePackage(services.SeasonPackageInput{
+		VenueID:           req.VenueID,
+		Name:              req.Name,
+		Season:            req.Season,
+		Price:             req.Price,
+		EventIDs:          req.EventIDs,
+		SaleStartsAt:      saleStartsAt,
+		SaleEndsAt:        saleEndsAt,
+		PreviousPackageID: req.PreviousPackageID,
+		RenewalDeadline:   renewalDeadline,
+	})
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, pkg)
+}
+
+// Get handles GET /season-packages/:id
+func (c *SeasonPackageController) Get(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	packageID, err := parseIDFromPath(r.URL.Path, "/season-packages/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	pkg, err := c.seasonPackageService.GetPackage(packageID)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, pkg)
+}
+
+// ListByVenue handles GET /venues/:id/season-packages
+func (c *SeasonPackageController) ListByVenue(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	venueID, err := parseIDFromPath(r.URL.Path, "/venues/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	packages, err := c.seasonPackageService.ListVenuePackages(venueID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, packages)
+}
+
+// UpdateStatus handles PUT /season-packages/:id/status (admin)
+func (c *SeasonPackageController) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	packageID, err := parseIDFromPath(r.URL.Path, "/season-packages/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		Status models.SeasonPackageStatus `json:"status"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	if err := c.seasonPackageService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).UpdateStatus(packageID, req.Status); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "paket durumu güncellendi"})
+}
+
+// Purchase handles POST /season-packages/:id/purchase
+func (c *SeasonPackageController) Purchase(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	packageID, err := parseIDFromPath(r.URL.Path, "/season-packages/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		SectionID int64  `json:"section_id"`
+		SeatID    *int64 `json:"seat_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	membership, err := c.seasonPackageService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).Purchase(userID, packageID, req.SectionID, req.SeatID)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, membership)
+}
+
+// Renew handles POST /season-memberships/:id/renew
+func (c *SeasonPackageController) Renew(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	membershipID, err := parseIDFromPath(r.URL.Path, "/season-memberships/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		PackageID int64 `json:"package_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	membership, err := c.seasonPackageService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).Renew(userID, membershipID, req.PackageID)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, membership)
+}
+
+// MyMemberships handles GET /season-memberships
+func (c *SeasonPackageController) MyMemberships(w http.ResponseWriter, r *http.Request) {
+	// 1. Get user ID
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	memberships, err := c.seasonPackageService.ForOrganizer(getOrganizerScope(r)).GetUserMemberships(userID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, memberships)
+}
+
+// GetMembership handles GET /season-memberships/:id (per-event entry tickets included)
+func (c *SeasonPackageController) GetMembership(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	membershipID, err := parseIDFromPath(r.URL.Path, "/season-memberships/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	membership, err := c.seasonPackageService.ForOrganizer(getOrganizerScope(r)).GetMembership(userID, membershipID)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, membership)
+}
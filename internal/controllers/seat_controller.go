@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// SeatController handles HTTP requests for seat maps, best-available allocation and companion seats
+type SeatController struct {
+	seatService *services.SeatService
+}
+
+func NewSeatController(seatService *services.SeatService) *SeatController {
+	return &SeatController{
+		seatService: seatService,
+	}
+}
+
+// parseSeatQuery reads the optional section_id and the attributes / exclude filter
+func parseSeatQuery(r *http.Request) (int64, models.SeatFilter, error) {
+	query := r.URL.Query()
+
+	var sectionID int64
+	if value := query.Get("section_id"); value != "" {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, models.SeatFilter{}, fmt.Errorf("geçersiz section_id değeri")
+		}
+		sectionID = id
+	}
+
+	filter, err := models.ParseSeatFilter(query.Get("attributes"), query.Get("exclude"))
+	if err != nil {
+		return 0, models.SeatFilter{}, err
+	}
+
+	return sectionID, filter, nil
+}
+
+// SeatMap handles GET /events/:id/seat-map?section_id=&attributes=&exclude=
+func (c *SeatController) SeatMap(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	sectionID, filter, err := parseSeatQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 2. Call service
+	seatMap, err := c.seatService.GetSeatMap(eventID, sectionID, getUserIDFromContext(r), filter)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, seatMap)
+}
+
+// BestAvailable handles GET /events/:id/best-available?quantity=&section_id=&attributes=&exclude=
+func (c *SeatController) BestAvailable(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	sectionID, filter, err := parseSeatQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	quantity := 1
+	if value := r.URL.Query().Get("quantity"); value != "" {
+		quantity, err = strconv.Atoi(value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "geçersiz quantity değeri")
+			return
+		}
+	}
+
+	// 2. Call service
+	seats, err := c.seatService.FindBestAvailable(eventID, sectionID, getUserIDFromContext(r), quantity, filter)
+	if err != nil {
+		respondError(w, http.StatusConflict, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, seats)
+}
+
+// LinkCompanion handles PUT /seats/:id/companion
+func (c *SeatController) LinkCompanion(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	seatID, err := parseIDFromPath(r.URL.Path, "/seats/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		CompanionSeatID int64 `json:"companion_seat_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	if err := c.seatService.ForOrganizer(getOrganizerScope(r)).LinkCompanion(seatID, req.CompanionSeatID); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "refakatçi koltuğu bağlandı"})
+}
+
+// UnlinkCompanion handles DELETE /seats/:id/companion
+func (c *SeatController) UnlinkCompanion(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	seatID, err := parseIDFromPath(r.URL.Path, "/seats/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	if err := c.seatService.ForOrganizer(getOrganizerScope(r)).UnlinkCompanion(seatID); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "refakatçi bağı kaldırıldı"})
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// SettlementController handles HTTP requests for organizer payout statements
+type SettlementController struct {
+	settlementService *services.SettlementService
+}
+
+func NewSettlementController(settlementService *services.SettlementService) *SettlementController {
+	return &SettlementController{
+		settlementService: settlementService,
+	}
+}
+
+// Generate handles POST /admin/settlements (platform admin)
+func (c *SettlementController) Generate(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	var req struct {
+		OrganizerID int64  `json:"organizer_id"`
+		Currency    string `json:"currency"`
+		PeriodStart string `json:"period_start"`
+		PeriodEnd   string `json:"period_end"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	periodStart, err := time.Parse(time.RFC3339, req.PeriodStart)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "period_start RFC3339 formatında olmalı")
+		return
+	}
+	periodEnd, err := time.Parse(time.RFC3339, req.PeriodEnd)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "period_end RFC3339 formatında olmalı")
+		return
+	}
+
+	// 2. Call service
+	statement, err := c.settlementService.ForOrganizer(getOrganizerScope(r)).
+		GenerateStatement(req.OrganizerID, req.Currency, periodStart, periodEnd)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, statement)
+}
+
+// List handles GET /settlements?organizer_id=
+func (c *SettlementController) List(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse query (organizer members always get their own organizer)
+	var organizerID int64
+	if value := r.URL.Query().Get("organizer_id"); value != "" {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "geçersiz organizer_id")
+			return
+		}
+		organizerID = id
+	}
+
+	// 2. Call service
+	statements, err := c.settlementService.ForOrganizer(getOrganizerScope(r)).ListStatements(organizerID)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, statements)
+}
+
+// Get handles GET /settlements/:id (?format=csv for a CSV export of the lines)
+func (c *SettlementController) Get(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	id, err := parseIDFromPath(r.URL.Path, "/settlements/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	statement, err := c.settlementService.ForOrganizer(getOrganizerScope(r)).GetStatement(id)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		respondJSON(w, http.StatusOK, statement)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition",
+			fmt.Sprintf(`attachment; filename="settlement-%d-%s.csv"`, statement.ID, statement.PeriodStart.Format("2006-01-02")))
+		w.WriteHeader(http.StatusOK)
+		services.WriteStatementCSV(w, statement)
+	default:
+		respondError(w, http.StatusBadRequest, "format csv veya json olmalı")
+	}
+}
+
+// MarkPaid handles POST /admin/settlements/:id/paid (platform admin)
+func (c *SettlementController) MarkPaid(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	id, err := parseIDFromPath(r.URL.Path, "/admin/settlements/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	var req struct {
+		PayoutReference string `json:"payout_reference"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	// 2. Call service
+	statement, err := c.settlementService.ForOrganizer(getOrganizerScope(r)).MarkPaid(id, req.PayoutReference)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, statement)
+}
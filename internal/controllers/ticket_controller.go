@@ -2,8 +2,10 @@ package controllers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
+	"github.com/biyonik/event-ticketing-api/internal/models"
 	"github.com/biyonik/event-ticketing-api/internal/services"
 )
 
@@ -22,9 +24,42 @@ func NewTicketController(ticketService *services.TicketService) *TicketControlle
 func (c *TicketController) Reserve(w http.ResponseWriter, r *http.Request) {
 	// 1. Parse request
 	var req struct {
-		EventID   int64  `json:"event_id"`
-		SectionID int64  `json:"section_id"`
-		SeatID    *int64 `json:"seat_id"`
+		EventID    int64   `json:"event_id"`
+		SectionID  int64   `json:"section_id"`
+		SeatID     *int64  `json:"seat_id"`
+		Price      float64 `json:"price"`
+		AccessCode string  `json:"access_code"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	scope := getOrganizerScope(r)
+
+	// 2. Call service (customers reserve for themselves)
+	ticket, err := c.ticketService.ForOrganizer(scope).WithActor(getAuditActor(r)).ReserveTicketWithOptions(scope.UserID, req.EventID, req.SectionID, req.SeatID, req.Price, services.ReserveOptions{
+		Email:      getUserEmailFromContext(r),
+		AccessCode: req.AccessCode,
+	})
+	if err != nil {
+		respondReserveError(w, err)
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, ticket)
+}
+
+// BoxOfficeReserve handles POST /admin/tickets/reserve (admin, bypasses purchase limits)
+func (c *TicketController) BoxOfficeReserve(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	var req struct {
+		UserID    int64   `json:"user_id"`
+		EventID   int64   `json:"event_id"`
+		SectionID int64   `json:"section_id"`
+		SeatID    *int64  `json:"seat_id"`
 		Price     float64 `json:"price"`
 	}
 
@@ -33,12 +68,15 @@ func (c *TicketController) Reserve(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userID := getUserIDFromContext(r)
+	adminID := getUserIDFromContext(r)
 
 	// 2. Call service
-	ticket, err := c.ticketService.ReserveTicket(userID, req.EventID, req.SectionID, req.SeatID, req.Price)
+	ticket, err := c.ticketService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).ReserveTicketWithOptions(req.UserID, req.EventID, req.SectionID, req.SeatID, req.Price, services.ReserveOptions{
+		OverrideLimits: true,
+		OverrideBy:     adminID,
+	})
 	if err != nil {
-		respondError(w, http.StatusBadRequest, err.Error())
+		respondReserveError(w, err)
 		return
 	}
 
@@ -46,6 +84,24 @@ func (c *TicketController) Reserve(w http.ResponseWriter, r *http.Request) {
 	respondJSON(w, http.StatusCreated, ticket)
 }
 
+// respondReserveError maps purchase limit violations to 422, everything else like respondServiceError
+func respondReserveError(w http.ResponseWriter, err error) {
+	var limitErr *models.PurchaseLimitError
+	if errors.As(err, &limitErr) {
+		respondJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{
+			"error": limitErr.Error(),
+			"limit": map[string]interface{}{
+				"scope":   limitErr.Scope,
+				"max":     limitErr.Limit,
+				"current": limitErr.Current,
+			},
+		})
+		return
+	}
+
+	respondServiceError(w, err)
+}
+
 // Purchase handles POST /tickets/:id/purchase
 func (c *TicketController) Purchase(w http.ResponseWriter, r *http.Request) {
 	// 1. Parse request
@@ -55,6 +111,12 @@ func (c *TicketController) Purchase(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		respondIfMatchError(w, err)
+		return
+	}
+
 	var req struct {
 		UserEmail string `json:"user_email"`
 		UserPhone string `json:"user_phone"`
@@ -66,12 +128,14 @@ func (c *TicketController) Purchase(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	if err := c.ticketService.PurchaseTicket(id, req.UserEmail, req.UserPhone); err != nil {
-		respondError(w, http.StatusBadRequest, err.Error())
+	ticket, err := c.ticketService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).PurchaseTicket(id, expectedVersion, req.UserEmail, req.UserPhone)
+	if err != nil {
+		respondReserveError(w, err) // Card/address limits are checked at purchase
 		return
 	}
 
 	// 3. Return response
+	setETag(w, ticket.Version)
 	respondJSON(w, http.StatusOK, map[string]string{"message": "bilet satın alındı"})
 }
 
@@ -84,6 +148,12 @@ func (c *TicketController) Cancel(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		respondIfMatchError(w, err)
+		return
+	}
+
 	var req struct {
 		UserEmail string `json:"user_email"`
 	}
@@ -94,12 +164,14 @@ func (c *TicketController) Cancel(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	if err := c.ticketService.CancelTicket(id, req.UserEmail); err != nil {
-		respondError(w, http.StatusBadRequest, err.Error())
+	ticket, err := c.ticketService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).CancelTicket(id, expectedVersion, req.UserEmail)
+	if err != nil {
+		respondServiceError(w, err)
 		return
 	}
 
 	// 3. Return response
+	setETag(w, ticket.Version)
 	respondJSON(w, http.StatusOK, map[string]string{"message": "bilet iptal edildi"})
 }
 
@@ -117,7 +189,7 @@ func (c *TicketController) Validate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	ticket, err := c.ticketService.ValidateTicket(req.TicketNumber, req.VerificationCode)
+	ticket, err := c.ticketService.ForOrganizer(getOrganizerScope(r)).ValidateTicket(req.TicketNumber, req.VerificationCode)
 	if err != nil {
 		respondError(w, http.StatusBadRequest, err.Error())
 		return
@@ -143,8 +215,8 @@ func (c *TicketController) Use(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	if err := c.ticketService.UseTicket(req.TicketNumber); err != nil {
-		respondError(w, http.StatusBadRequest, err.Error())
+	if err := c.ticketService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).UseTicket(req.TicketNumber); err != nil {
+		respondServiceError(w, err)
 		return
 	}
 
@@ -158,7 +230,7 @@ func (c *TicketController) GetUserTickets(w http.ResponseWriter, r *http.Request
 	userID := getUserIDFromContext(r)
 
 	// 2. Call service
-	tickets, err := c.ticketService.GetUserTickets(userID)
+	tickets, err := c.ticketService.ForOrganizer(getOrganizerScope(r)).GetUserTickets(userID)
 	if err != nil {
 		respondError(w, http.StatusInternalServerError, err.Error())
 		return
@@ -178,13 +250,14 @@ func (c *TicketController) GetByID(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 2. Call service
-	ticket, err := c.ticketService.GetTicketByID(id)
+	ticket, err := c.ticketService.ForOrganizer(getOrganizerScope(r)).GetTicketByID(id)
 	if err != nil {
 		respondError(w, http.StatusNotFound, err.Error())
 		return
 	}
 
 	// 3. Return response
+	setETag(w, ticket.Version)
 	respondJSON(w, http.StatusOK, ticket)
 }
 
@@ -198,7 +271,7 @@ func (c *TicketController) GetEventStats(w http.ResponseWriter, r *http.Request)
 	}
 
 	// 2. Call service
-	stats, err := c.ticketService.GetEventSalesStats(id)
+	stats, err := c.ticketService.ForOrganizer(getOrganizerScope(r)).GetEventSalesStats(id)
 	if err != nil {
 		respondError(w, http.StatusInternalServerError, err.Error())
 		return
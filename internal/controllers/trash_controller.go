@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// TrashController handles HTTP requests for the admin trash (soft-deleted events and venues)
+type TrashController struct {
+	eventService *services.EventService
+	venueService *services.VenueService
+}
+
+func NewTrashController(eventService *services.EventService, venueService *services.VenueService) *TrashController {
+	return &TrashController{
+		eventService: eventService,
+		venueService: venueService,
+	}
+}
+
+// ListEvents handles GET /admin/trash/events?limit=&offset=
+func (c *TrashController) ListEvents(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+	// 2. Call service
+	events, err := c.eventService.ForOrganizer(getOrganizerScope(r)).ListTrashedEvents(limit, offset)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, events)
+}
+
+// RestoreEvent handles POST /admin/trash/events/:id/restore
+func (c *TrashController) RestoreEvent(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	id, err := parseIDFromPath(r.URL.Path, "/admin/trash/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	event, err := c.eventService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).RestoreEvent(id)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, event)
+}
+
+// ListVenues handles GET /admin/trash/venues?limit=&offset=
+func (c *TrashController) ListVenues(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+	// 2. Call service
+	venues, err := c.venueService.ForOrganizer(getOrganizerScope(r)).ListTrashedVenues(limit, offset)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, venues)
+}
+
+// RestoreVenue handles POST /admin/trash/venues/:id/restore
+func (c *TrashController) RestoreVenue(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	id, err := parseIDFromPath(r.URL.Path, "/admin/trash/venues/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	venue, err := c.venueService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).RestoreVenue(id)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, venue)
+}
@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// VenueController handles HTTP requests for the venue lifecycle
+// (layout imports are handled by VenueLayoutController)
+type VenueController struct {
+	venueService *services.VenueService
+}
+
+func NewVenueController(venueService *services.VenueService) *VenueController {
+	return &VenueController{
+		venueService: venueService,
+	}
+}
+
+// Delete handles DELETE /venues/:id (moves the venue to the trash)
+func (c *VenueController) Delete(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse ID
+	id, err := parseIDFromPath(r.URL.Path, "/venues/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	// 2. Call service
+	if err := c.venueService.ForOrganizer(getOrganizerScope(r)).WithActor(getAuditActor(r)).DeleteVenue(id); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "mekan silindi"})
+}
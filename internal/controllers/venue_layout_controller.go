@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// maxLayoutUploadSize - düzen belgesi için en fazla istek gövdesi (10 MB)
+const maxLayoutUploadSize = 10 << 20
+
+// VenueLayoutController handles HTTP requests for bulk venue layout imports
+type VenueLayoutController struct {
+	layoutService *services.VenueLayoutService
+}
+
+func NewVenueLayoutController(layoutService *services.VenueLayoutService) *VenueLayoutController {
+	return &VenueLayoutController{
+		layoutService: layoutService,
+	}
+}
+
+// Import handles POST /venues/:id/layout?dry_run=true
+// The body is a JSON layout document or, with Content-Type text/csv, one seat per line.
+func (c *VenueLayoutController) Import(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	venueID, err := parseIDFromPath(r.URL.Path, "/venues/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz ID")
+		return
+	}
+
+	dryRun := false
+	if value := r.URL.Query().Get("dry_run"); value != "" {
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "geçersiz dry_run değeri")
+			return
+		}
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxLayoutUploadSize)
+
+	var layout *models.VenueLayout
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		layout, err = services.ParseVenueLayoutCSV(body)
+	case "", "application/json":
+		layout, err = services.ParseVenueLayoutJSON(body)
+	default:
+		respondError(w, http.StatusUnsupportedMediaType, "düzen belgesi application/json veya text/csv olmalı")
+		return
+	}
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 2. Call service
+	diff, err := c.layoutService.ForOrganizer(getOrganizerScope(r)).ImportLayout(venueID, layout, dryRun)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, diff)
+}
@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// WaitingListController handles HTTP requests for waiting-list offers
+type WaitingListController struct {
+	offerService *services.WaitingListOfferService
+}
+
+func NewWaitingListController(offerService *services.WaitingListOfferService) *WaitingListController {
+	return &WaitingListController{
+		offerService: offerService,
+	}
+}
+
+// MyOffers handles GET /waiting-lists/offers
+func (c *WaitingListController) MyOffers(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	offers, err := c.offerService.ForOrganizer(getOrganizerScope(r)).GetUserOffers(userID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, offers)
+}
+
+// ClaimOffer handles POST /waiting-lists/offers/:token/claim
+func (c *WaitingListController) ClaimOffer(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	claimToken := parseOfferToken(r.URL.Path)
+	if claimToken == "" {
+		respondError(w, http.StatusBadRequest, "geçersiz teklif kodu")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	ticket, err := c.offerService.ForOrganizer(getOrganizerScope(r)).ClaimOffer(userID, getUserEmailFromContext(r), claimToken)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusCreated, ticket)
+}
+
+// DeclineOffer handles POST /waiting-lists/offers/:token/decline
+func (c *WaitingListController) DeclineOffer(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	claimToken := parseOfferToken(r.URL.Path)
+	if claimToken == "" {
+		respondError(w, http.StatusBadRequest, "geçersiz teklif kodu")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	if err := c.offerService.ForOrganizer(getOrganizerScope(r)).DeclineOffer(userID, claimToken); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "teklif reddedildi"})
+}
+
+// parseOfferToken extracts the claim token from /waiting-lists/offers/:token/...
+func parseOfferToken(path string) string {
+	return strings.Split(strings.TrimPrefix(path, "/waiting-lists/offers/"), "/")[0]
+}
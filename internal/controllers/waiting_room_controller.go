@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+)
+
+// WaitingRoomController handles HTTP requests for the virtual waiting room
+type WaitingRoomController struct {
+	waitingRoomService *services.WaitingRoomService
+}
+
+func NewWaitingRoomController(waitingRoomService *services.WaitingRoomService) *WaitingRoomController {
+	return &WaitingRoomController{
+		waitingRoomService: waitingRoomService,
+	}
+}
+
+// Open handles POST /events/:id/waiting-room (admin)
+func (c *WaitingRoomController) Open(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid event ID")
+		return
+	}
+
+	var req struct {
+		OpensAt       *time.Time `json:"opens_at"`
+		RatePerMinute int        `json:"rate_per_minute"`
+		Burst         int        `json:"burst"`
+	}
+
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	// 2. Call service
+	room, err := c.waitingRoomService.OpenRoom(eventID, req.OpensAt, req.RatePerMinute, req.Burst)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, room)
+}
+
+// Close handles DELETE /events/:id/waiting-room (admin)
+func (c *WaitingRoomController) Close(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid event ID")
+		return
+	}
+
+	// 2. Call service
+	if err := c.waitingRoomService.CloseRoom(eventID); err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, map[string]string{"message": "bekleme odası kapatıldı"})
+}
+
+// Join handles POST /events/:id/waiting-room/join
+func (c *WaitingRoomController) Join(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid event ID")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	status, err := c.waitingRoomService.Join(userID, eventID)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 3. Return response
+	respondJSON(w, http.StatusOK, status)
+}
+
+// Status handles GET /events/:id/waiting-room/status (polling)
+func (c *WaitingRoomController) Status(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request
+	eventID, err := parseIDFromPath(r.URL.Path, "/events/")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid event ID")
+		return
+	}
+
+	userID := getUserIDFromContext(r)
+
+	// 2. Call service
+	status, err := c.waitingRoomService.GetStatus(userID, eventID)
+	if err != nil {
+		respondError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	// 3. Return response
+	// İstemci bu değere göre polling aralığını ayarlar
+	if !status.Admitted {
+		w.Header().Set("Retry-After", retryAfterSeconds(status.EstimatedWait))
+	}
+	respondJSON(w, http.StatusOK, status)
+}
+
+// retryAfterSeconds suggests a polling interval between 2 and 30 seconds
+func retryAfterSeconds(eta int64) string {
+	interval := eta / 10
+	if interval < 2 {
+		interval = 2
+	}
+	if interval > 30 {
+		interval = 30
+	}
+	return strconv.FormatInt(interval, 10)
+}
@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/biyonik/event-ticketing-api/internal/services"
+	"github.com/biyonik/event-ticketing-api/pkg/webhook"
+)
+
+// maxWebhookBodySize limits provider payloads (1 MB)
+const maxWebhookBodySize = 1 << 20
+
+// WebhookController handles HTTP callbacks from payment providers
+type WebhookController struct {
+	webhookService *services.WebhookService
+}
+
+func NewWebhookController(webhookService *services.WebhookService) *WebhookController {
+	return &WebhookController{
+		webhookService: webhookService,
+	}
+}
+
+// PaymentWebhook handles POST /webhooks/payments/:provider
+func (c *WebhookController) PaymentWebhook(w http.ResponseWriter, r *http.Request) {
+	// 1. Parse request (raw body is required for signature verification)
+	provider := strings.Split(strings.TrimPrefix(r.URL.Path, "/webhooks/payments/"), "/")[0]
+	if provider == "" {
+		respondError(w, http.StatusBadRequest, "sağlayıcı belirtilmedi")
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "geçersiz istek")
+		return
+	}
+
+	signature := r.Header.Get("X-Webhook-Signature")
+
+	// 2. Call service
+	result, err := c.webhookService.HandlePaymentWebhook(provider, body, signature)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrWebhookNotConfigured):
+			// Secret tanımlanmadan gelen webhook'lar doğrulanamaz
+			respondError(w, http.StatusServiceUnavailable, err.Error())
+		case errors.Is(err, webhook.ErrMissingSignature),
+			errors.Is(err, webhook.ErrInvalidSignatureHeader),
+			errors.Is(err, webhook.ErrSignatureMismatch),
+			errors.Is(err, webhook.ErrTimestampOutOfTolerance):
+			respondError(w, http.StatusUnauthorized, "geçersiz imza")
+		case errors.Is(err, services.ErrInvalidWebhookPayload):
+			respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			// 5xx: sağlayıcı webhook'u tekrar gönderir
+			respondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	// 3. Return response (duplicates are acknowledged with 200 as well)
+	respondJSON(w, http.StatusOK, result)
+}
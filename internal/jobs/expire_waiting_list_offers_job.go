@@ -0,0 +1,70 @@
+// -----------------------------------------------------------------------------
+// Expire Waiting List Offers Job
+// -----------------------------------------------------------------------------
+// Claim window'u dolan bekleme listesi tekliflerini kapatır ve koltuğu
+// sıradaki kişiye devreder. Scheduler tarafından periyodik olarak (örn. her
+// dakika) kuyruğa eklenmelidir.
+//
+// Kullanım:
+//
+//	queue.RegisterJob("*jobs.ExpireWaitingListOffersJob", func() queue.Job {
+//	    return jobs.NewExpireWaitingListOffersJob(offerService)
+//	})
+//	q.Later(time.Minute, jobs.NewExpireWaitingListOffersJob(offerService), "default")
+// -----------------------------------------------------------------------------
+
+package jobs
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+	"github.com/biyonik/event-ticketing-api/pkg/queue"
+)
+
+// ExpireWaitingListOffersJob, süresi dolan teklifleri rollover eden job.
+type ExpireWaitingListOffersJob struct {
+	queue.BaseJob
+
+	offerService *services.WaitingListOfferService
+}
+
+// NewExpireWaitingListOffersJob, yeni bir job instance oluşturur.
+func NewExpireWaitingListOffersJob(offerService *services.WaitingListOfferService) *ExpireWaitingListOffersJob {
+	return &ExpireWaitingListOffersJob{
+		BaseJob:      queue.BaseJob{MaxAttempts: 1},
+		offerService: offerService,
+	}
+}
+
+// Handle, süresi dolan teklifleri işler.
+func (j *ExpireWaitingListOffersJob) Handle() error {
+	expired, err := j.offerService.ForOrganizer(models.SystemScope()).ExpireOffers()
+	if err != nil {
+		return err
+	}
+
+	if expired > 0 {
+		log.Printf("⏱️  %d bekleme listesi teklifi sıradaki kişiye devredildi", expired)
+	}
+
+	return nil
+}
+
+// Failed, job başarısız olduğunda çağrılır.
+func (j *ExpireWaitingListOffersJob) Failed(err error) error {
+	log.Printf("❌ Bekleme listesi teklif rollover hatası: %v", err)
+	return nil
+}
+
+// GetPayload, job'ı JSON'a serialize eder (servis bağımlılığı serialize edilmez).
+func (j *ExpireWaitingListOffersJob) GetPayload() ([]byte, error) {
+	return json.Marshal(j.BaseJob)
+}
+
+// SetPayload, JSON'dan job'ı deserialize eder.
+func (j *ExpireWaitingListOffersJob) SetPayload(data []byte) error {
+	return json.Unmarshal(data, &j.BaseJob)
+}
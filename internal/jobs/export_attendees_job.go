@@ -0,0 +1,84 @@
+// -----------------------------------------------------------------------------
+// Export Attendees Job
+// -----------------------------------------------------------------------------
+// Etkinlik katılımcı listesini (CSV / XLSX) üretip storage'a yazar ve
+// isteyen kişiye süreli indirme bağlantısını e-postalar. Büyük etkinliklerde
+// HTTP isteğini bekletmemek için kuyrukta çalışır.
+//
+// Kullanım:
+//
+//	queue.RegisterJob("*jobs.ExportAttendeesJob", func() queue.Job {
+//	    return jobs.NewExportAttendeesJob(exportService, 0)
+//	})
+//	exportService.SetDispatcher(jobs.NewAttendeeExportDispatcher(q, exportService, "exports"))
+// -----------------------------------------------------------------------------
+
+package jobs
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+	"github.com/biyonik/event-ticketing-api/pkg/queue"
+)
+
+// ExportAttendeesJob, tek bir katılımcı listesi dışa aktarımını işleyen job.
+type ExportAttendeesJob struct {
+	queue.BaseJob
+	ExportID int64 `json:"export_id"`
+
+	exportService *services.AttendeeExportService
+}
+
+// NewExportAttendeesJob, yeni bir job instance oluşturur.
+func NewExportAttendeesJob(exportService *services.AttendeeExportService, exportID int64) *ExportAttendeesJob {
+	return &ExportAttendeesJob{
+		BaseJob:       queue.BaseJob{MaxAttempts: 3},
+		ExportID:      exportID,
+		exportService: exportService,
+	}
+}
+
+// Handle, dosyayı üretir ve bağlantıyı gönderir.
+func (j *ExportAttendeesJob) Handle() error {
+	return j.exportService.ForOrganizer(models.SystemScope()).ProcessExport(j.ExportID)
+}
+
+// Failed, tüm denemeler başarısız olduğunda dışa aktarımı failed olarak işaretler.
+func (j *ExportAttendeesJob) Failed(err error) error {
+	log.Printf("❌ Katılımcı listesi dışa aktarım hatası (export %d): %v", j.ExportID, err)
+	return j.exportService.ForOrganizer(models.SystemScope()).MarkExportFailed(j.ExportID, err)
+}
+
+// GetPayload, job'ı JSON'a serialize eder (servis bağımlılığı serialize edilmez).
+func (j *ExportAttendeesJob) GetPayload() ([]byte, error) {
+	return json.Marshal(j)
+}
+
+// SetPayload, JSON'dan job'ı deserialize eder.
+func (j *ExportAttendeesJob) SetPayload(data []byte) error {
+	return json.Unmarshal(data, j)
+}
+
+// AttendeeExportDispatcher, dışa aktarımları kuyruğa ekler (services.AttendeeExportDispatcher).
+type AttendeeExportDispatcher struct {
+	queue         queue.Queue
+	exportService *services.AttendeeExportService
+	queueName     string
+}
+
+// NewAttendeeExportDispatcher, yeni bir dispatcher oluşturur.
+func NewAttendeeExportDispatcher(q queue.Queue, exportService *services.AttendeeExportService, queueName string) *AttendeeExportDispatcher {
+	return &AttendeeExportDispatcher{
+		queue:         q,
+		exportService: exportService,
+		queueName:     queueName,
+	}
+}
+
+// DispatchAttendeeExport, dışa aktarım job'ını kuyruğa ekler.
+func (d *AttendeeExportDispatcher) DispatchAttendeeExport(exportID int64) error {
+	return d.queue.Push(NewExportAttendeesJob(d.exportService, exportID), d.queueName)
+}
@@ -0,0 +1,70 @@
+// -----------------------------------------------------------------------------
+// Purge Audit Logs Job
+// -----------------------------------------------------------------------------
+// Saklama süresi (AUDIT_RETENTION) dolan denetim kayıtlarını toplu olarak
+// siler. Scheduler tarafından periyodik olarak (örn. günde bir) kuyruğa
+// eklenmelidir. Saklama süresi 0 ise kayıtlar silinmez.
+//
+// Kullanım:
+//
+//	queue.RegisterJob("*jobs.PurgeAuditLogsJob", func() queue.Job {
+//	    return jobs.NewPurgeAuditLogsJob(auditService)
+//	})
+//	q.Later(24*time.Hour, jobs.NewPurgeAuditLogsJob(auditService), "default")
+// -----------------------------------------------------------------------------
+
+package jobs
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+	"github.com/biyonik/event-ticketing-api/pkg/queue"
+)
+
+// PurgeAuditLogsJob, süresi dolan denetim kayıtlarını silen job.
+type PurgeAuditLogsJob struct {
+	queue.BaseJob
+
+	auditService *services.AuditService
+}
+
+// NewPurgeAuditLogsJob, yeni bir job instance oluşturur.
+func NewPurgeAuditLogsJob(auditService *services.AuditService) *PurgeAuditLogsJob {
+	return &PurgeAuditLogsJob{
+		BaseJob:      queue.BaseJob{MaxAttempts: 1},
+		auditService: auditService,
+	}
+}
+
+// Handle, süresi dolan kayıtları siler.
+func (j *PurgeAuditLogsJob) Handle() error {
+	purged, err := j.auditService.ForOrganizer(models.SystemScope()).PurgeExpired()
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		log.Printf("🧹 %d eski denetim kaydı silindi", purged)
+	}
+
+	return nil
+}
+
+// Failed, job başarısız olduğunda çağrılır.
+func (j *PurgeAuditLogsJob) Failed(err error) error {
+	log.Printf("❌ Denetim kaydı temizleme hatası: %v", err)
+	return nil
+}
+
+// GetPayload, job'ı JSON'a serialize eder (servis bağımlılığı serialize edilmez).
+func (j *PurgeAuditLogsJob) GetPayload() ([]byte, error) {
+	return json.Marshal(j.BaseJob)
+}
+
+// SetPayload, JSON'dan job'ı deserialize eder.
+func (j *PurgeAuditLogsJob) SetPayload(data []byte) error {
+	return json.Unmarshal(data, &j.BaseJob)
+}
@@ -0,0 +1,89 @@
+// -----------------------------------------------------------------------------
+// Purge Trash Job
+// -----------------------------------------------------------------------------
+// Çöp kutusunda saklama süresinden (TRASH_RETENTION) uzun kalan etkinlik ve
+// mekanları kalıcı olarak siler. Önce etkinlikler silinir; böylece sadece
+// silinmiş etkinlikleri olan mekanlar da aynı çalıştırmada temizlenebilir.
+// Bilet / ödeme kaydı olan etkinlikler ve kullanımdaki mekanlar korunur.
+// Saklama süresi 0 ise hiçbir kayıt silinmez.
+//
+// Kullanım:
+//
+//	queue.RegisterJob("*jobs.PurgeTrashJob", func() queue.Job {
+//	    return jobs.NewPurgeTrashJob(eventService, venueService, cfg.Trash.Retention)
+//	})
+//	q.Later(24*time.Hour, jobs.NewPurgeTrashJob(eventService, venueService, cfg.Trash.Retention), "default")
+// -----------------------------------------------------------------------------
+
+package jobs
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+	"github.com/biyonik/event-ticketing-api/pkg/queue"
+)
+
+// PurgeTrashJob, saklama süresi dolan silinmiş etkinlik ve mekanları kalıcı olarak silen job.
+type PurgeTrashJob struct {
+	queue.BaseJob
+
+	eventService *services.EventService
+	venueService *services.VenueService
+	retention    time.Duration
+}
+
+// NewPurgeTrashJob, yeni bir job instance oluşturur.
+func NewPurgeTrashJob(eventService *services.EventService, venueService *services.VenueService, retention time.Duration) *PurgeTrashJob {
+	return &PurgeTrashJob{
+		BaseJob:      queue.BaseJob{MaxAttempts: 1},
+		eventService: eventService,
+		venueService: venueService,
+		retention:    retention,
+	}
+}
+
+// Handle, saklama süresi dolan kayıtları kalıcı olarak siler.
+func (j *PurgeTrashJob) Handle() error {
+	if j.retention <= 0 {
+		return nil
+	}
+
+	cutoff := time.Now().Add(-j.retention)
+	actor := models.SystemActor("purge_trash")
+
+	events, err := j.eventService.ForOrganizer(models.SystemScope()).WithActor(actor).PurgeTrashedEvents(cutoff)
+	if err != nil {
+		return err
+	}
+
+	venues, err := j.venueService.ForOrganizer(models.SystemScope()).WithActor(actor).PurgeTrashedVenues(cutoff)
+	if err != nil {
+		return err
+	}
+
+	if events > 0 || venues > 0 {
+		log.Printf("🧹 Çöp kutusundan %d etkinlik ve %d mekan kalıcı olarak silindi", events, venues)
+	}
+
+	return nil
+}
+
+// Failed, job başarısız olduğunda çağrılır.
+func (j *PurgeTrashJob) Failed(err error) error {
+	log.Printf("❌ Çöp kutusu temizleme hatası: %v", err)
+	return nil
+}
+
+// GetPayload, job'ı JSON'a serialize eder (servis bağımlılıkları serialize edilmez).
+func (j *PurgeTrashJob) GetPayload() ([]byte, error) {
+	return json.Marshal(j.BaseJob)
+}
+
+// SetPayload, JSON'dan job'ı deserialize eder.
+func (j *PurgeTrashJob) SetPayload(data []byte) error {
+	return json.Unmarshal(data, &j.BaseJob)
+}
@@ -0,0 +1,111 @@
+// -----------------------------------------------------------------------------
+// Reconcile Inventory Job
+// -----------------------------------------------------------------------------
+// Satışı etkileyen tüm etkinliklerin boş koltuk sayacını (available_seats)
+// bilet tablosu, bekleyen bekleme listesi teklifleri ve bloke koltuklardan
+// yeniden hesaplar ve sapmaları loglar. dryRun false ise sapmalar etkinlik
+// satırı kilitlenerek düzeltilir ve denetim kaydına (reconcile) yazılır.
+//
+// Job her çalıştırmadan sonra (başarısız olsa da) kendini interval sonrasına
+// tekrar kuyruğa ekler; bu yüzden uygulama kurulumunda sadece bir kez
+// kuyruğa eklenmelidir. interval 0 ise tek seferlik çalışır. Gecikmeyi
+// bekleyip senkron çalıştıran SyncQueue ile değil, worker'lı bir kuyrukla
+// (Redis) kullanılmalıdır.
+//
+// Kullanım:
+//
+//	queue.RegisterJob("*jobs.ReconcileInventoryJob", func() queue.Job {
+//	    return jobs.NewReconcileInventoryJob(inventoryService, q, cfg.Inventory.ReconcileInterval, !cfg.Inventory.AutoCorrect)
+//	})
+//	q.Later(cfg.Inventory.ReconcileInterval, jobs.NewReconcileInventoryJob(inventoryService, q, cfg.Inventory.ReconcileInterval, !cfg.Inventory.AutoCorrect), "default")
+// -----------------------------------------------------------------------------
+
+package jobs
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/services"
+	"github.com/biyonik/event-ticketing-api/pkg/queue"
+)
+
+// ReconcileInventoryJob, boş koltuk sayaçlarını gerçek envanterle periyodik olarak uzlaştıran job.
+type ReconcileInventoryJob struct {
+	queue.BaseJob
+
+	DryRun   bool          `json:"dry_run"`
+	Interval time.Duration `json:"interval"` // Sonraki çalıştırmaya kadar geçecek süre (0 = tekrar yok)
+
+	inventoryService *services.InventoryService
+	queue            queue.Queue
+}
+
+// NewReconcileInventoryJob, yeni bir job instance oluşturur.
+func NewReconcileInventoryJob(inventoryService *services.InventoryService, q queue.Queue, interval time.Duration, dryRun bool) *ReconcileInventoryJob {
+	return &ReconcileInventoryJob{
+		BaseJob:          queue.BaseJob{MaxAttempts: 1},
+		DryRun:           dryRun,
+		Interval:         interval,
+		inventoryService: inventoryService,
+		queue:            q,
+	}
+}
+
+// Handle, sayaçları kontrol eder ve sapmaları loglar (dryRun değilse düzeltir),
+// ardından bir sonraki çalıştırmayı kuyruğa ekler.
+func (j *ReconcileInventoryJob) Handle() error {
+	defer j.scheduleNext()
+
+	report, err := j.inventoryService.ForOrganizer(models.SystemScope()).WithActor(models.SystemActor("reconcile_inventory")).Reconcile(j.DryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range report.Discrepancies {
+		log.Printf("📦 Envanter sapması (event %d): sayaç %d, beklenen %d, düzeltildi: %t",
+			d.EventID, d.Recorded, d.Expected, d.Corrected)
+	}
+
+	if len(report.Discrepancies) > 0 {
+		log.Printf("📦 Envanter uzlaştırma: %d etkinlik kontrol edildi, %d sapma, %d düzeltme (dry-run: %t)",
+			report.Checked, len(report.Discrepancies), report.Corrected, report.DryRun)
+	}
+
+	return nil
+}
+
+// scheduleNext, job'ı Interval sonrasına aynı kuyruğa tekrar ekler.
+func (j *ReconcileInventoryJob) scheduleNext() {
+	if j.Interval <= 0 || j.queue == nil {
+		return
+	}
+
+	queueName := j.GetQueue()
+	if queueName == "" {
+		queueName = "default"
+	}
+
+	next := NewReconcileInventoryJob(j.inventoryService, j.queue, j.Interval, j.DryRun)
+	if err := j.queue.Later(j.Interval, next, queueName); err != nil {
+		log.Printf("❌ Envanter uzlaştırma tekrar planlanamadı: %v", err)
+	}
+}
+
+// Failed, job başarısız olduğunda çağrılır.
+func (j *ReconcileInventoryJob) Failed(err error) error {
+	log.Printf("❌ Envanter uzlaştırma hatası: %v", err)
+	return nil
+}
+
+// GetPayload, job'ı JSON'a serialize eder (servis ve kuyruk bağımlılıkları serialize edilmez).
+func (j *ReconcileInventoryJob) GetPayload() ([]byte, error) {
+	return json.Marshal(j)
+}
+
+// SetPayload, JSON'dan job'ı deserialize eder.
+func (j *ReconcileInventoryJob) SetPayload(data []byte) error {
+	return json.Unmarshal(data, j)
+}
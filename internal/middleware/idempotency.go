@@ -0,0 +1,334 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	conduitReq "github.com/biyonik/event-ticketing-api/internal/http/request"
+	"github.com/biyonik/event-ticketing-api/internal/http/response"
+	"github.com/biyonik/event-ticketing-api/pkg/cache"
+)
+
+// -----------------------------------------------------------------------------
+// Idempotency Middleware
+// -----------------------------------------------------------------------------
+// Mobil istemciler ağ kesintilerinde POST isteklerini tekrar gönderir. Bu
+// middleware, Idempotency-Key header'ı taşıyan istekleri (user, key) bazında
+// cache'e kaydeder ve retry'larda handler'ı tekrar çalıştırmak yerine saklanan
+// cevabı döndürür.
+//
+// Davranış:
+// - Aynı key + aynı body  → saklanan cevap replay edilir (Idempotent-Replayed: true)
+// - Aynı key + farklı body → 422 Unprocessable Entity
+// - Aynı key işlenirken gelen retry → ilk istek bitene kadar bekler
+// - 5xx cevaplar saklanmaz (retry gerçekten tekrar denenebilsin)
+//
+// Kullanım:
+//
+//	router.POST("/tickets/reserve", handler,
+//	    middleware.Auth(),
+//	    middleware.Idempotency(cacheStore, 24*time.Hour),
+//	)
+// -----------------------------------------------------------------------------
+
+const (
+	// IdempotencyKeyHeader, istemcinin gönderdiği header adı.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// idempotencyMaxKeyLength, kabul edilen en uzun key.
+	idempotencyMaxKeyLength = 255
+
+	// idempotencyLockTTL, in-flight lock'un maksimum ömrü (handler çökse bile serbest kalır).
+	idempotencyLockTTL = 30 * time.Second
+
+	// idempotencyPollInterval, bekleyen retry'ın cache'i kontrol etme aralığı.
+	idempotencyPollInterval = 50 * time.Millisecond
+)
+
+// idempotencyWaitTimeout, eşzamanlı retry'ın ilk isteği bekleme süresi (testlerde kısaltılır).
+var idempotencyWaitTimeout = 10 * time.Second
+
+// idempotencyRecord, cache'te saklanan cevap kaydıdır.
+type idempotencyRecord struct {
+	Fingerprint string            `json:"fingerprint"`
+	StatusCode  int               `json:"status_code"`
+	Header      map[string]string `json:"header"`
+	Body        string            `json:"body"`
+}
+
+// Idempotency, Idempotency-Key tabanlı tekrar koruması sağlayan middleware'i döndürür.
+//
+// Parametreler:
+//   - store: Cache driver (Redis önerilir; birden fazla instance için zorunlu)
+//   - ttl: Cevabın saklanma süresi
+//
+// Header gönderilmeyen istekler olduğu gibi geçirilir.
+func Idempotency(store cache.Cache, ttl time.Duration) Middleware {
+	locker, ok := store.(cache.Locker)
+	if !ok {
+		log.Println("⚠️  Idempotency: cache driver Locker desteklemiyor, process-local lock kullanılıyor")
+		locker = newLocalLocker(store)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || r.Method == http.MethodGet || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(key) > idempotencyMaxKeyLength {
+				response.BadRequest(w, fmt.Sprintf("%s en fazla %d karakter olabilir", IdempotencyKeyHeader, idempotencyMaxKeyLength))
+				return
+			}
+
+			// Body'yi oku ve handler için geri koy
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				response.BadRequest(w, "İstek gövdesi okunamadı")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			fingerprint := requestFingerprint(r, body)
+			recordKey := idempotencyCacheKey(r, key)
+			lockKey := recordKey + ":lock"
+
+			// Lock değeri: parmak izi + bu isteğe özel token. Token sayesinde TTL'i aşan
+			// yavaş bir handler, o arada başka bir isteğin aldığı lock'u silemez.
+			lockToken, err := newIdempotencyLockToken()
+			if err != nil {
+				response.ServerError(w, "Idempotency lock alınamadı")
+				return
+			}
+			lockValue := fingerprint + ":" + lockToken
+
+			deadline := time.Now().Add(idempotencyWaitTimeout)
+			for {
+				// 1. Daha önce tamamlanmış bir cevap var mı?
+				record, err := loadIdempotencyRecord(store, recordKey)
+				if err != nil {
+					response.ServerError(w, "Idempotency kaydı okunamadı")
+					return
+				}
+				if record != nil {
+					if record.Fingerprint != fingerprint {
+						response.Error(w, http.StatusUnprocessableEntity, "Bu Idempotency-Key farklı bir istek için kullanılmış")
+						return
+					}
+					replayIdempotencyRecord(w, record)
+					return
+				}
+
+				// 2. İsteği işlemek için lock almayı dene
+				acquired, err := locker.Add(lockKey, lockValue, idempotencyLockTTL)
+				if err != nil {
+					response.ServerError(w, "Idempotency lock alınamadı")
+					return
+				}
+				if acquired {
+					break
+				}
+
+				// 3. Aynı key başka bir istek tarafından işleniyor
+				if inFlight, _ := store.Get(lockKey); inFlight != nil {
+					if held, ok := inFlight.(string); ok && !strings.HasPrefix(held, fingerprint+":") {
+						response.Error(w, http.StatusUnprocessableEntity, "Bu Idempotency-Key farklı bir istek için kullanılmış")
+						return
+					}
+				}
+
+				if time.Now().After(deadline) {
+					w.Header().Set("Retry-After", "1")
+					response.Conflict(w, "Aynı Idempotency-Key ile gönderilen istek hâlâ işleniyor")
+					return
+				}
+
+				select {
+				case <-r.Context().Done():
+					return
+				case <-time.After(idempotencyPollInterval):
+				}
+			}
+			defer func() {
+				if _, err := locker.Release(lockKey, lockValue); err != nil {
+					log.Printf("⚠️  Idempotency lock bırakılamadı [%s]: %v", lockKey, err)
+				}
+			}()
+
+			// 4. Handler'ı çalıştır ve cevabı yakala
+			recorder := newResponseRecorder(w)
+			next.ServeHTTP(recorder, r)
+
+			if recorder.statusCode >= http.StatusInternalServerError {
+				return
+			}
+
+			record := &idempotencyRecord{
+				Fingerprint: fingerprint,
+				StatusCode:  recorder.statusCode,
+				Header: map[string]string{
+					"Content-Type": recorder.Header().Get("Content-Type"),
+				},
+				Body: recorder.body.String(),
+			}
+
+			if err := storeIdempotencyRecord(store, recordKey, record, ttl); err != nil {
+				log.Printf("⚠️  Idempotency kaydı yazılamadı [%s]: %v", recordKey, err)
+			}
+		})
+	}
+}
+
+// idempotencyCacheKey, (user, key) ikilisi için cache anahtarı üretir.
+// Giriş yapmamış istekler IP adresi ile ayrıştırılır (port hariç; retry'lar farklı
+// kaynak port'tan gelir).
+func idempotencyCacheKey(r *http.Request, key string) string {
+	owner := "ip:" + conduitReq.New(r).GetIP()
+	if userID := GetUserID(r.Context()); userID > 0 {
+		owner = "user:" + strconv.FormatInt(userID, 10)
+	}
+
+	hash := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("idempotency:%s:%s", owner, hex.EncodeToString(hash[:]))
+}
+
+// newIdempotencyLockToken, lock sahibini ayırt eden rastgele token üretir.
+func newIdempotencyLockToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// requestFingerprint, method + path + body üzerinden SHA-256 parmak izi üretir.
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// loadIdempotencyRecord, cache'teki kaydı okur.
+// Kayıt JSON string olarak saklanır; böylece Memory ve Redis driver'ları aynı tipi döndürür.
+func loadIdempotencyRecord(store cache.Cache, key string) (*idempotencyRecord, error) {
+	raw, err := store.Get(key)
+	if err != nil || raw == nil {
+		return nil, err
+	}
+
+	encoded, ok := raw.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected idempotency record type %T", raw)
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal([]byte(encoded), &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// storeIdempotencyRecord, kaydı JSON string olarak cache'e yazar.
+func storeIdempotencyRecord(store cache.Cache, key string, record *idempotencyRecord, ttl time.Duration) error {
+	encoded, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(key, string(encoded), ttl)
+}
+
+// replayIdempotencyRecord, saklanan cevabı istemciye yazar.
+func replayIdempotencyRecord(w http.ResponseWriter, record *idempotencyRecord) {
+	for name, value := range record.Header {
+		if value != "" {
+			w.Header().Set(name, value)
+		}
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(record.StatusCode)
+	w.Write([]byte(record.Body))
+}
+
+// responseRecorder, handler cevabını hem istemciye yazar hem de saklar.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+}
+
+func (rr *responseRecorder) WriteHeader(code int) {
+	if rr.wroteHeader {
+		return
+	}
+	rr.statusCode = code
+	rr.wroteHeader = true
+	rr.ResponseWriter.WriteHeader(code)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if !rr.wroteHeader {
+		rr.WriteHeader(http.StatusOK)
+	}
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
+
+// localLocker, Locker desteklemeyen driver'lar için process içi fallback'tir.
+type localLocker struct {
+	mu    sync.Mutex
+	store cache.Cache
+}
+
+func newLocalLocker(store cache.Cache) *localLocker {
+	return &localLocker{store: store}
+}
+
+func (l *localLocker) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	exists, err := l.store.Has(key)
+	if err != nil || exists {
+		return false, err
+	}
+
+	return true, l.store.Set(key, value, ttl)
+}
+
+// Release, key hâlâ verilen değeri taşıyorsa siler.
+func (l *localLocker) Release(key string, value interface{}) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	current, err := l.store.Get(key)
+	if err != nil || current == nil || !reflect.DeepEqual(current, value) {
+		return false, err
+	}
+
+	return true, l.store.Delete(key)
+}
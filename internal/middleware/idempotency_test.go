@@ -0,0 +1,190 @@
+// -----------------------------------------------------------------------------
+// Idempotency Middleware Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Aynı key + aynı body → handler bir kez çalışır, cevap replay edilir
+// - Aynı key + farklı body → 422
+// - İşlenmekte olan key → bekleme süresi sonunda 409
+// - 5xx cevapların saklanmaması
+// - Giriş yapmamış istemcilerin port'tan bağımsız (IP ile) ayrıştırılması
+// - Lock'un sadece sahibi tarafından bırakılması
+// -----------------------------------------------------------------------------
+
+package middleware
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/pkg/cache"
+)
+
+func newIdempotencyTestStore() *cache.MemoryCache {
+	return cache.NewMemoryCache(log.New(testWriter{}, "", 0))
+}
+
+// testWriter, cache loglarını yutar.
+type testWriter struct{}
+
+func (testWriter) Write(p []byte) (int, error) { return len(p), nil }
+
+func newIdempotencyRequest(key, body, remoteAddr string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/tickets/reserve", strings.NewReader(body))
+	r.Header.Set(IdempotencyKeyHeader, key)
+	r.RemoteAddr = remoteAddr
+	return r
+}
+
+// countingHandler, çağrı sayısını tutar ve verilen status ile cevap verir.
+func countingHandler(calls *int32, status int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `{"call":%d}`, n)
+	})
+}
+
+func TestIdempotency_ReplaysStoredResponse(t *testing.T) {
+	var calls int32
+	handler := Idempotency(newIdempotencyTestStore(), time.Hour)(countingHandler(&calls, http.StatusCreated))
+
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, newIdempotencyRequest("key-1", `{"seat":1}`, "10.0.0.1:50000"))
+
+	second := httptest.NewRecorder()
+	handler.ServeHTTP(second, newIdempotencyRequest("key-1", `{"seat":1}`, "10.0.0.1:50000"))
+
+	if calls != 1 {
+		t.Fatalf("expected handler to run once, ran %d times", calls)
+	}
+	if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() {
+		t.Errorf("expected replay of %d %q, got %d %q", first.Code, first.Body.String(), second.Code, second.Body.String())
+	}
+	if second.Header().Get("Idempotent-Replayed") != "true" {
+		t.Errorf("expected Idempotent-Replayed header on replay")
+	}
+	if first.Header().Get("Idempotent-Replayed") != "" {
+		t.Errorf("first response must not be marked as replayed")
+	}
+}
+
+func TestIdempotency_RejectsDifferentBody(t *testing.T) {
+	var calls int32
+	handler := Idempotency(newIdempotencyTestStore(), time.Hour)(countingHandler(&calls, http.StatusCreated))
+
+	handler.ServeHTTP(httptest.NewRecorder(), newIdempotencyRequest("key-1", `{"seat":1}`, "10.0.0.1:50000"))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newIdempotencyRequest("key-1", `{"seat":2}`, "10.0.0.1:50000"))
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected 422 for a different body, got %d", rec.Code)
+	}
+	if calls != 1 {
+		t.Errorf("expected handler to run once, ran %d times", calls)
+	}
+}
+
+func TestIdempotency_InFlightReturnsConflict(t *testing.T) {
+	previous := idempotencyWaitTimeout
+	idempotencyWaitTimeout = 100 * time.Millisecond
+	defer func() { idempotencyWaitTimeout = previous }()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusCreated)
+	})
+	handler := Idempotency(newIdempotencyTestStore(), time.Hour)(slow)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(httptest.NewRecorder(), newIdempotencyRequest("key-1", `{"seat":1}`, "10.0.0.1:50000"))
+	}()
+	<-started
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newIdempotencyRequest("key-1", `{"seat":1}`, "10.0.0.1:50000"))
+
+	close(release)
+	<-done
+
+	if rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 while the first request is in flight, got %d", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Errorf("expected Retry-After header on 409")
+	}
+}
+
+func TestIdempotency_DoesNotStoreServerErrors(t *testing.T) {
+	var calls int32
+	handler := Idempotency(newIdempotencyTestStore(), time.Hour)(countingHandler(&calls, http.StatusInternalServerError))
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newIdempotencyRequest("key-1", `{"seat":1}`, "10.0.0.1:50000"))
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("expected 500, got %d", rec.Code)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("expected 5xx to be retried, handler ran %d times", calls)
+	}
+}
+
+func TestIdempotency_AnonymousKeyIgnoresSourcePort(t *testing.T) {
+	tests := []struct {
+		name     string
+		first    string
+		second   string
+		wantSame bool
+	}{
+		{"same IP different port", "10.0.0.1:50000", "10.0.0.1:50001", true},
+		{"different IP", "10.0.0.1:50000", "10.0.0.2:50000", false},
+		{"IPv6 different port", "[::1]:50000", "[::1]:50001", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := idempotencyCacheKey(newIdempotencyRequest("key-1", "", tt.first), "key-1")
+			second := idempotencyCacheKey(newIdempotencyRequest("key-1", "", tt.second), "key-1")
+
+			if (first == second) != tt.wantSame {
+				t.Errorf("keys %q and %q: expected same=%v", first, second, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestIdempotency_ExpiredLockIsNotReleasedByPreviousOwner(t *testing.T) {
+	store := newIdempotencyTestStore()
+
+	// İlk isteğin lock'u TTL ile düştü ve ikinci istek lock'u aldı
+	if acquired, _ := store.Add("lock", "fp:second", time.Minute); !acquired {
+		t.Fatalf("expected lock to be acquired")
+	}
+
+	// İlk isteğin gecikmeli bırakması ikinci isteğin lock'unu silmemeli
+	if released, _ := store.Release("lock", "fp:first"); released {
+		t.Errorf("expected release with a stale token to be ignored")
+	}
+	if exists, _ := store.Has("lock"); !exists {
+		t.Errorf("expected lock of the second request to remain")
+	}
+
+	if released, _ := store.Release("lock", "fp:second"); !released {
+		t.Errorf("expected owner to release the lock")
+	}
+}
@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/biyonik/event-ticketing-api/internal/http/response"
+)
+
+// -----------------------------------------------------------------------------
+// Organizer Middleware
+// -----------------------------------------------------------------------------
+// Organizatör panelindeki isteklerin hangi organizatör adına yapıldığını
+// belirler. Controller'lar bu bilgiyle servislerin ForOrganizer kopyalarını
+// kullanır; böylece etkinlik, mekan, bilet ve rapor sorguları otomatik olarak
+// organizatörün kendi verisiyle sınırlanır.
+//
+// Davranış:
+// - Organizatör X-Organizer-ID header'ı ile seçilir
+// - Kullanıcının organizatördeki üyeliği doğrulanır, rolü context'e eklenir
+// - Header yoksa sadece platform admin'i kısıtsız devam edebilir
+// - Platform admin'i header gönderirse organizatör adına owner olarak işlem yapar
+//
+// Kullanım:
+//
+//	panel := r.Group("/organizer")
+//	panel.Use(middleware.Auth())
+//	panel.Use(middleware.Organizer(organizerService))
+//
+// Context'e Eklenen Değerler:
+// - "organizer_id": int64 (0 = platform, kısıtsız)
+// - "organizer_role": string (owner, manager, box_office, scanner)
+// -----------------------------------------------------------------------------
+
+// OrganizerIDHeader, isteğin yapıldığı organizatörün taşındığı header.
+const OrganizerIDHeader = "X-Organizer-ID"
+
+// OrganizerResolver, kullanıcının organizatördeki rolünü çözer.
+type OrganizerResolver interface {
+	ResolveRole(userID, organizerID int64) (string, error)
+}
+
+// Organizer, isteğin organizatör kapsamını belirleyen middleware'i döndürür.
+//
+// NOT:
+// Bu middleware'den önce Auth() middleware'i çalışmalıdır!
+func Organizer(resolver OrganizerResolver) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// 1. Kullanıcı authenticated mi?
+			userID := GetUserID(r.Context())
+			if userID == 0 {
+				response.Error(w, http.StatusUnauthorized, "Kimlik doğrulaması gerekli")
+				return
+			}
+
+			isAdmin := GetUserRole(r.Context()) == "admin"
+
+			// 2. Organizatör seçimi
+			header := r.Header.Get(OrganizerIDHeader)
+			if header == "" {
+				if !isAdmin {
+					response.Error(w, http.StatusBadRequest, "X-Organizer-ID header gerekli")
+					return
+				}
+				// Platform admin'i kısıtsız devam eder
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			organizerID, err := strconv.ParseInt(header, 10, 64)
+			if err != nil || organizerID <= 0 {
+				response.Error(w, http.StatusBadRequest, "Geçersiz X-Organizer-ID")
+				return
+			}
+
+			// 3. Üyelik ve rol
+			role := "owner"
+			if !isAdmin {
+				role, err = resolver.ResolveRole(userID, organizerID)
+				if err != nil {
+					response.Error(w, http.StatusForbidden, "Bu organizatör için yetkiniz yok")
+					return
+				}
+			}
+
+			// 4. Kapsamı context'e ekle
+			ctx := context.WithValue(r.Context(), "organizer_id", organizerID)
+			ctx = context.WithValue(ctx, "organizer_role", role)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// OrganizerRole, belirtilen organizatör rollerine sahip üyelerin erişimine izin verir.
+// Platform admin'i (kısıtsız kapsam) her zaman geçer.
+//
+// Örnek:
+//
+//	panel.POST("/events", CreateEventHandler).
+//	    Middleware(middleware.OrganizerRole("owner", "manager"))
+func OrganizerRole(allowedRoles ...string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if GetOrganizerID(r.Context()) == 0 && GetUserRole(r.Context()) == "admin" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			role := GetOrganizerRole(r.Context())
+			for _, allowedRole := range allowedRoles {
+				if role == allowedRole {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			response.Error(w, http.StatusForbidden, "Bu işlem için yetkiniz yok")
+		})
+	}
+}
+
+// GetOrganizerID, context'ten organizatör ID'sini döndürür (0 = kısıtsız).
+func GetOrganizerID(ctx context.Context) int64 {
+	id, ok := ctx.Value("organizer_id").(int64)
+	if !ok {
+		return 0
+	}
+
+	return id
+}
+
+// GetOrganizerRole, context'ten kullanıcının organizatör rolünü döndürür.
+func GetOrganizerRole(ctx context.Context) string {
+	role, ok := ctx.Value("organizer_role").(string)
+	if !ok {
+		return ""
+	}
+
+	return role
+}
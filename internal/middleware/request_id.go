@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// -----------------------------------------------------------------------------
+// Request ID Middleware
+// -----------------------------------------------------------------------------
+// Her isteğe bir istek ID'si atar. İstemci veya load balancer X-Request-ID
+// header'ı gönderdiyse (ve güvenli karakterlerden oluşuyorsa) o kullanılır,
+// yoksa rastgele bir ID üretilir. ID cevap header'ına yazılır ve denetim
+// kayıtlarında (audit log) isteğin izini sürmek için context'e eklenir.
+//
+// Kullanım:
+//
+//	r.Use(middleware.RequestID())
+//
+// Context'e Eklenen Değerler:
+// - "request_id": string
+// -----------------------------------------------------------------------------
+
+// RequestIDHeader, istek ID'sinin taşındığı header.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength, dışarıdan kabul edilen istek ID'sinin en fazla uzunluğu.
+const maxRequestIDLength = 64
+
+// RequestID, isteğe ID atayan middleware'i döndürür.
+func RequestID() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// 1. Gelen ID'yi kullan veya yenisini üret
+			requestID := r.Header.Get(RequestIDHeader)
+			if !isValidRequestID(requestID) {
+				requestID = newRequestID()
+			}
+
+			// 2. Cevaba ve context'e ekle
+			w.Header().Set(RequestIDHeader, requestID)
+			ctx := context.WithValue(r.Context(), "request_id", requestID)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// GetRequestID, context'ten istek ID'sini döndürür (middleware yoksa boş).
+func GetRequestID(ctx context.Context) string {
+	requestID, ok := ctx.Value("request_id").(string)
+	if !ok {
+		return ""
+	}
+
+	return requestID
+}
+
+// isValidRequestID, dışarıdan gelen ID'nin log / veritabanı için güvenli olup olmadığını kontrol eder
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for _, c := range id {
+		isAlphaNum := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+		if !isAlphaNum && c != '-' && c != '_' && c != '.' && c != ':' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newRequestID, 128 bit rastgele hex ID üretir
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+
+	return hex.EncodeToString(b)
+}
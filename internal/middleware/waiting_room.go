@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/biyonik/event-ticketing-api/internal/http/response"
+	"github.com/biyonik/event-ticketing-api/pkg/waitingroom"
+)
+
+// -----------------------------------------------------------------------------
+// Waiting Room Middleware
+// -----------------------------------------------------------------------------
+// Bekleme odası aktif olan etkinliklerde rezervasyon endpoint'lerine sadece
+// kuyruktan kabul edilmiş kullanıcıların erişmesini sağlar.
+//
+// Davranış:
+// - Etkinlik için bekleme odası yoksa istek olduğu gibi geçirilir
+// - Varsa X-Waiting-Room-Token header'ında geçerli bir admission token aranır
+// - Token imzası, süresi, etkinlik ve kullanıcı eşleşmesi doğrulanır
+// - Token yoksa / geçersizse 403 döner (istemci polling endpoint'ine yönlenir)
+//
+// Kullanım:
+//
+//	router.POST("/tickets/reserve", handler,
+//	    middleware.Auth(),
+//	    middleware.WaitingRoom(manager, middleware.EventIDFromBody),
+//	)
+// -----------------------------------------------------------------------------
+
+// WaitingRoomTokenHeader, admission token'ın taşındığı header.
+const WaitingRoomTokenHeader = "X-Waiting-Room-Token"
+
+// EventResolver, istekten hedef etkinliğin ID'sini çıkarır.
+type EventResolver func(r *http.Request) (int64, bool)
+
+// WaitingRoom, admission token kontrolü yapan middleware'i döndürür.
+func WaitingRoom(manager *waitingroom.Manager, resolve EventResolver) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			eventID, ok := resolve(r)
+			if !ok {
+				// Etkinlik belirlenemezse validation handler'a bırakılır
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			roomID := waitingroom.EventRoomID(eventID)
+
+			// 1. Etkinlik için bekleme odası aktif mi?
+			active, err := manager.IsActive(roomID)
+			if err != nil {
+				log.Printf("⚠️  WaitingRoom: oda durumu okunamadı [%s]: %v", roomID, err)
+				response.ServerError(w, "Bekleme odası durumu kontrol edilemedi")
+				return
+			}
+			if !active {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// 2. Admission token doğrulaması
+			userID := GetUserID(r.Context())
+			if userID <= 0 {
+				response.Unauthorized(w, "Bu işlem için giriş yapmalısınız")
+				return
+			}
+
+			token := r.Header.Get(WaitingRoomTokenHeader)
+			if token == "" {
+				response.Forbidden(w, "Bu etkinlik için bekleme odasından geçmeniz gerekiyor")
+				return
+			}
+
+			err = manager.VerifyToken(token, roomID, waitingroom.UserVisitorID(userID))
+			switch {
+			case err == nil:
+				next.ServeHTTP(w, r)
+			case errors.Is(err, waitingroom.ErrTokenExpired):
+				response.Forbidden(w, "Bekleme odası giriş izninizin süresi doldu")
+			default:
+				response.Forbidden(w, "Geçersiz bekleme odası giriş izni")
+			}
+		})
+	}
+}
+
+// EventIDFromBody, JSON body'deki event_id alanını okur ve body'yi handler için geri koyar.
+func EventIDFromBody(r *http.Request) (int64, bool) {
+	if r.Body == nil {
+		return 0, false
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return 0, false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	var payload struct {
+		EventID int64 `json:"event_id"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || payload.EventID <= 0 {
+		return 0, false
+	}
+
+	return payload.EventID, true
+}
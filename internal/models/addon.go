@@ -0,0 +1,135 @@
+// -----------------------------------------------------------------------------
+// Add-On Model
+// -----------------------------------------------------------------------------
+// Biletle birlikte satılan ek ürünleri temsil eder (otopark, merch, hospitality).
+// Her ürünün kendi stoğu ve fiyatı vardır.
+//
+// Kapsam (scope):
+// - order: Sipariş başına limit (sipariş = kullanıcının etkinlikteki biletleri)
+// - ticket: Bilet başına limit (ör. her bilete bir hospitality upgrade)
+//
+// Satın alınan her satır (AddOnItem) bir bilete bağlıdır; biletle birlikte
+// satılır, iptal edilir ve iade edilir. Scannable ürünler (ör. otopark)
+// girişte okutulan bir voucher ve QR kod alır.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"time"
+)
+
+// AddOnType, ek ürün türünü temsil eder
+type AddOnType string
+
+const (
+	AddOnTypeParking     AddOnType = "parking"
+	AddOnTypeMerchandise AddOnType = "merchandise"
+	AddOnTypeHospitality AddOnType = "hospitality"
+	AddOnTypeOther       AddOnType = "other"
+)
+
+// AddOnScope, ek ürün limitinin hangi birimde sayıldığını belirtir
+type AddOnScope string
+
+const (
+	AddOnScopeOrder  AddOnScope = "order"
+	AddOnScopeTicket AddOnScope = "ticket"
+)
+
+// AddOnItemStatus, satın alınan ek ürün satırının durumunu temsil eder
+type AddOnItemStatus string
+
+const (
+	AddOnItemStatusReserved  AddOnItemStatus = "reserved"  // Bilet rezerve, ödeme bekleniyor
+	AddOnItemStatusSold      AddOnItemStatus = "sold"      // Bilet satın alındı
+	AddOnItemStatusUsed      AddOnItemStatus = "used"      // Voucher okutuldu
+	AddOnItemStatusCancelled AddOnItemStatus = "cancelled" // Rezervasyon düştü (ödeme yok)
+	AddOnItemStatusRefunded  AddOnItemStatus = "refunded"  // Satış iade edildi
+)
+
+// AddOn, bir etkinliğin ek ürünüdür
+type AddOn struct {
+	BaseModel
+	EventID     int64      `json:"event_id" db:"event_id"`
+	Name        string     `json:"name" db:"name"`
+	Description string     `json:"description,omitempty" db:"description"`
+	Type        AddOnType  `json:"type" db:"type"`
+	Price       float64    `json:"price" db:"price"`
+	Inventory   int        `json:"inventory" db:"inventory"`
+	Scope       AddOnScope `json:"scope" db:"scope"`
+	MaxQuantity *int       `json:"max_quantity,omitempty" db:"max_quantity"` // nil = limit yok
+	Scannable   bool       `json:"scannable" db:"scannable"`
+	IsActive    bool       `json:"is_active" db:"is_active"`
+	SoldCount   int        `json:"sold_count" db:"sold_count"` // Aktif satır adedi, sorguda hesaplanır
+}
+
+// AddOnItem, bir bilete bağlı satın alınmış ek üründür
+type AddOnItem struct {
+	BaseModel
+	AddOnID          int64           `json:"addon_id" db:"addon_id"`
+	EventID          int64           `json:"event_id" db:"event_id"`
+	UserID           int64           `json:"user_id" db:"user_id"`
+	TicketID         int64           `json:"ticket_id" db:"ticket_id"`
+	Quantity         int             `json:"quantity" db:"quantity"`
+	UnitPrice        float64         `json:"unit_price" db:"unit_price"`
+	TotalPrice       float64         `json:"total_price" db:"total_price"`
+	Status           AddOnItemStatus `json:"status" db:"status"`
+	VoucherCode      *string         `json:"voucher_code,omitempty" db:"voucher_code"`
+	VerificationCode *string         `json:"-" db:"verification_code"`
+	QRCodeData       *string         `json:"qr_code_data,omitempty" db:"qr_code_data"`
+	QRCodeImage      []byte          `json:"qr_code_image,omitempty" db:"qr_code_image"`
+	UsedAt           *time.Time      `json:"used_at,omitempty" db:"used_at"`
+	RefundedAt       *time.Time      `json:"refunded_at,omitempty" db:"refunded_at"`
+
+	// İlişkili veriler
+	AddOn *AddOn `json:"addon,omitempty" db:"-"`
+}
+
+// Remaining, kalan stok miktarını döndürür
+func (a *AddOn) Remaining() int {
+	if remaining := a.Inventory - a.SoldCount; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// IsActive, satırın stok tuttuğunu (rezerve, satılmış veya kullanılmış) kontrol eder
+func (i *AddOnItem) IsActive() bool {
+	return i.Status == AddOnItemStatusReserved ||
+		i.Status == AddOnItemStatusSold ||
+		i.Status == AddOnItemStatusUsed
+}
+
+// CanUse, voucher'ın girişte okutulup okutulamayacağını kontrol eder
+func (i *AddOnItem) CanUse() bool {
+	return i.Status == AddOnItemStatusSold
+}
+
+// CanRefund, satırın iade edilip edilemeyeceğini kontrol eder
+func (i *AddOnItem) CanRefund() bool {
+	return i.Status == AddOnItemStatusReserved || i.Status == AddOnItemStatusSold
+}
+
+// OrderLine, sipariş özetindeki bir satırdır
+type OrderLine struct {
+	Kind        string  `json:"kind"` // ticket, addon
+	ReferenceID int64   `json:"reference_id"`
+	TicketID    int64   `json:"ticket_id"`
+	Name        string  `json:"name"`
+	Quantity    int     `json:"quantity"`
+	UnitPrice   float64 `json:"unit_price"`
+	Total       float64 `json:"total"`
+	Status      string  `json:"status"`
+}
+
+// OrderSummary, kullanıcının bir etkinlikteki siparişinin fiyat dökümüdür
+// (biletler + ek ürünler). Ödeme kaydı da aynı kullanıcı + etkinlik bazındadır.
+type OrderSummary struct {
+	EventID      int64        `json:"event_id"`
+	UserID       int64        `json:"user_id"`
+	Lines        []*OrderLine `json:"lines"`
+	TicketsTotal float64      `json:"tickets_total"`
+	AddOnsTotal  float64      `json:"addons_total"`
+	Total        float64      `json:"total"`
+}
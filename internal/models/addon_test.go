@@ -0,0 +1,62 @@
+// -----------------------------------------------------------------------------
+// Add-On Tests
+// -----------------------------------------------------------------------------
+// Bu testler, ek ürün stok hesabının aşırı satışta negatife düşmediğini ve
+// voucher'ın ödeme alınmadan kullanılamadığını doğrular.
+//
+// Testler:
+// - Kalan stok (satılan, aşırı satış, 0 alt sınırı)
+// - Voucher kullanımı ve iade (stok tutma, kullanılmış voucher)
+// -----------------------------------------------------------------------------
+
+package models
+
+import "testing"
+
+// TestAddOn_Remaining tests remaining inventory, including oversold stock.
+func TestAddOn_Remaining(t *testing.T) {
+	tests := []struct {
+		name     string
+		addOn    AddOn
+		expected int
+	}{
+		{"nothing sold", AddOn{Inventory: 50}, 50},
+		{"partially sold", AddOn{Inventory: 50, SoldCount: 18}, 32},
+		{"sold out", AddOn{Inventory: 50, SoldCount: 50}, 0},
+		{"oversold never negative", AddOn{Inventory: 50, SoldCount: 53}, 0},
+		{"no inventory", AddOn{}, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.addOn.Remaining(); got != tc.expected {
+				t.Errorf("Expected %d remaining, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestAddOnItem_VoucherLifecycle tests that a held voucher cannot be redeemed
+// and a redeemed voucher cannot be refunded.
+func TestAddOnItem_VoucherLifecycle(t *testing.T) {
+	item := &AddOnItem{Status: AddOnItemStatusReserved}
+	if item.CanUse() {
+		t.Error("Expected a reserved voucher to be unusable before payment")
+	}
+	if !item.CanRefund() {
+		t.Error("Expected a reserved voucher to be releasable")
+	}
+
+	item.Status = AddOnItemStatusSold
+	if !item.CanUse() {
+		t.Error("Expected a sold voucher to be usable")
+	}
+
+	item.Status = AddOnItemStatusUsed
+	if item.CanUse() {
+		t.Error("Expected a used voucher not to be usable twice")
+	}
+	if item.CanRefund() {
+		t.Error("Expected a used voucher not to be refundable")
+	}
+}
@@ -0,0 +1,177 @@
+// -----------------------------------------------------------------------------
+// Analytics Models
+// -----------------------------------------------------------------------------
+// Satış analitiği kayıtları ve raporlarını temsil eder. Satın alma, iptal,
+// check-in ve sayfa görüntüleme olayları analytics_events tablosuna anlık
+// görüntü (bölüm, bilet tipi, kod, kanal, tutar) olarak yazılır; raporlar bu
+// kayıtlardan tarih aralığı ve zaman birimine göre üretilir.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"time"
+)
+
+// AnalyticsActivity, kaydedilen analitik olayın türüdür
+type AnalyticsActivity string
+
+const (
+	AnalyticsActivityPurchase     AnalyticsActivity = "purchase"
+	AnalyticsActivityCancellation AnalyticsActivity = "cancellation"
+	AnalyticsActivityCheckIn      AnalyticsActivity = "check_in"
+	AnalyticsActivityPageView     AnalyticsActivity = "page_view"
+)
+
+// Satış kanalları
+const (
+	SalesChannelOnline    = "online"
+	SalesChannelBoxOffice = "box_office" // Gişe (limit override ile satış)
+	SalesChannelSeason    = "season"     // Kombine kapsamında verilen bilet
+)
+
+// AnalyticsGranularity, zaman serisi raporlarının zaman birimidir
+type AnalyticsGranularity string
+
+const (
+	GranularityHour  AnalyticsGranularity = "hour"
+	GranularityDay   AnalyticsGranularity = "day"
+	GranularityWeek  AnalyticsGranularity = "week" // Pazartesi başlangıçlı
+	GranularityMonth AnalyticsGranularity = "month"
+)
+
+// IsValid, zaman biriminin tanımlı olup olmadığını kontrol eder
+func (g AnalyticsGranularity) IsValid() bool {
+	switch g {
+	case GranularityHour, GranularityDay, GranularityWeek, GranularityMonth:
+		return true
+	}
+	return false
+}
+
+// Truncate, zamanı verilen konumda bulunduğu dilimin başlangıcına indirir
+func (g AnalyticsGranularity) Truncate(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+
+	switch g {
+	case GranularityHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	case GranularityWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, loc)
+	case GranularityMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+}
+
+// Next, dilim başlangıcından sonraki dilimin başlangıcını döndürür
+func (g AnalyticsGranularity) Next(start time.Time) time.Time {
+	switch g {
+	case GranularityHour:
+		return start.Add(time.Hour)
+	case GranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// AnalyticsDimension, satış kırılım raporlarının boyutudur
+type AnalyticsDimension string
+
+const (
+	DimensionSection    AnalyticsDimension = "section"
+	DimensionTicketType AnalyticsDimension = "ticket_type"
+	DimensionPromoCode  AnalyticsDimension = "promo_code" // Presale erişim kodu
+	DimensionChannel    AnalyticsDimension = "channel"
+)
+
+// IsValid, boyutun tanımlı olup olmadığını kontrol eder
+func (d AnalyticsDimension) IsValid() bool {
+	switch d {
+	case DimensionSection, DimensionTicketType, DimensionPromoCode, DimensionChannel:
+		return true
+	}
+	return false
+}
+
+// SalesReportQuery, zaman serisi raporlarının parametreleridir
+type SalesReportQuery struct {
+	EventID     int64
+	From        time.Time // Dahil
+	To          time.Time // Hariç
+	Granularity AnalyticsGranularity
+	Location    *time.Location // Gün / hafta / ay sınırları için saat dilimi
+}
+
+// SalesActivity, saatlik toplanmış ham analitik satırıdır
+type SalesActivity struct {
+	Hour             time.Time
+	Purchases        int
+	Revenue          float64
+	Cancellations    int
+	CancelledRevenue float64
+	CheckIns         int
+	PageViews        int
+}
+
+// SalesBucket, zaman serisindeki tek bir dilimdir
+type SalesBucket struct {
+	Period           time.Time `json:"period"`
+	Purchases        int       `json:"purchases"`
+	Cancellations    int       `json:"cancellations"`
+	NetTickets       int       `json:"net_tickets"`
+	Revenue          float64   `json:"revenue"`
+	CancelledRevenue float64   `json:"cancelled_revenue"`
+	NetRevenue       float64   `json:"net_revenue"`
+	CheckIns         int       `json:"check_ins"`
+	PageViews        int       `json:"page_views"`
+}
+
+// Add, saatlik satırı dilime ekler
+func (b *SalesBucket) Add(activity *SalesActivity) {
+	b.Purchases += activity.Purchases
+	b.Cancellations += activity.Cancellations
+	b.NetTickets = b.Purchases - b.Cancellations
+	b.Revenue += activity.Revenue
+	b.CancelledRevenue += activity.CancelledRevenue
+	b.NetRevenue = b.Revenue - b.CancelledRevenue
+	b.CheckIns += activity.CheckIns
+	b.PageViews += activity.PageViews
+}
+
+// SalesBreakdown, bir boyut değeri için satış toplamıdır
+type SalesBreakdown struct {
+	Dimension        AnalyticsDimension `json:"dimension"`
+	Key              string             `json:"key"`   // Boş = değer yok (örn. kodsuz satış)
+	Label            string             `json:"label"` // Bölüm adı vb.
+	Purchases        int                `json:"purchases"`
+	Cancellations    int                `json:"cancellations"`
+	NetTickets       int                `json:"net_tickets"`
+	Revenue          float64            `json:"revenue"`
+	CancelledRevenue float64            `json:"cancelled_revenue"`
+	NetRevenue       float64            `json:"net_revenue"`
+}
+
+// SellThroughPoint, kümülatif satış eğrisindeki tek bir noktadır (dilim sonu itibarıyla)
+type SellThroughPoint struct {
+	Period            time.Time `json:"period"`
+	CumulativeTickets int       `json:"cumulative_tickets"`
+	Capacity          int       `json:"capacity"`
+	SellThrough       float64   `json:"sell_through"` // Yüzde
+}
+
+// EventSalesStats, bir etkinliğin anlık satış özetidir
+type EventSalesStats struct {
+	EventID        int64   `json:"event_id"`
+	TotalCapacity  int     `json:"total_capacity"`
+	AvailableSeats int     `json:"available_seats"`
+	SoldTickets    int     `json:"sold_tickets"`
+	OccupancyRate  float64 `json:"occupancy_rate"` // Yüzde
+	TotalRevenue   float64 `json:"total_revenue"`
+	AveragePrice   float64 `json:"average_price"`
+}
@@ -0,0 +1,95 @@
+// -----------------------------------------------------------------------------
+// Analytics Tests
+// -----------------------------------------------------------------------------
+// Bu testler, satış analitiği dilimlerinin organizatörün saat dilimine göre
+// doğru sınırlarda başladığını ve dilim toplamlarının iptalleri düştüğünü
+// doğrular.
+//
+// Testler:
+// - Dilim başlangıcı (saat dilimine göre, pazartesi başlangıçlı hafta)
+// - Sonraki dilim (gün, hafta ve yıl geçişi)
+// - Dilim toplamları (net bilet, net gelir)
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// TestAnalyticsGranularity_Truncate tests bucket starts in UTC and in a local zone.
+func TestAnalyticsGranularity_Truncate(t *testing.T) {
+	istanbul := time.FixedZone("UTC+3", 3*60*60)
+	// 2025-03-05 (çarşamba) 22:30 UTC = 2025-03-06 (perşembe) 01:30 İstanbul
+	at := time.Date(2025, 3, 5, 22, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		granularity AnalyticsGranularity
+		loc         *time.Location
+		expected    time.Time
+	}{
+		{"hour utc", GranularityHour, time.UTC, time.Date(2025, 3, 5, 22, 0, 0, 0, time.UTC)},
+		{"day utc", GranularityDay, time.UTC, time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"day in local zone", GranularityDay, istanbul, time.Date(2025, 3, 6, 0, 0, 0, 0, istanbul)},
+		{"week starts on monday", GranularityWeek, time.UTC, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"month in local zone", GranularityMonth, istanbul, time.Date(2025, 3, 1, 0, 0, 0, 0, istanbul)},
+		{"unknown falls back to day", AnalyticsGranularity("year"), time.UTC, time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.granularity.Truncate(at, tc.loc); !got.Equal(tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+
+	// Pazar günü bir önceki pazartesiye iner
+	sunday := time.Date(2025, 3, 9, 18, 0, 0, 0, time.UTC)
+	monday := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	if got := GranularityWeek.Truncate(sunday, time.UTC); !got.Equal(monday) {
+		t.Errorf("Expected sunday to truncate to %v, got %v", monday, got)
+	}
+}
+
+// TestAnalyticsGranularity_Next tests the next bucket start across day, month and year boundaries.
+func TestAnalyticsGranularity_Next(t *testing.T) {
+	tests := []struct {
+		name        string
+		granularity AnalyticsGranularity
+		start       time.Time
+		expected    time.Time
+	}{
+		{"hour", GranularityHour, time.Date(2025, 3, 5, 23, 0, 0, 0, time.UTC), time.Date(2025, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{"day", GranularityDay, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"week", GranularityWeek, time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"month", GranularityMonth, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.granularity.Next(tc.start); !got.Equal(tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestSalesBucket_Add tests that cancellations are subtracted from net tickets and revenue.
+func TestSalesBucket_Add(t *testing.T) {
+	bucket := &SalesBucket{}
+	bucket.Add(&SalesActivity{Purchases: 10, Revenue: 1000, PageViews: 300})
+	bucket.Add(&SalesActivity{Purchases: 4, Revenue: 400, Cancellations: 3, CancelledRevenue: 300, CheckIns: 6, PageViews: 120})
+
+	if bucket.Purchases != 14 || bucket.Cancellations != 3 || bucket.NetTickets != 11 {
+		t.Errorf("Expected tickets 14/3/11, got %d/%d/%d", bucket.Purchases, bucket.Cancellations, bucket.NetTickets)
+	}
+	if bucket.Revenue != 1400 || bucket.CancelledRevenue != 300 || bucket.NetRevenue != 1100 {
+		t.Errorf("Expected revenue 1400/300/1100, got %v/%v/%v", bucket.Revenue, bucket.CancelledRevenue, bucket.NetRevenue)
+	}
+	if bucket.CheckIns != 6 || bucket.PageViews != 420 {
+		t.Errorf("Expected 6 check-ins and 420 page views, got %d and %d", bucket.CheckIns, bucket.PageViews)
+	}
+}
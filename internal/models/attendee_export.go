@@ -0,0 +1,102 @@
+// -----------------------------------------------------------------------------
+// Attendee Export Models
+// -----------------------------------------------------------------------------
+// Güvenlik ve akreditasyon ekipleri için etkinlik katılımcı listesi dışa
+// aktarımını temsil eder. Dışa aktarım kuyrukta işlenir; tamamlandığında
+// dosya storage'da durur ve indirme bağlantısı ExpiresAt'e kadar geçerlidir.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+// AttendeeExportFormat, dışa aktarım dosya biçimidir
+type AttendeeExportFormat string
+
+const (
+	AttendeeExportCSV  AttendeeExportFormat = "csv"
+	AttendeeExportXLSX AttendeeExportFormat = "xlsx"
+)
+
+// IsValid, biçimin desteklenip desteklenmediğini kontrol eder
+func (f AttendeeExportFormat) IsValid() bool {
+	return f == AttendeeExportCSV || f == AttendeeExportXLSX
+}
+
+// ContentType, biçimin MIME tipini döndürür
+func (f AttendeeExportFormat) ContentType() string {
+	if f == AttendeeExportXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// AttendeeExportStatus, dışa aktarımın durumudur
+type AttendeeExportStatus string
+
+const (
+	AttendeeExportPending    AttendeeExportStatus = "pending"    // Kuyrukta
+	AttendeeExportProcessing AttendeeExportStatus = "processing" // Dosya üretiliyor
+	AttendeeExportCompleted  AttendeeExportStatus = "completed"  // Dosya hazır, bağlantı gönderildi
+	AttendeeExportFailed     AttendeeExportStatus = "failed"
+)
+
+// AttendeeExport, bir katılımcı listesi dışa aktarım talebidir
+type AttendeeExport struct {
+	BaseModel
+	EventID      int64                `json:"event_id" db:"event_id"`
+	RequestedBy  int64                `json:"requested_by" db:"requested_by"`
+	Email        string               `json:"email" db:"email"`
+	Format       AttendeeExportFormat `json:"format" db:"format"`
+	Status       AttendeeExportStatus `json:"status" db:"status"`
+	FilePath     *string              `json:"-" db:"file_path"`
+	RowCount     int                  `json:"row_count" db:"row_count"`
+	ErrorMessage *string              `json:"error_message,omitempty" db:"error_message"`
+	CompletedAt  *time.Time           `json:"completed_at,omitempty" db:"completed_at"`
+	ExpiresAt    *time.Time           `json:"expires_at,omitempty" db:"expires_at"`
+}
+
+// IsDownloadable, dosyanın hazır ve bağlantının süresinin dolmamış olduğunu kontrol eder
+func (e *AttendeeExport) IsDownloadable(now time.Time) bool {
+	return e.Status == AttendeeExportCompleted && e.FilePath != nil &&
+		e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
+
+// FileName, indirilen dosyanın adıdır
+func (e *AttendeeExport) FileName() string {
+	return "attendees-event-" + strconv.FormatInt(e.EventID, 10) + "-" +
+		e.CreatedAt.Format("20060102-1504") + "." + string(e.Format)
+}
+
+// AttendeeExportColumns, dışa aktarılan dosyanın başlık satırıdır
+var AttendeeExportColumns = []string{
+	"ticket_number", "holder_name", "holder_email", "section", "seat_row", "seat_number", "status", "checked_in_at",
+}
+
+// AttendeeRow, katılımcı listesindeki tek bir bilettir
+type AttendeeRow struct {
+	TicketNumber string
+	HolderName   string
+	HolderEmail  string
+	SectionName  string
+	SeatRow      string // Ayakta / genel alan biletlerinde boş
+	SeatNumber   string
+	Status       TicketStatus
+	CheckedInAt  *time.Time // Bilet kullanımı veya ilk oturum girişi
+}
+
+// Record, satırı AttendeeExportColumns sırasıyla döndürür
+func (r *AttendeeRow) Record(loc *time.Location) []string {
+	checkedInAt := ""
+	if r.CheckedInAt != nil {
+		checkedInAt = r.CheckedInAt.In(loc).Format("2006-01-02 15:04:05")
+	}
+
+	return []string{
+		r.TicketNumber, r.HolderName, r.HolderEmail, r.SectionName,
+		r.SeatRow, r.SeatNumber, string(r.Status), checkedInAt,
+	}
+}
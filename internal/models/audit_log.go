@@ -0,0 +1,135 @@
+// -----------------------------------------------------------------------------
+// Audit Log Models
+// -----------------------------------------------------------------------------
+// Durum değiştiren işlemlerin denetim kayıtlarını temsil eder. Her kayıt
+// işlemi yapanı (kullanıcı veya sistem job'ı), eylemi, varlığı, alan
+// farklarını, istemci IP'sini ve istek ID'sini taşır. Kayıtlar append-only'dir.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/pkg/audit"
+)
+
+// AuditActorType, işlemi yapanın türüdür
+type AuditActorType string
+
+const (
+	AuditActorUser   AuditActorType = "user"
+	AuditActorSystem AuditActorType = "system" // Zamanlanmış job'lar, webhook'lar
+)
+
+// AuditAction, denetlenen eylemdir
+type AuditAction string
+
+const (
+	AuditActionCreate       AuditAction = "create"
+	AuditActionUpdate       AuditAction = "update"
+	AuditActionDelete       AuditAction = "delete"
+	AuditActionStatusChange AuditAction = "status_change"
+	AuditActionRestore      AuditAction = "restore"   // Çöp kutusundan geri yükleme
+	AuditActionPurge        AuditAction = "purge"     // Saklama süresi dolan kaydın kalıcı silinmesi
+	AuditActionReconcile    AuditAction = "reconcile" // Envanter uzlaştırmasıyla sayaç düzeltmesi
+)
+
+// AuditEntityType, değişen kaydın tipidir
+type AuditEntityType string
+
+const (
+	AuditEntityEvent         AuditEntityType = "event"
+	AuditEntityTicket        AuditEntityType = "ticket"
+	AuditEntityPayment       AuditEntityType = "payment"
+	AuditEntityWaitingList   AuditEntityType = "waiting_list"
+	AuditEntityVenue         AuditEntityType = "venue"
+	AuditEntitySalePhase     AuditEntityType = "sale_phase"
+	AuditEntityPurchaseLimit AuditEntityType = "purchase_limit"
+	AuditEntityAddOn         AuditEntityType = "add_on"
+	AuditEntityEventSession  AuditEntityType = "event_session"
+	AuditEntitySeasonPackage AuditEntityType = "season_package"
+)
+
+// AuditActor, işlemi yapan ve isteğin izini taşır
+type AuditActor struct {
+	Type      AuditActorType
+	ID        string // Kullanıcı ID'si veya job adı
+	IP        string
+	RequestID string
+}
+
+// UserActor, HTTP isteği yapan kullanıcı için actor oluşturur
+func UserActor(userID int64, ip, requestID string) AuditActor {
+	return AuditActor{
+		Type:      AuditActorUser,
+		ID:        strconv.FormatInt(userID, 10),
+		IP:        ip,
+		RequestID: requestID,
+	}
+}
+
+// SystemActor, zamanlanmış job veya webhook gibi sistem kaynaklı işlemler için actor oluşturur
+func SystemActor(name string) AuditActor {
+	return AuditActor{Type: AuditActorSystem, ID: name}
+}
+
+// IsZero, actor'ün hiç atanmadığını kontrol eder
+func (a AuditActor) IsZero() bool {
+	return a.Type == ""
+}
+
+// AuditLog, tek bir denetim kaydıdır
+type AuditLog struct {
+	ID         int64           `json:"id" db:"id"`
+	ActorType  AuditActorType  `json:"actor_type" db:"actor_type"`
+	ActorID    string          `json:"actor_id" db:"actor_id"`
+	Action     AuditAction     `json:"action" db:"action"`
+	EntityType AuditEntityType `json:"entity_type" db:"entity_type"`
+	EntityID   int64           `json:"entity_id" db:"entity_id"`
+	ChangesRaw string          `json:"-" db:"changes"`
+	Changes    audit.Changes   `json:"changes" db:"-"`
+	IPAddress  string          `json:"ip_address,omitempty" db:"ip_address"`
+	RequestID  string          `json:"request_id,omitempty" db:"request_id"`
+	CreatedAt  time.Time       `json:"created_at" db:"created_at"`
+}
+
+// AuditLogFilter, denetim kayıtlarının admin sorgusudur. Sonuçlar en yeniden
+// eskiye sıralanır; sonraki sayfa için BeforeID son kaydın ID'si olmalıdır.
+type AuditLogFilter struct {
+	EntityType AuditEntityType
+	EntityID   int64
+	ActorType  AuditActorType
+	ActorID    string
+	Action     AuditAction
+	From       *time.Time // Dahil
+	To         *time.Time // Hariç
+	BeforeID   int64
+	Limit      int
+}
+
+// Normalize, varsayılan ve üst limit değerlerini uygular
+func (f *AuditLogFilter) Normalize() {
+	if f.Limit <= 0 {
+		f.Limit = 50
+	}
+	if f.Limit > 200 {
+		f.Limit = 200
+	}
+}
+
+// Validate, filtrenin tutarlı olup olmadığını kontrol eder
+func (f *AuditLogFilter) Validate() error {
+	if f.EntityID != 0 && f.EntityType == "" {
+		return fmt.Errorf("entity_id için entity_type gerekli")
+	}
+	if f.ActorID != "" && f.ActorType == "" {
+		return fmt.Errorf("actor_id için actor_type gerekli")
+	}
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return fmt.Errorf("from, to'dan önce olmalı")
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package models
+
+import "fmt"
+
+// ConflictError, iyimser kilitleme (version) çakışmasında döner: kayıt okunduktan
+// sonra başka bir işlem tarafından değiştirilmiştir. HTTP katmanında 409 Conflict'e
+// karşılık gelir; istemci kaydı yeniden okuyup değişikliği tekrar uygulamalıdır.
+type ConflictError struct {
+	Entity   AuditEntityType // event, ticket, payment
+	ID       int64
+	Expected int64 // İstemcinin / servisin okuduğu version
+	Current  int64 // Veritabanındaki güncel version
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s #%d başka bir işlem tarafından değiştirildi (beklenen sürüm: %d, güncel sürüm: %d)",
+		e.Entity, e.ID, e.Expected, e.Current)
+}
+
+// CheckVersion, istemcinin If-Match ile okuduğunu bildirdiği sürümü kaydın güncel
+// sürümüyle karşılaştırır; farklıysa *ConflictError döner. expected 0 ise ön koşul yoktur.
+func CheckVersion(entity AuditEntityType, id, expected, current int64) error {
+	if expected != 0 && expected != current {
+		return &ConflictError{
+			Entity:   entity,
+			ID:       id,
+			Expected: expected,
+			Current:  current,
+		}
+	}
+
+	return nil
+}
@@ -41,27 +41,46 @@ type Event struct {
 	Type            EventType   `json:"type" db:"type"`
 	Status          EventStatus `json:"status" db:"status"`
 	VenueID         int64       `json:"venue_id" db:"venue_id"`
+	SeriesID        *int64      `json:"series_id,omitempty" db:"series_id"` // Tekrarlayan seriden üretildiyse
 	StartTime       time.Time   `json:"start_time" db:"start_time"`
 	EndTime         time.Time   `json:"end_time" db:"end_time"`
 	ImageURL        string      `json:"image_url,omitempty" db:"image_url"`
 	TotalCapacity   int         `json:"total_capacity" db:"total_capacity"`
 	AvailableSeats  int         `json:"available_seats" db:"available_seats"`
-	BasePr ice       float64     `json:"base_price" db:"base_price"`
+	BasePrice       float64     `json:"base_price" db:"base_price"`
 	OrganizerId     int64       `json:"organizer_id" db:"organizer_id"`
 	IsFeatured      bool        `json:"is_featured" db:"is_featured"`
 	SaleStartTime   *time.Time  `json:"sale_start_time,omitempty" db:"sale_start_time"`
 	SaleEndTime     *time.Time  `json:"sale_end_time,omitempty" db:"sale_end_time"`
-	DeletedAt       *time.Time  `json:"-" db:"deleted_at"`
+	DeletedAt       *time.Time  `json:"deleted_at,omitempty" db:"deleted_at"`
+	Version         int64       `json:"version" db:"version"` // İyimser kilitleme: her yazmada artar (ETag)
 
 	// İlişkili veriler
-	Venue     *Venue `json:"venue,omitempty" db:"-"`
-	Organizer *User  `json:"organizer,omitempty" db:"-"`
+	Venue      *Venue          `json:"venue,omitempty" db:"-"`
+	Organizer  *Organizer      `json:"organizer,omitempty" db:"-"`
+	SalePhases []*SalePhase    `json:"sale_phases,omitempty" db:"-"` // Yüklüyse satış penceresi aşamalardan belirlenir
+	Images     []*ImageVariant `json:"images,omitempty" db:"-"`      // Görsel varyantları (ImageURL = hero)
 }
 
-// IsSaleActive, bilet satışının aktif olup olmadığını kontrol eder
+// IsSaleActive, bilet satışının herkese açık olarak aktif olup olmadığını kontrol eder
 func (e *Event) IsSaleActive() bool {
+	return e.IsSaleActiveFor(nil)
+}
+
+// IsSaleActiveFor, bilet satışının verilen alıcı için aktif olup olmadığını kontrol eder.
+// Satış aşamaları tanımlıysa alıcının erişebildiği açık bir aşama olmalıdır;
+// değilse SaleStartTime / SaleEndTime penceresi geçerlidir.
+func (e *Event) IsSaleActiveFor(buyer *SaleBuyer) bool {
 	now := time.Now()
 
+	if len(e.SalePhases) > 0 {
+		// Presale'ler genel satış (sale_active) öncesinde, published durumunda da açılabilir
+		if e.Status != EventStatusSaleActive && e.Status != EventStatusPublished {
+			return false
+		}
+		return e.AvailableSeats > 0 && e.ActivePhaseFor(buyer, now) != nil
+	}
+
 	if e.Status != EventStatusSaleActive {
 		return false
 	}
@@ -77,6 +96,23 @@ func (e *Event) IsSaleActive() bool {
 	return e.AvailableSeats > 0
 }
 
+// ActivePhaseFor, alıcının verilen anda satın alabileceği aşamayı döndürür.
+// Birden fazla aşama açıksa sort_order'ı küçük olan (ör. presale) önceliklidir.
+func (e *Event) ActivePhaseFor(buyer *SaleBuyer, now time.Time) *SalePhase {
+	var active *SalePhase
+
+	for _, phase := range e.SalePhases {
+		if !phase.IsOpen(now) || !phase.Allows(buyer) || !phase.HasInventory() {
+			continue
+		}
+		if active == nil || phase.SortOrder < active.SortOrder {
+			active = phase
+		}
+	}
+
+	return active
+}
+
 // IsSoldOut, etkinliğin tükenip tükenmediğini kontrol eder
 func (e *Event) IsSoldOut() bool {
 	return e.AvailableSeats <= 0
@@ -84,8 +120,8 @@ func (e *Event) IsSoldOut() bool {
 
 // CanCancel, etkinliğin iptal edilip edilemeyeceğini kontrol eder
 func (e *Event) CanCancel() bool {
-	// Etkinlik henüz başlamadıysa iptal edilebilir
-	return time.Now().Before(e.StartTime)
+	// Durum makinesi izin veriyorsa ve etkinlik henüz başlamadıysa iptal edilebilir
+	return eventStates.Check(e, EventStatusCancelled) == nil && time.Now().Before(e.StartTime)
 }
 
 // IsUpcoming, etkinliğin yaklaşan olup olmadığını kontrol eder
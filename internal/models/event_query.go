@@ -0,0 +1,227 @@
+// -----------------------------------------------------------------------------
+// Event Query Model
+// -----------------------------------------------------------------------------
+// Etkinlik listesi için tipli filtre + sıralama. Sayfalama keyset cursor ile
+// yapılır: cursor son kaydın sıralama değerini ve ID'sini taşır, bir sonraki
+// sayfa (değer, id) çiftinden sonrası olarak sorgulanır. Araya yeni etkinlik
+// eklense de sayfalar kaymaz.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/pkg/pagination"
+)
+
+const (
+	DefaultEventQueryLimit = 20
+	MaxEventQueryLimit     = 100
+)
+
+// EventSort, liste sıralamasıdır ("-" öneki azalan sıra demektir)
+type EventSort string
+
+const (
+	EventSortStartTime     EventSort = "start_time"
+	EventSortStartTimeDesc EventSort = "-start_time"
+	EventSortPrice         EventSort = "price"
+	EventSortPriceDesc     EventSort = "-price"
+	EventSortName          EventSort = "name"
+	EventSortNameDesc      EventSort = "-name"
+	EventSortCreated       EventSort = "created_at"
+	EventSortCreatedDesc   EventSort = "-created_at" // En yeni eklenenler
+)
+
+// eventSortColumns, sıralama anahtarlarının events kolonlarıdır
+var eventSortColumns = map[string]string{
+	"start_time": "start_time",
+	"price":      "base_price",
+	"name":       "name",
+	"created_at": "created_at",
+}
+
+// IsValid, sıralamanın desteklenip desteklenmediğini kontrol eder
+func (s EventSort) IsValid() bool {
+	_, ok := eventSortColumns[strings.TrimPrefix(string(s), "-")]
+	return ok
+}
+
+// Column, sıralanan kolonu döndürür
+func (s EventSort) Column() string {
+	return eventSortColumns[strings.TrimPrefix(string(s), "-")]
+}
+
+// Descending, sıralamanın azalan olup olmadığını döndürür
+func (s EventSort) Descending() bool {
+	return strings.HasPrefix(string(s), "-")
+}
+
+// CursorValue, etkinliğin sıralama değerini cursor'a yazılacak metin olarak döndürür
+func (s EventSort) CursorValue(e *Event) string {
+	switch s.Column() {
+	case "start_time":
+		return e.StartTime.UTC().Format(time.RFC3339Nano)
+	case "created_at":
+		return e.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case "base_price":
+		return strconv.FormatFloat(e.BasePrice, 'f', -1, 64)
+	default:
+		return e.Name
+	}
+}
+
+// ParseCursorValue, cursor'daki metni kolon tipine çevirir
+func (s EventSort) ParseCursorValue(value string) (interface{}, error) {
+	switch s.Column() {
+	case "start_time", "created_at":
+		return time.Parse(time.RFC3339Nano, value)
+	case "base_price":
+		return strconv.ParseFloat(value, 64)
+	default:
+		return value, nil
+	}
+}
+
+// EventQuery, tipli etkinlik listesi sorgusudur. Boş alanlar filtre uygulamaz.
+type EventQuery struct {
+	Types     []EventType
+	Statuses  []EventStatus
+	VenueID   int64
+	City      string
+	StartFrom *time.Time // start_time >= StartFrom
+	StartTo   *time.Time // start_time <= StartTo
+	MinPrice  *float64
+	MaxPrice  *float64
+	Available *bool // true: müsait koltuğu olanlar, false: tükenmiş olanlar
+	Featured  *bool
+
+	Sort         EventSort
+	Limit        int
+	Cursor       string // Önceki sayfanın next_cursor değeri
+	IncludeTotal bool   // Toplam kayıt sayısı da hesaplansın mı (ek COUNT sorgusu)
+}
+
+// Normalize, varsayılan sıralama ve limiti uygular
+func (q *EventQuery) Normalize() {
+	if q.Sort == "" {
+		q.Sort = EventSortStartTime
+	}
+	if q.Limit < 1 {
+		q.Limit = DefaultEventQueryLimit
+	}
+	if q.Limit > MaxEventQueryLimit {
+		q.Limit = MaxEventQueryLimit
+	}
+}
+
+// Validate, filtre değerlerini kontrol eder
+func (q *EventQuery) Validate() error {
+	if !q.Sort.IsValid() {
+		return fmt.Errorf("sort: geçersiz sıralama %q", q.Sort)
+	}
+
+	for _, eventType := range q.Types {
+		switch eventType {
+		case EventTypeConcert, EventTypeTheater, EventTypeSports, EventTypeConference, EventTypeFestival:
+		default:
+			return fmt.Errorf("type: geçersiz etkinlik tipi %q", eventType)
+		}
+	}
+
+	for _, status := range q.Statuses {
+		switch status {
+		case EventStatusDraft, EventStatusPublished, EventStatusSaleActive, EventStatusSoldOut, EventStatusCancelled, EventStatusCompleted:
+		default:
+			return fmt.Errorf("status: geçersiz durum %q", status)
+		}
+	}
+
+	if q.StartFrom != nil && q.StartTo != nil && q.StartTo.Before(*q.StartFrom) {
+		return fmt.Errorf("end_date start_date'ten önce olamaz")
+	}
+
+	if q.MinPrice != nil && *q.MinPrice < 0 {
+		return fmt.Errorf("min_price negatif olamaz")
+	}
+	if q.MinPrice != nil && q.MaxPrice != nil && *q.MaxPrice < *q.MinPrice {
+		return fmt.Errorf("max_price min_price'tan küçük olamaz")
+	}
+
+	return nil
+}
+
+// Fingerprint, filtrelerin parmak izidir; cursor başka filtrelerle kullanılamaz
+func (q *EventQuery) Fingerprint() string {
+	parts := []string{
+		fmt.Sprint(q.Types),
+		fmt.Sprint(q.Statuses),
+		strconv.FormatInt(q.VenueID, 10),
+		strings.ToLower(q.City),
+		formatOptionalTime(q.StartFrom),
+		formatOptionalTime(q.StartTo),
+		formatOptionalFloat(q.MinPrice),
+		formatOptionalFloat(q.MaxPrice),
+		formatOptionalBool(q.Available),
+		formatOptionalBool(q.Featured),
+	}
+	return pagination.Fingerprint(parts...)
+}
+
+// DecodeCursor, sorgunun cursor'ını çözer ve bu sorguya ait olduğunu doğrular (yoksa nil)
+func (q *EventQuery) DecodeCursor() (*pagination.Cursor, error) {
+	if q.Cursor == "" {
+		return nil, nil
+	}
+
+	cursor, err := pagination.Decode(q.Cursor)
+	if err != nil || !cursor.Matches(string(q.Sort), q.Fingerprint()) {
+		return nil, fmt.Errorf("cursor: bu sorgu için geçersiz cursor")
+	}
+	if _, err := q.Sort.ParseCursorValue(cursor.Value); err != nil {
+		return nil, fmt.Errorf("cursor: bu sorgu için geçersiz cursor")
+	}
+
+	return cursor, nil
+}
+
+// NextCursor, sayfanın son etkinliğinden sonraki sayfanın cursor'ını üretir
+func (q *EventQuery) NextCursor(last *Event) string {
+	return pagination.Encode(pagination.Cursor{
+		Sort:        string(q.Sort),
+		Value:       q.Sort.CursorValue(last),
+		ID:          last.ID,
+		Fingerprint: q.Fingerprint(),
+	})
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func formatOptionalFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+func formatOptionalBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+// EventPage, cursor sayfalı etkinlik listesidir
+type EventPage struct {
+	Events []*Event
+	Meta   pagination.Meta
+}
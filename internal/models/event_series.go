@@ -0,0 +1,63 @@
+// -----------------------------------------------------------------------------
+// Event Series Model
+// -----------------------------------------------------------------------------
+// Tekrarlayan etkinlik serisini temsil eder (ör. sezon boyunca haftada dört
+// akşam oynanan bir tiyatro oyunu). Seri bir tekrar kuralı (RRULE benzeri,
+// bkz. pkg/recurrence) ve istisna günleri tutar; her gösterim serinin açıklama,
+// mekan, tip ve fiyat ayarlarını paylaşan ayrı bir Event'tir.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"time"
+)
+
+// DefaultSeriesTimezone, saat dilimi belirtilmeyen seriler için kullanılır
+const DefaultSeriesTimezone = "Europe/Istanbul"
+
+// EventSeries, tekrarlayan bir etkinlik serisidir
+type EventSeries struct {
+	BaseModel
+	Name            string    `json:"name" db:"name"`
+	Description     string    `json:"description" db:"description"`
+	Type            EventType `json:"type" db:"type"`
+	VenueID         int64     `json:"venue_id" db:"venue_id"`
+	BasePrice       float64   `json:"base_price" db:"base_price"`
+	ImageURL        string    `json:"image_url,omitempty" db:"image_url"`
+	DurationMinutes int       `json:"duration_minutes" db:"duration_minutes"`
+	RecurrenceRule  string    `json:"recurrence_rule" db:"recurrence_rule"`
+	FirstStartTime  time.Time `json:"first_start_time" db:"first_start_time"`
+	Timezone        string    `json:"timezone" db:"timezone"`
+
+	// İlişkili veriler
+	Exceptions []time.Time `json:"exceptions,omitempty" db:"-"` // Gösterim yapılmayacak günler
+}
+
+// Duration, bir gösterimin süresini döndürür
+func (s *EventSeries) Duration() time.Duration {
+	return time.Duration(s.DurationMinutes) * time.Minute
+}
+
+// Location, serinin saat dilimini döndürür (geçersizse varsayılan)
+func (s *EventSeries) Location() *time.Location {
+	if loc, err := time.LoadLocation(s.Timezone); err == nil {
+		return loc
+	}
+	if loc, err := time.LoadLocation(DefaultSeriesTimezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// Performance, "gösterim seç" listesindeki bir satırdır
+type Performance struct {
+	EventID        int64       `json:"event_id"`
+	StartTime      time.Time   `json:"start_time"`
+	EndTime        time.Time   `json:"end_time"`
+	Status         EventStatus `json:"status"`
+	AvailableSeats int         `json:"available_seats"`
+	TotalCapacity  int         `json:"total_capacity"`
+	BasePrice      float64     `json:"base_price"`
+	IsSoldOut      bool        `json:"is_sold_out"`
+}
@@ -0,0 +1,71 @@
+// -----------------------------------------------------------------------------
+// Event Session Model
+// -----------------------------------------------------------------------------
+// Çok günlü etkinliklerin (festival) gün / oturumlarını temsil eder.
+//
+// Bir festival kombinesi tek bir bilettir; bilet bir veya daha fazla oturuma
+// giriş hakkı (TicketEntitlement) verir. Kapasite oturum bazında tutulur ve
+// check-in, etkinliğin tamamına değil o anda açık olan oturuma göre yapılır.
+// Oturumu olmayan etkinlikler eskisi gibi tek girişli bilet kullanır.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"time"
+)
+
+// EventSession, bir etkinliğin gün / oturumudur
+type EventSession struct {
+	BaseModel
+	EventID        int64      `json:"event_id" db:"event_id"`
+	Name           string     `json:"name" db:"name"`
+	DoorsOpenAt    *time.Time `json:"doors_open_at,omitempty" db:"doors_open_at"` // nil = StartsAt
+	StartsAt       time.Time  `json:"starts_at" db:"starts_at"`
+	EndsAt         time.Time  `json:"ends_at" db:"ends_at"`
+	Capacity       int        `json:"capacity" db:"capacity"`
+	AllowReentry   bool       `json:"allow_reentry" db:"allow_reentry"`
+	IsActive       bool       `json:"is_active" db:"is_active"`
+	SoldCount      int        `json:"sold_count" db:"sold_count"`             // Aktif giriş hakkı sayısı, sorguda hesaplanır
+	CheckedInCount int        `json:"checked_in_count" db:"checked_in_count"` // Giriş yapmış bilet sayısı, sorguda hesaplanır
+}
+
+// Remaining, oturumda kalan giriş hakkı sayısını döndürür
+func (s *EventSession) Remaining() int {
+	if s.SoldCount >= s.Capacity {
+		return 0
+	}
+	return s.Capacity - s.SoldCount
+}
+
+// EntryOpensAt, girişin açıldığı zamanı döndürür
+func (s *EventSession) EntryOpensAt() time.Time {
+	if s.DoorsOpenAt != nil {
+		return *s.DoorsOpenAt
+	}
+	return s.StartsAt
+}
+
+// IsRunning, oturumun verilen anda giriş kabul edip etmediğini kontrol eder
+func (s *EventSession) IsRunning(at time.Time) bool {
+	return s.IsActive && !at.Before(s.EntryOpensAt()) && at.Before(s.EndsAt)
+}
+
+// TicketEntitlement, bir biletin bir oturuma giriş hakkıdır
+type TicketEntitlement struct {
+	ID           int64      `json:"id" db:"id"`
+	TicketID     int64      `json:"ticket_id" db:"ticket_id"`
+	SessionID    int64      `json:"session_id" db:"session_id"`
+	EntryCount   int        `json:"entry_count" db:"entry_count"`
+	FirstEntryAt *time.Time `json:"first_entry_at,omitempty" db:"first_entry_at"`
+	LastEntryAt  *time.Time `json:"last_entry_at,omitempty" db:"last_entry_at"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+
+	// İlişkili veriler
+	Session *EventSession `json:"session,omitempty" db:"-"`
+}
+
+// HasEntered, bu oturumda giriş yapılıp yapılmadığını kontrol eder
+func (e *TicketEntitlement) HasEntered() bool {
+	return e.EntryCount > 0
+}
@@ -0,0 +1,71 @@
+// -----------------------------------------------------------------------------
+// Event Session Tests
+// -----------------------------------------------------------------------------
+// Bu testler, çok günlü etkinlik oturumlarının kapasite ve giriş aralığı
+// hesaplarının sınır anlarında doğru çalıştığını doğrular.
+//
+// Testler:
+// - Oturum kapasitesi (kalan giriş hakkı, 0 alt sınırı)
+// - Giriş aralığı (kapı saati dahil, bitiş hariç, gece yarısını aşan oturum)
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// TestEventSession_Remaining tests remaining session capacity, including oversold sessions.
+func TestEventSession_Remaining(t *testing.T) {
+	tests := []struct {
+		name     string
+		session  EventSession
+		expected int
+	}{
+		{"empty session", EventSession{Capacity: 1000}, 1000},
+		{"partially sold", EventSession{Capacity: 1000, SoldCount: 640}, 360},
+		{"sold out", EventSession{Capacity: 1000, SoldCount: 1000}, 0},
+		{"oversold never negative", EventSession{Capacity: 1000, SoldCount: 1002}, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.session.Remaining(); got != tc.expected {
+				t.Errorf("Expected %d remaining, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestEventSession_IsRunning tests the entry window from doors (or start) to end.
+func TestEventSession_IsRunning(t *testing.T) {
+	doors := time.Date(2025, 7, 12, 14, 0, 0, 0, time.UTC)
+	starts := time.Date(2025, 7, 12, 16, 0, 0, 0, time.UTC)
+	ends := time.Date(2025, 7, 13, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		doors    *time.Time
+		isActive bool
+		at       time.Time
+		expected bool
+	}{
+		{"before doors", &doors, true, doors.Add(-time.Minute), false},
+		{"doors open is inclusive", &doors, true, doors, true},
+		{"without doors time entry opens at start", nil, true, doors, false},
+		{"start without doors time", nil, true, starts, true},
+		{"after midnight", &doors, true, ends.Add(-time.Hour), true},
+		{"end is exclusive", &doors, true, ends, false},
+		{"inactive session", &doors, false, starts, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			session := &EventSession{DoorsOpenAt: tc.doors, StartsAt: starts, EndsAt: ends, IsActive: tc.isActive}
+			if got := session.IsRunning(tc.at); got != tc.expected {
+				t.Errorf("Expected IsRunning %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// -----------------------------------------------------------------------------
+// Geo Models
+// -----------------------------------------------------------------------------
+// Konuma göre mekan / etkinlik araması için nokta, sınır kutusu ve mesafe
+// hesapları. Veritabanında önce (latitude, longitude) indeksiyle sınır kutusu
+// ön filtresi uygulanır, ardından haversine mesafesi hesaplanıp sıralanır.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	EarthRadiusKm  = 6371.0 // Ortalama dünya yarıçapı
+	MaxGeoRadiusKm = 500.0  // Yarıçap araması için üst sınır
+)
+
+// GeoPoint, enlem / boylam çiftidir (derece)
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Validate, koordinatların geçerli aralıkta olup olmadığını kontrol eder
+func (p GeoPoint) Validate() error {
+	if math.IsNaN(p.Lat) || p.Lat < -90 || p.Lat > 90 {
+		return fmt.Errorf("lat: -90 ile 90 arasında olmalı")
+	}
+	if math.IsNaN(p.Lng) || p.Lng < -180 || p.Lng > 180 {
+		return fmt.Errorf("lng: -180 ile 180 arasında olmalı")
+	}
+	return nil
+}
+
+// DistanceKm, iki nokta arasındaki büyük daire mesafesini haversine formülüyle hesaplar
+func (p GeoPoint) DistanceKm(other GeoPoint) float64 {
+	lat1, lat2 := degreesToRadians(p.Lat), degreesToRadians(other.Lat)
+	dLat := lat2 - lat1
+	dLng := degreesToRadians(other.Lng - p.Lng)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// GeoBounds, enlem / boylam sınır kutusudur.
+// MinLng > MaxLng ise kutu 180. meridyeni (antimeridyen) kesiyordur.
+type GeoBounds struct {
+	MinLat float64 `json:"min_lat"`
+	MinLng float64 `json:"min_lng"`
+	MaxLat float64 `json:"max_lat"`
+	MaxLng float64 `json:"max_lng"`
+}
+
+// Validate, sınır kutusunun geçerli olup olmadığını kontrol eder
+func (b GeoBounds) Validate() error {
+	if err := (GeoPoint{Lat: b.MinLat, Lng: b.MinLng}).Validate(); err != nil {
+		return err
+	}
+	if err := (GeoPoint{Lat: b.MaxLat, Lng: b.MaxLng}).Validate(); err != nil {
+		return err
+	}
+	if b.MinLat > b.MaxLat {
+		return fmt.Errorf("min_lat max_lat'tan büyük olamaz")
+	}
+	return nil
+}
+
+// CrossesAntimeridian, kutunun 180. meridyeni kesip kesmediğini döndürür
+func (b GeoBounds) CrossesAntimeridian() bool {
+	return b.MinLng > b.MaxLng
+}
+
+// Center, kutunun merkez noktasıdır (sonuçlar bu noktaya uzaklığa göre sıralanır)
+func (b GeoBounds) Center() GeoPoint {
+	maxLng := b.MaxLng
+	if b.CrossesAntimeridian() {
+		maxLng += 360
+	}
+
+	lng := (b.MinLng + maxLng) / 2
+	if lng > 180 {
+		lng -= 360
+	}
+
+	return GeoPoint{Lat: (b.MinLat + b.MaxLat) / 2, Lng: lng}
+}
+
+// BoundsAround, merkezden radiusKm uzaklıktaki tüm noktaları içeren en küçük kutuyu döndürür.
+// Haversine sorgusunun ön filtresidir; kutu dairenin köşelerini de içerir.
+func BoundsAround(center GeoPoint, radiusKm float64) GeoBounds {
+	dLat := radiansToDegrees(radiusKm / EarthRadiusKm)
+	bounds := GeoBounds{
+		MinLat: math.Max(center.Lat-dLat, -90),
+		MaxLat: math.Min(center.Lat+dLat, 90),
+		MinLng: -180,
+		MaxLng: 180,
+	}
+
+	// Kutup çevresinde tüm boylamlar kapsanır
+	if bounds.MinLat == -90 || bounds.MaxLat == 90 {
+		return bounds
+	}
+
+	dLng := radiansToDegrees(math.Asin(math.Min(1, math.Sin(radiusKm/EarthRadiusKm)/math.Cos(degreesToRadians(center.Lat)))))
+	if dLng >= 180 {
+		return bounds
+	}
+
+	bounds.MinLng = wrapLongitude(center.Lng - dLng)
+	bounds.MaxLng = wrapLongitude(center.Lng + dLng)
+	return bounds
+}
+
+func wrapLongitude(lng float64) float64 {
+	if lng < -180 {
+		return lng + 360
+	}
+	if lng > 180 {
+		return lng - 360
+	}
+	return lng
+}
+
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func radiansToDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// GeoMatch, konum sorgusunun eşleşen kaydıdır (mekan veya etkinlik)
+type GeoMatch struct {
+	ID         int64
+	VenueID    int64
+	DistanceKm float64
+}
+
+// NearbyVenue, merkeze uzaklığıyla birlikte bir mekandır
+type NearbyVenue struct {
+	*Venue
+	DistanceKm float64 `json:"distance_km"`
+}
+
+// NearbyEvent, mekanının merkeze uzaklığıyla birlikte bir etkinliktir
+type NearbyEvent struct {
+	*Event
+	DistanceKm float64 `json:"distance_km"`
+}
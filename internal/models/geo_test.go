@@ -0,0 +1,194 @@
+// -----------------------------------------------------------------------------
+// Geo Tests
+// -----------------------------------------------------------------------------
+// Bu testler, yakındaki etkinlik aramasında kullanılan mesafe ve sınır kutusu
+// hesaplarının kutuplarda ve antimeridyen karşısında da doğru kaldığını
+// doğrular.
+//
+// Testler:
+// - Koordinat ve sınır kutusu doğrulama (NaN, ters enlem, aralık dışı)
+// - Haversine mesafesi (antimeridyen karşısı dahil)
+// - Kutu merkezi ve antimeridyen kesişimi
+// - Yarıçap kutusu (kutup, antimeridyen, dairenin kutu içinde kalması)
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"math"
+	"testing"
+)
+
+// TestGeoPoint_Validate tests coordinate ranges and NaN values.
+func TestGeoPoint_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		point   GeoPoint
+		wantErr bool
+	}{
+		{"corners", GeoPoint{Lat: -90, Lng: 180}, false},
+		{"lat too high", GeoPoint{Lat: 90.1, Lng: 0}, true},
+		{"lng too low", GeoPoint{Lat: 0, Lng: -180.1}, true},
+		{"nan lat", GeoPoint{Lat: math.NaN(), Lng: 0}, true},
+		{"nan lng", GeoPoint{Lat: 0, Lng: math.NaN()}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.point.Validate(); (err != nil) != tc.wantErr {
+				t.Errorf("Expected error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestGeoPoint_DistanceKm tests haversine distances, including across the antimeridian.
+func TestGeoPoint_DistanceKm(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     GeoPoint
+		to       GeoPoint
+		expected float64
+	}{
+		{"same point", GeoPoint{Lat: 41.0082, Lng: 28.9784}, GeoPoint{Lat: 41.0082, Lng: 28.9784}, 0},
+		{"one degree on the equator", GeoPoint{Lat: 0, Lng: 0}, GeoPoint{Lat: 0, Lng: 1}, 111.195},
+		{"across the antimeridian", GeoPoint{Lat: 0, Lng: 179.5}, GeoPoint{Lat: 0, Lng: -179.5}, 111.195},
+		{"istanbul to ankara", GeoPoint{Lat: 41.0082, Lng: 28.9784}, GeoPoint{Lat: 39.9334, Lng: 32.8597}, 349.9},
+		{"pole to pole", GeoPoint{Lat: 90, Lng: 0}, GeoPoint{Lat: -90, Lng: 0}, math.Pi * EarthRadiusKm},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.from.DistanceKm(tc.to); math.Abs(got-tc.expected) > 1 {
+				t.Errorf("Expected ~%.3f km, got %.3f", tc.expected, got)
+			}
+			if a, b := tc.from.DistanceKm(tc.to), tc.to.DistanceKm(tc.from); math.Abs(a-b) > 1e-9 {
+				t.Errorf("Expected a symmetric distance, got %v and %v", a, b)
+			}
+		})
+	}
+}
+
+// TestGeoBounds_Validate tests inverted latitudes and boxes crossing the antimeridian.
+func TestGeoBounds_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		bounds  GeoBounds
+		wantErr bool
+	}{
+		{"regular", GeoBounds{MinLat: 40, MinLng: 28, MaxLat: 42, MaxLng: 30}, false},
+		{"crossing the antimeridian", GeoBounds{MinLat: -20, MinLng: 170, MaxLat: -10, MaxLng: -170}, false},
+		{"inverted latitudes", GeoBounds{MinLat: 42, MinLng: 28, MaxLat: 40, MaxLng: 30}, true},
+		{"longitude out of range", GeoBounds{MinLat: 40, MinLng: 28, MaxLat: 42, MaxLng: 190}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.bounds.Validate(); (err != nil) != tc.wantErr {
+				t.Errorf("Expected error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestGeoBounds_Center tests the center of regular and antimeridian-crossing boxes.
+func TestGeoBounds_Center(t *testing.T) {
+	tests := []struct {
+		name     string
+		bounds   GeoBounds
+		crosses  bool
+		expected GeoPoint
+	}{
+		{"regular", GeoBounds{MinLat: 40, MinLng: 28, MaxLat: 42, MaxLng: 30}, false, GeoPoint{Lat: 41, Lng: 29}},
+		{"antimeridian east of 180", GeoBounds{MinLat: -20, MinLng: 175, MaxLat: -10, MaxLng: -165}, true, GeoPoint{Lat: -15, Lng: -175}},
+		{"antimeridian west of 180", GeoBounds{MinLat: -20, MinLng: 165, MaxLat: -10, MaxLng: -175}, true, GeoPoint{Lat: -15, Lng: 175}},
+		{"whole world", GeoBounds{MinLat: -90, MinLng: -180, MaxLat: 90, MaxLng: 180}, false, GeoPoint{Lat: 0, Lng: 0}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.bounds.CrossesAntimeridian(); got != tc.crosses {
+				t.Errorf("Expected CrossesAntimeridian %t, got %t", tc.crosses, got)
+			}
+			got := tc.bounds.Center()
+			if math.Abs(got.Lat-tc.expected.Lat) > 1e-9 || math.Abs(got.Lng-tc.expected.Lng) > 1e-9 {
+				t.Errorf("Expected center %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestBoundsAround tests that the radius box stays valid near the poles and the
+// antimeridian and always contains the whole circle.
+func TestBoundsAround(t *testing.T) {
+	tests := []struct {
+		name     string
+		center   GeoPoint
+		radiusKm float64
+		crosses  bool
+		allLng   bool
+	}{
+		{"istanbul", GeoPoint{Lat: 41.0082, Lng: 28.9784}, 25, false, false},
+		{"equator", GeoPoint{Lat: 0, Lng: 0}, 111.195, false, false},
+		{"fiji crosses the antimeridian", GeoPoint{Lat: -17.7, Lng: 179.5}, 200, true, false},
+		{"west of the antimeridian", GeoPoint{Lat: -14.3, Lng: -179.9}, 50, true, false},
+		{"near the north pole", GeoPoint{Lat: 89.5, Lng: 10}, 100, false, true},
+		{"high latitude", GeoPoint{Lat: 78.2, Lng: 15.6}, MaxGeoRadiusKm, false, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bounds := BoundsAround(tc.center, tc.radiusKm)
+
+			if err := bounds.Validate(); err != nil {
+				t.Fatalf("Expected valid bounds, got %+v: %v", bounds, err)
+			}
+			if got := bounds.CrossesAntimeridian(); got != tc.crosses {
+				t.Errorf("Expected CrossesAntimeridian %t, got %t (%+v)", tc.crosses, got, bounds)
+			}
+			if allLng := bounds.MinLng == -180 && bounds.MaxLng == 180; allLng != tc.allLng {
+				t.Errorf("Expected all longitudes %t, got %t (%+v)", tc.allLng, allLng, bounds)
+			}
+			if !boundsContain(bounds, tc.center) {
+				t.Errorf("Bounds %+v do not contain the center", bounds)
+			}
+
+			// Daire üzerindeki noktalar kutunun içinde kalmalı
+			for bearing := 0.0; bearing < 360; bearing += 15 {
+				point := destinationPoint(tc.center, bearing, tc.radiusKm*0.999)
+				if !boundsContain(bounds, point) {
+					t.Fatalf("Bounds %+v do not contain %+v (bearing %.0f)", bounds, point, bearing)
+				}
+			}
+		})
+	}
+
+	// Bir derece yarıçap ekvatorda yaklaşık bir derecelik kutu verir
+	bounds := BoundsAround(GeoPoint{}, 111.195)
+	if math.Abs(bounds.MaxLat-1) > 0.001 || math.Abs(bounds.MaxLng-1) > 0.001 {
+		t.Errorf("Expected a ~1 degree box, got %+v", bounds)
+	}
+}
+
+// boundsContain, noktanın kutu içinde olup olmadığını antimeridyeni dikkate alarak döndürür
+func boundsContain(b GeoBounds, p GeoPoint) bool {
+	if p.Lat < b.MinLat || p.Lat > b.MaxLat {
+		return false
+	}
+	if b.CrossesAntimeridian() {
+		return p.Lng >= b.MinLng || p.Lng <= b.MaxLng
+	}
+	return p.Lng >= b.MinLng && p.Lng <= b.MaxLng
+}
+
+// destinationPoint, merkezden verilen yönde (derece) distanceKm uzaklıktaki noktayı döndürür
+func destinationPoint(from GeoPoint, bearing, distanceKm float64) GeoPoint {
+	lat1, lng1 := degreesToRadians(from.Lat), degreesToRadians(from.Lng)
+	angular := distanceKm / EarthRadiusKm
+	theta := degreesToRadians(bearing)
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(angular) + math.Cos(lat1)*math.Sin(angular)*math.Cos(theta))
+	lng2 := lng1 + math.Atan2(math.Sin(theta)*math.Sin(angular)*math.Cos(lat1), math.Cos(angular)-math.Sin(lat1)*math.Sin(lat2))
+
+	return GeoPoint{Lat: radiansToDegrees(lat2), Lng: wrapLongitude(radiansToDegrees(lng2))}
+}
@@ -0,0 +1,31 @@
+// -----------------------------------------------------------------------------
+// Image Models
+// -----------------------------------------------------------------------------
+// Etkinlik ve mekanlara yüklenen görsellerin varyantlarını temsil eder.
+// Her yüklemede thumbnail, card ve hero varyantları üretilir; sahibin
+// ImageURL alanı hero varyantını gösterir.
+// -----------------------------------------------------------------------------
+
+package models
+
+// ImageEntityType, görselin ait olduğu kayıt tipidir
+type ImageEntityType string
+
+const (
+	ImageEntityEvent ImageEntityType = "event"
+	ImageEntityVenue ImageEntityType = "venue"
+)
+
+// ImageVariant, bir görselin storage'daki boyutlandırılmış bir varyantıdır
+type ImageVariant struct {
+	BaseModel
+	EntityType  ImageEntityType `json:"-" db:"entity_type"`
+	EntityID    int64           `json:"-" db:"entity_id"`
+	Variant     string          `json:"variant" db:"variant"` // thumbnail, card, hero
+	Path        string          `json:"-" db:"path"`
+	URL         string          `json:"url" db:"url"`
+	ContentType string          `json:"content_type" db:"content_type"`
+	Width       int             `json:"width" db:"width"`
+	Height      int             `json:"height" db:"height"`
+	SizeBytes   int             `json:"size_bytes" db:"size_bytes"`
+}
@@ -0,0 +1,90 @@
+// -----------------------------------------------------------------------------
+// Inventory Reconciliation Models
+// -----------------------------------------------------------------------------
+// events.available_seats denormalize bir sayaçtır; iptal veya süresi dolan
+// rezervasyon yarıda kalırsa gerçek envanterden sapar. Uzlaştırma, sayacı
+// bilet tablosu, aktif tutmalar (bekleme listesi teklifleri) ve bloke
+// koltuklardan yeniden hesaplar ve farkları raporlar.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"time"
+)
+
+// InventoryCount, bir etkinliğin envanterini oluşturan sayımlardır
+type InventoryCount struct {
+	EventID         int64
+	EventName       string
+	TotalCapacity   int
+	AvailableSeats  int // Kayıtlı sayaç (events.available_seats)
+	SeatCount       int // Mekandaki silinmemiş koltuklar (0 = koltuksuz / ayakta mekan)
+	ActiveSeatCount int // Bunlardan satışa açık (is_active) olanlar
+	ActiveTickets   int // Rezerve, satılmış veya kullanılmış biletler (pasif koltuktakiler hariç)
+	ActiveHolds     int // Bekleyen bekleme listesi teklifleri ve tutulan refakatçi koltukları (pasif koltuktakiler hariç)
+}
+
+// BlockedSeats, bloke (pasif) koltuklar yüzünden satılamayan kapasitedir.
+// Koltuksuz mekanlarda 0'dır.
+func (c *InventoryCount) BlockedSeats() int {
+	if c.SeatCount == 0 || c.ActiveSeatCount >= c.TotalCapacity {
+		return 0
+	}
+	return c.TotalCapacity - c.ActiveSeatCount
+}
+
+// ExpectedAvailable, sayacın olması gereken değeridir:
+// kapasite - bloke koltuklar - aktif biletler - aktif tutmalar (0'ın altına inmez)
+func (c *InventoryCount) ExpectedAvailable() int {
+	expected := c.TotalCapacity - c.BlockedSeats() - c.ActiveTickets - c.ActiveHolds
+	if expected < 0 {
+		return 0
+	}
+	return expected
+}
+
+// Drift, kayıtlı sayaç ile beklenen değer arasındaki farktır (pozitif = fazla satılabilir görünüyor)
+func (c *InventoryCount) Drift() int {
+	return c.AvailableSeats - c.ExpectedAvailable()
+}
+
+// InventoryDiscrepancy, sayacı gerçek envanterle uyuşmayan bir etkinliktir
+type InventoryDiscrepancy struct {
+	EventID       int64  `json:"event_id"`
+	EventName     string `json:"event_name"`
+	TotalCapacity int    `json:"total_capacity"`
+	Recorded      int    `json:"recorded"` // events.available_seats
+	Expected      int    `json:"expected"` // Yeniden hesaplanan değer
+	Drift         int    `json:"drift"`    // recorded - expected
+	ActiveTickets int    `json:"active_tickets"`
+	ActiveHolds   int    `json:"active_holds"`
+	BlockedSeats  int    `json:"blocked_seats"`
+	Corrected     bool   `json:"corrected"`
+	Error         string `json:"error,omitempty"` // Düzeltme yapılamadıysa nedeni
+}
+
+// NewInventoryDiscrepancy, sayımlardan fark kaydı oluşturur
+func NewInventoryDiscrepancy(count *InventoryCount) *InventoryDiscrepancy {
+	return &InventoryDiscrepancy{
+		EventID:       count.EventID,
+		EventName:     count.EventName,
+		TotalCapacity: count.TotalCapacity,
+		Recorded:      count.AvailableSeats,
+		Expected:      count.ExpectedAvailable(),
+		Drift:         count.Drift(),
+		ActiveTickets: count.ActiveTickets,
+		ActiveHolds:   count.ActiveHolds,
+		BlockedSeats:  count.BlockedSeats(),
+	}
+}
+
+// ReconciliationReport, bir uzlaştırma çalıştırmasının sonucudur
+type ReconciliationReport struct {
+	DryRun        bool                    `json:"dry_run"`
+	Checked       int                     `json:"checked"`   // Kontrol edilen etkinlik sayısı
+	Corrected     int                     `json:"corrected"` // Sayacı düzeltilen etkinlik sayısı
+	Discrepancies []*InventoryDiscrepancy `json:"discrepancies"`
+	StartedAt     time.Time               `json:"started_at"`
+	FinishedAt    time.Time               `json:"finished_at"`
+}
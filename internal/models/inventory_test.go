@@ -0,0 +1,106 @@
+// -----------------------------------------------------------------------------
+// Inventory Reconciliation Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Bloke koltuk kapasitesi (koltuksuz mekan, pasif koltuklar)
+// - Beklenen boş koltuk sayısı (biletler, tutmalar, 0 alt sınırı)
+// - Sayaç sapması ve fark kaydı
+// -----------------------------------------------------------------------------
+
+package models
+
+import "testing"
+
+func TestInventoryCount_BlockedSeats(t *testing.T) {
+	tests := []struct {
+		name  string
+		count InventoryCount
+		want  int
+	}{
+		{"standing venue", InventoryCount{TotalCapacity: 500}, 0},
+		{"all seats active", InventoryCount{TotalCapacity: 100, SeatCount: 100, ActiveSeatCount: 100}, 0},
+		{"inactive seats", InventoryCount{TotalCapacity: 100, SeatCount: 100, ActiveSeatCount: 92}, 8},
+		{"more active seats than capacity", InventoryCount{TotalCapacity: 80, SeatCount: 120, ActiveSeatCount: 100}, 0},
+		{"capacity above seat count", InventoryCount{TotalCapacity: 120, SeatCount: 100, ActiveSeatCount: 100}, 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.count.BlockedSeats(); got != tt.want {
+				t.Fatalf("BlockedSeats() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInventoryCount_ExpectedAvailable(t *testing.T) {
+	tests := []struct {
+		name  string
+		count InventoryCount
+		want  int
+	}{
+		{"empty event", InventoryCount{TotalCapacity: 100}, 100},
+		{"tickets and holds", InventoryCount{TotalCapacity: 100, ActiveTickets: 40, ActiveHolds: 2}, 58},
+		{"blocked seats", InventoryCount{TotalCapacity: 100, SeatCount: 100, ActiveSeatCount: 90, ActiveTickets: 30}, 60},
+		{"sold out", InventoryCount{TotalCapacity: 50, ActiveTickets: 49, ActiveHolds: 1}, 0},
+		{"oversold never negative", InventoryCount{TotalCapacity: 50, ActiveTickets: 52}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.count.ExpectedAvailable(); got != tt.want {
+				t.Fatalf("ExpectedAvailable() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInventoryCount_Drift(t *testing.T) {
+	tests := []struct {
+		name  string
+		count InventoryCount
+		want  int
+	}{
+		{"in sync", InventoryCount{TotalCapacity: 100, AvailableSeats: 60, ActiveTickets: 40}, 0},
+		{"counter too high", InventoryCount{TotalCapacity: 100, AvailableSeats: 63, ActiveTickets: 40}, 3},
+		{"counter too low", InventoryCount{TotalCapacity: 100, AvailableSeats: 55, ActiveTickets: 40}, -5},
+		{"held seats are not available", InventoryCount{TotalCapacity: 100, AvailableSeats: 60, ActiveTickets: 38, ActiveHolds: 2}, 0},
+		{"blocked seats are not available", InventoryCount{TotalCapacity: 100, AvailableSeats: 60, SeatCount: 100, ActiveSeatCount: 95, ActiveTickets: 40}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.count.Drift(); got != tt.want {
+				t.Fatalf("Drift() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewInventoryDiscrepancy(t *testing.T) {
+	count := &InventoryCount{
+		EventID:         7,
+		EventName:       "Konser",
+		TotalCapacity:   100,
+		AvailableSeats:  20,
+		SeatCount:       100,
+		ActiveSeatCount: 96,
+		ActiveTickets:   70,
+		ActiveHolds:     1,
+	}
+
+	d := NewInventoryDiscrepancy(count)
+
+	if d.EventID != 7 || d.EventName != "Konser" || d.TotalCapacity != 100 {
+		t.Fatalf("event fields not copied: %+v", d)
+	}
+	if d.Recorded != 20 || d.Expected != 25 || d.Drift != -5 {
+		t.Fatalf("recorded/expected/drift = %d/%d/%d, want 20/25/-5", d.Recorded, d.Expected, d.Drift)
+	}
+	if d.BlockedSeats != 4 || d.ActiveTickets != 70 || d.ActiveHolds != 1 {
+		t.Fatalf("breakdown = %d/%d/%d, want 4/70/1", d.BlockedSeats, d.ActiveTickets, d.ActiveHolds)
+	}
+	if d.Corrected {
+		t.Fatalf("new discrepancy must not be marked corrected")
+	}
+}
@@ -0,0 +1,205 @@
+// -----------------------------------------------------------------------------
+// Organizer Model
+// -----------------------------------------------------------------------------
+// Organizatör (promoter) hesaplarını temsil eder. Etkinlikler ve mekanlar bir
+// organizatöre aittir; organizatör kullanıcıları (üyeler) sadece kendi
+// organizatörlerinin etkinlik, mekan, bilet ve satış verisini görebilir.
+//
+// Roller:
+// - owner: Her şey + üye yönetimi
+// - manager: Etkinlik / mekan yönetimi ve raporlar
+// - box_office: Gişe satışı ve bilet sorgulama
+// - scanner: Sadece girişte bilet doğrulama
+// -----------------------------------------------------------------------------
+
+package models
+
+import "errors"
+
+// OrganizerStatus, organizatör hesabının durumunu temsil eder
+type OrganizerStatus string
+
+const (
+	OrganizerStatusActive    OrganizerStatus = "active"
+	OrganizerStatusSuspended OrganizerStatus = "suspended"
+)
+
+// OrganizerRole, organizatör üyesinin rolünü temsil eder
+type OrganizerRole string
+
+const (
+	OrganizerRoleOwner     OrganizerRole = "owner"
+	OrganizerRoleManager   OrganizerRole = "manager"
+	OrganizerRoleBoxOffice OrganizerRole = "box_office"
+	OrganizerRoleScanner   OrganizerRole = "scanner"
+)
+
+// OrganizerPermission, bir rolün yapabileceği işlem grubudur
+type OrganizerPermission string
+
+const (
+	PermissionManageMembers OrganizerPermission = "manage_members"
+	PermissionManageEvents  OrganizerPermission = "manage_events"
+	PermissionManageVenues  OrganizerPermission = "manage_venues"
+	PermissionViewReports   OrganizerPermission = "view_reports"
+	PermissionSellTickets   OrganizerPermission = "sell_tickets"
+	PermissionViewTickets   OrganizerPermission = "view_tickets"
+	PermissionScanTickets   OrganizerPermission = "scan_tickets"
+)
+
+// rolePermissions, her rolün izinlerini tanımlar
+var rolePermissions = map[OrganizerRole][]OrganizerPermission{
+	OrganizerRoleOwner: {
+		PermissionManageMembers, PermissionManageEvents, PermissionManageVenues, PermissionViewReports,
+		PermissionSellTickets, PermissionViewTickets, PermissionScanTickets,
+	},
+	OrganizerRoleManager: {
+		PermissionManageEvents, PermissionManageVenues, PermissionViewReports,
+		PermissionSellTickets, PermissionViewTickets, PermissionScanTickets,
+	},
+	OrganizerRoleBoxOffice: {
+		PermissionSellTickets, PermissionViewTickets, PermissionScanTickets,
+	},
+	OrganizerRoleScanner: {
+		PermissionScanTickets,
+	},
+}
+
+// IsValid, rolün tanımlı olup olmadığını kontrol eder
+func (r OrganizerRole) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Can, rolün verilen izne sahip olup olmadığını kontrol eder
+func (r OrganizerRole) Can(permission OrganizerPermission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Organizer, bir organizatör hesabıdır
+type Organizer struct {
+	BaseModel
+	Name         string          `json:"name" db:"name"`
+	Slug         string          `json:"slug" db:"slug"`
+	ContactEmail *string         `json:"contact_email,omitempty" db:"contact_email"`
+	Status       OrganizerStatus `json:"status" db:"status"`
+}
+
+// IsActive, organizatörün aktif olup olmadığını kontrol eder
+func (o *Organizer) IsActive() bool {
+	return o.Status == OrganizerStatusActive
+}
+
+// OrganizerMember, bir kullanıcının organizatördeki üyeliğidir
+type OrganizerMember struct {
+	BaseModel
+	OrganizerID int64         `json:"organizer_id" db:"organizer_id"`
+	UserID      int64         `json:"user_id" db:"user_id"`
+	Role        OrganizerRole `json:"role" db:"role"`
+
+	// İlişkili veriler
+	Organizer *Organizer `json:"organizer,omitempty" db:"-"`
+}
+
+// ScopeKind, kapsamın kimin adına işlem yaptığını belirtir
+type ScopeKind string
+
+const (
+	ScopeKindCustomer  ScopeKind = "customer"  // Bilet alıcısı: organizatör izni yok, sadece kendi verisi
+	ScopeKindOrganizer ScopeKind = "organizer" // Organizatör üyesi: sadece kendi organizatörünün verisi
+	ScopeKindPlatform  ScopeKind = "platform"  // Platform admin'i: kısıtsız
+	ScopeKindSystem    ScopeKind = "system"    // Arka plan işleri ve imzası doğrulanmış webhook'lar: kısıtsız
+)
+
+// ErrNoOrganizerScope, kapsamı belirtilmemiş (sıfır değer) bir servis çağrısında döner
+var ErrNoOrganizerScope = errors.New("işlem kapsamı belirtilmedi")
+
+// OrganizerScope, isteği yapan kullanıcının organizatör bağlamıdır.
+// Sıfır değer geçersizdir: servisler kapsamsız çağrıları reddeder, kapsam her zaman
+// CustomerScope, MemberScope, PlatformScope veya SystemScope ile açıkça verilir.
+// OrganizerID 0 ise veri organizatöre göre kısıtlanmaz (müşteri, platform, sistem).
+type OrganizerScope struct {
+	Kind        ScopeKind
+	OrganizerID int64
+	UserID      int64
+	Role        OrganizerRole
+}
+
+// CustomerScope, bilet alıcısının kapsamıdır (sadece kendi biletleri / ödemeleri)
+func CustomerScope(userID int64) OrganizerScope {
+	return OrganizerScope{Kind: ScopeKindCustomer, UserID: userID}
+}
+
+// MemberScope, organizatör üyesinin kapsamıdır (rolünün izinleri, sadece kendi organizatörü)
+func MemberScope(organizerID, userID int64, role OrganizerRole) OrganizerScope {
+	return OrganizerScope{Kind: ScopeKindOrganizer, OrganizerID: organizerID, UserID: userID, Role: role}
+}
+
+// PlatformScope, platform admin'inin kısıtsız kapsamıdır
+func PlatformScope(userID int64) OrganizerScope {
+	return OrganizerScope{Kind: ScopeKindPlatform, UserID: userID}
+}
+
+// SystemScope, arka plan işlerinin ve doğrulanmış webhook'ların kısıtsız kapsamıdır
+func SystemScope() OrganizerScope {
+	return OrganizerScope{Kind: ScopeKindSystem}
+}
+
+// Validate, kapsamın eksiksiz olup olmadığını kontrol eder
+func (s OrganizerScope) Validate() error {
+	switch s.Kind {
+	case ScopeKindCustomer, ScopeKindPlatform:
+		if s.UserID <= 0 {
+			return errors.New("kimlik doğrulaması gerekli")
+		}
+	case ScopeKindOrganizer:
+		if s.OrganizerID <= 0 || s.UserID <= 0 || !s.Role.IsValid() {
+			return errors.New("geçersiz organizatör kapsamı")
+		}
+	case ScopeKindSystem:
+	default:
+		return ErrNoOrganizerScope
+	}
+
+	return nil
+}
+
+// IsPlatform, kapsamın kısıtsız (platform admin'i veya sistem) olduğunu belirtir
+func (s OrganizerScope) IsPlatform() bool {
+	return s.Kind == ScopeKindPlatform || s.Kind == ScopeKindSystem
+}
+
+// Can, kapsamın verilen izne sahip olup olmadığını kontrol eder (platform her şeye yetkilidir,
+// müşterinin ve geçersiz kapsamın organizatör izni yoktur)
+func (s OrganizerScope) Can(permission OrganizerPermission) bool {
+	if s.Validate() != nil {
+		return false
+	}
+
+	return s.IsPlatform() || (s.Kind == ScopeKindOrganizer && s.Role.Can(permission))
+}
+
+// CanManage, kapsamın verilen organizatöre ait bir kayıt (etkinlik, mekan) üzerinde izinle işlem
+// yapıp yapamayacağını kontrol eder: platform her organizatörün kaydına, üye sadece kendi organizatörününkine
+func (s OrganizerScope) CanManage(organizerID int64, permission OrganizerPermission) bool {
+	if !s.Can(permission) {
+		return false
+	}
+
+	return s.IsPlatform() || s.OrganizerID == organizerID
+}
+
+// CanActFor, kapsamın verilen kullanıcının biletleri / ödemeleri üzerinde işlem yapıp
+// yapamayacağını kontrol eder: müşteri sadece kendisi için, organizatör üyesi izniyle
+func (s OrganizerScope) CanActFor(userID int64, permission OrganizerPermission) bool {
+	if s.Kind == ScopeKindCustomer {
+		return s.Validate() == nil && s.UserID == userID
+	}
+
+	return s.Can(permission)
+}
@@ -0,0 +1,175 @@
+// -----------------------------------------------------------------------------
+// Organizer Scope Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Rol izinleri (owner, manager, box_office, scanner)
+// - Kapsam doğrulama (sıfır değer, eksik kullanıcı / organizatör / rol)
+// - İzin kontrolü (platform, sistem, üye, müşteri)
+// - Kayıt yönetimi (üye başka organizatörün etkinliğini değiştiremez)
+// - Kullanıcı adına işlem (müşteri sadece kendisi için)
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOrganizerRole_Can(t *testing.T) {
+	tests := []struct {
+		role       OrganizerRole
+		permission OrganizerPermission
+		want       bool
+	}{
+		{OrganizerRoleOwner, PermissionManageMembers, true},
+		{OrganizerRoleManager, PermissionManageMembers, false},
+		{OrganizerRoleManager, PermissionManageEvents, true},
+		{OrganizerRoleBoxOffice, PermissionSellTickets, true},
+		{OrganizerRoleBoxOffice, PermissionManageEvents, false},
+		{OrganizerRoleScanner, PermissionScanTickets, true},
+		{OrganizerRoleScanner, PermissionViewTickets, false},
+		{OrganizerRole("guest"), PermissionScanTickets, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"/"+string(tt.permission), func(t *testing.T) {
+			if got := tt.role.Can(tt.permission); got != tt.want {
+				t.Fatalf("Can() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrganizerScope_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		scope   OrganizerScope
+		wantErr bool
+	}{
+		{"zero value", OrganizerScope{}, true},
+		{"customer", CustomerScope(7), false},
+		{"anonymous customer", CustomerScope(0), true},
+		{"member", MemberScope(3, 7, OrganizerRoleManager), false},
+		{"member without organizer", MemberScope(0, 7, OrganizerRoleManager), true},
+		{"member without role", MemberScope(3, 7, ""), true},
+		{"member with unknown role", MemberScope(3, 7, OrganizerRole("guest")), true},
+		{"platform", PlatformScope(1), false},
+		{"anonymous platform", PlatformScope(0), true},
+		{"system", SystemScope(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.scope.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %t", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := (OrganizerScope{}).Validate(); !errors.Is(err, ErrNoOrganizerScope) {
+		t.Fatalf("zero scope error = %v, want ErrNoOrganizerScope", err)
+	}
+}
+
+func TestOrganizerScope_Can(t *testing.T) {
+	tests := []struct {
+		name       string
+		scope      OrganizerScope
+		permission OrganizerPermission
+		want       bool
+	}{
+		{"zero value is not platform", OrganizerScope{}, PermissionSellTickets, false},
+		{"zero value with organizer id", OrganizerScope{OrganizerID: 3, Role: OrganizerRoleOwner}, PermissionSellTickets, false},
+		{"platform", PlatformScope(1), PermissionManageMembers, true},
+		{"system", SystemScope(), PermissionManageEvents, true},
+		{"member with permission", MemberScope(3, 7, OrganizerRoleBoxOffice), PermissionSellTickets, true},
+		{"member without permission", MemberScope(3, 7, OrganizerRoleScanner), PermissionSellTickets, false},
+		{"customer", CustomerScope(7), PermissionSellTickets, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.Can(tt.permission); got != tt.want {
+				t.Fatalf("Can() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrganizerScope_IsPlatform(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope OrganizerScope
+		want  bool
+	}{
+		{"zero value", OrganizerScope{}, false},
+		{"customer", CustomerScope(7), false},
+		{"member", MemberScope(3, 7, OrganizerRoleOwner), false},
+		{"platform", PlatformScope(1), true},
+		{"system", SystemScope(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.IsPlatform(); got != tt.want {
+				t.Fatalf("IsPlatform() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrganizerScope_CanManage(t *testing.T) {
+	tests := []struct {
+		name        string
+		scope       OrganizerScope
+		organizerID int64
+		permission  OrganizerPermission
+		want        bool
+	}{
+		{"manager of the owning organizer", MemberScope(3, 7, OrganizerRoleManager), 3, PermissionManageEvents, true},
+		{"manager of another organizer", MemberScope(3, 7, OrganizerRoleManager), 4, PermissionManageEvents, false},
+		{"owner of another organizer", MemberScope(4, 7, OrganizerRoleOwner), 3, PermissionManageEvents, false},
+		{"scanner of the owning organizer", MemberScope(3, 7, OrganizerRoleScanner), 3, PermissionManageEvents, false},
+		{"scanner scanning its own event", MemberScope(3, 7, OrganizerRoleScanner), 3, PermissionScanTickets, true},
+		{"scanner of another organizer", MemberScope(4, 7, OrganizerRoleScanner), 3, PermissionScanTickets, false},
+		{"platform", PlatformScope(1), 4, PermissionManageEvents, true},
+		{"system", SystemScope(), 4, PermissionManageEvents, true},
+		{"customer", CustomerScope(7), 3, PermissionManageEvents, false},
+		{"zero value with matching organizer", OrganizerScope{OrganizerID: 3, Role: OrganizerRoleOwner}, 3, PermissionManageEvents, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.CanManage(tt.organizerID, tt.permission); got != tt.want {
+				t.Fatalf("CanManage() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrganizerScope_CanActFor(t *testing.T) {
+	tests := []struct {
+		name   string
+		scope  OrganizerScope
+		userID int64
+		want   bool
+	}{
+		{"customer for self", CustomerScope(7), 7, true},
+		{"customer for another user", CustomerScope(7), 8, false},
+		{"anonymous customer", CustomerScope(0), 0, false},
+		{"box office for a buyer", MemberScope(3, 9, OrganizerRoleBoxOffice), 7, true},
+		{"scanner for a buyer", MemberScope(3, 9, OrganizerRoleScanner), 7, false},
+		{"platform for a buyer", PlatformScope(1), 7, true},
+		{"zero value", OrganizerScope{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.CanActFor(tt.userID, PermissionSellTickets); got != tt.want {
+				t.Fatalf("CanActFor() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// -----------------------------------------------------------------------------
+// Purchase Limit Model
+// -----------------------------------------------------------------------------
+// Etkinlik bazında bilet alım limitlerini temsil eder.
+// Limitler rezerve + satılmış biletler üzerinden sayılır:
+// - Kullanıcı başına (rezervasyonda)
+// - Ödeme kartı (card fingerprint) başına (satın almada)
+// - Fatura adresi (hane) başına (satın almada)
+// Kart ve adres istemciden alınmaz; ödeme sağlayıcısının bildirdiği
+// değerlerdir (PaymentInstrument).
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// PurchaseLimitScope, limitin hangi alıcı kimliğine uygulandığını belirtir
+type PurchaseLimitScope string
+
+const (
+	PurchaseLimitScopeUser    PurchaseLimitScope = "user"
+	PurchaseLimitScopeCard    PurchaseLimitScope = "card"
+	PurchaseLimitScopeAddress PurchaseLimitScope = "address"
+)
+
+// PurchaseLimit, bir etkinliğin alım limitleridir (nil = limit yok)
+type PurchaseLimit struct {
+	BaseModel
+	EventID       int64 `json:"event_id" db:"event_id"`
+	MaxPerUser    *int  `json:"max_per_user,omitempty" db:"max_per_user"`
+	MaxPerCard    *int  `json:"max_per_card,omitempty" db:"max_per_card"`
+	MaxPerAddress *int  `json:"max_per_address,omitempty" db:"max_per_address"`
+}
+
+// LimitFor, verilen kapsam için limiti döndürür
+func (p *PurchaseLimit) LimitFor(scope PurchaseLimitScope) *int {
+	switch scope {
+	case PurchaseLimitScopeUser:
+		return p.MaxPerUser
+	case PurchaseLimitScopeCard:
+		return p.MaxPerCard
+	case PurchaseLimitScopeAddress:
+		return p.MaxPerAddress
+	}
+	return nil
+}
+
+// PurchaseLimitError, alım limiti aşıldığında döner
+type PurchaseLimitError struct {
+	Scope     PurchaseLimitScope
+	Limit     int
+	Current   int
+	Requested int
+}
+
+func (e *PurchaseLimitError) Error() string {
+	label := map[PurchaseLimitScope]string{
+		PurchaseLimitScopeUser:    "kullanıcı",
+		PurchaseLimitScopeCard:    "ödeme kartı",
+		PurchaseLimitScopeAddress: "adres",
+	}[e.Scope]
+
+	return fmt.Sprintf("bu etkinlik için %s başına en fazla %d bilet alınabilir (mevcut: %d, istenen: %d)",
+		label, e.Limit, e.Current, e.Requested)
+}
+
+// HashAddress, adresi normalize edip SHA-256 özetini döndürür.
+// Büyük/küçük harf, noktalama ve boşluk farkları aynı haneyi temsil eder.
+func HashAddress(address string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(address) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	if b.Len() == 0 {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// PaymentInstrument, ödeme sağlayıcısının tamamlanan ödeme için bildirdiği
+// kart parmak izi ve fatura adresidir
+type PaymentInstrument struct {
+	CardFingerprint string
+	BillingAddress  string
+}
+
+// Identifiers, limit sayımında kullanılan kart parmak izini ve adres özetini döndürür (boşsa nil)
+func (p PaymentInstrument) Identifiers() (cardFingerprint, addressHash *string) {
+	if fp := strings.TrimSpace(p.CardFingerprint); fp != "" {
+		cardFingerprint = &fp
+	}
+	if hash := HashAddress(p.BillingAddress); hash != "" {
+		addressHash = &hash
+	}
+	return cardFingerprint, addressHash
+}
@@ -14,24 +14,29 @@ import (
 type PaymentStatus string
 
 const (
-	PaymentStatusPending   PaymentStatus = "pending"
-	PaymentStatusCompleted PaymentStatus = "completed"
-	PaymentStatusFailed    PaymentStatus = "failed"
-	PaymentStatusRefunded  PaymentStatus = "refunded"
+	PaymentStatusPending     PaymentStatus = "pending"
+	PaymentStatusCompleted   PaymentStatus = "completed"
+	PaymentStatusFailed      PaymentStatus = "failed"
+	PaymentStatusRefunded    PaymentStatus = "refunded"
+	PaymentStatusChargedBack PaymentStatus = "charged_back" // Kart sahibi bankası ödemeyi ters ibraz etti
 )
 
 // Payment, bir ödeme işlemini temsil eder
 type Payment struct {
 	BaseModel
-	TicketID      int64         `json:"ticket_id" db:"ticket_id"`
-	UserID        int64         `json:"user_id" db:"user_id"`
-	Amount        float64       `json:"amount" db:"amount"`
-	Currency      string        `json:"currency" db:"currency"` // "TRY", "USD", "EUR"
-	Status        PaymentStatus `json:"status" db:"status"`
-	PaymentMethod string        `json:"payment_method" db:"payment_method"` // "credit_card", "debit_card", "paypal"
-	TransactionID string        `json:"transaction_id,omitempty" db:"transaction_id"`
-	PaidAt        *time.Time    `json:"paid_at,omitempty" db:"paid_at"`
-	RefundedAt    *time.Time    `json:"refunded_at,omitempty" db:"refunded_at"`
+	TicketID        int64         `json:"ticket_id" db:"ticket_id"`
+	UserID          int64         `json:"user_id" db:"user_id"`
+	Amount          float64       `json:"amount" db:"amount"`
+	Currency        string        `json:"currency" db:"currency"` // "TRY", "USD", "EUR"
+	Status          PaymentStatus `json:"status" db:"status"`
+	PaymentMethod   string        `json:"payment_method" db:"payment_method"` // "credit_card", "debit_card", "paypal"
+	TransactionID   string        `json:"transaction_id,omitempty" db:"transaction_id"`
+	CardFingerprint *string       `json:"-" db:"card_fingerprint"` // Sağlayıcının bildirdiği kart parmak izi
+	AddressHash     *string       `json:"-" db:"address_hash"`     // Sağlayıcının bildirdiği fatura adresinin özeti
+	PaidAt          *time.Time    `json:"paid_at,omitempty" db:"paid_at"`
+	RefundedAt      *time.Time    `json:"refunded_at,omitempty" db:"refunded_at"`
+	ChargedBackAt   *time.Time    `json:"charged_back_at,omitempty" db:"charged_back_at"`
+	Version         int64         `json:"version" db:"version"` // İyimser kilitleme: her yazmada artar
 
 	// İlişkili veriler
 	Ticket *Ticket `json:"ticket,omitempty" db:"-"`
@@ -45,18 +50,32 @@ func (p *Payment) IsCompleted() bool {
 
 // CanRefund, ödemenin iade edilip edilemeyeceğini kontrol eder
 func (p *Payment) CanRefund() bool {
-	return p.Status == PaymentStatusCompleted
+	return paymentStates.Check(p, PaymentStatusRefunded) == nil
 }
 
+// WaitingListStatus, bekleme listesi kaydının durumunu temsil eder
+type WaitingListStatus string
+
+const (
+	WaitingListStatusWaiting   WaitingListStatus = "waiting"   // Sırada bekliyor
+	WaitingListStatusNotified  WaitingListStatus = "notified"  // Müsaitlik bildirildi
+	WaitingListStatusOffered   WaitingListStatus = "offered"   // Claim token ile koltuk teklif edildi
+	WaitingListStatusPurchased WaitingListStatus = "purchased" // Teklif kullanıldı
+	WaitingListStatusExpired   WaitingListStatus = "expired"   // Teklif süresi içinde kullanılmadı
+	WaitingListStatusCancelled WaitingListStatus = "cancelled" // Kullanıcı listeden çıktı
+)
+
 // WaitingList, bekleme listesi kayıtlarını temsil eder
 type WaitingList struct {
 	BaseModel
-	EventID     int64      `json:"event_id" db:"event_id"`
-	UserID      int64      `json:"user_id" db:"user_id"`
-	SectionID   *int64     `json:"section_id,omitempty" db:"section_id"` // İsteğe bağlı bölüm tercihi
-	IsNotified  bool       `json:"is_notified" db:"is_notified"`
-	NotifiedAt  *time.Time `json:"notified_at,omitempty" db:"notified_at"`
-	Position    int        `json:"position" db:"position"` // Sıradaki pozisyon
+	EventID    int64             `json:"event_id" db:"event_id"`
+	UserID     int64             `json:"user_id" db:"user_id"`
+	Status     WaitingListStatus `json:"status" db:"status"`
+	Priority   int               `json:"priority" db:"priority"`               // Yüksek öncelik önce teklif alır
+	SectionID  *int64            `json:"section_id,omitempty" db:"section_id"` // İsteğe bağlı bölüm tercihi
+	IsNotified bool              `json:"is_notified" db:"is_notified"`
+	NotifiedAt *time.Time        `json:"notified_at,omitempty" db:"notified_at"`
+	Position   int               `json:"position" db:"position"` // Sıradaki pozisyon
 
 	// İlişkili veriler
 	Event *Event `json:"event,omitempty" db:"-"`
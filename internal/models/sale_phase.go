@@ -0,0 +1,105 @@
+// -----------------------------------------------------------------------------
+// Sale Phase Model
+// -----------------------------------------------------------------------------
+// Bir etkinliğin satış aşamalarını temsil eder (presale, genel satış vb.).
+// Her aşamanın kendi penceresi, erişim yöntemi ve envanter kotası vardır.
+//
+// Erişim yöntemleri:
+// - public: Herkes
+// - code: Geçerli bir erişim kodu sunan kullanıcılar
+// - segment: Belirli bir kullanıcı segmentindekiler (ör. fan_club)
+// - allow_list: E-posta adresi listede olanlar
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// SalePhaseAccessType, satış aşamasının erişim yöntemini temsil eder
+type SalePhaseAccessType string
+
+const (
+	SalePhaseAccessPublic    SalePhaseAccessType = "public"
+	SalePhaseAccessCode      SalePhaseAccessType = "code"
+	SalePhaseAccessSegment   SalePhaseAccessType = "segment"
+	SalePhaseAccessAllowList SalePhaseAccessType = "allow_list"
+)
+
+// SalePhase, bir etkinliğin satış aşamasıdır
+type SalePhase struct {
+	BaseModel
+	EventID    int64               `json:"event_id" db:"event_id"`
+	Name       string              `json:"name" db:"name"`
+	AccessType SalePhaseAccessType `json:"access_type" db:"access_type"`
+	Segment    *string             `json:"segment,omitempty" db:"segment"`
+	Allocation *int                `json:"allocation,omitempty" db:"allocation"` // nil = kota yok
+	StartsAt   time.Time           `json:"starts_at" db:"starts_at"`
+	EndsAt     time.Time           `json:"ends_at" db:"ends_at"`
+	SortOrder  int                 `json:"sort_order" db:"sort_order"`
+	SoldCount  int                 `json:"sold_count" db:"sold_count"` // Aktif (rezerve + satılmış) bilet sayısı, sorguda hesaplanır
+}
+
+// SalePhaseCode, code erişimli aşamalar için erişim kodudur
+type SalePhaseCode struct {
+	BaseModel
+	SalePhaseID int64  `json:"sale_phase_id" db:"sale_phase_id"`
+	Code        string `json:"code" db:"code"`
+	MaxUses     int    `json:"max_uses" db:"max_uses"`
+	UserID      *int64 `json:"user_id,omitempty" db:"user_id"` // İlk kullanımda bağlanır
+}
+
+// SaleBuyer, aktif aşamayı belirlemek için alıcının erişim bilgileridir.
+// Servis katmanı tarafından doldurulur (segmentler, allow-list ve kod kontrolleri DB'den).
+type SaleBuyer struct {
+	UserID       int64
+	Segments     []string
+	AllowListed  map[int64]bool // Alıcının e-postasının listede olduğu aşamalar
+	CodeValidFor map[int64]bool // Sunulan kodun geçerli olduğu aşamalar
+}
+
+// IsOpen, aşamanın verilen anda açık olup olmadığını kontrol eder
+func (p *SalePhase) IsOpen(now time.Time) bool {
+	return !now.Before(p.StartsAt) && now.Before(p.EndsAt)
+}
+
+// HasInventory, aşamanın kotasında yer olup olmadığını kontrol eder
+func (p *SalePhase) HasInventory() bool {
+	return p.Allocation == nil || p.SoldCount < *p.Allocation
+}
+
+// RemainingAllocation, kalan kota miktarını döndürür (kota yoksa -1)
+func (p *SalePhase) RemainingAllocation() int {
+	if p.Allocation == nil {
+		return -1
+	}
+	if remaining := *p.Allocation - p.SoldCount; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// Allows, alıcının bu aşamaya erişimi olup olmadığını kontrol eder
+func (p *SalePhase) Allows(buyer *SaleBuyer) bool {
+	switch p.AccessType {
+	case SalePhaseAccessPublic:
+		return true
+	case SalePhaseAccessCode:
+		return buyer != nil && buyer.CodeValidFor[p.ID]
+	case SalePhaseAccessSegment:
+		if buyer == nil || p.Segment == nil {
+			return false
+		}
+		for _, segment := range buyer.Segments {
+			if strings.EqualFold(segment, *p.Segment) {
+				return true
+			}
+		}
+		return false
+	case SalePhaseAccessAllowList:
+		return buyer != nil && buyer.AllowListed[p.ID]
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+// -----------------------------------------------------------------------------
+// Search Models
+// -----------------------------------------------------------------------------
+// Tam metin etkinlik aramasının sonuç tipleri. İndeks sadece ID ve alaka puanı
+// döndürür; etkinlikler veritabanından yüklenip puan sırasıyla eşlenir.
+// -----------------------------------------------------------------------------
+
+package models
+
+import "github.com/biyonik/event-ticketing-api/pkg/search"
+
+// SearchableEventStatuses, arama indeksinde tutulan etkinlik durumlarıdır
+var SearchableEventStatuses = []EventStatus{
+	EventStatusPublished,
+	EventStatusSaleActive,
+	EventStatusSoldOut,
+}
+
+// IsSearchable, etkinliğin arama sonuçlarında görünüp görünmeyeceğini döndürür
+func (e *Event) IsSearchable() bool {
+	for _, status := range SearchableEventStatuses {
+		if e.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// EventSearchHit, alaka puanıyla birlikte bir etkinliktir
+type EventSearchHit struct {
+	*Event
+	Score float64 `json:"score"`
+}
+
+// EventSearchResult, sayfalanmış arama sonucu ve facet sayılarıdır
+type EventSearchResult struct {
+	Events   []*EventSearchHit `json:"events"`
+	Total    int               `json:"total"`
+	Page     int               `json:"page"`
+	PageSize int               `json:"page_size"`
+	Facets   search.Facets     `json:"facets"`
+}
@@ -0,0 +1,83 @@
+// -----------------------------------------------------------------------------
+// Season Package Model
+// -----------------------------------------------------------------------------
+// Sezon kombinesini temsil eder. Bir paket aynı mekandaki birden fazla
+// etkinliği gruplar (ör. bir kulübün iç saha maçları) ve paket fiyatıyla satılır.
+// Satın alınan her kombine (SeasonMembership) paketteki tüm etkinliklerde aynı
+// koltuğu ayırır ve her etkinlik için ayrı giriş bileti üretir.
+//
+// Yenileme: Paket önceki sezon paketine bağlıysa (PreviousPackageID),
+// RenewalDeadline'a kadar önceki kombine sahiplerinin koltukları başkalarına
+// satılmaz; sahipler koltuklarını öncelikli olarak yenileyebilir.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"time"
+)
+
+// SeasonPackageStatus, paket durumunu temsil eder
+type SeasonPackageStatus string
+
+const (
+	SeasonPackageStatusDraft  SeasonPackageStatus = "draft"
+	SeasonPackageStatusOnSale SeasonPackageStatus = "on_sale"
+	SeasonPackageStatusClosed SeasonPackageStatus = "closed"
+)
+
+// SeasonMembershipStatus, kombine durumunu temsil eder
+type SeasonMembershipStatus string
+
+const (
+	SeasonMembershipStatusActive    SeasonMembershipStatus = "active"
+	SeasonMembershipStatusCancelled SeasonMembershipStatus = "cancelled"
+)
+
+// SeasonPackage, bir sezon kombine paketidir
+type SeasonPackage struct {
+	BaseModel
+	VenueID           int64               `json:"venue_id" db:"venue_id"`
+	Name              string              `json:"name" db:"name"`
+	Season            string              `json:"season" db:"season"` // ör. 2025-2026
+	Price             float64             `json:"price" db:"price"`
+	Status            SeasonPackageStatus `json:"status" db:"status"`
+	SaleStartsAt      time.Time           `json:"sale_starts_at" db:"sale_starts_at"`
+	SaleEndsAt        time.Time           `json:"sale_ends_at" db:"sale_ends_at"`
+	PreviousPackageID *int64              `json:"previous_package_id,omitempty" db:"previous_package_id"`
+	RenewalDeadline   *time.Time          `json:"renewal_deadline,omitempty" db:"renewal_deadline"`
+
+	// İlişkili veriler
+	EventIDs []int64 `json:"event_ids" db:"-"`
+}
+
+// SeasonMembership, satın alınmış bir kombinedir
+type SeasonMembership struct {
+	BaseModel
+	SeasonPackageID int64                  `json:"season_package_id" db:"season_package_id"`
+	UserID          int64                  `json:"user_id" db:"user_id"`
+	SectionID       int64                  `json:"section_id" db:"section_id"`
+	SeatID          *int64                 `json:"seat_id,omitempty" db:"seat_id"`
+	Price           float64                `json:"price" db:"price"`
+	Status          SeasonMembershipStatus `json:"status" db:"status"`
+	RenewedFromID   *int64                 `json:"renewed_from_id,omitempty" db:"renewed_from_id"`
+
+	// İlişkili veriler
+	Tickets []*Ticket `json:"tickets,omitempty" db:"-"` // Etkinlik başına giriş biletleri
+}
+
+// IsOnSale, paketin verilen anda satışta olup olmadığını kontrol eder
+func (p *SeasonPackage) IsOnSale(now time.Time) bool {
+	return p.Status == SeasonPackageStatusOnSale &&
+		!now.Before(p.SaleStartsAt) && now.Before(p.SaleEndsAt)
+}
+
+// InRenewalPeriod, önceki sezon sahiplerinin koltuk önceliğinin sürüp sürmediğini kontrol eder
+func (p *SeasonPackage) InRenewalPeriod(now time.Time) bool {
+	return p.PreviousPackageID != nil && p.RenewalDeadline != nil && now.Before(*p.RenewalDeadline)
+}
+
+// IsActive, kombinenin aktif olup olmadığını kontrol eder
+func (m *SeasonMembership) IsActive() bool {
+	return m.Status == SeasonMembershipStatusActive
+}
@@ -0,0 +1,76 @@
+// -----------------------------------------------------------------------------
+// Season Package Tests
+// -----------------------------------------------------------------------------
+// Bu testler, kombine paketlerin satış penceresi ve yenileme önceliği
+// kurallarının sınır anlarında doğru çalıştığını doğrular.
+//
+// Testler:
+// - Satış penceresi (durum, başlangıç dahil, bitiş hariç)
+// - Yenileme önceliği (önceki paket, son tarih hariç)
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// TestSeasonPackage_IsOnSale tests the sale window boundaries and status.
+func TestSeasonPackage_IsOnSale(t *testing.T) {
+	start := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		status   SeasonPackageStatus
+		now      time.Time
+		expected bool
+	}{
+		{"before sale", SeasonPackageStatusOnSale, start.Add(-time.Minute), false},
+		{"sale start is inclusive", SeasonPackageStatusOnSale, start, true},
+		{"during sale", SeasonPackageStatusOnSale, start.Add(48 * time.Hour), true},
+		{"sale end is exclusive", SeasonPackageStatusOnSale, end, false},
+		{"draft", SeasonPackageStatusDraft, start.Add(time.Hour), false},
+		{"closed", SeasonPackageStatusClosed, start.Add(time.Hour), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pkg := &SeasonPackage{Status: tc.status, SaleStartsAt: start, SaleEndsAt: end}
+			if got := pkg.IsOnSale(tc.now); got != tc.expected {
+				t.Errorf("Expected IsOnSale %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestSeasonPackage_InRenewalPeriod tests that renewal priority needs a previous
+// package and ends at the deadline.
+func TestSeasonPackage_InRenewalPeriod(t *testing.T) {
+	previousID := int64(4)
+	deadline := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		previousID *int64
+		deadline   *time.Time
+		now        time.Time
+		expected   bool
+	}{
+		{"before deadline", &previousID, &deadline, deadline.Add(-time.Hour), true},
+		{"deadline is exclusive", &previousID, &deadline, deadline, false},
+		{"after deadline", &previousID, &deadline, deadline.Add(time.Hour), false},
+		{"first season", nil, &deadline, deadline.Add(-time.Hour), false},
+		{"no deadline", &previousID, nil, deadline.Add(-time.Hour), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pkg := &SeasonPackage{PreviousPackageID: tc.previousID, RenewalDeadline: tc.deadline}
+			if got := pkg.InRenewalPeriod(tc.now); got != tc.expected {
+				t.Errorf("Expected InRenewalPeriod %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// -----------------------------------------------------------------------------
+// Seat Attribute Models
+// -----------------------------------------------------------------------------
+// Koltuk özellikleri (erişilebilirlik, görüş kısıtı, koridor, ayakta alan),
+// koltuk haritası ve en iyi koltuk önerisi için kullanılan filtre ve çıktı
+// modelleri. Özellikler seats.attributes kolonunda virgülle ayrılmış tutulur.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SeatAttribute, bir koltuk özelliğidir
+type SeatAttribute string
+
+const (
+	SeatAttributeWheelchair     SeatAttribute = "wheelchair"      // Tekerlekli sandalye alanı
+	SeatAttributeCompanion      SeatAttribute = "companion"       // Refakatçi koltuğu
+	SeatAttributeObstructedView SeatAttribute = "obstructed_view" // Görüşü engelli (kolon, ekipman)
+	SeatAttributeRestrictedView SeatAttribute = "restricted_view" // Kısıtlı görüş (sahnenin bir kısmı görünmez)
+	SeatAttributeAisle          SeatAttribute = "aisle"           // Koridor kenarı
+	SeatAttributeStanding       SeatAttribute = "standing"        // Ayakta alan
+)
+
+// SeatAttributes, tanımlı tüm koltuk özellikleridir
+var SeatAttributes = []SeatAttribute{
+	SeatAttributeWheelchair,
+	SeatAttributeCompanion,
+	SeatAttributeObstructedView,
+	SeatAttributeRestrictedView,
+	SeatAttributeAisle,
+	SeatAttributeStanding,
+}
+
+// IsValid, özelliğin tanımlı olup olmadığını kontrol eder
+func (a SeatAttribute) IsValid() bool {
+	for _, attribute := range SeatAttributes {
+		if a == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+// AttributeList, seats.attributes kolonunu özellik listesine çevirir
+func (s *Seat) AttributeList() []SeatAttribute {
+	if s.Attributes == "" {
+		return nil
+	}
+
+	parts := strings.Split(s.Attributes, ",")
+	attributes := make([]SeatAttribute, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			attributes = append(attributes, SeatAttribute(part))
+		}
+	}
+	return attributes
+}
+
+// HasAttribute, koltuğun verilen özelliğe sahip olup olmadığını kontrol eder
+func (s *Seat) HasAttribute(attribute SeatAttribute) bool {
+	for _, a := range s.AttributeList() {
+		if a == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAccessible, koltuğun tekerlekli sandalye alanı olup olmadığını kontrol eder
+func (s *Seat) IsAccessible() bool {
+	return s.HasAttribute(SeatAttributeWheelchair)
+}
+
+// HasRestrictedView, koltuğun görüşünün engelli veya kısıtlı olup olmadığını kontrol eder
+func (s *Seat) HasRestrictedView() bool {
+	return s.HasAttribute(SeatAttributeObstructedView) || s.HasAttribute(SeatAttributeRestrictedView)
+}
+
+// SeatFilter, koltuk haritası ve en iyi koltuk önerisi için özellik filtresidir
+type SeatFilter struct {
+	Require []SeatAttribute `json:"require,omitempty"` // Koltukta bulunması gereken özellikler
+	Exclude []SeatAttribute `json:"exclude,omitempty"` // Koltukta bulunmaması gereken özellikler
+}
+
+// ParseSeatFilter, virgülle ayrılmış özellik listelerinden filtre oluşturur
+func ParseSeatFilter(require, exclude string) (SeatFilter, error) {
+	var filter SeatFilter
+	var err error
+
+	if filter.Require, err = parseSeatAttributes(require); err != nil {
+		return SeatFilter{}, err
+	}
+	if filter.Exclude, err = parseSeatAttributes(exclude); err != nil {
+		return SeatFilter{}, err
+	}
+
+	for _, required := range filter.Require {
+		for _, excluded := range filter.Exclude {
+			if required == excluded {
+				return SeatFilter{}, fmt.Errorf("%s hem istenip hem hariç tutulamaz", required)
+			}
+		}
+	}
+
+	return filter, nil
+}
+
+func parseSeatAttributes(value string) ([]SeatAttribute, error) {
+	var attributes []SeatAttribute
+	for _, part := range strings.Split(value, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+		attribute := SeatAttribute(part)
+		if !attribute.IsValid() {
+			return nil, fmt.Errorf("geçersiz koltuk özelliği: %s", part)
+		}
+		attributes = append(attributes, attribute)
+	}
+	return attributes, nil
+}
+
+// Matches, koltuğun filtreye uyup uymadığını kontrol eder
+func (f SeatFilter) Matches(seat *Seat) bool {
+	for _, attribute := range f.Require {
+		if !seat.HasAttribute(attribute) {
+			return false
+		}
+	}
+	for _, attribute := range f.Exclude {
+		if seat.HasAttribute(attribute) {
+			return false
+		}
+	}
+	return true
+}
+
+// FindAdjacentSeats, aynı sıradaki ilk quantity adet yan yana, boş ve filtreye uyan koltuğu döndürür.
+// Koltuklar düzen sırasında gelmelidir; aynı bölüm ve sıradaki ardışık koltuklar bir sırayı oluşturur.
+// statuses'ta olmayan koltuklar boş kabul edilir. Uygun blok yoksa nil döner.
+func FindAdjacentSeats(seats []*Seat, statuses map[int64]SeatStatus, quantity int, filter SeatFilter) []*Seat {
+	if quantity < 1 {
+		return nil
+	}
+
+	start := 0
+	for i, seat := range seats {
+		if i > 0 && (seat.SectionID != seats[i-1].SectionID || seat.Row != seats[i-1].Row) {
+			start = i
+		}
+
+		if status, ok := statuses[seat.ID]; (ok && status != SeatStatusAvailable) || !filter.Matches(seat) {
+			start = i + 1
+			continue
+		}
+
+		if i+1-start == quantity {
+			return seats[start : i+1]
+		}
+	}
+
+	return nil
+}
+
+// SeatStatus, koltuğun bir etkinlik için satış durumudur
+type SeatStatus string
+
+const (
+	SeatStatusAvailable SeatStatus = "available"
+	SeatStatusTaken     SeatStatus = "taken" // Rezerve, satılmış veya kullanılmış bilet var
+	SeatStatusHeld      SeatStatus = "held"  // Bekleme listesi teklifi veya refakatçi için tutuluyor
+)
+
+// SeatMap, bir etkinliğin koltuk haritasıdır
+type SeatMap struct {
+	EventID  int64             `json:"event_id"`
+	VenueID  int64             `json:"venue_id"`
+	Filter   SeatFilter        `json:"filter"`
+	Sections []*SeatMapSection `json:"sections"`
+}
+
+// SeatMapSection, koltuk haritasındaki bir bölümdür
+type SeatMapSection struct {
+	SectionID int64          `json:"section_id"`
+	Name      string         `json:"name"`
+	Available int            `json:"available"` // Filtreye uyan boş koltuk sayısı
+	Seats     []*SeatMapSeat `json:"seats"`
+}
+
+// SeatMapSeat, koltuk haritasındaki bir koltuktur
+type SeatMapSeat struct {
+	ID              int64           `json:"id"`
+	SectionID       int64           `json:"section_id"`
+	Row             string          `json:"row"`
+	Number          string          `json:"number"`
+	Attributes      []SeatAttribute `json:"attributes,omitempty"`
+	CompanionSeatID *int64          `json:"companion_seat_id,omitempty"`
+	Status          SeatStatus      `json:"status"`
+}
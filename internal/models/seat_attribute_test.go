@@ -0,0 +1,162 @@
+// -----------------------------------------------------------------------------
+// Seat Attribute Tests
+// -----------------------------------------------------------------------------
+// Bu testler, koltuk özelliklerinin ayrıştırılmasını ve en iyi yan yana
+// koltuk bloğunun dolu, filtrelenmiş ya da farklı sıradaki koltukları
+// atlayarak seçildiğini doğrular.
+//
+// Testler:
+// - Özellik listesi ve görüş kısıtı / erişilebilirlik kontrolleri
+// - Filtre ayrıştırma (geçersiz özellik, çelişen istek / hariç tutma)
+// - Filtre eşleşmesi
+// - Yan yana koltuk ayırma (sıra sınırı, dolu koltuk, filtre)
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestSeat_AttributeChecks tests attribute parsing and the accessibility / view checks.
+func TestSeat_AttributeChecks(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes string
+		expected   []SeatAttribute
+		accessible bool
+		restricted bool
+	}{
+		{"no attributes", "", nil, false, false},
+		{"aisle", "aisle", []SeatAttribute{SeatAttributeAisle}, false, false},
+		{"wheelchair with spaces", " wheelchair , aisle ,", []SeatAttribute{SeatAttributeWheelchair, SeatAttributeAisle}, true, false},
+		{"obstructed", "obstructed_view", []SeatAttribute{SeatAttributeObstructedView}, false, true},
+		{"restricted", "aisle,restricted_view", []SeatAttribute{SeatAttributeAisle, SeatAttributeRestrictedView}, false, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			seat := &Seat{Attributes: tc.attributes}
+			if got := seat.AttributeList(); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected attributes %v, got %v", tc.expected, got)
+			}
+			if got := seat.IsAccessible(); got != tc.accessible {
+				t.Errorf("Expected IsAccessible %t, got %t", tc.accessible, got)
+			}
+			if got := seat.HasRestrictedView(); got != tc.restricted {
+				t.Errorf("Expected HasRestrictedView %t, got %t", tc.restricted, got)
+			}
+		})
+	}
+}
+
+// TestParseSeatFilter tests filter parsing, unknown attributes and conflicting requests.
+func TestParseSeatFilter(t *testing.T) {
+	tests := []struct {
+		name            string
+		require         string
+		exclude         string
+		expectedRequire []SeatAttribute
+		expectedExclude []SeatAttribute
+		wantErr         bool
+	}{
+		{"empty", "", "", nil, nil, false},
+		{"require and exclude", "Wheelchair", "obstructed_view, restricted_view", []SeatAttribute{SeatAttributeWheelchair}, []SeatAttribute{SeatAttributeObstructedView, SeatAttributeRestrictedView}, false},
+		{"unknown attribute", "balcony", "", nil, nil, true},
+		{"required and excluded", "aisle", "aisle", nil, nil, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := ParseSeatFilter(tc.require, tc.exclude)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error %t, got %v", tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(filter.Require, tc.expectedRequire) || !reflect.DeepEqual(filter.Exclude, tc.expectedExclude) {
+				t.Errorf("Expected require %v exclude %v, got %+v", tc.expectedRequire, tc.expectedExclude, filter)
+			}
+		})
+	}
+}
+
+// TestSeatFilter_Matches tests required and excluded attributes against seats.
+func TestSeatFilter_Matches(t *testing.T) {
+	filter := SeatFilter{
+		Require: []SeatAttribute{SeatAttributeAisle},
+		Exclude: []SeatAttribute{SeatAttributeObstructedView},
+	}
+
+	tests := []struct {
+		attributes string
+		expected   bool
+	}{
+		{"aisle", true},
+		{"aisle,wheelchair", true},
+		{"", false},
+		{"aisle,obstructed_view", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.attributes, func(t *testing.T) {
+			if got := filter.Matches(&Seat{Attributes: tc.attributes}); got != tc.expected {
+				t.Errorf("Expected Matches %t, got %t", tc.expected, got)
+			}
+		})
+	}
+
+	if !(SeatFilter{}).Matches(&Seat{Attributes: "obstructed_view"}) {
+		t.Error("Expected an empty filter to match every seat")
+	}
+}
+
+// TestFindAdjacentSeats tests that a block never spans taken, held or filtered
+// seats, rows or sections.
+func TestFindAdjacentSeats(t *testing.T) {
+	// Bölüm 1: A sırası 1-4, B sırası 1-3; bölüm 2: A sırası 1-2
+	seats := []*Seat{
+		{BaseModel: BaseModel{ID: 1}, SectionID: 1, Row: "A", Number: "1"},
+		{BaseModel: BaseModel{ID: 2}, SectionID: 1, Row: "A", Number: "2"},
+		{BaseModel: BaseModel{ID: 3}, SectionID: 1, Row: "A", Number: "3", Attributes: "obstructed_view"},
+		{BaseModel: BaseModel{ID: 4}, SectionID: 1, Row: "A", Number: "4"},
+		{BaseModel: BaseModel{ID: 5}, SectionID: 1, Row: "B", Number: "1", Attributes: "wheelchair"},
+		{BaseModel: BaseModel{ID: 6}, SectionID: 1, Row: "B", Number: "2"},
+		{BaseModel: BaseModel{ID: 7}, SectionID: 1, Row: "B", Number: "3"},
+		{BaseModel: BaseModel{ID: 8}, SectionID: 2, Row: "A", Number: "1"},
+		{BaseModel: BaseModel{ID: 9}, SectionID: 2, Row: "A", Number: "2"},
+	}
+	noObstructed := SeatFilter{Exclude: []SeatAttribute{SeatAttributeObstructedView}}
+
+	tests := []struct {
+		name     string
+		statuses map[int64]SeatStatus
+		quantity int
+		filter   SeatFilter
+		expected []int64
+	}{
+		{"front row first", nil, 3, SeatFilter{}, []int64{1, 2, 3}},
+		{"filtered seat breaks the block", nil, 3, noObstructed, []int64{5, 6, 7}},
+		{"taken seat breaks the block", map[int64]SeatStatus{2: SeatStatusTaken}, 2, SeatFilter{}, []int64{3, 4}},
+		{"held seat breaks the block", map[int64]SeatStatus{6: SeatStatusHeld}, 3, noObstructed, nil},
+		{"explicitly available seat", map[int64]SeatStatus{1: SeatStatusAvailable}, 2, SeatFilter{}, []int64{1, 2}},
+		{"block does not span rows", map[int64]SeatStatus{1: SeatStatusTaken, 2: SeatStatusTaken, 3: SeatStatusTaken}, 2, SeatFilter{}, []int64{5, 6}},
+		{"block does not span sections", map[int64]SeatStatus{1: SeatStatusTaken, 4: SeatStatusTaken, 6: SeatStatusTaken}, 2, noObstructed, []int64{8, 9}},
+		{"required attribute", nil, 1, SeatFilter{Require: []SeatAttribute{SeatAttributeWheelchair}}, []int64{5}},
+		{"no row is long enough", nil, 5, SeatFilter{}, nil},
+		{"zero quantity", nil, 0, SeatFilter{}, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			block := FindAdjacentSeats(seats, tc.statuses, tc.quantity, tc.filter)
+
+			var got []int64
+			for _, seat := range block {
+				got = append(got, seat.ID)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected seats %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
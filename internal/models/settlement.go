@@ -0,0 +1,109 @@
+// -----------------------------------------------------------------------------
+// Settlement Models
+// -----------------------------------------------------------------------------
+// Organizatöre dönem bazında yapılacak ödemenin (payout) ekstresini temsil eder.
+// Ekstre, dönem içinde tamamlanan satışlardan iadeleri, ters ibrazları
+// (chargeback) ve platform ücretlerini düşer. Oluşturulan ekstre değişmez;
+// her ödeme hareketi sadece bir ekstrede yer alır.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"time"
+)
+
+// SettlementStatus, ekstrenin durumunu temsil eder
+type SettlementStatus string
+
+const (
+	SettlementStatusIssued SettlementStatus = "issued" // Oluşturuldu, ödeme bekliyor
+	SettlementStatusPaid   SettlementStatus = "paid"   // Organizatöre ödendi
+)
+
+// SettlementLineType, ekstre kaleminin türünü temsil eder
+type SettlementLineType string
+
+const (
+	SettlementLineSale          SettlementLineType = "sale"           // Tamamlanan satış (+)
+	SettlementLineRefund        SettlementLineType = "refund"         // İade (-)
+	SettlementLineChargeback    SettlementLineType = "chargeback"     // Ters ibraz (-)
+	SettlementLineFee           SettlementLineType = "fee"            // Satış komisyonu (-)
+	SettlementLineChargebackFee SettlementLineType = "chargeback_fee" // Ters ibraz ücreti (-)
+)
+
+// SettlementStatement, bir organizatörün dönem ekstresidir
+type SettlementStatement struct {
+	BaseModel
+	OrganizerID      int64            `json:"organizer_id" db:"organizer_id"`
+	PeriodStart      time.Time        `json:"period_start" db:"period_start"`
+	PeriodEnd        time.Time        `json:"period_end" db:"period_end"` // Hariç
+	Currency         string           `json:"currency" db:"currency"`
+	GrossAmount      float64          `json:"gross_amount" db:"gross_amount"`
+	RefundAmount     float64          `json:"refund_amount" db:"refund_amount"`
+	ChargebackAmount float64          `json:"chargeback_amount" db:"chargeback_amount"`
+	FeeAmount        float64          `json:"fee_amount" db:"fee_amount"`
+	NetAmount        float64          `json:"net_amount" db:"net_amount"`
+	LineCount        int              `json:"line_count" db:"line_count"`
+	Status           SettlementStatus `json:"status" db:"status"`
+	PaidAt           *time.Time       `json:"paid_at,omitempty" db:"paid_at"`
+	PayoutReference  *string          `json:"payout_reference,omitempty" db:"payout_reference"`
+
+	// İlişkili veriler
+	Lines []*SettlementLine `json:"lines,omitempty" db:"-"`
+}
+
+// IsPaid, ekstrenin ödenip ödenmediğini kontrol eder
+func (s *SettlementStatement) IsPaid() bool {
+	return s.Status == SettlementStatusPaid
+}
+
+// AddLine, kalemi ekler ve ekstre toplamlarını günceller
+func (s *SettlementStatement) AddLine(line *SettlementLine) {
+	switch line.LineType {
+	case SettlementLineSale:
+		s.GrossAmount += line.Amount
+	case SettlementLineRefund:
+		s.RefundAmount -= line.Amount
+	case SettlementLineChargeback:
+		s.ChargebackAmount -= line.Amount
+	case SettlementLineFee, SettlementLineChargebackFee:
+		s.FeeAmount -= line.Amount
+	}
+
+	s.NetAmount += line.Amount
+	s.LineCount++
+	s.Lines = append(s.Lines, line)
+}
+
+// SettlementLine, ekstredeki tek bir ödeme hareketidir
+type SettlementLine struct {
+	ID          int64              `json:"id" db:"id"`
+	StatementID int64              `json:"statement_id" db:"statement_id"`
+	PaymentID   int64              `json:"payment_id" db:"payment_id"`
+	EventID     int64              `json:"event_id" db:"event_id"`
+	LineType    SettlementLineType `json:"line_type" db:"line_type"`
+	Amount      float64            `json:"amount" db:"amount"` // İşaretli: satış +, diğerleri -
+	OccurredAt  time.Time          `json:"occurred_at" db:"occurred_at"`
+	CreatedAt   time.Time          `json:"created_at" db:"created_at"`
+}
+
+// SettlementMovement, henüz bir ekstreye girmemiş ödeme hareketidir (satış, iade, ters ibraz)
+type SettlementMovement struct {
+	PaymentID  int64
+	EventID    int64
+	Type       SettlementLineType
+	Amount     float64 // Ödeme tutarı (işaretsiz)
+	OccurredAt time.Time
+}
+
+// RevenueBreakdown, bir etkinliğin gelir dağılımıdır
+type RevenueBreakdown struct {
+	EventID          int64   `json:"event_id"`
+	Currency         string  `json:"currency"`
+	PaymentCount     int     `json:"payment_count"`
+	GrossAmount      float64 `json:"gross_amount"`
+	RefundAmount     float64 `json:"refund_amount"`
+	ChargebackAmount float64 `json:"chargeback_amount"`
+	NetAmount        float64 `json:"net_amount"`
+}
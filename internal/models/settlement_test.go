@@ -0,0 +1,95 @@
+// -----------------------------------------------------------------------------
+// Settlement Tests
+// -----------------------------------------------------------------------------
+// Bu testler, organizatör hesap ekstresinin kalem türlerini doğru toplamlara
+// dağıttığını ve net tutarın toplamlarla tutarlı kaldığını doğrular.
+//
+// Testler:
+// - Kalem ekleme (satış, iade, ters ibraz, ücretler)
+// - Ekstre toplamları (brüt - iade - ters ibraz - ücret = net)
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"math"
+	"testing"
+)
+
+// TestSettlementStatement_AddLine tests that each line type lands in its own total.
+func TestSettlementStatement_AddLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       SettlementLine
+		gross      float64
+		refund     float64
+		chargeback float64
+		fee        float64
+		net        float64
+	}{
+		{"sale", SettlementLine{LineType: SettlementLineSale, Amount: 250}, 250, 0, 0, 0, 250},
+		{"refund", SettlementLine{LineType: SettlementLineRefund, Amount: -100}, 0, 100, 0, 0, -100},
+		{"chargeback", SettlementLine{LineType: SettlementLineChargeback, Amount: -80}, 0, 0, 80, 0, -80},
+		{"fee", SettlementLine{LineType: SettlementLineFee, Amount: -12.5}, 0, 0, 0, 12.5, -12.5},
+		{"chargeback fee", SettlementLine{LineType: SettlementLineChargebackFee, Amount: -15}, 0, 0, 0, 15, -15},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &SettlementStatement{}
+			line := tc.line
+			s.AddLine(&line)
+
+			if s.GrossAmount != tc.gross {
+				t.Errorf("Expected gross %v, got %v", tc.gross, s.GrossAmount)
+			}
+			if s.RefundAmount != tc.refund {
+				t.Errorf("Expected refund %v, got %v", tc.refund, s.RefundAmount)
+			}
+			if s.ChargebackAmount != tc.chargeback {
+				t.Errorf("Expected chargeback %v, got %v", tc.chargeback, s.ChargebackAmount)
+			}
+			if s.FeeAmount != tc.fee {
+				t.Errorf("Expected fee %v, got %v", tc.fee, s.FeeAmount)
+			}
+			if s.NetAmount != tc.net {
+				t.Errorf("Expected net %v, got %v", tc.net, s.NetAmount)
+			}
+			if s.LineCount != 1 || len(s.Lines) != 1 {
+				t.Errorf("Expected 1 line, got count %d and %d lines", s.LineCount, len(s.Lines))
+			}
+		})
+	}
+}
+
+// TestSettlementStatement_Totals tests that net equals gross minus refunds,
+// chargebacks and fees on a mixed statement.
+func TestSettlementStatement_Totals(t *testing.T) {
+	s := &SettlementStatement{}
+	lines := []SettlementLine{
+		{LineType: SettlementLineSale, Amount: 500},
+		{LineType: SettlementLineFee, Amount: -25},
+		{LineType: SettlementLineSale, Amount: 300},
+		{LineType: SettlementLineFee, Amount: -15},
+		{LineType: SettlementLineRefund, Amount: -300},
+		{LineType: SettlementLineChargeback, Amount: -100},
+		{LineType: SettlementLineChargebackFee, Amount: -20},
+	}
+	for i := range lines {
+		s.AddLine(&lines[i])
+	}
+
+	expected := s.GrossAmount - s.RefundAmount - s.ChargebackAmount - s.FeeAmount
+	if math.Abs(s.NetAmount-expected) > 1e-9 {
+		t.Errorf("Expected net to equal gross - refund - chargeback - fee (%v), got %v", expected, s.NetAmount)
+	}
+	if s.NetAmount != 340 {
+		t.Errorf("Expected net 340, got %v", s.NetAmount)
+	}
+	if s.FeeAmount != 60 {
+		t.Errorf("Expected fee 60, got %v", s.FeeAmount)
+	}
+	if s.LineCount != len(lines) {
+		t.Errorf("Expected %d lines, got %d", len(lines), s.LineCount)
+	}
+}
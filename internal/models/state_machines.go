@@ -0,0 +1,135 @@
+// -----------------------------------------------------------------------------
+// State Machines
+// -----------------------------------------------------------------------------
+// Etkinlik, bilet ve ödeme durumlarının izin verilen geçişleri, guard'ları ve
+// yan etki hook'ları burada tanımlanır. Servisler durum değiştirirken bu
+// makinelerden geçer; tanımlanmamış geçişler (ör. completed → sale_active)
+// yapılamaz.
+//
+// Etkinlik:
+//
+//	draft → published → sale_active ⇄ sold_out
+//	published / sale_active / sold_out → completed
+//	draft / published / sale_active / sold_out → cancelled
+//
+// Bilet:
+//
+//	reserved → sold → used
+//	reserved / sold → cancelled
+//	reserved → expired
+//
+// Ödeme:
+//
+//	pending → completed / failed
+//	failed → completed (geç gelen başarılı bildirim)
+//	completed → refunded / charged_back
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/pkg/statemachine"
+)
+
+type (
+	EventStateMachine   = statemachine.Machine[EventStatus, *Event]
+	TicketStateMachine  = statemachine.Machine[TicketStatus, *Ticket]
+	PaymentStateMachine = statemachine.Machine[PaymentStatus, *Payment]
+)
+
+// NewEventStateMachine, etkinlik durum makinesini oluşturur
+func NewEventStateMachine() *EventStateMachine {
+	return statemachine.New("event",
+		func(e *Event) EventStatus { return e.Status },
+		func(e *Event, status EventStatus) { e.Status = status },
+	).
+		Permit(EventStatusDraft, EventStatusPublished, EventStatusCancelled).
+		Permit(EventStatusPublished, EventStatusSaleActive, EventStatusSoldOut, EventStatusCancelled, EventStatusCompleted).
+		Permit(EventStatusSaleActive, EventStatusSoldOut, EventStatusCancelled, EventStatusCompleted).
+		Permit(EventStatusSoldOut, EventStatusSaleActive, EventStatusCancelled, EventStatusCompleted).
+		Guard(EventStatusPublished, func(e *Event, from EventStatus) error {
+			if e.StartTime.Before(time.Now()) {
+				return fmt.Errorf("geçmiş tarihli etkinlik yayınlanamaz")
+			}
+			return nil
+		}).
+		Guard(EventStatusSoldOut, func(e *Event, from EventStatus) error {
+			if !e.IsSoldOut() {
+				return fmt.Errorf("etkinlikte hala müsait koltuk var")
+			}
+			return nil
+		}).
+		Guard(EventStatusSaleActive, func(e *Event, from EventStatus) error {
+			if from == EventStatusSoldOut && e.IsSoldOut() {
+				return fmt.Errorf("etkinlikte müsait koltuk yok")
+			}
+			return nil
+		}).
+		Guard(EventStatusCompleted, func(e *Event, from EventStatus) error {
+			if !e.IsCompleted() {
+				return fmt.Errorf("bitmemiş etkinlik tamamlandı olarak işaretlenemez")
+			}
+			return nil
+		})
+}
+
+// NewTicketStateMachine, bilet durum makinesini oluşturur; zaman damgaları hook'larla atanır
+func NewTicketStateMachine() *TicketStateMachine {
+	return statemachine.New("ticket",
+		func(t *Ticket) TicketStatus { return t.Status },
+		func(t *Ticket, status TicketStatus) { t.Status = status },
+	).
+		Permit(TicketStatusReserved, TicketStatusSold, TicketStatusCancelled, TicketStatusExpired).
+		Permit(TicketStatusSold, TicketStatusUsed, TicketStatusCancelled).
+		Guard(TicketStatusSold, func(t *Ticket, from TicketStatus) error {
+			if t.ReservationExpiry != nil && time.Now().After(*t.ReservationExpiry) {
+				return fmt.Errorf("rezervasyon süresi dolmuş")
+			}
+			return nil
+		}).
+		OnEnter(TicketStatusSold, func(t *Ticket, from TicketStatus) {
+			now := time.Now()
+			t.PurchasedAt = &now
+		}).
+		OnEnter(TicketStatusUsed, func(t *Ticket, from TicketStatus) {
+			now := time.Now()
+			t.UsedAt = &now
+		}).
+		OnEnter(TicketStatusCancelled, func(t *Ticket, from TicketStatus) {
+			now := time.Now()
+			t.CancelledAt = &now
+		})
+}
+
+// NewPaymentStateMachine, ödeme durum makinesini oluşturur; zaman damgaları hook'larla atanır
+func NewPaymentStateMachine() *PaymentStateMachine {
+	return statemachine.New("payment",
+		func(p *Payment) PaymentStatus { return p.Status },
+		func(p *Payment, status PaymentStatus) { p.Status = status },
+	).
+		Permit(PaymentStatusPending, PaymentStatusCompleted, PaymentStatusFailed).
+		Permit(PaymentStatusFailed, PaymentStatusCompleted).
+		Permit(PaymentStatusCompleted, PaymentStatusRefunded, PaymentStatusChargedBack).
+		OnEnter(PaymentStatusCompleted, func(p *Payment, from PaymentStatus) {
+			now := time.Now()
+			p.PaidAt = &now
+		}).
+		OnEnter(PaymentStatusRefunded, func(p *Payment, from PaymentStatus) {
+			now := time.Now()
+			p.RefundedAt = &now
+		}).
+		OnEnter(PaymentStatusChargedBack, func(p *Payment, from PaymentStatus) {
+			now := time.Now()
+			p.ChargedBackAt = &now
+		})
+}
+
+// Dinleyicisiz varsayılan makineler; model yardımcı metotları (CanCancel, MarkAsSold vb.) kullanır
+var (
+	eventStates   = NewEventStateMachine()
+	ticketStates  = NewTicketStateMachine()
+	paymentStates = NewPaymentStateMachine()
+)
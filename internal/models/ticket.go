@@ -22,45 +22,67 @@ const (
 	TicketStatusExpired   TicketStatus = "expired"   // Süresi dolmuş rezervasyon
 )
 
+// TicketType, bilet tipini temsil eder (tickets.ticket_type)
+type TicketType string
+
+const (
+	TicketTypeStandard  TicketType = "standard"   // Standart bilet
+	TicketTypeVIP       TicketType = "vip"        // VIP bilet
+	TicketTypeEarlyBird TicketType = "early_bird" // Erken rezervasyon
+	TicketTypeSeason    TicketType = "season"     // Kombine kapsamındaki giriş bileti
+)
+
 // Ticket, bir bileti temsil eder
 type Ticket struct {
 	BaseModel
-	TicketCode     string       `json:"ticket_code" db:"ticket_code"` // Unique ticket code
+	TicketNumber   string       `json:"ticket_number" db:"ticket_number"` // Unique ticket number
 	EventID        int64        `json:"event_id" db:"event_id"`
-	SeatID         int64        `json:"seat_id" db:"seat_id"`
+	SectionID      int64        `json:"section_id" db:"section_id"`
+	SeatID         *int64       `json:"seat_id,omitempty" db:"seat_id"` // Ayakta / koltuksuz biletlerde nil
 	UserID         int64        `json:"user_id" db:"user_id"`
+	TicketType     TicketType   `json:"ticket_type" db:"ticket_type"`
 	Status         TicketStatus `json:"status" db:"status"`
 	Price          float64      `json:"price" db:"price"`
-	PricingType    string       `json:"pricing_type" db:"pricing_type"` // "early_bird", "vip", "dynamic"
-	QRCodeURL      string       `json:"qr_code_url,omitempty" db:"qr_code_url"`
-	ReservedAt     *time.Time   `json:"reserved_at,omitempty" db:"reserved_at"`
+	QRCodeData     string       `json:"qr_code_data,omitempty" db:"qr_code_data"`
+	QRCodeImage    []byte       `json:"qr_code_image,omitempty" db:"qr_code_image"` // PNG (JSON'da base64)
+	VerificationCode string     `json:"verification_code,omitempty" db:"verification_code"` // 6 haneli manuel doğrulama kodu
 	PurchasedAt    *time.Time   `json:"purchased_at,omitempty" db:"purchased_at"`
 	CancelledAt    *time.Time   `json:"cancelled_at,omitempty" db:"cancelled_at"`
 	UsedAt         *time.Time   `json:"used_at,omitempty" db:"used_at"`
 	ReservationExpiry *time.Time `json:"reservation_expiry,omitempty" db:"reservation_expiry"`
+	CardFingerprint   *string    `json:"-" db:"card_fingerprint"`  // Limit sayımı için ödeme kartı parmak izi
+	AddressHash       *string    `json:"-" db:"address_hash"`      // Limit sayımı için normalize adres özeti
+	LimitOverrideBy   *int64     `json:"limit_override_by,omitempty" db:"limit_override_by"` // Limiti aşan gişe satışını yapan admin
+	SalePhaseID       *int64     `json:"sale_phase_id,omitempty" db:"sale_phase_id"`            // Biletin alındığı satış aşaması
+	SalePhaseCodeID   *int64     `json:"-" db:"sale_phase_code_id"`                              // Presale erişim kodu
+	SeasonMembershipID *int64    `json:"season_membership_id,omitempty" db:"season_membership_id"` // Kombine kapsamındaki giriş bileti
 	DeletedAt      *time.Time   `json:"-" db:"deleted_at"`
+	Version        int64        `json:"version" db:"version"` // İyimser kilitleme: her yazmada artar (ETag)
 
 	// İlişkili veriler
 	Event *Event `json:"event,omitempty" db:"-"`
 	Seat  *Seat  `json:"seat,omitempty" db:"-"`
 	User  *User  `json:"user,omitempty" db:"-"`
+	AddOns []*AddOnItem `json:"addons,omitempty" db:"-"` // Bilete bağlı ek ürünler
+	Entitlements   []*TicketEntitlement `json:"entitlements,omitempty" db:"-"`    // Festival: giriş hakkı verilen oturumlar
+	CurrentSession *EventSession        `json:"current_session,omitempty" db:"-"` // Festival: check-in yapılan / açık oturum
 }
 
 // State Pattern Methods
 
 // CanPurchase, biletin satın alınıp alınamayacağını kontrol eder
 func (t *Ticket) CanPurchase() bool {
-	return t.Status == TicketStatusReserved && time.Now().Before(*t.ReservationExpiry)
+	return ticketStates.Check(t, TicketStatusSold) == nil
 }
 
 // CanCancel, biletin iptal edilip edilemeyeceğini kontrol eder
 func (t *Ticket) CanCancel() bool {
-	return t.Status == TicketStatusReserved || t.Status == TicketStatusSold
+	return ticketStates.Check(t, TicketStatusCancelled) == nil
 }
 
 // CanUse, biletin kullanılıp kullanılamayacağını kontrol eder (check-in)
 func (t *Ticket) CanUse() bool {
-	return t.Status == TicketStatusSold
+	return ticketStates.Check(t, TicketStatusUsed) == nil
 }
 
 // IsExpired, rezervasyonun süresinin dolup dolmadığını kontrol eder
@@ -76,43 +98,29 @@ func (t *Ticket) IsExpired() bool {
 
 // MarkAsSold, bileti satılmış olarak işaretler (State transition)
 func (t *Ticket) MarkAsSold() error {
-	if !t.CanPurchase() {
-		return ErrInvalidStateTransition
-	}
-	t.Status = TicketStatusSold
-	now := time.Now()
-	t.PurchasedAt = &now
-	return nil
+	return t.transition(TicketStatusSold)
 }
 
 // MarkAsUsed, bileti kullanılmış olarak işaretler (Check-in)
 func (t *Ticket) MarkAsUsed() error {
-	if !t.CanUse() {
-		return ErrInvalidStateTransition
-	}
-	t.Status = TicketStatusUsed
-	now := time.Now()
-	t.UsedAt = &now
-	return nil
+	return t.transition(TicketStatusUsed)
 }
 
 // MarkAsCancelled, bileti iptal edilmiş olarak işaretler
 func (t *Ticket) MarkAsCancelled() error {
-	if !t.CanCancel() {
-		return ErrInvalidStateTransition
-	}
-	t.Status = TicketStatusCancelled
-	now := time.Now()
-	t.CancelledAt = &now
-	return nil
+	return t.transition(TicketStatusCancelled)
 }
 
 // MarkAsExpired, rezervasyonu süresi dolmuş olarak işaretler
 func (t *Ticket) MarkAsExpired() error {
-	if t.Status != TicketStatusReserved {
+	return t.transition(TicketStatusExpired)
+}
+
+// transition, bileti durum makinesi üzerinden (kalıcı kayıt olmadan) yeni duruma geçirir
+func (t *Ticket) transition(to TicketStatus) error {
+	if err := ticketStates.Fire(t, to, nil); err != nil {
 		return ErrInvalidStateTransition
 	}
-	t.Status = TicketStatusExpired
 	return nil
 }
 
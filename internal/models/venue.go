@@ -14,6 +14,7 @@ import (
 // Venue, bir etkinlik mekanını temsil eder
 type Venue struct {
 	BaseModel
+	OrganizerID int64      `json:"organizer_id" db:"organizer_id"` // Mekanın sahibi organizatör
 	Name        string     `json:"name" db:"name"`
 	Address     string     `json:"address" db:"address"`
 	City        string     `json:"city" db:"city"`
@@ -23,10 +24,11 @@ type Venue struct {
 	ImageURL    string     `json:"image_url,omitempty" db:"image_url"`
 	Latitude    float64    `json:"latitude" db:"latitude"`
 	Longitude   float64    `json:"longitude" db:"longitude"`
-	DeletedAt   *time.Time `json:"-" db:"deleted_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty" db:"deleted_at"` // Sadece çöp kutusu listelerinde dolu
 
 	// İlişkili veriler
-	Sections []Section `json:"sections,omitempty" db:"-"`
+	Sections []Section       `json:"sections,omitempty" db:"-"`
+	Images   []*ImageVariant `json:"images,omitempty" db:"-"` // Görsel varyantları (ImageURL = hero)
 }
 
 // Section, mekan içindeki bir bölümü temsil eder (VIP, Normal, Balkon vb.)
@@ -36,7 +38,7 @@ type Section struct {
 	Name        string     `json:"name" db:"name"` // "VIP", "Tribune A", "Balkon 1"
 	Description string     `json:"description,omitempty" db:"description"`
 	Capacity    int        `json:"capacity" db:"capacity"`
-	RowCount    int        `json:"row_count" db:"row_count"`       // Kaç sıra var
+	RowCount    int        `json:"row_count" db:"row_count"`         // Kaç sıra var
 	SeatsPerRow int        `json:"seats_per_row" db:"seats_per_row"` // Sıra başına koltuk
 	DeletedAt   *time.Time `json:"-" db:"deleted_at"`
 
@@ -48,10 +50,12 @@ type Section struct {
 // Seat, bir koltuğu temsil eder
 type Seat struct {
 	BaseModel
-	SectionID int64  `json:"section_id" db:"section_id"`
-	Row       string `json:"row" db:"row"`        // "A", "B", "C" veya "1", "2", "3"
-	Number    string `json:"number" db:"number"`  // "1", "2", "3" veya "A1", "B2"
-	IsActive  bool   `json:"is_active" db:"is_active"` // Koltuk kullanılabilir mi?
+	SectionID       int64  `json:"section_id" db:"section_id"`
+	Row             string `json:"row" db:"row"`                                       // "A", "B", "C" veya "1", "2", "3"
+	Number          string `json:"number" db:"number"`                                 // "1", "2", "3" veya "A1", "B2"
+	Attributes      string `json:"attributes" db:"attributes"`                         // Virgülle ayrılmış özellikler (örn. "aisle,obstructed_view")
+	CompanionSeatID *int64 `json:"companion_seat_id,omitempty" db:"companion_seat_id"` // Erişilebilir koltuk ↔ refakatçi koltuğu
+	IsActive        bool   `json:"is_active" db:"is_active"`                           // Koltuk kullanılabilir mi?
 
 	// İlişkili veriler
 	Section *Section `json:"section,omitempty" db:"-"`
@@ -0,0 +1,366 @@
+// -----------------------------------------------------------------------------
+// Venue Layout Models
+// -----------------------------------------------------------------------------
+// Bir mekanın bölüm / sıra / koltuk düzenini tek bir belge olarak temsil eder.
+// Stadyum gibi binlerce koltuklu mekanlar koltuk koltuk değil, sıra tanımları
+// (numaralandırma şeması, boşluklar, özellikler) ile tarif edilir; belge
+// genişletilerek koltuk listesine çevrilir ve mevcut düzenle karşılaştırılır.
+//
+// JSON örneği:
+//
+//	{"sections": [{"name": "Tribün A", "rows": [
+//	    {"label": "A-T", "count": 30, "gaps": [13], "seat_attributes": {"1": ["aisle"]}},
+//	    {"label": "U", "start": 1, "count": 20, "numbering": "odd", "attributes": ["obstructed_view"]}
+//	]}]}
+//
+// CSV'de her satır bir koltuktur: section,row,number,attributes ("aisle|obstructed_view")
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Düzen sınırları (seats / sections kolon boyutları)
+const (
+	MaxLayoutSeats          = 200000
+	MaxSectionNameLength    = 100
+	MaxSeatLabelLength      = 10 // seats.row ve seats.number
+	MaxSeatAttributesLength = 255
+)
+
+// SeatNumbering, bir sıradaki koltuk numaralandırma şemasıdır
+type SeatNumbering string
+
+const (
+	NumberingConsecutive SeatNumbering = "consecutive" // start, start+1, ... (varsayılan)
+	NumberingDescending  SeatNumbering = "descending"  // Sağdan sola: start+count-1, ..., start
+	NumberingOdd         SeatNumbering = "odd"         // 1, 3, 5, ... (tek numaralı kanat)
+	NumberingEven        SeatNumbering = "even"        // 2, 4, 6, ... (çift numaralı kanat)
+)
+
+// VenueLayout, bir mekanın hedef düzenidir; belgede olmayan koltuklar içe aktarımda pasife alınır
+type VenueLayout struct {
+	Sections []*LayoutSection `json:"sections"`
+}
+
+// LayoutSection, düzendeki bir bölümdür; sıra tanımları ve / veya tek tek koltuklar içerir
+type LayoutSection struct {
+	Name  string        `json:"name"`
+	Rows  []*LayoutRow  `json:"rows,omitempty"`
+	Seats []*LayoutSeat `json:"seats,omitempty"` // Tek tek tanımlanan koltuklar (CSV)
+}
+
+// LayoutRow, aynı şemayla numaralanan bir veya daha fazla sıradır
+type LayoutRow struct {
+	Label          string              `json:"label"`     // "A", ya da aralık: "A-T", "1-25"
+	Start          int                 `json:"start"`     // İlk koltuk numarası (varsayılan 1, even için 2)
+	Count          int                 `json:"count"`     // Sıradaki numara sayısı (boşluklar dahil)
+	Numbering      SeatNumbering       `json:"numbering"` // Varsayılan consecutive
+	Prefix         string              `json:"prefix"`    // Numara öneki ("W" → "W1")
+	Gaps           []int               `json:"gaps"`      // Var olmayan koltuk numaraları (kolon, merdiven, 13 vb.)
+	Attributes     []string            `json:"attributes"`
+	SeatAttributes map[string][]string `json:"seat_attributes"` // Numara → ek özellikler
+}
+
+// LayoutSeat, genişletilmiş düzendeki tek bir koltuktur
+type LayoutSeat struct {
+	Row        string   `json:"row"`
+	Number     string   `json:"number"`
+	Attributes []string `json:"attributes,omitempty"`
+}
+
+// Key, koltuğun bölüm içindeki benzersiz anahtarıdır (unique section_id, row, number)
+func (s *LayoutSeat) Key() string {
+	return SeatKey(s.Row, s.Number)
+}
+
+// SeatKey, sıra ve numaradan koltuk anahtarı üretir; kolon karşılaştırması gibi büyük / küçük harf duyarsızdır
+func SeatKey(row, number string) string {
+	return strings.ToLower(row) + "\x00" + strings.ToLower(number)
+}
+
+// AttributeString, özellikleri seats.attributes biçiminde (sıralı, virgülle ayrılmış) döndürür
+func (s *LayoutSeat) AttributeString() string {
+	return JoinSeatAttributes(s.Attributes)
+}
+
+// JoinSeatAttributes, özellikleri tekilleştirip sıralayarak birleştirir
+func JoinSeatAttributes(attributes []string) string {
+	seen := make(map[string]bool, len(attributes))
+	unique := make([]string, 0, len(attributes))
+	for _, attribute := range attributes {
+		attribute = strings.ToLower(strings.TrimSpace(attribute))
+		if attribute == "" || seen[attribute] {
+			continue
+		}
+		seen[attribute] = true
+		unique = append(unique, attribute)
+	}
+	sort.Strings(unique)
+	return strings.Join(unique, ",")
+}
+
+// ExpandSeats, bölümün sıra tanımlarını ve tek tek koltuklarını koltuk listesine çevirir
+func (s *LayoutSection) ExpandSeats() ([]*LayoutSeat, error) {
+	var seats []*LayoutSeat
+
+	for i, row := range s.Rows {
+		expanded, err := row.Expand()
+		if err != nil {
+			return nil, fmt.Errorf("rows[%d]: %w", i, err)
+		}
+		seats = append(seats, expanded...)
+	}
+
+	for _, seat := range s.Seats {
+		seats = append(seats, &LayoutSeat{
+			Row:        strings.TrimSpace(seat.Row),
+			Number:     strings.TrimSpace(seat.Number),
+			Attributes: seat.Attributes,
+		})
+	}
+
+	return seats, nil
+}
+
+// Expand, sıra tanımını koltuklara çevirir
+func (r *LayoutRow) Expand() ([]*LayoutSeat, error) {
+	labels, err := expandRowLabels(r.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers, err := r.seatNumbers()
+	if err != nil {
+		return nil, err
+	}
+
+	var seats []*LayoutSeat
+	for _, label := range labels {
+		for _, number := range numbers {
+			value := r.Prefix + strconv.Itoa(number)
+			attributes := append(append([]string{}, r.Attributes...), r.SeatAttributes[strconv.Itoa(number)]...)
+			seats = append(seats, &LayoutSeat{Row: label, Number: value, Attributes: attributes})
+		}
+	}
+
+	return seats, nil
+}
+
+// seatNumbers, numaralandırma şemasına göre boşluklar çıkarılmış koltuk numaralarını üretir
+func (r *LayoutRow) seatNumbers() ([]int, error) {
+	if r.Count <= 0 {
+		return nil, fmt.Errorf("count pozitif olmalı")
+	}
+	if r.Count > MaxLayoutSeats {
+		return nil, fmt.Errorf("count en fazla %d olabilir", MaxLayoutSeats)
+	}
+
+	numbering := r.Numbering
+	if numbering == "" {
+		numbering = NumberingConsecutive
+	}
+
+	start := r.Start
+	if start == 0 {
+		start = 1
+		if numbering == NumberingEven {
+			start = 2
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("start negatif olamaz")
+	}
+
+	step := 1
+	switch numbering {
+	case NumberingConsecutive, NumberingDescending:
+	case NumberingOdd, NumberingEven:
+		step = 2
+		if (numbering == NumberingOdd) != (start%2 == 1) {
+			return nil, fmt.Errorf("start numaralandırma şemasıyla uyumlu değil (%s)", numbering)
+		}
+	default:
+		return nil, fmt.Errorf("numbering: consecutive, descending, odd veya even olmalı")
+	}
+
+	gaps := make(map[int]bool, len(r.Gaps))
+	for _, gap := range r.Gaps {
+		gaps[gap] = true
+	}
+
+	numbers := make([]int, 0, r.Count)
+	for i := 0; i < r.Count; i++ {
+		number := start + i*step
+		if numbering == NumberingDescending {
+			number = start + (r.Count-1-i)*step
+		}
+		if !gaps[number] {
+			numbers = append(numbers, number)
+		}
+	}
+
+	for key := range r.SeatAttributes {
+		number, err := strconv.Atoi(key)
+		if err != nil || !containsInt(numbers, number) {
+			return nil, fmt.Errorf("seat_attributes: sırada %s numaralı koltuk yok", key)
+		}
+	}
+
+	return numbers, nil
+}
+
+// expandRowLabels, "A-T" veya "1-25" gibi aralıkları sıra adlarına çevirir.
+// Aralık olmayan etiketler ("K", "K-1", "VIP") olduğu gibi kullanılır.
+func expandRowLabels(label string) ([]string, error) {
+	label = strings.TrimSpace(label)
+	if label == "" {
+		return nil, fmt.Errorf("label gerekli")
+	}
+
+	from, to, isRange := strings.Cut(label, "-")
+	if !isRange {
+		return []string{label}, nil
+	}
+	from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+
+	// Tek harfli aralık: A-T
+	if len(from) == 1 && len(to) == 1 && isUpperLetter(from[0]) && isUpperLetter(to[0]) {
+		if to[0] < from[0] {
+			return nil, fmt.Errorf("geçersiz sıra aralığı: %s", label)
+		}
+		labels := make([]string, 0, to[0]-from[0]+1)
+		for c := from[0]; c <= to[0]; c++ {
+			labels = append(labels, string(c))
+		}
+		return labels, nil
+	}
+
+	// Sayısal aralık: 1-25
+	first, errFrom := strconv.Atoi(from)
+	last, errTo := strconv.Atoi(to)
+	if errFrom == nil && errTo == nil {
+		if last < first || last-first >= 1000 {
+			return nil, fmt.Errorf("geçersiz sıra aralığı: %s", label)
+		}
+		labels := make([]string, 0, last-first+1)
+		for n := first; n <= last; n++ {
+			labels = append(labels, strconv.Itoa(n))
+		}
+		return labels, nil
+	}
+
+	return []string{label}, nil
+}
+
+func isUpperLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// LayoutChangeAction, içe aktarımın bir bölüm veya koltuk için yapacağı işlemdir
+type LayoutChangeAction string
+
+const (
+	LayoutCreate     LayoutChangeAction = "create"
+	LayoutUpdate     LayoutChangeAction = "update"     // Koltuk özellikleri veya bölüm sıra sayıları değişir
+	LayoutReactivate LayoutChangeAction = "reactivate" // Pasif / silinmiş koltuk tekrar kullanılır
+	LayoutDeactivate LayoutChangeAction = "deactivate" // Belgede olmayan koltuk pasife alınır
+	LayoutUnchanged  LayoutChangeAction = "unchanged"
+)
+
+// MaxLayoutDiffChanges, diff'te koltuk bazında listelenen en fazla değişiklik sayısıdır
+const MaxLayoutDiffChanges = 500
+
+// VenueLayoutDiff, düzen belgesinin mevcut düzene göre farkıdır (dry-run çıktısı)
+type VenueLayoutDiff struct {
+	VenueID          int64                `json:"venue_id"`
+	DryRun           bool                 `json:"dry_run"`
+	Sections         []*SectionLayoutDiff `json:"sections"`
+	SeatsCreated     int                  `json:"seats_created"`
+	SeatsUpdated     int                  `json:"seats_updated"`
+	SeatsReactivated int                  `json:"seats_reactivated"`
+	SeatsDeactivated int                  `json:"seats_deactivated"`
+	SeatsUnchanged   int                  `json:"seats_unchanged"`
+	Capacity         int                  `json:"capacity"`  // İçe aktarım sonrası aktif koltuk sayısı
+	Changes          []*SeatLayoutChange  `json:"changes"`   // İlk MaxLayoutDiffChanges değişiklik
+	Truncated        bool                 `json:"truncated"` // Changes listesi kısaltıldı mı
+}
+
+// HasChanges, içe aktarımın veritabanında bir şey değiştirip değiştirmeyeceğini döndürür
+func (d *VenueLayoutDiff) HasChanges() bool {
+	for _, section := range d.Sections {
+		if section.Action != LayoutUnchanged {
+			return true
+		}
+	}
+	return d.SeatsCreated+d.SeatsUpdated+d.SeatsReactivated+d.SeatsDeactivated > 0
+}
+
+// AddChange, koltuk değişikliğini sayaçlara ve (sınır dahilinde) listeye ekler
+func (d *VenueLayoutDiff) AddChange(change *SeatLayoutChange) {
+	switch change.Action {
+	case LayoutCreate:
+		d.SeatsCreated++
+	case LayoutUpdate:
+		d.SeatsUpdated++
+	case LayoutReactivate:
+		d.SeatsReactivated++
+	case LayoutDeactivate:
+		d.SeatsDeactivated++
+	default:
+		d.SeatsUnchanged++
+		return
+	}
+
+	if len(d.Changes) < MaxLayoutDiffChanges {
+		d.Changes = append(d.Changes, change)
+	} else {
+		d.Truncated = true
+	}
+}
+
+// SectionLayoutDiff, bir bölümün farkıdır
+type SectionLayoutDiff struct {
+	SectionID   int64              `json:"section_id,omitempty"` // Yeni bölümlerde içe aktarımdan sonra dolar
+	Name        string             `json:"name"`
+	Action      LayoutChangeAction `json:"action"` // create, update, unchanged, deactivate (belgede yok)
+	RowCount    int                `json:"row_count"`
+	SeatsPerRow int                `json:"seats_per_row"`
+	Seats       int                `json:"seats"` // Aktif koltuk sayısı
+}
+
+// SeatLayoutChange, tek bir koltuğun değişikliğidir
+type SeatLayoutChange struct {
+	Section    string             `json:"section"`
+	Row        string             `json:"row"`
+	Number     string             `json:"number"`
+	Action     LayoutChangeAction `json:"action"`
+	Attributes string             `json:"attributes,omitempty"`
+	Previous   string             `json:"previous_attributes,omitempty"`
+}
+
+// ExistingSeat, mevcut düzendeki bir koltuktur (pasif ve silinmiş koltuklar dahil)
+type ExistingSeat struct {
+	ID          int64
+	SectionID   int64
+	SectionName string
+	Row         string
+	Number      string
+	Attributes  string
+	IsActive    bool
+	Deleted     bool
+}
@@ -0,0 +1,204 @@
+// -----------------------------------------------------------------------------
+// Venue Layout Tests
+// -----------------------------------------------------------------------------
+// Bu testler, mekan yerleşim planının koltuklara doğru genişletildiğini ve
+// geçersiz planların veritabanına ulaşmadan reddedildiğini doğrular.
+//
+// Testler:
+// - Sıra aralıkları (harf, sayı, aralık olmayan etiketler)
+// - Numaralandırma şemaları ve boşluklar
+// - Sıra genişletme (önek, koltuk özellikleri)
+// - Özellik birleştirme ve koltuk anahtarı
+// - Diff sayaçları ve liste sınırı
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestExpandRowLabels tests letter and number row ranges and rejects reversed or oversized ones.
+func TestExpandRowLabels(t *testing.T) {
+	tests := []struct {
+		label    string
+		expected []string
+		wantErr  bool
+	}{
+		{"K", []string{"K"}, false},
+		{" A-D ", []string{"A", "B", "C", "D"}, false},
+		{"1-3", []string{"1", "2", "3"}, false},
+		{"K-1", []string{"K-1"}, false},
+		{"VIP", []string{"VIP"}, false},
+		{"D-A", nil, true},
+		{"5-1", nil, true},
+		{"1-1001", nil, true},
+		{"", nil, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			got, err := expandRowLabels(tc.label)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error %t, got %v", tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestLayoutRow_SeatNumbers tests numbering schemes, gaps and invalid rows.
+func TestLayoutRow_SeatNumbers(t *testing.T) {
+	tests := []struct {
+		name     string
+		row      LayoutRow
+		expected []int
+		wantErr  bool
+	}{
+		{"consecutive default", LayoutRow{Count: 4}, []int{1, 2, 3, 4}, false},
+		{"consecutive with gaps", LayoutRow{Count: 5, Gaps: []int{3}}, []int{1, 2, 4, 5}, false},
+		{"custom start", LayoutRow{Start: 101, Count: 3}, []int{101, 102, 103}, false},
+		{"descending", LayoutRow{Count: 4, Numbering: NumberingDescending}, []int{4, 3, 2, 1}, false},
+		{"odd", LayoutRow{Count: 4, Numbering: NumberingOdd}, []int{1, 3, 5, 7}, false},
+		{"even defaults to 2", LayoutRow{Count: 3, Numbering: NumberingEven}, []int{2, 4, 6}, false},
+		{"odd with even start", LayoutRow{Start: 2, Count: 3, Numbering: NumberingOdd}, nil, true},
+		{"even with odd start", LayoutRow{Start: 1, Count: 3, Numbering: NumberingEven}, nil, true},
+		{"unknown numbering", LayoutRow{Count: 3, Numbering: SeatNumbering("spiral")}, nil, true},
+		{"zero count", LayoutRow{}, nil, true},
+		{"count above limit", LayoutRow{Count: MaxLayoutSeats + 1}, nil, true},
+		{"negative start", LayoutRow{Start: -1, Count: 3}, nil, true},
+		{"attribute on a gap", LayoutRow{Count: 5, Gaps: []int{3}, SeatAttributes: map[string][]string{"3": {"aisle"}}}, nil, true},
+		{"attribute outside row", LayoutRow{Count: 5, SeatAttributes: map[string][]string{"9": {"aisle"}}}, nil, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			row := tc.row
+			got, err := row.seatNumbers()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error %t, got %v", tc.wantErr, err)
+			}
+			if !tc.wantErr && !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestLayoutRow_Expand tests seat order, prefixes and per-seat attributes of a row range.
+func TestLayoutRow_Expand(t *testing.T) {
+	row := &LayoutRow{
+		Label:          "A-B",
+		Count:          3,
+		Prefix:         "W",
+		Attributes:     []string{"wheelchair"},
+		SeatAttributes: map[string][]string{"1": {"aisle"}},
+	}
+
+	seats, err := row.Expand()
+	if err != nil {
+		t.Fatalf("Expand failed: %v", err)
+	}
+	if len(seats) != 6 {
+		t.Fatalf("Expected 6 seats, got %d", len(seats))
+	}
+	if seats[0].Row != "A" || seats[0].Number != "W1" || seats[3].Row != "B" || seats[3].Number != "W1" {
+		t.Errorf("Unexpected seat order: %s%s, %s%s", seats[0].Row, seats[0].Number, seats[3].Row, seats[3].Number)
+	}
+	if got := seats[0].AttributeString(); got != "aisle,wheelchair" {
+		t.Errorf("Expected seat 1 attributes 'aisle,wheelchair', got '%s'", got)
+	}
+	if got := seats[1].AttributeString(); got != "wheelchair" {
+		t.Errorf("Expected seat 2 attributes 'wheelchair', got '%s'", got)
+	}
+
+	// Sıra özellik dilimi koltuklar arasında paylaşılmamalı
+	seats[1].Attributes[0] = "changed"
+	if seats[2].Attributes[0] != "wheelchair" {
+		t.Error("Row attributes are shared between seats")
+	}
+}
+
+// TestJoinSeatAttributes tests that attributes are normalized, deduplicated and sorted.
+func TestJoinSeatAttributes(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes []string
+		expected   string
+	}{
+		{"empty", nil, ""},
+		{"sorted", []string{"obstructed_view", "aisle"}, "aisle,obstructed_view"},
+		{"deduplicated and normalized", []string{" Aisle", "aisle", "", "WHEELCHAIR "}, "aisle,wheelchair"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := JoinSeatAttributes(tc.attributes); got != tc.expected {
+				t.Errorf("Expected '%s', got '%s'", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestSeatKey_CaseInsensitive tests that seat keys ignore case but keep row and number apart.
+func TestSeatKey_CaseInsensitive(t *testing.T) {
+	if SeatKey("a", "w1") != SeatKey("A", "W1") {
+		t.Error("Expected SeatKey to be case insensitive")
+	}
+	if SeatKey("1", "12") == SeatKey("11", "2") {
+		t.Error("Expected SeatKey to separate row and number")
+	}
+}
+
+// TestVenueLayoutDiff_AddChange tests diff counters and the change list limit.
+func TestVenueLayoutDiff_AddChange(t *testing.T) {
+	diff := &VenueLayoutDiff{}
+	actions := []LayoutChangeAction{LayoutCreate, LayoutUpdate, LayoutReactivate, LayoutDeactivate, LayoutUnchanged, LayoutUnchanged}
+	for _, action := range actions {
+		diff.AddChange(&SeatLayoutChange{Action: action})
+	}
+
+	if diff.SeatsCreated != 1 || diff.SeatsUpdated != 1 || diff.SeatsReactivated != 1 || diff.SeatsDeactivated != 1 || diff.SeatsUnchanged != 2 {
+		t.Errorf("Unexpected counters: %+v", diff)
+	}
+	if len(diff.Changes) != 4 {
+		t.Errorf("Expected 4 listed changes (unchanged seats are not listed), got %d", len(diff.Changes))
+	}
+	if !diff.HasChanges() {
+		t.Error("Expected HasChanges to be true")
+	}
+
+	full := &VenueLayoutDiff{}
+	for i := 0; i < MaxLayoutDiffChanges+1; i++ {
+		full.AddChange(&SeatLayoutChange{Action: LayoutCreate})
+	}
+	if len(full.Changes) != MaxLayoutDiffChanges || !full.Truncated || full.SeatsCreated != MaxLayoutDiffChanges+1 {
+		t.Errorf("Expected a truncated list of %d changes counting %d creates, got %d changes, truncated %t, %d creates",
+			MaxLayoutDiffChanges, MaxLayoutDiffChanges+1, len(full.Changes), full.Truncated, full.SeatsCreated)
+	}
+}
+
+// TestVenueLayoutDiff_HasChanges tests that unchanged seats and sections do not count as changes.
+func TestVenueLayoutDiff_HasChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		diff     VenueLayoutDiff
+		expected bool
+	}{
+		{"empty", VenueLayoutDiff{}, false},
+		{"only unchanged", VenueLayoutDiff{SeatsUnchanged: 40, Sections: []*SectionLayoutDiff{{Action: LayoutUnchanged}}}, false},
+		{"section update", VenueLayoutDiff{Sections: []*SectionLayoutDiff{{Action: LayoutUpdate}}}, true},
+		{"seat deactivated", VenueLayoutDiff{SeatsDeactivated: 1}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.diff.HasChanges(); got != tc.expected {
+				t.Errorf("Expected HasChanges %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
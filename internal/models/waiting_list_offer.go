@@ -0,0 +1,57 @@
+// -----------------------------------------------------------------------------
+// Waiting List Offer Model
+// -----------------------------------------------------------------------------
+// İptal edilen veya süresi dolan bir rezervasyondan boşalan koltuğun, bekleme
+// listesindeki sıradaki kullanıcıya yapılan teklifini temsil eder.
+// Teklif, claim token ile sınırlı bir süre (claim window) boyunca geçerlidir.
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"time"
+)
+
+// WaitingListOfferStatus, teklifin durumunu temsil eder
+type WaitingListOfferStatus string
+
+const (
+	WaitingListOfferStatusPending  WaitingListOfferStatus = "pending"  // Claim bekleniyor
+	WaitingListOfferStatusClaimed  WaitingListOfferStatus = "claimed"  // Kullanıcı teklifi aldı, rezervasyon oluştu
+	WaitingListOfferStatusDeclined WaitingListOfferStatus = "declined" // Kullanıcı teklifi reddetti
+	WaitingListOfferStatusExpired  WaitingListOfferStatus = "expired"  // Claim window doldu
+)
+
+// WaitingListOffer, bekleme listesindeki bir kullanıcıya yapılan koltuk teklifidir
+type WaitingListOffer struct {
+	BaseModel
+	WaitingListID int64                  `json:"waiting_list_id" db:"waiting_list_id"`
+	EventID       int64                  `json:"event_id" db:"event_id"`
+	UserID        int64                  `json:"user_id" db:"user_id"`
+	SectionID     int64                  `json:"section_id" db:"section_id"`
+	SeatID        *int64                 `json:"seat_id,omitempty" db:"seat_id"`
+	Price         float64                `json:"price" db:"price"`
+	ClaimToken    string                 `json:"claim_token" db:"claim_token"`
+	Status        WaitingListOfferStatus `json:"status" db:"status"`
+	ExpiresAt     time.Time              `json:"expires_at" db:"expires_at"`
+	ClaimedAt     *time.Time             `json:"claimed_at,omitempty" db:"claimed_at"`
+	TicketID      *int64                 `json:"ticket_id,omitempty" db:"ticket_id"`
+}
+
+// CanClaim, teklifin hala kullanılabilir olup olmadığını kontrol eder
+func (o *WaitingListOffer) CanClaim() bool {
+	return o.Status == WaitingListOfferStatusPending && time.Now().Before(o.ExpiresAt)
+}
+
+// IsExpired, claim window'un dolup dolmadığını kontrol eder
+func (o *WaitingListOffer) IsExpired() bool {
+	return o.Status == WaitingListOfferStatusPending && time.Now().After(o.ExpiresAt)
+}
+
+// GetTimeLeft, claim window'da kalan süreyi döndürür
+func (o *WaitingListOffer) GetTimeLeft() time.Duration {
+	if !o.CanClaim() {
+		return 0
+	}
+	return time.Until(o.ExpiresAt)
+}
@@ -0,0 +1,49 @@
+// -----------------------------------------------------------------------------
+// Webhook Event Model
+// -----------------------------------------------------------------------------
+// Ödeme sağlayıcılarından gelen webhook bildirimlerini temsil eder.
+// Her kayıt, sağlayıcının olay ID'si ile tekilleştirilir (idempotency).
+// -----------------------------------------------------------------------------
+
+package models
+
+import (
+	"time"
+)
+
+// WebhookEventStatus, webhook kaydının işlenme durumunu temsil eder
+type WebhookEventStatus string
+
+const (
+	WebhookEventStatusReceived  WebhookEventStatus = "received"  // Alındı, henüz işlenmedi
+	WebhookEventStatusProcessed WebhookEventStatus = "processed" // Başarıyla uygulandı
+	WebhookEventStatusIgnored   WebhookEventStatus = "ignored"   // Desteklenmeyen veya etkisiz olay
+	WebhookEventStatusFailed    WebhookEventStatus = "failed"    // İşlenirken hata oluştu
+)
+
+// Desteklenen ödeme webhook olay tipleri
+const (
+	WebhookTypePaymentCompleted   = "payment.completed"
+	WebhookTypePaymentFailed      = "payment.failed"
+	WebhookTypePaymentRefunded    = "payment.refunded"
+	WebhookTypePaymentChargedBack = "payment.charged_back"
+)
+
+// WebhookEvent, sağlayıcıdan alınan tek bir webhook olayını temsil eder
+type WebhookEvent struct {
+	BaseModel
+	Provider        string             `json:"provider" db:"provider"`
+	ProviderEventID string             `json:"provider_event_id" db:"provider_event_id"`
+	EventType       string             `json:"event_type" db:"event_type"`
+	TransactionID   string             `json:"transaction_id,omitempty" db:"transaction_id"`
+	Payload         string             `json:"payload" db:"payload"`
+	Status          WebhookEventStatus `json:"status" db:"status"`
+	ErrorMessage    string             `json:"error_message,omitempty" db:"error_message"`
+	Attempts        int                `json:"attempts" db:"attempts"`
+	ProcessedAt     *time.Time         `json:"processed_at,omitempty" db:"processed_at"`
+}
+
+// IsFinal, olayın tekrar işlenmeye ihtiyaç duymadığını kontrol eder
+func (w *WebhookEvent) IsFinal() bool {
+	return w.Status == WebhookEventStatusProcessed || w.Status == WebhookEventStatusIgnored
+}
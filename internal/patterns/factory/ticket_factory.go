@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/biyonik/event-ticketing-api/internal/models"
@@ -164,6 +165,84 @@ func (f *TicketFactory) CreateSeasonTicket(req *TicketCreationRequest) (*models.
 	return ticket, nil
 }
 
+// SeasonEventInfo identifies one event covered by a season package
+type SeasonEventInfo struct {
+	EventID   int64
+	EventName string
+}
+
+// CreateSeasonPackageTickets issues one season entry ticket per covered event.
+// req.Price is the package price; it is split across the events (remainder cents
+// go to the first ticket) so per-event revenue adds up to the package price.
+func (f *TicketFactory) CreateSeasonPackageTickets(req *TicketCreationRequest, events []SeasonEventInfo) ([]*models.Ticket, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("season package has no events")
+	}
+
+	totalCents := int64(math.Round(req.Price * 100))
+	shareCents := totalCents / int64(len(events))
+	remainderCents := totalCents - shareCents*int64(len(events))
+
+	tickets := make([]*models.Ticket, 0, len(events))
+
+	for i, event := range events {
+		ticketReq := *req
+		ticketReq.EventID = event.EventID
+		ticketReq.EventName = event.EventName
+		ticketReq.Price = float64(shareCents) / 100
+		if i == 0 {
+			ticketReq.Price = float64(shareCents+remainderCents) / 100
+		}
+
+		ticket, err := f.CreateSeasonTicket(&ticketReq)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create season ticket for event %d: %w", event.EventID, err)
+		}
+
+		tickets = append(tickets, ticket)
+	}
+
+	return tickets, nil
+}
+
+// IssueAddOnVoucher assigns a scannable voucher (code, verification code and QR) to an add-on item
+// Format: ADD-YYYYMMDD-XXXXXXXX
+func (f *TicketFactory) IssueAddOnVoucher(item *models.AddOnItem, addOn *models.AddOn, ticketNumber string) error {
+	number, err := f.generateTicketNumber()
+	if err != nil {
+		return fmt.Errorf("failed to generate voucher code: %w", err)
+	}
+	voucherCode := "ADD" + number[len("TKT"):]
+
+	verificationCode, err := f.generateVerificationCode()
+	if err != nil {
+		return fmt.Errorf("failed to generate verification code: %w", err)
+	}
+
+	qrData := fmt.Sprintf(
+		"ADDON:%s|EVENT:%d|USER:%d|TICKET:%s|TYPE:%s|QTY:%d|CODE:%s",
+		voucherCode,
+		item.EventID,
+		item.UserID,
+		ticketNumber,
+		addOn.Type,
+		item.Quantity,
+		verificationCode,
+	)
+
+	qrCodeImage, err := f.qrGenerator.Generate(qrData)
+	if err != nil {
+		return fmt.Errorf("failed to generate voucher QR code: %w", err)
+	}
+
+	item.VoucherCode = &voucherCode
+	item.VerificationCode = &verificationCode
+	item.QRCodeData = &qrData
+	item.QRCodeImage = qrCodeImage
+
+	return nil
+}
+
 // RegenerateQRCode regenerates the QR code for a ticket
 func (f *TicketFactory) RegenerateQRCode(ticket *models.Ticket) error {
 	qrCodeImage, err := f.qrGenerator.Generate(ticket.QRCodeData)
@@ -249,6 +328,11 @@ func (v *TicketValidator) ValidateVerificationCode(code string, ticket *models.T
 	return code == ticket.VerificationCode
 }
 
+// ValidateAddOnVerificationCode validates the verification code of an add-on voucher
+func (v *TicketValidator) ValidateAddOnVerificationCode(code string, item *models.AddOnItem) bool {
+	return item.VerificationCode != nil && code == *item.VerificationCode
+}
+
 // TicketPrinter generates printable ticket data
 type TicketPrinter struct{}
 
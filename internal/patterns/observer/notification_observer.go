@@ -12,15 +12,18 @@ import (
 type EventType string
 
 const (
-	EventTypeTicketPurchased    EventType = "ticket_purchased"
-	EventTypeTicketCancelled    EventType = "ticket_cancelled"
-	EventTypeTicketUsed         EventType = "ticket_used"
-	EventTypeWaitingListAdded   EventType = "waiting_list_added"
-	EventTypeWaitingListNotify  EventType = "waiting_list_notify"
-	EventTypeEventStatusChanged EventType = "event_status_changed"
-	EventTypePaymentCompleted   EventType = "payment_completed"
-	EventTypePaymentFailed      EventType = "payment_failed"
-	EventTypeReservationExpired EventType = "reservation_expired"
+	EventTypeTicketPurchased      EventType = "ticket_purchased"
+	EventTypeTicketCancelled      EventType = "ticket_cancelled"
+	EventTypeTicketUsed           EventType = "ticket_used"
+	EventTypeWaitingListAdded     EventType = "waiting_list_added"
+	EventTypeWaitingListNotify    EventType = "waiting_list_notify"
+	EventTypeWaitingListOffer     EventType = "waiting_list_offer"
+	EventTypeEventStatusChanged   EventType = "event_status_changed"
+	EventTypeTicketStatusChanged  EventType = "ticket_status_changed"
+	EventTypePaymentStatusChanged EventType = "payment_status_changed"
+	EventTypePaymentCompleted     EventType = "payment_completed"
+	EventTypePaymentFailed        EventType = "payment_failed"
+	EventTypeReservationExpired   EventType = "reservation_expired"
 )
 
 // EventData holds data for an event
@@ -104,6 +107,8 @@ func (o *EmailNotificationObserver) Update(event *EventData) error {
 		return o.handleTicketCancelled(event)
 	case EventTypeWaitingListNotify:
 		return o.handleWaitingListNotify(event)
+	case EventTypeWaitingListOffer:
+		return o.handleWaitingListOffer(event)
 	case EventTypePaymentCompleted:
 		return o.handlePaymentCompleted(event)
 	case EventTypePaymentFailed:
@@ -139,15 +144,38 @@ Bilet Detayları:
 - Koltuk: %s
 - Fiyat: %.2f TL
 - Doğrulama Kodu: %s
-
+%s
 Biletinizi göstermek için QR kodunuzu kullanabilirsiniz.
 
 İyi eğlenceler!
-`, data.UserEmail, data.EventName, data.TicketNumber, data.EventName, data.VenueName, data.EventDateTime, data.SeatInfo, data.Price, data.VerificationCode)
+`, data.UserEmail, data.EventName, data.TicketNumber, data.EventName, data.VenueName, data.EventDateTime, data.SeatInfo, data.Price, data.VerificationCode, formatAddOnReceipt(data))
 
 	return o.EmailService.SendEmail(data.UserEmail, subject, body)
 }
 
+// formatAddOnReceipt renders the add-on lines of a purchase receipt (empty without add-ons)
+func formatAddOnReceipt(data *TicketPurchaseData) string {
+	if len(data.AddOns) == 0 {
+		return ""
+	}
+
+	receipt := "\nEk Ürünler:\n"
+	for _, item := range data.AddOns {
+		name := fmt.Sprintf("Ek ürün #%d", item.AddOnID)
+		if item.AddOn != nil {
+			name = item.AddOn.Name
+		}
+		receipt += fmt.Sprintf("- %s x%d: %.2f TL", name, item.Quantity, item.TotalPrice)
+		if item.VoucherCode != nil {
+			receipt += fmt.Sprintf(" (Voucher: %s)", *item.VoucherCode)
+		}
+		receipt += "\n"
+	}
+	receipt += fmt.Sprintf("\nToplam: %.2f TL\n", data.Total)
+
+	return receipt
+}
+
 func (o *EmailNotificationObserver) handleTicketCancelled(event *EventData) error {
 	data, ok := event.Data.(*TicketCancellationData)
 	if !ok {
@@ -194,6 +222,33 @@ Tarih: %s
 	return o.EmailService.SendEmail(data.UserEmail, subject, body)
 }
 
+func (o *EmailNotificationObserver) handleWaitingListOffer(event *EventData) error {
+	data, ok := event.Data.(*WaitingListOfferData)
+	if !ok {
+		return fmt.Errorf("invalid data type for waiting list offer event")
+	}
+
+	subject := fmt.Sprintf("Sizin İçin Bir Koltuk Ayırdık - %s", data.EventName)
+	body := fmt.Sprintf(`
+Sayın %s,
+
+Bekleme listesinde olduğunuz %s etkinliği için bir koltuk sizin adınıza ayrıldı.
+
+Etkinlik: %s
+Mekan: %s
+Tarih: %s
+Koltuk: %s
+Fiyat: %.2f TL
+
+Teklif Kodu: %s
+Son Geçerlilik: %s
+
+Bu süre içinde teklifi onaylamazsanız koltuk sıradaki kişiye aktarılacaktır.
+`, data.UserEmail, data.EventName, data.EventName, data.VenueName, data.EventDateTime, data.SeatInfo, data.Price, data.ClaimToken, data.ExpiresAt.Format("02.01.2006 15:04"))
+
+	return o.EmailService.SendEmail(data.UserEmail, subject, body)
+}
+
 func (o *EmailNotificationObserver) handlePaymentCompleted(event *EventData) error {
 	data, ok := event.Data.(*PaymentData)
 	if !ok {
@@ -276,6 +331,8 @@ func (o *SMSNotificationObserver) Update(event *EventData) error {
 		return o.handleTicketPurchased(event)
 	case EventTypeWaitingListNotify:
 		return o.handleWaitingListNotify(event)
+	case EventTypeWaitingListOffer:
+		return o.handleWaitingListOffer(event)
 	case EventTypeReservationExpired:
 		return o.handleReservationExpired(event)
 	}
@@ -310,6 +367,18 @@ func (o *SMSNotificationObserver) handleWaitingListNotify(event *EventData) erro
 	return o.SMSService.SendSMS(data.UserPhone, message)
 }
 
+func (o *SMSNotificationObserver) handleWaitingListOffer(event *EventData) error {
+	data, ok := event.Data.(*WaitingListOfferData)
+	if !ok {
+		return fmt.Errorf("invalid data type for waiting list offer event")
+	}
+
+	message := fmt.Sprintf("%s icin koltugunuz ayrildi! Teklif kodu: %s. Son: %s",
+		data.EventName, data.ClaimToken, data.ExpiresAt.Format("02.01 15:04"))
+
+	return o.SMSService.SendSMS(data.UserPhone, message)
+}
+
 func (o *SMSNotificationObserver) handleReservationExpired(event *EventData) error {
 	data, ok := event.Data.(*ReservationExpiredData)
 	if !ok {
@@ -348,16 +417,38 @@ func (o *AnalyticsObserver) Update(event *EventData) error {
 	case EventTypeTicketPurchased:
 		if data, ok := event.Data.(*TicketPurchaseData); ok {
 			userID = fmt.Sprintf("%d", data.UserID)
+			properties["ticket_id"] = data.TicketID
 			properties["event_id"] = data.EventID
 			properties["ticket_number"] = data.TicketNumber
 			properties["price"] = data.Price
 		}
+	case EventTypeTicketCancelled:
+		if data, ok := event.Data.(*TicketCancellationData); ok {
+			userID = fmt.Sprintf("%d", data.UserID)
+			properties["ticket_id"] = data.TicketID
+			properties["event_id"] = data.EventID
+			properties["ticket_number"] = data.TicketNumber
+			properties["refund_amount"] = data.RefundAmount
+		}
+	case EventTypeTicketUsed:
+		if data, ok := event.Data.(map[string]interface{}); ok {
+			for key, value := range data {
+				properties[key] = value
+			}
+		}
 	case EventTypePaymentCompleted:
 		if data, ok := event.Data.(*PaymentData); ok {
 			userID = fmt.Sprintf("%d", data.UserID)
 			properties["amount"] = data.Amount
 			properties["transaction_id"] = data.TransactionID
 		}
+	case EventTypeEventStatusChanged, EventTypeTicketStatusChanged, EventTypePaymentStatusChanged:
+		if data, ok := event.Data.(*StatusChangeData); ok {
+			properties["entity"] = data.Entity
+			properties["entity_id"] = data.EntityID
+			properties["from"] = data.From
+			properties["to"] = data.To
+		}
 	}
 
 	return o.AnalyticsService.TrackEvent(string(event.Type), userID, properties)
@@ -369,6 +460,7 @@ func (o *AnalyticsObserver) GetName() string {
 
 // Event Data Structures
 type TicketPurchaseData struct {
+	TicketID         int64
 	UserID           int64
 	UserEmail        string
 	UserPhone        string
@@ -380,9 +472,14 @@ type TicketPurchaseData struct {
 	VerificationCode string
 	SeatInfo         string
 	Price            float64
+	AddOns           []*models.AddOnItem // Biletle birlikte satılan ek ürünler (AddOn dolu)
+	Total            float64             // Bilet + ek ürünler
 }
 
 type TicketCancellationData struct {
+	TicketID     int64
+	UserID       int64
+	EventID      int64
 	UserEmail    string
 	TicketNumber string
 	RefundAmount float64
@@ -396,6 +493,20 @@ type WaitingListNotifyData struct {
 	EventDateTime string
 }
 
+type WaitingListOfferData struct {
+	UserID        int64
+	UserEmail     string
+	UserPhone     string
+	EventID       int64
+	EventName     string
+	VenueName     string
+	EventDateTime string
+	SeatInfo      string
+	Price         float64
+	ClaimToken    string
+	ExpiresAt     time.Time
+}
+
 type PaymentData struct {
 	UserID        int64
 	UserEmail     string
@@ -411,3 +522,11 @@ type ReservationExpiredData struct {
 	EventName     string
 	ReservationID string
 }
+
+// StatusChangeData, durum makinesinin bir geçiş olayıdır (etkinlik, bilet veya ödeme)
+type StatusChangeData struct {
+	Entity   string // event, ticket, payment
+	EntityID int64
+	From     string
+	To       string
+}
@@ -0,0 +1,357 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type AddOnRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewAddOnRepository(db *sql.DB) *AddOnRepository {
+	return &AddOnRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// activeAddOnItemCondition - stok tutan satırlar (rezerve + satılmış + kullanılmış)
+const activeAddOnItemCondition = `i.status IN ('reserved', 'sold', 'used')`
+
+// addOnColumns - sold_count subquery ile birlikte seçilen kolonlar
+const addOnColumns = `
+	a.id, a.event_id, a.name, COALESCE(a.description, ''), a.type, a.price, a.inventory,
+	a.scope, a.max_quantity, a.scannable, a.is_active, a.created_at, a.updated_at,
+	(SELECT COALESCE(SUM(i.quantity), 0) FROM addon_items i WHERE i.addon_id = a.id AND ` + activeAddOnItemCondition + `) AS sold_count
+`
+
+// Create - Conduit-Go Builder ile ek ürün oluşturma
+func (r *AddOnRepository) Create(addOn *models.AddOn) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("event_addons").
+		ExecInsert(map[string]interface{}{
+			"event_id":     addOn.EventID,
+			"name":         addOn.Name,
+			"description":  addOn.Description,
+			"type":         addOn.Type,
+			"price":        addOn.Price,
+			"inventory":    addOn.Inventory,
+			"scope":        addOn.Scope,
+			"max_quantity": addOn.MaxQuantity,
+			"scannable":    addOn.Scannable,
+			"is_active":    addOn.IsActive,
+			"created_at":   addOn.CreatedAt,
+			"updated_at":   addOn.UpdatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create add-on: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindByID - Raw SQL: tek ek ürün + satılan adet (aggregate subquery)
+func (r *AddOnRepository) FindByID(id int64) (*models.AddOn, error) {
+	query := `SELECT ` + addOnColumns + ` FROM event_addons a WHERE a.id = ?`
+
+	addOn, err := scanAddOn(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("add-on not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find add-on: %w", err)
+	}
+
+	return addOn, nil
+}
+
+// FindByEventID - Raw SQL: etkinliğin ek ürünleri + satılan adetler
+func (r *AddOnRepository) FindByEventID(eventID int64, onlyActive bool) ([]*models.AddOn, error) {
+	query := `SELECT ` + addOnColumns + ` FROM event_addons a WHERE a.event_id = ?`
+	if onlyActive {
+		query += ` AND a.is_active = TRUE`
+	}
+	query += ` ORDER BY a.type ASC, a.name ASC`
+
+	rows, err := r.db.Query(query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query add-ons: %w", err)
+	}
+	defer rows.Close()
+
+	var addOns []*models.AddOn
+	for rows.Next() {
+		addOn, err := scanAddOn(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan add-on: %w", err)
+		}
+		addOns = append(addOns, addOn)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate add-ons: %w", err)
+	}
+
+	return addOns, nil
+}
+
+// rowScanner - *sql.Row ve *sql.Rows için ortak Scan arayüzü
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanAddOn - tek satırı AddOn modeline dönüştürür
+func scanAddOn(row rowScanner) (*models.AddOn, error) {
+	var addOn models.AddOn
+	var maxQuantity sql.NullInt64
+
+	err := row.Scan(&addOn.ID, &addOn.EventID, &addOn.Name, &addOn.Description, &addOn.Type, &addOn.Price,
+		&addOn.Inventory, &addOn.Scope, &maxQuantity, &addOn.Scannable, &addOn.IsActive,
+		&addOn.CreatedAt, &addOn.UpdatedAt, &addOn.SoldCount)
+	if err != nil {
+		return nil, err
+	}
+	addOn.MaxQuantity = nullIntPtr(maxQuantity)
+
+	return &addOn, nil
+}
+
+// Update - Builder ile ek ürün güncelleme
+func (r *AddOnRepository) Update(addOn *models.AddOn) error {
+	addOn.UpdatedAt = time.Now()
+
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("event_addons").
+		Where("id", "=", addOn.ID).
+		ExecUpdate(map[string]interface{}{
+			"name":         addOn.Name,
+			"description":  addOn.Description,
+			"price":        addOn.Price,
+			"inventory":    addOn.Inventory,
+			"max_quantity": addOn.MaxQuantity,
+			"is_active":    addOn.IsActive,
+			"updated_at":   addOn.UpdatedAt,
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to update add-on: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("add-on not found")
+	}
+
+	return nil
+}
+
+// LockInventory - SELECT ... FOR UPDATE (transaction içinde)
+// Ek ürün satırını kilitler ve stok tutan adet toplamını döndürür; stok kontrolü
+// ile satır kaydı aynı kilit altında yapılır.
+func (r *AddOnRepository) LockInventory(tx *sql.Tx, addOnID int64) (int, error) {
+	var id int64
+	if err := tx.QueryRow(`SELECT id FROM event_addons WHERE id = ? FOR UPDATE`, addOnID).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("add-on not found")
+		}
+		return 0, fmt.Errorf("failed to lock add-on: %w", err)
+	}
+
+	query := `SELECT COALESCE(SUM(i.quantity), 0) FROM addon_items i WHERE i.addon_id = ? AND ` + activeAddOnItemCondition
+
+	var sold int
+	// Sayım kilitten sonra en güncel commit'lenmiş veriyi görmek için tx snapshot'ı dışında yapılır
+	if err := r.db.QueryRow(query, addOnID).Scan(&sold); err != nil {
+		return 0, fmt.Errorf("failed to count add-on items: %w", err)
+	}
+
+	return sold, nil
+}
+
+// CountQuantityForOrder - SUM query: kullanıcının etkinlikteki aktif adetleri (sipariş kapsamı)
+func (r *AddOnRepository) CountQuantityForOrder(addOnID, userID, eventID int64) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(i.quantity), 0)
+		FROM addon_items i
+		WHERE i.addon_id = ? AND i.user_id = ? AND i.event_id = ? AND ` + activeAddOnItemCondition
+
+	var count int
+	if err := r.db.QueryRow(query, addOnID, userID, eventID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count order add-ons: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountQuantityForTicket - SUM query: bilete bağlı aktif adetler (bilet kapsamı)
+func (r *AddOnRepository) CountQuantityForTicket(addOnID, ticketID int64) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(i.quantity), 0)
+		FROM addon_items i
+		WHERE i.addon_id = ? AND i.ticket_id = ? AND ` + activeAddOnItemCondition
+
+	var count int
+	if err := r.db.QueryRow(query, addOnID, ticketID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count ticket add-ons: %w", err)
+	}
+
+	return count, nil
+}
+
+// CreateItem - Builder ile ek ürün satırı oluşturma
+func (r *AddOnRepository) CreateItem(item *models.AddOnItem) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("addon_items").
+		ExecInsert(map[string]interface{}{
+			"addon_id":          item.AddOnID,
+			"event_id":          item.EventID,
+			"user_id":           item.UserID,
+			"ticket_id":         item.TicketID,
+			"quantity":          item.Quantity,
+			"unit_price":        item.UnitPrice,
+			"total_price":       item.TotalPrice,
+			"status":            item.Status,
+			"voucher_code":      item.VoucherCode,
+			"verification_code": item.VerificationCode,
+			"qr_code_data":      item.QRCodeData,
+			"qr_code_image":     item.QRCodeImage,
+			"created_at":        item.CreatedAt,
+			"updated_at":        item.UpdatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create add-on item: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindItemByID - Builder ile tek satır
+func (r *AddOnRepository) FindItemByID(id int64) (*models.AddOnItem, error) {
+	var item models.AddOnItem
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("addon_items").
+		Where("id", "=", id).
+		First(&item)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("add-on item not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find add-on item: %w", err)
+	}
+
+	return &item, nil
+}
+
+// FindItemByVoucherCode - Builder ile voucher sorgusu (girişte okutma)
+func (r *AddOnRepository) FindItemByVoucherCode(voucherCode string) (*models.AddOnItem, error) {
+	var item models.AddOnItem
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("addon_items").
+		Where("voucher_code", "=", voucherCode).
+		First(&item)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("add-on voucher not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find add-on voucher: %w", err)
+	}
+
+	return &item, nil
+}
+
+// FindItemsByTicketID - Builder ile bilete bağlı satırlar
+func (r *AddOnRepository) FindItemsByTicketID(ticketID int64) ([]*models.AddOnItem, error) {
+	var items []*models.AddOnItem
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("addon_items").
+		Where("ticket_id", "=", ticketID).
+		OrderBy("id", "ASC").
+		Get(&items)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query add-on items: %w", err)
+	}
+
+	return items, nil
+}
+
+// FindItemsByUserAndEvent - Builder ile kullanıcının etkinlikteki satırları (sipariş)
+func (r *AddOnRepository) FindItemsByUserAndEvent(userID, eventID int64) ([]*models.AddOnItem, error) {
+	var items []*models.AddOnItem
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("addon_items").
+		Where("user_id", "=", userID).
+		Where("event_id", "=", eventID).
+		OrderBy("id", "ASC").
+		Get(&items)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query add-on items: %w", err)
+	}
+
+	return items, nil
+}
+
+// UpdateItemStatus - Builder ile satır durumu güncelleme (used_at / refunded_at dahil)
+func (r *AddOnRepository) UpdateItemStatus(id int64, status models.AddOnItemStatus) error {
+	now := time.Now()
+	data := map[string]interface{}{
+		"status":     status,
+		"updated_at": now,
+	}
+
+	switch status {
+	case models.AddOnItemStatusUsed:
+		data["used_at"] = now
+	case models.AddOnItemStatusRefunded:
+		data["refunded_at"] = now
+	}
+
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("addon_items").
+		Where("id", "=", id).
+		ExecUpdate(data)
+
+	if err != nil {
+		return fmt.Errorf("failed to update add-on item status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("add-on item not found")
+	}
+
+	return nil
+}
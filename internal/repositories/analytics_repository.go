@@ -0,0 +1,211 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type AnalyticsRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewAnalyticsRepository(db *sql.DB) *AnalyticsRepository {
+	return &AnalyticsRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// analyticsDimensionColumns - kırılım boyutu → gruplama ve etiket ifadeleri (kullanıcı girdisi SQL'e girmez)
+var analyticsDimensionColumns = map[models.AnalyticsDimension]struct {
+	key   string
+	label string
+}{
+	models.DimensionSection:    {"COALESCE(CAST(a.section_id AS CHAR), '')", "COALESCE(MAX(s.name), '')"},
+	models.DimensionTicketType: {"COALESCE(a.ticket_type, '')", "COALESCE(a.ticket_type, '')"},
+	models.DimensionPromoCode:  {"COALESCE(a.promo_code, '')", "COALESCE(a.promo_code, '')"},
+	models.DimensionChannel:    {"a.channel", "a.channel"},
+}
+
+// RecordTicketActivity - Raw SQL (INSERT IGNORE ... SELECT): bilet olayını biletin o anki bölüm,
+// tip, presale kodu, kanal ve tutarıyla kaydeder. dedupKey daha önce kaydedildiyse hiçbir şey yapmaz.
+// Kayıt yapıldıysa true döner.
+func (r *AnalyticsRepository) RecordTicketActivity(activity models.AnalyticsActivity, ticketID int64, dedupKey string, occurredAt time.Time) (bool, error) {
+	query := `
+		INSERT IGNORE INTO analytics_events
+			(activity, event_id, ticket_id, user_id, section_id, ticket_type, promo_code,
+			 channel, amount, dedup_key, occurred_at, created_at)
+		SELECT ?, t.event_id, t.id, t.user_id, t.section_id, t.ticket_type, c.code,
+			CASE
+				WHEN t.limit_override_by IS NOT NULL THEN ?
+				WHEN t.season_membership_id IS NOT NULL THEN ?
+				ELSE ?
+			END,
+			t.price, ?, ?, ?
+		FROM tickets t
+		LEFT JOIN sale_phase_codes c ON c.id = t.sale_phase_code_id
+		WHERE t.id = ?
+	`
+
+	result, err := r.db.Exec(query,
+		activity,
+		models.SalesChannelBoxOffice, models.SalesChannelSeason, models.SalesChannelOnline,
+		dedupKey, occurredAt, time.Now(),
+		ticketID,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record ticket activity: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// RecordPageView - Builder ile etkinlik sayfası görüntüleme kaydı
+func (r *AnalyticsRepository) RecordPageView(eventID int64, userID *int64, channel string, occurredAt time.Time) error {
+	_, err := database.NewBuilder(r.db, r.grammar).
+		Table("analytics_events").
+		ExecInsert(map[string]interface{}{
+			"activity":    models.AnalyticsActivityPageView,
+			"event_id":    eventID,
+			"user_id":     userID,
+			"channel":     channel,
+			"occurred_at": occurredAt,
+			"created_at":  time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to record page view: %w", err)
+	}
+
+	return nil
+}
+
+// FindHourlyActivity - Raw SQL (aggregate): [from, to) aralığındaki olayların saatlik toplamları.
+// Saatler Unix zamanından hesaplanır; sonuç veritabanı oturumunun saat diliminden bağımsızdır.
+func (r *AnalyticsRepository) FindHourlyActivity(eventID int64, from, to time.Time) ([]*models.SalesActivity, error) {
+	query := `
+		SELECT FLOOR(UNIX_TIMESTAMP(occurred_at) / 3600) AS hour_index,
+			SUM(activity = ?),
+			COALESCE(SUM(CASE WHEN activity = ? THEN amount ELSE 0 END), 0),
+			SUM(activity = ?),
+			COALESCE(SUM(CASE WHEN activity = ? THEN amount ELSE 0 END), 0),
+			SUM(activity = ?),
+			SUM(activity = ?)
+		FROM analytics_events
+		WHERE event_id = ? AND occurred_at >= ? AND occurred_at < ?
+		GROUP BY hour_index
+		ORDER BY hour_index ASC
+	`
+
+	rows, err := r.db.Query(query,
+		models.AnalyticsActivityPurchase, models.AnalyticsActivityPurchase,
+		models.AnalyticsActivityCancellation, models.AnalyticsActivityCancellation,
+		models.AnalyticsActivityCheckIn, models.AnalyticsActivityPageView,
+		eventID, from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hourly activity: %w", err)
+	}
+	defer rows.Close()
+
+	var activities []*models.SalesActivity
+	for rows.Next() {
+		var hourIndex int64
+		var activity models.SalesActivity
+		if err := rows.Scan(&hourIndex, &activity.Purchases, &activity.Revenue,
+			&activity.Cancellations, &activity.CancelledRevenue,
+			&activity.CheckIns, &activity.PageViews); err != nil {
+			return nil, fmt.Errorf("failed to scan hourly activity: %w", err)
+		}
+		activity.Hour = time.Unix(hourIndex*3600, 0).UTC()
+		activities = append(activities, &activity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate hourly activity: %w", err)
+	}
+
+	return activities, nil
+}
+
+// FindBreakdown - Raw SQL (aggregate): [from, to) aralığındaki satış ve iptallerin boyuta göre dağılımı
+func (r *AnalyticsRepository) FindBreakdown(eventID int64, dimension models.AnalyticsDimension, from, to time.Time) ([]*models.SalesBreakdown, error) {
+	columns, ok := analyticsDimensionColumns[dimension]
+	if !ok {
+		return nil, fmt.Errorf("unsupported analytics dimension: %s", dimension)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %[1]s AS dimension_key, %[2]s,
+			SUM(a.activity = ?),
+			SUM(a.activity = ?),
+			COALESCE(SUM(CASE WHEN a.activity = ? THEN a.amount ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN a.activity = ? THEN a.amount ELSE 0 END), 0)
+		FROM analytics_events a
+		LEFT JOIN sections s ON s.id = a.section_id
+		WHERE a.event_id = ? AND a.activity IN (?, ?)
+		  AND a.occurred_at >= ? AND a.occurred_at < ?
+		GROUP BY dimension_key
+		ORDER BY 5 DESC, dimension_key ASC
+	`, columns.key, columns.label)
+
+	rows, err := r.db.Query(query,
+		models.AnalyticsActivityPurchase, models.AnalyticsActivityCancellation,
+		models.AnalyticsActivityPurchase, models.AnalyticsActivityCancellation,
+		eventID, models.AnalyticsActivityPurchase, models.AnalyticsActivityCancellation,
+		from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sales breakdown: %w", err)
+	}
+	defer rows.Close()
+
+	var breakdown []*models.SalesBreakdown
+	for rows.Next() {
+		row := models.SalesBreakdown{Dimension: dimension}
+		if err := rows.Scan(&row.Key, &row.Label, &row.Purchases, &row.Cancellations,
+			&row.Revenue, &row.CancelledRevenue); err != nil {
+			return nil, fmt.Errorf("failed to scan sales breakdown: %w", err)
+		}
+		row.NetTickets = row.Purchases - row.Cancellations
+		row.NetRevenue = row.Revenue - row.CancelledRevenue
+		breakdown = append(breakdown, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sales breakdown: %w", err)
+	}
+
+	return breakdown, nil
+}
+
+// CountNetSoldBefore - COUNT query: verilen andan önceki satışlardan iptallerin düşülmüş hali
+func (r *AnalyticsRepository) CountNetSoldBefore(eventID int64, before time.Time) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(CASE WHEN activity = ? THEN 1 ELSE -1 END), 0)
+		FROM analytics_events
+		WHERE event_id = ? AND activity IN (?, ?) AND occurred_at < ?
+	`
+
+	var count int
+	err := r.db.QueryRow(query,
+		models.AnalyticsActivityPurchase,
+		eventID, models.AnalyticsActivityPurchase, models.AnalyticsActivityCancellation,
+		before,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count net sold tickets: %w", err)
+	}
+
+	return count, nil
+}
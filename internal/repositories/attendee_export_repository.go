@@ -0,0 +1,212 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type AttendeeExportRepository struct {
+	db          *sql.DB
+	grammar     database.Grammar
+	organizerID int64 // 0 = kısıtsız; > 0 ise sadece organizatörün etkinliklerinin dışa aktarımları
+}
+
+func NewAttendeeExportRepository(db *sql.DB) *AttendeeExportRepository {
+	return &AttendeeExportRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// ForOrganizer - organizatöre kısıtlı kopya döndürür (0 = kısıtsız)
+func (r *AttendeeExportRepository) ForOrganizer(organizerID int64) *AttendeeExportRepository {
+	scoped := *r
+	scoped.organizerID = organizerID
+	return &scoped
+}
+
+// query - attendee_exports için Builder; kısıtlıysa organizatörün etkinlikleriyle sınırlanır
+func (r *AttendeeExportRepository) query() *database.QueryBuilder {
+	builder := database.NewBuilder(r.db, r.grammar).Table("attendee_exports")
+	if r.organizerID == 0 {
+		return builder
+	}
+
+	return builder.WhereInSub("event_id", organizerEvents(r.db, r.grammar, r.organizerID))
+}
+
+// Create - Builder ile dışa aktarım talebi oluşturma
+func (r *AttendeeExportRepository) Create(export *models.AttendeeExport) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("attendee_exports").
+		ExecInsert(map[string]interface{}{
+			"event_id":     export.EventID,
+			"requested_by": export.RequestedBy,
+			"email":        export.Email,
+			"format":       export.Format,
+			"status":       export.Status,
+			"created_at":   export.CreatedAt,
+			"updated_at":   export.UpdatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create attendee export: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindByID - Builder ile tek dışa aktarım
+func (r *AttendeeExportRepository) FindByID(id int64) (*models.AttendeeExport, error) {
+	var export models.AttendeeExport
+	err := r.query().
+		Where("id", "=", id).
+		First(&export)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("attendee export not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find attendee export: %w", err)
+	}
+
+	return &export, nil
+}
+
+// FindByEvent - Builder ile etkinliğin dışa aktarımları (yeniden eskiye)
+func (r *AttendeeExportRepository) FindByEvent(eventID int64) ([]*models.AttendeeExport, error) {
+	var exports []*models.AttendeeExport
+	err := r.query().
+		Where("event_id", "=", eventID).
+		OrderBy("created_at", "DESC").
+		OrderBy("id", "DESC").
+		Get(&exports)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attendee exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+// MarkProcessing - Koşullu update: tamamlanmamış dışa aktarımı işleniyor durumuna alır
+// (önceki denemesi yarıda kalan job'lar da tekrar alabilir). Güncelleme yapıldıysa true döner.
+func (r *AttendeeExportRepository) MarkProcessing(id int64) (bool, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("attendee_exports").
+		Where("id", "=", id).
+		Where("status", "!=", models.AttendeeExportCompleted).
+		ExecUpdate(map[string]interface{}{
+			"status":        models.AttendeeExportProcessing,
+			"error_message": nil,
+			"updated_at":    time.Now(),
+		})
+
+	if err != nil {
+		return false, fmt.Errorf("failed to mark attendee export processing: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// MarkCompleted - Builder ile dosya bilgisi ve bağlantı süresini kaydeder
+func (r *AttendeeExportRepository) MarkCompleted(id int64, filePath string, rowCount int, completedAt, expiresAt time.Time) error {
+	_, err := database.NewBuilder(r.db, r.grammar).
+		Table("attendee_exports").
+		Where("id", "=", id).
+		ExecUpdate(map[string]interface{}{
+			"status":       models.AttendeeExportCompleted,
+			"file_path":    filePath,
+			"row_count":    rowCount,
+			"completed_at": completedAt,
+			"expires_at":   expiresAt,
+			"updated_at":   time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to mark attendee export completed: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed - Builder ile hata mesajını kaydeder (tamamlanmış dışa aktarım değişmez)
+func (r *AttendeeExportRepository) MarkFailed(id int64, message string) error {
+	_, err := database.NewBuilder(r.db, r.grammar).
+		Table("attendee_exports").
+		Where("id", "=", id).
+		Where("status", "!=", models.AttendeeExportCompleted).
+		ExecUpdate(map[string]interface{}{
+			"status":        models.AttendeeExportFailed,
+			"error_message": message,
+			"updated_at":    time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to mark attendee export failed: %w", err)
+	}
+
+	return nil
+}
+
+// StreamAttendees - Raw SQL (JOIN): etkinliğin satılmış ve kullanılmış biletlerini bölüm / sıra / koltuk
+// sırasıyla tek tek fn'e verir; liste belleğe alınmaz. fn hata döndürürse okuma durur.
+func (r *AttendeeExportRepository) StreamAttendees(eventID int64, fn func(*models.AttendeeRow) error) (int, error) {
+	query := `
+		SELECT t.ticket_number, COALESCE(u.name, ''), COALESCE(u.email, ''), COALESCE(s.name, ''),
+			COALESCE(st.row, ''), COALESCE(st.number, ''), t.status,
+			COALESCE(t.used_at, (
+				SELECT MIN(e.first_entry_at) FROM ticket_session_entitlements e WHERE e.ticket_id = t.id
+			))
+		FROM tickets t
+		LEFT JOIN users u ON u.id = t.user_id
+		LEFT JOIN sections s ON s.id = t.section_id
+		LEFT JOIN seats st ON st.id = t.seat_id
+		WHERE t.event_id = ? AND t.status IN (?, ?)
+		ORDER BY s.name ASC, st.row ASC, st.number ASC, t.ticket_number ASC
+	`
+
+	rows, err := r.db.Query(query, eventID, models.TicketStatusSold, models.TicketStatusUsed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query attendees: %w", err)
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		var row models.AttendeeRow
+		var checkedInAt sql.NullTime
+		if err := rows.Scan(&row.TicketNumber, &row.HolderName, &row.HolderEmail, &row.SectionName,
+			&row.SeatRow, &row.SeatNumber, &row.Status, &checkedInAt); err != nil {
+			return count, fmt.Errorf("failed to scan attendee: %w", err)
+		}
+		if checkedInAt.Valid {
+			row.CheckedInAt = &checkedInAt.Time
+		}
+
+		if err := fn(&row); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	if err := rows.Err(); err != nil {
+		return count, fmt.Errorf("failed to iterate attendees: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+// AuditLogRepository, append-only denetim kayıtlarını yönetir.
+// Güncelleme metodu yoktur; silme sadece saklama süresi dolan kayıtlar içindir.
+type AuditLogRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewAuditLogRepository(db *sql.DB) *AuditLogRepository {
+	return &AuditLogRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// Append - Builder ile denetim kaydı ekleme
+func (r *AuditLogRepository) Append(entry *models.AuditLog) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("audit_logs").
+		ExecInsert(map[string]interface{}{
+			"actor_type":  entry.ActorType,
+			"actor_id":    entry.ActorID,
+			"action":      entry.Action,
+			"entity_type": entry.EntityType,
+			"entity_id":   entry.EntityID,
+			"changes":     entry.ChangesRaw,
+			"ip_address":  entry.IPAddress,
+			"request_id":  entry.RequestID,
+			"created_at":  entry.CreatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to append audit log: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// Find - Builder ile filtreye uyan kayıtlar (en yeniden eskiye, BeforeID ile keyset sayfalama)
+func (r *AuditLogRepository) Find(filter *models.AuditLogFilter) ([]*models.AuditLog, error) {
+	builder := database.NewBuilder(r.db, r.grammar).Table("audit_logs")
+
+	if filter.EntityType != "" {
+		builder.Where("entity_type", "=", filter.EntityType)
+	}
+
+	if filter.EntityID != 0 {
+		builder.Where("entity_id", "=", filter.EntityID)
+	}
+
+	if filter.ActorType != "" {
+		builder.Where("actor_type", "=", filter.ActorType)
+	}
+
+	if filter.ActorID != "" {
+		builder.Where("actor_id", "=", filter.ActorID)
+	}
+
+	if filter.Action != "" {
+		builder.Where("action", "=", filter.Action)
+	}
+
+	if filter.From != nil {
+		builder.Where("created_at", ">=", *filter.From)
+	}
+
+	if filter.To != nil {
+		builder.Where("created_at", "<", *filter.To)
+	}
+
+	if filter.BeforeID > 0 {
+		builder.Where("id", "<", filter.BeforeID)
+	}
+
+	var entries []*models.AuditLog
+	err := builder.
+		OrderBy("id", "DESC").
+		Limit(filter.Limit).
+		Get(&entries)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find audit logs: %w", err)
+	}
+
+	return entries, nil
+}
+
+// DeleteOlderThan - Raw SQL (batch delete): saklama süresi dolan en fazla limit kaydı siler
+func (r *AuditLogRepository) DeleteOlderThan(cutoff time.Time, limit int) (int64, error) {
+	query := `DELETE FROM audit_logs WHERE created_at < ? ORDER BY id LIMIT ?`
+
+	result, err := r.db.Exec(query, cutoff, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired audit logs: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
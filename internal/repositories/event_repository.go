@@ -3,15 +3,18 @@ package repositories
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/biyonik/event-ticketing-api/internal/models"
 	"github.com/biyonik/event-ticketing-api/pkg/database"
+	"github.com/biyonik/event-ticketing-api/pkg/pagination"
 )
 
 type EventRepository struct {
-	db      *sql.DB
-	grammar database.Grammar
+	db          *sql.DB
+	grammar     database.Grammar
+	organizerID int64 // 0 = kısıtsız; > 0 ise tüm sorgular bu organizatörle sınırlanır
 }
 
 func NewEventRepository(db *sql.DB) *EventRepository {
@@ -21,8 +24,43 @@ func NewEventRepository(db *sql.DB) *EventRepository {
 	}
 }
 
+// ForOrganizer - organizatöre kısıtlı kopya döndürür (0 = kısıtsız)
+func (r *EventRepository) ForOrganizer(organizerID int64) *EventRepository {
+	scoped := *r
+	scoped.organizerID = organizerID
+	return &scoped
+}
+
+// query - events tablosu için Builder (organizatör kısıtı ve soft delete kapsamı otomatik eklenir)
+func (r *EventRepository) query() *database.QueryBuilder {
+	builder := database.NewBuilder(r.db, r.grammar).Table("events").SoftDeletes()
+	return r.scope(builder)
+}
+
+// scope - Builder'a organizatör kısıtını ekler
+func (r *EventRepository) scope(builder *database.QueryBuilder) *database.QueryBuilder {
+	if r.organizerID > 0 {
+		builder.Where("organizer_id", "=", r.organizerID)
+	}
+	return builder
+}
+
+// scopeSQL - raw SQL için organizatör kısıtı
+func (r *EventRepository) scopeSQL(query string, args ...interface{}) (string, []interface{}) {
+	if r.organizerID > 0 {
+		query += ` AND organizer_id = ?`
+		args = append(args, r.organizerID)
+	}
+	return query, args
+}
+
 // Create - Conduit-Go Database Builder ile event oluşturma
 func (r *EventRepository) Create(event *models.Event) (int64, error) {
+	// Kısıtlı repository başka organizatör adına etkinlik oluşturamaz
+	if r.organizerID > 0 {
+		event.OrganizerId = r.organizerID
+	}
+
 	result, err := database.NewBuilder(r.db, r.grammar).
 		Table("events").
 		ExecInsert(map[string]interface{}{
@@ -31,6 +69,8 @@ func (r *EventRepository) Create(event *models.Event) (int64, error) {
 			"type":            event.Type,
 			"status":          event.Status,
 			"venue_id":        event.VenueID,
+			"organizer_id":    event.OrganizerId,
+			"series_id":       event.SeriesID,
 			"start_time":      event.StartTime,
 			"end_time":        event.EndTime,
 			"base_price":      event.BasePrice,
@@ -59,10 +99,8 @@ func (r *EventRepository) Create(event *models.Event) (int64, error) {
 func (r *EventRepository) FindByID(id int64) (*models.Event, error) {
 	var event models.Event
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("events").
+	err := r.query().
 		Where("id", "=", id).
-		WhereNull("deleted_at").
 		First(&event)
 
 	if err == sql.ErrNoRows {
@@ -77,9 +115,7 @@ func (r *EventRepository) FindByID(id int64) (*models.Event, error) {
 
 // FindAll - Conduit-Go Query Builder ile filtreleme
 func (r *EventRepository) FindAll(filters map[string]interface{}, limit, offset int) ([]*models.Event, error) {
-	builder := database.NewBuilder(r.db, r.grammar).
-		Table("events").
-		WhereNull("deleted_at")
+	builder := r.query()
 
 	// Apply filters using Conduit-Go query builder
 	if status, ok := filters["status"].(models.EventStatus); ok {
@@ -116,28 +152,27 @@ func (r *EventRepository) FindAll(filters map[string]interface{}, limit, offset
 	return events, nil
 }
 
-// Update - Conduit-Go Builder ile güncelleme
+// Update - Builder ile version koşullu güncelleme (optimistic locking).
+// available_seats yazılmaz; sayaç sadece Increment/DecrementAvailableSeats ile atomik değişir.
+// Etkinlik okunduktan sonra değiştiyse *models.ConflictError döner.
 func (r *EventRepository) Update(event *models.Event) error {
 	event.UpdatedAt = time.Now()
 
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("events").
+	result, err := r.query().
 		Where("id", "=", event.ID).
-		WhereNull("deleted_at").
-		ExecUpdate(map[string]interface{}{
-			"name":            event.Name,
-			"description":     event.Description,
-			"type":            event.Type,
-			"status":          event.Status,
-			"start_time":      event.StartTime,
-			"end_time":        event.EndTime,
-			"base_price":      event.BasePrice,
-			"available_seats": event.AvailableSeats,
-			"image_url":       event.ImageURL,
-			"featured":        event.Featured,
-			"metadata":        event.Metadata,
-			"updated_at":      event.UpdatedAt,
-		})
+		ExecUpdateIfVersion(map[string]interface{}{
+			"name":        event.Name,
+			"description": event.Description,
+			"type":        event.Type,
+			"status":      event.Status,
+			"start_time":  event.StartTime,
+			"end_time":    event.EndTime,
+			"base_price":  event.BasePrice,
+			"image_url":   event.ImageURL,
+			"featured":    event.Featured,
+			"metadata":    event.Metadata,
+			"updated_at":  event.UpdatedAt,
+		}, event.Version)
 
 	if err != nil {
 		return fmt.Errorf("failed to update event: %w", err)
@@ -149,21 +184,34 @@ func (r *EventRepository) Update(event *models.Event) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("event not found")
+		return r.versionConflict(event.ID, event.Version)
 	}
 
+	event.Version++
 	return nil
 }
 
-// Delete - Soft delete using Conduit-Go Builder
+// versionConflict - koşullu güncelleme satır etkilemediğinde etkinliğin silinmiş mi
+// yoksa başka bir işlem tarafından değiştirilmiş mi olduğunu ayırır
+func (r *EventRepository) versionConflict(id, expected int64) error {
+	current, err := r.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	return &models.ConflictError{
+		Entity:   models.AuditEntityEvent,
+		ID:       id,
+		Expected: expected,
+		Current:  current.Version,
+	}
+}
+
+// Delete - Soft delete using Conduit-Go Builder (SoftDeletes kapsamında ExecDelete deleted_at'i doldurur)
 func (r *EventRepository) Delete(id int64) error {
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("events").
+	result, err := r.query().
 		Where("id", "=", id).
-		WhereNull("deleted_at").
-		ExecUpdate(map[string]interface{}{
-			"deleted_at": time.Now(),
-		})
+		ExecDelete()
 
 	if err != nil {
 		return fmt.Errorf("failed to delete event: %w", err)
@@ -183,12 +231,11 @@ func (r *EventRepository) Delete(id int64) error {
 
 // UpdateStatus - Builder ile status güncelleme
 func (r *EventRepository) UpdateStatus(id int64, status models.EventStatus) error {
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("events").
+	result, err := r.query().
 		Where("id", "=", id).
-		WhereNull("deleted_at").
 		ExecUpdate(map[string]interface{}{
 			"status":     status,
+			"version":    database.Increment(1),
 			"updated_at": time.Now(),
 		})
 
@@ -208,16 +255,30 @@ func (r *EventRepository) UpdateStatus(id int64, status models.EventStatus) erro
 	return nil
 }
 
-// DecrementAvailableSeats - Atomic decrement (Conduit-Go'da increment/decrement yok, raw SQL kullanıyoruz ama prepared statement ile)
+// DecrementAvailableSeats - Builder ile atomik azaltma (Increment: available_seats = available_seats + ?)
 func (r *EventRepository) DecrementAvailableSeats(id int64, count int) error {
-	// Conduit-Go'da henüz Decrement/Increment yok, raw SQL ama güvenli
-	query := `
-		UPDATE events
-		SET available_seats = available_seats - ?, updated_at = ?
-		WHERE id = ? AND available_seats >= ? AND deleted_at IS NULL
-	`
+	return r.decrementAvailableSeats(r.db, id, count)
+}
+
+// DecrementAvailableSeatsWithTx - Atomic decrement (transaction içinde, ör. kombine satışı)
+func (r *EventRepository) DecrementAvailableSeatsWithTx(tx *sql.Tx, id int64, count int) error {
+	return r.decrementAvailableSeats(tx, id, count)
+}
+
+// decrementAvailableSeats - sayaç okunmadan azaltılır (blind write yok); version değişmez
+// (atomik sayaç istisnası, bkz. pkg/database/versioning.go), böylece satış sırasında yapılan
+// etkinlik düzenlemeleri gereksiz yere çakışmaz
+func (r *EventRepository) decrementAvailableSeats(executor database.QueryExecutor, id int64, count int) error {
+	builder := database.NewBuilder(executor, r.grammar).Table("events").SoftDeletes()
+
+	result, err := r.scope(builder).
+		Where("id", "=", id).
+		Where("available_seats", ">=", count).
+		ExecUpdate(map[string]interface{}{
+			"available_seats": database.Increment(-count),
+			"updated_at":      time.Now(),
+		})
 
-	result, err := r.db.Exec(query, count, time.Now(), id, count)
 	if err != nil {
 		return fmt.Errorf("failed to decrement available seats: %w", err)
 	}
@@ -234,15 +295,15 @@ func (r *EventRepository) DecrementAvailableSeats(id int64, count int) error {
 	return nil
 }
 
-// IncrementAvailableSeats - Atomic increment
+// IncrementAvailableSeats - Builder ile atomik artırma
 func (r *EventRepository) IncrementAvailableSeats(id int64, count int) error {
-	query := `
-		UPDATE events
-		SET available_seats = available_seats + ?, updated_at = ?
-		WHERE id = ? AND deleted_at IS NULL
-	`
+	result, err := r.query().
+		Where("id", "=", id).
+		ExecUpdate(map[string]interface{}{
+			"available_seats": database.Increment(count),
+			"updated_at":      time.Now(),
+		})
 
-	result, err := r.db.Exec(query, count, time.Now(), id)
 	if err != nil {
 		return fmt.Errorf("failed to increment available seats: %w", err)
 	}
@@ -263,9 +324,7 @@ func (r *EventRepository) IncrementAvailableSeats(id int64, count int) error {
 func (r *EventRepository) GetUpcomingEvents(limit int) ([]*models.Event, error) {
 	var events []*models.Event
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("events").
-		WhereNull("deleted_at").
+	err := r.query().
 		WhereIn("status", []interface{}{models.EventStatusPublished, models.EventStatusSaleActive}).
 		Where("start_time", ">", time.Now()).
 		OrderBy("start_time", "ASC").
@@ -283,9 +342,7 @@ func (r *EventRepository) GetUpcomingEvents(limit int) ([]*models.Event, error)
 func (r *EventRepository) GetFeaturedEvents(limit int) ([]*models.Event, error] {
 	var events []*models.Event
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("events").
-		WhereNull("deleted_at").
+	err := r.query().
 		Where("featured", "=", true).
 		WhereIn("status", []interface{}{models.EventStatusPublished, models.EventStatusSaleActive}).
 		Where("start_time", ">", time.Now()).
@@ -306,12 +363,14 @@ func (r *EventRepository) SearchByName(keyword string, limit int) ([]*models.Eve
 
 	searchTerm := "%" + keyword + "%"
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("events").
-		WhereNull("deleted_at").
+	builder := r.query().
 		Where("name", "LIKE", searchTerm).
 		OrWhere("description", "LIKE", searchTerm).
-		WhereIn("status", []interface{}{models.EventStatusPublished, models.EventStatusSaleActive}).
+		WhereIn("status", []interface{}{models.EventStatusPublished, models.EventStatusSaleActive})
+
+	// OR gruplanmadığı için organizatör kısıtı her iki dala da uygulanır;
+	// soft delete kapsamı OR dallarını gruplayarak sona eklenir
+	err := r.scope(builder).
 		OrderBy("start_time", "ASC").
 		Limit(limit).
 		Get(&events)
@@ -322,3 +381,501 @@ func (r *EventRepository) SearchByName(keyword string, limit int) ([]*models.Eve
 
 	return events, nil
 }
+
+// FindNearby - Raw SQL (JOIN + haversine + sınır kutusu ön filtresi): mekanı merkeze radiusKm
+// içinde olan yaklaşan etkinlikler, yakından uzağa. radiusKm 0 ise sadece kutu uygulanır.
+// filters FindAll ile aynı anahtarları kabul eder; status verilmezse yayında / satışta olanlar döner.
+func (r *EventRepository) FindNearby(center models.GeoPoint, bounds models.GeoBounds, radiusKm float64, filters map[string]interface{}, limit, offset int) ([]*models.NearbyEvent, error) {
+	query := `SELECT e.id, e.venue_id, ` + haversineDistanceSQL("v") + ` AS distance_km
+		FROM events e
+		INNER JOIN venues v ON v.id = e.venue_id
+		WHERE e.deleted_at IS NULL AND v.deleted_at IS NULL AND e.start_time > ?`
+	args := []interface{}{center.Lat, center.Lat, center.Lng, time.Now()}
+
+	condition, boundsArgs := geoBoundsSQL("v", bounds)
+	query += condition
+	args = append(args, boundsArgs...)
+
+	if status, ok := filters["status"].(models.EventStatus); ok {
+		query += ` AND e.status = ?`
+		args = append(args, status)
+	} else {
+		query += ` AND e.status IN (?, ?)`
+		args = append(args, models.EventStatusPublished, models.EventStatusSaleActive)
+	}
+
+	if eventType, ok := filters["type"].(models.EventType); ok {
+		query += ` AND e.type = ?`
+		args = append(args, eventType)
+	}
+
+	if featured, ok := filters["featured"].(bool); ok {
+		query += ` AND e.featured = ?`
+		args = append(args, featured)
+	}
+
+	if startDate, ok := filters["start_date"].(time.Time); ok {
+		query += ` AND e.start_time >= ?`
+		args = append(args, startDate)
+	}
+
+	if endDate, ok := filters["end_date"].(time.Time); ok {
+		query += ` AND e.start_time <= ?`
+		args = append(args, endDate)
+	}
+
+	if r.organizerID > 0 {
+		query += ` AND e.organizer_id = ?`
+		args = append(args, r.organizerID)
+	}
+
+	if radiusKm > 0 {
+		query += ` HAVING distance_km <= ?`
+		args = append(args, radiusKm)
+	}
+
+	query += ` ORDER BY distance_km ASC, e.start_time ASC, e.id ASC LIMIT ? OFFSET ?`
+	args = append(args, limit, offset)
+
+	matches, err := scanGeoMatches(r.db, query, args, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query nearby events: %w", err)
+	}
+
+	events, err := r.FindByIDs(geoMatchIDs(matches))
+	if err != nil {
+		return nil, err
+	}
+
+	nearby := make([]*models.NearbyEvent, 0, len(matches))
+	for _, match := range matches {
+		if event, ok := events[match.ID]; ok {
+			nearby = append(nearby, &models.NearbyEvent{Event: event, DistanceKm: match.DistanceKm})
+		}
+	}
+
+	return nearby, nil
+}
+
+// FindByIDs - Builder ile WhereIn: ID'ye göre etkinlikler
+func (r *EventRepository) FindByIDs(ids []interface{}) (map[int64]*models.Event, error) {
+	events := make(map[int64]*models.Event, len(ids))
+	if len(ids) == 0 {
+		return events, nil
+	}
+
+	var rows []*models.Event
+	err := r.query().
+		WhereIn("id", ids).
+		Get(&rows)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+
+	for _, event := range rows {
+		events[event.ID] = event
+	}
+
+	return events, nil
+}
+
+// sqlPlaceholders - raw SQL IN listesi için "?, ?, ?"
+func sqlPlaceholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// eventQueryConditions - EventQuery filtrelerinin raw SQL koşulları (e: events, v: venues)
+func (r *EventRepository) eventQueryConditions(q *models.EventQuery) (string, []interface{}) {
+	query := ` WHERE e.deleted_at IS NULL`
+	var args []interface{}
+
+	if len(q.Types) > 0 {
+		query += ` AND e.type IN (` + sqlPlaceholders(len(q.Types)) + `)`
+		for _, eventType := range q.Types {
+			args = append(args, eventType)
+		}
+	}
+
+	if len(q.Statuses) > 0 {
+		query += ` AND e.status IN (` + sqlPlaceholders(len(q.Statuses)) + `)`
+		for _, status := range q.Statuses {
+			args = append(args, status)
+		}
+	}
+
+	if q.VenueID > 0 {
+		query += ` AND e.venue_id = ?`
+		args = append(args, q.VenueID)
+	}
+
+	if q.City != "" {
+		query += ` AND v.city = ?`
+		args = append(args, q.City)
+	}
+
+	if q.StartFrom != nil {
+		query += ` AND e.start_time >= ?`
+		args = append(args, *q.StartFrom)
+	}
+
+	if q.StartTo != nil {
+		query += ` AND e.start_time <= ?`
+		args = append(args, *q.StartTo)
+	}
+
+	if q.MinPrice != nil {
+		query += ` AND e.base_price >= ?`
+		args = append(args, *q.MinPrice)
+	}
+
+	if q.MaxPrice != nil {
+		query += ` AND e.base_price <= ?`
+		args = append(args, *q.MaxPrice)
+	}
+
+	if q.Available != nil {
+		if *q.Available {
+			query += ` AND e.available_seats > 0`
+		} else {
+			query += ` AND e.available_seats <= 0`
+		}
+	}
+
+	if q.Featured != nil {
+		query += ` AND e.featured = ?`
+		args = append(args, *q.Featured)
+	}
+
+	if r.organizerID > 0 {
+		query += ` AND e.organizer_id = ?`
+		args = append(args, r.organizerID)
+	}
+
+	return query, args
+}
+
+// FindByQuery - Raw SQL (JOIN + keyset): sıralamada cursor'dan sonra gelen en fazla limit etkinlik.
+// Eşit sıralama değerlerinde ID sırası belirleyicidir; (değer, id) çifti sayfalar arasında sabittir.
+func (r *EventRepository) FindByQuery(q *models.EventQuery, after *pagination.Cursor, limit int) ([]*models.Event, error) {
+	conditions, args := r.eventQueryConditions(q)
+	query := `SELECT e.id FROM events e INNER JOIN venues v ON v.id = e.venue_id` + conditions
+
+	column := "e." + q.Sort.Column()
+	operator, direction := ">", "ASC"
+	if q.Sort.Descending() {
+		operator, direction = "<", "DESC"
+	}
+
+	if after != nil {
+		value, err := q.Sort.ParseCursorValue(after.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor value: %w", err)
+		}
+		query += ` AND (` + column + ` ` + operator + ` ? OR (` + column + ` = ? AND e.id ` + operator + ` ?))`
+		args = append(args, value, value, after.ID)
+	}
+
+	query += ` ORDER BY ` + column + ` ` + direction + `, e.id ` + direction + ` LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []interface{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan event id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+
+	found, err := r.FindByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*models.Event, 0, len(ids))
+	for _, id := range ids {
+		if event, ok := found[id.(int64)]; ok {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// CountByQuery - Raw SQL (JOIN + COUNT): filtrelere uyan toplam etkinlik sayısı
+func (r *EventRepository) CountByQuery(q *models.EventQuery) (int, error) {
+	conditions, args := r.eventQueryConditions(q)
+	query := `SELECT COUNT(*) FROM events e INNER JOIN venues v ON v.id = e.venue_id` + conditions
+
+	var count int
+	if err := r.db.QueryRow(query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountByVenue - Raw SQL: mekandaki silinmemiş etkinlik sayısı
+func (r *EventRepository) CountByVenue(venueID int64) (int, error) {
+	query, args := r.scopeSQL(`SELECT COUNT(*) FROM events WHERE venue_id = ? AND deleted_at IS NULL`, venueID)
+
+	var count int
+	if err := r.db.QueryRow(query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count venue events: %w", err)
+	}
+
+	return count, nil
+}
+
+// Trash (Soft Delete) Methods
+
+// FindTrashed - Builder ile OnlyTrashed: çöp kutusundaki etkinlikler (en son silinen önce)
+func (r *EventRepository) FindTrashed(limit, offset int) ([]*models.Event, error) {
+	var events []*models.Event
+
+	err := r.query().
+		OnlyTrashed().
+		OrderBy("deleted_at", "DESC").
+		Limit(limit).
+		Offset(offset).
+		Get(&events)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query trashed events: %w", err)
+	}
+
+	return events, nil
+}
+
+// FindTrashedByID - Builder ile OnlyTrashed: çöp kutusundaki tek etkinlik
+func (r *EventRepository) FindTrashedByID(id int64) (*models.Event, error) {
+	var event models.Event
+
+	err := r.query().
+		OnlyTrashed().
+		Where("id", "=", id).
+		First(&event)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("event not found in trash")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trashed event: %w", err)
+	}
+
+	return &event, nil
+}
+
+// Restore - Builder ile ExecRestore: silinmiş etkinliğin deleted_at'ini temizler
+func (r *EventRepository) Restore(id int64) error {
+	result, err := r.query().
+		Where("id", "=", id).
+		ExecRestore()
+
+	if err != nil {
+		return fmt.Errorf("failed to restore event: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("event not found in trash")
+	}
+
+	return nil
+}
+
+// FindPurgeableIDs - Raw SQL (NOT EXISTS): cutoff'tan önce silinmiş ve bileti / ödemesi
+// olmayan etkinlikler (tickets ve payments ON DELETE RESTRICT olduğu için diğerleri kalıcı silinemez)
+func (r *EventRepository) FindPurgeableIDs(cutoff time.Time, limit int) ([]int64, error) {
+	query := `
+		SELECT e.id FROM events e
+		WHERE e.deleted_at IS NOT NULL AND e.deleted_at < ?
+		AND NOT EXISTS (SELECT 1 FROM tickets t WHERE t.event_id = e.id)
+		AND NOT EXISTS (SELECT 1 FROM payments p WHERE p.event_id = e.id)
+		ORDER BY e.deleted_at ASC
+		LIMIT ?`
+
+	rows, err := r.db.Query(query, cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query purgeable events: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan purgeable event: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// ForceDelete - Builder ile ExecForceDelete: cutoff'tan önce silinmiş etkinliği kalıcı olarak siler.
+// Bu arada geri yüklenen etkinliğe dokunulmaz (false döner).
+func (r *EventRepository) ForceDelete(id int64, cutoff time.Time) (bool, error) {
+	result, err := r.query().
+		OnlyTrashed().
+		Where("id", "=", id).
+		Where("deleted_at", "<", cutoff).
+		ExecForceDelete()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to purge event: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// Inventory Reconciliation Methods
+
+// FindReconcilableIDs - Raw SQL (keyset): afterID'den sonraki, sayacı satışı etkileyen
+// (taslak, yayında, satışta, tükendi) silinmemiş etkinlikler
+func (r *EventRepository) FindReconcilableIDs(afterID int64, limit int) ([]int64, error) {
+	query, args := r.scopeSQL(`
+		SELECT id FROM events
+		WHERE deleted_at IS NULL AND id > ? AND status IN (?, ?, ?, ?)`,
+		afterID,
+		models.EventStatusDraft, models.EventStatusPublished, models.EventStatusSaleActive, models.EventStatusSoldOut)
+	query += ` ORDER BY id ASC LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reconcilable events: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan reconcilable event: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// CountInventory - Raw SQL (COUNT subquery): etkinliğin kayıtlı sayacı ile bilet, bekleme listesi
+// teklifi, refakatçi koltuğu tutması ve koltuk sayımları. Pasif koltuktaki bilet / tutmalar sayılmaz;
+// o koltuk zaten bloke kapasite olarak düşülür. Kendi bileti veya teklifi olan refakatçi koltuğu
+// bir kez sayılır.
+func (r *EventRepository) CountInventory(id int64) (*models.InventoryCount, error) {
+	query, args := r.scopeSQL(`
+		SELECT e.id, e.name, e.total_capacity, e.available_seats,
+			(SELECT COUNT(*) FROM seats st
+				INNER JOIN sections s ON s.id = st.section_id
+				WHERE s.venue_id = e.venue_id AND s.deleted_at IS NULL AND st.deleted_at IS NULL),
+			(SELECT COUNT(*) FROM seats st
+				INNER JOIN sections s ON s.id = st.section_id
+				WHERE s.venue_id = e.venue_id AND s.deleted_at IS NULL AND st.deleted_at IS NULL
+					AND st.is_active = TRUE),
+			(SELECT COUNT(*) FROM tickets t
+				LEFT JOIN seats st ON st.id = t.seat_id
+				WHERE t.event_id = e.id AND t.status IN (?, ?, ?)
+					AND (t.seat_id IS NULL OR (st.is_active = TRUE AND st.deleted_at IS NULL))),
+			(SELECT COUNT(*) FROM waiting_list_offers o
+				LEFT JOIN seats st ON st.id = o.seat_id
+				WHERE o.event_id = e.id AND o.status = ?
+					AND (o.seat_id IS NULL OR (st.is_active = TRUE AND st.deleted_at IS NULL))),
+			(SELECT COUNT(DISTINCT c.id) FROM tickets t
+				INNER JOIN seats w ON w.id = t.seat_id
+				INNER JOIN seats c ON c.id = w.companion_seat_id
+				WHERE t.event_id = e.id AND t.status IN (?, ?, ?)
+					AND FIND_IN_SET(?, w.attributes) > 0
+					AND c.is_active = TRUE AND c.deleted_at IS NULL
+					AND NOT EXISTS (SELECT 1 FROM tickets ct
+						WHERE ct.event_id = e.id AND ct.seat_id = c.id AND ct.status IN (?, ?, ?))
+					AND NOT EXISTS (SELECT 1 FROM waiting_list_offers co
+						WHERE co.event_id = e.id AND co.seat_id = c.id AND co.status = ?))
+		FROM events e
+		WHERE e.id = ? AND e.deleted_at IS NULL`,
+		models.TicketStatusReserved, models.TicketStatusSold, models.TicketStatusUsed,
+		models.WaitingListOfferStatusPending,
+		models.TicketStatusReserved, models.TicketStatusSold, models.TicketStatusUsed,
+		models.SeatAttributeWheelchair,
+		models.TicketStatusReserved, models.TicketStatusSold, models.TicketStatusUsed,
+		models.WaitingListOfferStatusPending,
+		id)
+
+	var count models.InventoryCount
+	var companionHolds int
+	err := r.db.QueryRow(query, args...).Scan(
+		&count.EventID, &count.EventName, &count.TotalCapacity, &count.AvailableSeats,
+		&count.SeatCount, &count.ActiveSeatCount, &count.ActiveTickets, &count.ActiveHolds, &companionHolds,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("event not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to count event inventory: %w", err)
+	}
+	count.ActiveHolds += companionHolds
+
+	return &count, nil
+}
+
+// LockAvailableSeats - SELECT ... FOR UPDATE (transaction içinde): etkinlik satırını kilitler ve
+// güncel sayacı döndürür. Kilit commit'e kadar sayaç artırma / azaltmalarını bekletir.
+func (r *EventRepository) LockAvailableSeats(tx *sql.Tx, id int64) (int, error) {
+	var availableSeats int
+	if err := tx.QueryRow(`SELECT available_seats FROM events WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, id).Scan(&availableSeats); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("event not found")
+		}
+		return 0, fmt.Errorf("failed to lock event: %w", err)
+	}
+
+	return availableSeats, nil
+}
+
+// SetAvailableSeatsWithTx - Builder ile transaction içinde sayacın mutlak değere çekilmesi.
+// Sadece LockAvailableSeats kilidi altında çağrılmalıdır; version değişmez (atomik sayaç istisnası,
+// bkz. pkg/database/versioning.go).
+func (r *EventRepository) SetAvailableSeatsWithTx(tx *sql.Tx, id int64, availableSeats int) error {
+	result, err := database.NewBuilder(tx, r.grammar).
+		Table("events").
+		Where("id", "=", id).
+		ExecUpdate(map[string]interface{}{
+			"available_seats": availableSeats,
+			"updated_at":      time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to set available seats: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("event not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type EventSeriesRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewEventSeriesRepository(db *sql.DB) *EventSeriesRepository {
+	return &EventSeriesRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// Create - Conduit-Go Builder ile seri oluşturma
+func (r *EventSeriesRepository) Create(series *models.EventSeries) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("event_series").
+		ExecInsert(map[string]interface{}{
+			"name":             series.Name,
+			"description":      series.Description,
+			"type":             series.Type,
+			"venue_id":         series.VenueID,
+			"base_price":       series.BasePrice,
+			"image_url":        series.ImageURL,
+			"duration_minutes": series.DurationMinutes,
+			"recurrence_rule":  series.RecurrenceRule,
+			"first_start_time": series.FirstStartTime,
+			"timezone":         series.Timezone,
+			"created_at":       series.CreatedAt,
+			"updated_at":       series.UpdatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create event series: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindByID - Builder ile tek seri (istisna günleriyle birlikte)
+func (r *EventSeriesRepository) FindByID(id int64) (*models.EventSeries, error) {
+	var series models.EventSeries
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("event_series").
+		Where("id", "=", id).
+		First(&series)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("event series not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event series: %w", err)
+	}
+
+	exceptions, err := r.FindExceptions(id)
+	if err != nil {
+		return nil, err
+	}
+	series.Exceptions = exceptions
+
+	return &series, nil
+}
+
+// Update - Builder ile seri ayarlarını güncelleme
+func (r *EventSeriesRepository) Update(series *models.EventSeries) error {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("event_series").
+		Where("id", "=", series.ID).
+		ExecUpdate(map[string]interface{}{
+			"name":             series.Name,
+			"description":      series.Description,
+			"base_price":       series.BasePrice,
+			"image_url":        series.ImageURL,
+			"duration_minutes": series.DurationMinutes,
+			"updated_at":       series.UpdatedAt,
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to update event series: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("event series not found")
+	}
+
+	return nil
+}
+
+// AddException - Raw SQL (INSERT IGNORE: aynı gün tekrar eklenmez)
+func (r *EventSeriesRepository) AddException(seriesID int64, date time.Time) error {
+	_, err := r.db.Exec(
+		`INSERT IGNORE INTO event_series_exceptions (series_id, exception_date, created_at) VALUES (?, ?, ?)`,
+		seriesID, date.Format("2006-01-02"), time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add series exception: %w", err)
+	}
+
+	return nil
+}
+
+// FindExceptions - Raw SQL: serinin istisna günleri (tarih sırasına göre)
+func (r *EventSeriesRepository) FindExceptions(seriesID int64) ([]time.Time, error) {
+	rows, err := r.db.Query(
+		`SELECT exception_date FROM event_series_exceptions WHERE series_id = ? ORDER BY exception_date ASC`,
+		seriesID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query series exceptions: %w", err)
+	}
+	defer rows.Close()
+
+	var dates []time.Time
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			return nil, fmt.Errorf("failed to scan series exception: %w", err)
+		}
+		dates = append(dates, date)
+	}
+
+	return dates, rows.Err()
+}
+
+// FindEvents - Builder ile serinin tüm gösterimleri (başlangıç sırasına göre)
+func (r *EventSeriesRepository) FindEvents(seriesID int64) ([]*models.Event, error) {
+	var events []*models.Event
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("events").
+		Where("series_id", "=", seriesID).
+		WhereNull("deleted_at").
+		OrderBy("start_time", "ASC").
+		Get(&events)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query series events: %w", err)
+	}
+
+	return events, nil
+}
+
+// FindPerformances - Builder ile satışa açık / görünür gösterimler ("gösterim seç" listesi)
+func (r *EventSeriesRepository) FindPerformances(seriesID int64, from, to time.Time) ([]*models.Event, error) {
+	var events []*models.Event
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("events").
+		Where("series_id", "=", seriesID).
+		WhereIn("status", []interface{}{
+			models.EventStatusPublished,
+			models.EventStatusSaleActive,
+			models.EventStatusSoldOut,
+		}).
+		Where("start_time", ">=", from).
+		Where("start_time", "<=", to).
+		WhereNull("deleted_at").
+		OrderBy("start_time", "ASC").
+		Get(&events)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query performances: %w", err)
+	}
+
+	return events, nil
+}
+
+// BulkUpdateFutureEvents - Builder ile serinin gelecekteki (iptal edilmemiş) gösterimlerini günceller
+func (r *EventSeriesRepository) BulkUpdateFutureEvents(seriesID int64, data map[string]interface{}) (int64, error) {
+	data["updated_at"] = time.Now()
+	data["version"] = database.Increment(1)
+
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("events").
+		Where("series_id", "=", seriesID).
+		Where("start_time", ">", time.Now()).
+		Where("status", "!=", models.EventStatusCancelled).
+		WhereNull("deleted_at").
+		ExecUpdate(data)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to bulk update series events: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateFutureEventDuration - Raw SQL (bitiş zamanı her gösterimin kendi başlangıcından hesaplanır)
+func (r *EventSeriesRepository) UpdateFutureEventDuration(seriesID int64, minutes int) (int64, error) {
+	query := `
+		UPDATE events
+		SET end_time = DATE_ADD(start_time, INTERVAL ? MINUTE), updated_at = ?, version = version + 1
+		WHERE series_id = ?
+		  AND start_time > ?
+		  AND status != ?
+		  AND deleted_at IS NULL
+	`
+
+	now := time.Now()
+	result, err := r.db.Exec(query, minutes, now, seriesID, now, models.EventStatusCancelled)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update series event duration: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,271 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type EventSessionRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewEventSessionRepository(db *sql.DB) *EventSessionRepository {
+	return &EventSessionRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// activeEntitlementCondition - kapasite tutan haklar (bileti rezerve + satılmış + kullanılmış)
+const activeEntitlementCondition = `t.status IN ('reserved', 'sold', 'used')`
+
+// sessionColumns - sold_count / checked_in_count subquery'leri ile birlikte seçilen kolonlar
+const sessionColumns = `
+	s.id, s.event_id, s.name, s.doors_open_at, s.starts_at, s.ends_at, s.capacity,
+	s.allow_reentry, s.is_active, s.created_at, s.updated_at,
+	(SELECT COUNT(*) FROM ticket_session_entitlements e INNER JOIN tickets t ON t.id = e.ticket_id
+	 WHERE e.session_id = s.id AND ` + activeEntitlementCondition + `) AS sold_count,
+	(SELECT COUNT(*) FROM ticket_session_entitlements e
+	 WHERE e.session_id = s.id AND e.entry_count > 0) AS checked_in_count
+`
+
+// Create - Conduit-Go Builder ile oturum oluşturma
+func (r *EventSessionRepository) Create(session *models.EventSession) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("event_sessions").
+		ExecInsert(map[string]interface{}{
+			"event_id":      session.EventID,
+			"name":          session.Name,
+			"doors_open_at": session.DoorsOpenAt,
+			"starts_at":     session.StartsAt,
+			"ends_at":       session.EndsAt,
+			"capacity":      session.Capacity,
+			"allow_reentry": session.AllowReentry,
+			"is_active":     session.IsActive,
+			"created_at":    session.CreatedAt,
+			"updated_at":    session.UpdatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create event session: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindByID - Raw SQL: tek oturum + hak / giriş sayıları (aggregate subquery)
+func (r *EventSessionRepository) FindByID(id int64) (*models.EventSession, error) {
+	query := `SELECT ` + sessionColumns + ` FROM event_sessions s WHERE s.id = ?`
+
+	session, err := scanSession(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("event session not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event session: %w", err)
+	}
+
+	return session, nil
+}
+
+// FindByEventID - Raw SQL: etkinliğin oturumları (başlangıç sırasına göre)
+func (r *EventSessionRepository) FindByEventID(eventID int64) ([]*models.EventSession, error) {
+	query := `SELECT ` + sessionColumns + ` FROM event_sessions s WHERE s.event_id = ? ORDER BY s.starts_at ASC`
+
+	return r.querySessions(query, eventID)
+}
+
+// FindRunning - Raw SQL: verilen anda girişi açık olan oturumlar
+func (r *EventSessionRepository) FindRunning(eventID int64, at time.Time) ([]*models.EventSession, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM event_sessions s
+		WHERE s.event_id = ?
+		  AND s.is_active = TRUE
+		  AND COALESCE(s.doors_open_at, s.starts_at) <= ?
+		  AND s.ends_at > ?
+		ORDER BY s.starts_at ASC
+	`
+
+	return r.querySessions(query, eventID, at, at)
+}
+
+func (r *EventSessionRepository) querySessions(query string, args ...interface{}) ([]*models.EventSession, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query event sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*models.EventSession
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate event sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// scanSession - tek satırı EventSession modeline dönüştürür
+func scanSession(row rowScanner) (*models.EventSession, error) {
+	var session models.EventSession
+	var doorsOpenAt sql.NullTime
+
+	err := row.Scan(&session.ID, &session.EventID, &session.Name, &doorsOpenAt, &session.StartsAt,
+		&session.EndsAt, &session.Capacity, &session.AllowReentry, &session.IsActive,
+		&session.CreatedAt, &session.UpdatedAt, &session.SoldCount, &session.CheckedInCount)
+	if err != nil {
+		return nil, err
+	}
+	if doorsOpenAt.Valid {
+		session.DoorsOpenAt = &doorsOpenAt.Time
+	}
+
+	return &session, nil
+}
+
+// CountByEventID - COUNT query: etkinliğin oturum sayısı (0 = tek girişli etkinlik)
+func (r *EventSessionRepository) CountByEventID(eventID int64) (int, error) {
+	var count int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM event_sessions WHERE event_id = ?`, eventID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count event sessions: %w", err)
+	}
+
+	return count, nil
+}
+
+// Update - Builder ile oturum güncelleme
+func (r *EventSessionRepository) Update(session *models.EventSession) error {
+	session.UpdatedAt = time.Now()
+
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("event_sessions").
+		Where("id", "=", session.ID).
+		ExecUpdate(map[string]interface{}{
+			"name":          session.Name,
+			"doors_open_at": session.DoorsOpenAt,
+			"starts_at":     session.StartsAt,
+			"ends_at":       session.EndsAt,
+			"capacity":      session.Capacity,
+			"allow_reentry": session.AllowReentry,
+			"is_active":     session.IsActive,
+			"updated_at":    session.UpdatedAt,
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to update event session: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("event session not found")
+	}
+
+	return nil
+}
+
+// LockCapacity - SELECT ... FOR UPDATE ile oturumu kilitler ve aktif hak sayısını döndürür
+func (r *EventSessionRepository) LockCapacity(tx *sql.Tx, sessionID int64) (int, error) {
+	var id int64
+	if err := tx.QueryRow(`SELECT id FROM event_sessions WHERE id = ? FOR UPDATE`, sessionID).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("event session not found")
+		}
+		return 0, fmt.Errorf("failed to lock event session: %w", err)
+	}
+
+	query := `
+		SELECT COUNT(*)
+		FROM ticket_session_entitlements e
+		INNER JOIN tickets t ON t.id = e.ticket_id
+		WHERE e.session_id = ? AND ` + activeEntitlementCondition
+
+	var sold int
+	// Sayım kilitten sonra en güncel commit'lenmiş veriyi görmek için tx snapshot'ı dışında yapılır
+	if err := r.db.QueryRow(query, sessionID).Scan(&sold); err != nil {
+		return 0, fmt.Errorf("failed to count session entitlements: %w", err)
+	}
+
+	return sold, nil
+}
+
+// CreateEntitlement - Raw SQL (INSERT IGNORE: aynı oturum için tekrar hak verilmez)
+func (r *EventSessionRepository) CreateEntitlement(ticketID, sessionID int64) (bool, error) {
+	result, err := r.db.Exec(
+		`INSERT IGNORE INTO ticket_session_entitlements (ticket_id, session_id, created_at) VALUES (?, ?, ?)`,
+		ticketID, sessionID, time.Now(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to create session entitlement: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// FindEntitlementsByTicketID - Builder ile biletin giriş hakları
+func (r *EventSessionRepository) FindEntitlementsByTicketID(ticketID int64) ([]*models.TicketEntitlement, error) {
+	var entitlements []*models.TicketEntitlement
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("ticket_session_entitlements").
+		Where("ticket_id", "=", ticketID).
+		OrderBy("session_id", "ASC").
+		Get(&entitlements)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session entitlements: %w", err)
+	}
+
+	return entitlements, nil
+}
+
+// RecordEntry - Atomic update: girişi kaydeder. Tekrar giriş kapalıysa sadece ilk giriş kabul edilir;
+// false dönerse bilet bu oturumda zaten giriş yapmıştır.
+func (r *EventSessionRepository) RecordEntry(ticketID, sessionID int64, allowReentry bool) (bool, error) {
+	query := `
+		UPDATE ticket_session_entitlements
+		SET entry_count = entry_count + 1,
+		    first_entry_at = COALESCE(first_entry_at, ?),
+		    last_entry_at = ?
+		WHERE ticket_id = ? AND session_id = ? AND (? OR entry_count = 0)
+	`
+
+	now := time.Now()
+	result, err := r.db.Exec(query, now, now, ticketID, sessionID, allowReentry)
+	if err != nil {
+		return false, fmt.Errorf("failed to record session entry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
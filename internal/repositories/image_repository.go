@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+// ImageRepository - etkinlik / mekan görsel varyantları. Sahiplik kontrolü
+// servis katmanında kısıtlı event / venue repository'leri ile yapılır.
+type ImageRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewImageRepository(db *sql.DB) *ImageRepository {
+	return &ImageRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// ReplaceVariants - Builder ile transaction içinde kaydın varyantlarını yenileriyle değiştirme
+func (r *ImageRepository) ReplaceVariants(tx *sql.Tx, entityType models.ImageEntityType, entityID int64, variants []*models.ImageVariant) error {
+	_, err := database.NewBuilder(tx, r.grammar).
+		Table("images").
+		Where("entity_type", "=", entityType).
+		Where("entity_id", "=", entityID).
+		ExecDelete()
+
+	if err != nil {
+		return fmt.Errorf("failed to delete image variants: %w", err)
+	}
+
+	for _, variant := range variants {
+		result, err := database.NewBuilder(tx, r.grammar).
+			Table("images").
+			ExecInsert(map[string]interface{}{
+				"entity_type":  entityType,
+				"entity_id":    entityID,
+				"variant":      variant.Variant,
+				"path":         variant.Path,
+				"url":          variant.URL,
+				"content_type": variant.ContentType,
+				"width":        variant.Width,
+				"height":       variant.Height,
+				"size_bytes":   variant.SizeBytes,
+				"created_at":   variant.CreatedAt,
+				"updated_at":   variant.UpdatedAt,
+			})
+
+		if err != nil {
+			return fmt.Errorf("failed to create image variant: %w", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+		variant.ID = id
+		variant.EntityType = entityType
+		variant.EntityID = entityID
+	}
+
+	return nil
+}
+
+// FindByEntity - Builder ile kaydın varyantları (küçükten büyüğe)
+func (r *ImageRepository) FindByEntity(entityType models.ImageEntityType, entityID int64) ([]*models.ImageVariant, error) {
+	var variants []*models.ImageVariant
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("images").
+		Where("entity_type", "=", entityType).
+		Where("entity_id", "=", entityID).
+		OrderBy("width", "ASC").
+		Get(&variants)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query image variants: %w", err)
+	}
+
+	return variants, nil
+}
+
+// UpdateEventImageURL - Builder ile transaction içinde etkinliğin görsel URL'ini güncelleme
+func (r *ImageRepository) UpdateEventImageURL(tx *sql.Tx, eventID int64, url string) error {
+	return r.updateImageURL(tx, "events", eventID, url)
+}
+
+// UpdateVenueImageURL - Builder ile transaction içinde mekanın görsel URL'ini güncelleme
+func (r *ImageRepository) UpdateVenueImageURL(tx *sql.Tx, venueID int64, url string) error {
+	return r.updateImageURL(tx, "venues", venueID, url)
+}
+
+func (r *ImageRepository) updateImageURL(tx *sql.Tx, table string, id int64, url string) error {
+	data := map[string]interface{}{
+		"image_url":  url,
+		"updated_at": time.Now(),
+	}
+
+	// Etkinlikler optimistic locking kullanır; görsel değişikliği de bir yazmadır
+	if table == "events" {
+		data["version"] = database.Increment(1)
+	}
+
+	result, err := database.NewBuilder(tx, r.grammar).
+		Table(table).
+		Where("id", "=", id).
+		WhereNull("deleted_at").
+		ExecUpdate(data)
+
+	if err != nil {
+		return fmt.Errorf("failed to update image url: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("image owner not found")
+	}
+
+	return nil
+}
+
+// CountByPath - Raw SQL ile bir storage dosyasını gösteren kayıt sayısı
+func (r *ImageRepository) CountByPath(path string) (int, error) {
+	var count int
+
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM images WHERE path = ?`, path).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count image references: %w", err)
+	}
+
+	return count, nil
+}
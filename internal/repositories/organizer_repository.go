@@ -0,0 +1,253 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type OrganizerRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewOrganizerRepository(db *sql.DB) *OrganizerRepository {
+	return &OrganizerRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// organizerEventCondition - raw SQL için organizatör kısıtı (event_id kolonu olan tablolar)
+const organizerEventCondition = ` AND event_id IN (SELECT id FROM events WHERE organizer_id = ?)`
+
+// organizerEvents - organizatörün etkinlik ID'lerini seçen alt sorgu (Builder WhereInSub kısıtı için).
+// Ana sorguyla birlikte derlenir; ID listesi önceden çekilmez.
+func organizerEvents(db *sql.DB, grammar database.Grammar, organizerID int64) *database.QueryBuilder {
+	return database.NewBuilder(db, grammar).
+		Table("events").
+		Select("id").
+		Where("organizer_id", "=", organizerID)
+}
+
+// Create - Conduit-Go Builder ile organizatör oluşturma
+func (r *OrganizerRepository) Create(organizer *models.Organizer) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("organizers").
+		ExecInsert(map[string]interface{}{
+			"name":          organizer.Name,
+			"slug":          organizer.Slug,
+			"contact_email": organizer.ContactEmail,
+			"status":        organizer.Status,
+			"created_at":    organizer.CreatedAt,
+			"updated_at":    organizer.UpdatedAt,
+		})
+
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return 0, fmt.Errorf("organizer slug already exists")
+		}
+		return 0, fmt.Errorf("failed to create organizer: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindByID - Builder ile tek organizatör
+func (r *OrganizerRepository) FindByID(id int64) (*models.Organizer, error) {
+	var organizer models.Organizer
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("organizers").
+		Where("id", "=", id).
+		First(&organizer)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("organizer not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find organizer: %w", err)
+	}
+
+	return &organizer, nil
+}
+
+// UpdateStatus - Builder ile durum güncelleme
+func (r *OrganizerRepository) UpdateStatus(id int64, status models.OrganizerStatus) error {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("organizers").
+		Where("id", "=", id).
+		ExecUpdate(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to update organizer status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("organizer not found")
+	}
+
+	return nil
+}
+
+// AddMember - Builder ile üye ekleme
+func (r *OrganizerRepository) AddMember(member *models.OrganizerMember) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("organizer_members").
+		ExecInsert(map[string]interface{}{
+			"organizer_id": member.OrganizerID,
+			"user_id":      member.UserID,
+			"role":         member.Role,
+			"created_at":   member.CreatedAt,
+			"updated_at":   member.UpdatedAt,
+		})
+
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return 0, fmt.Errorf("user is already a member")
+		}
+		return 0, fmt.Errorf("failed to add organizer member: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindMember - Builder ile kullanıcının organizatördeki üyeliği
+func (r *OrganizerRepository) FindMember(organizerID, userID int64) (*models.OrganizerMember, error) {
+	var member models.OrganizerMember
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("organizer_members").
+		Where("organizer_id", "=", organizerID).
+		Where("user_id", "=", userID).
+		First(&member)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("organizer member not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find organizer member: %w", err)
+	}
+
+	return &member, nil
+}
+
+// FindMembers - Builder ile organizatörün üyeleri
+func (r *OrganizerRepository) FindMembers(organizerID int64) ([]*models.OrganizerMember, error) {
+	var members []*models.OrganizerMember
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("organizer_members").
+		Where("organizer_id", "=", organizerID).
+		OrderBy("id", "ASC").
+		Get(&members)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query organizer members: %w", err)
+	}
+
+	return members, nil
+}
+
+// FindMembershipsByUserID - Builder ile kullanıcının tüm üyelikleri
+func (r *OrganizerRepository) FindMembershipsByUserID(userID int64) ([]*models.OrganizerMember, error) {
+	var members []*models.OrganizerMember
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("organizer_members").
+		Where("user_id", "=", userID).
+		OrderBy("organizer_id", "ASC").
+		Get(&members)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user memberships: %w", err)
+	}
+
+	return members, nil
+}
+
+// UpdateMemberRole - Builder ile rol güncelleme
+func (r *OrganizerRepository) UpdateMemberRole(organizerID, userID int64, role models.OrganizerRole) error {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("organizer_members").
+		Where("organizer_id", "=", organizerID).
+		Where("user_id", "=", userID).
+		ExecUpdate(map[string]interface{}{
+			"role":       role,
+			"updated_at": time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to update member role: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("organizer member not found")
+	}
+
+	return nil
+}
+
+// RemoveMember - Builder ile üyeyi çıkarma
+func (r *OrganizerRepository) RemoveMember(organizerID, userID int64) error {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("organizer_members").
+		Where("organizer_id", "=", organizerID).
+		Where("user_id", "=", userID).
+		ExecDelete()
+
+	if err != nil {
+		return fmt.Errorf("failed to remove organizer member: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("organizer member not found")
+	}
+
+	return nil
+}
+
+// CountOwners - COUNT query: organizatörün owner sayısı (son owner çıkarılamaz)
+func (r *OrganizerRepository) CountOwners(organizerID int64) (int, error) {
+	var count int
+	err := r.db.QueryRow(
+		`SELECT COUNT(*) FROM organizer_members WHERE organizer_id = ? AND role = ?`,
+		organizerID, models.OrganizerRoleOwner,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count organizer owners: %w", err)
+	}
+
+	return count, nil
+}
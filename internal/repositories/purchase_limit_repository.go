@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type PurchaseLimitRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewPurchaseLimitRepository(db *sql.DB) *PurchaseLimitRepository {
+	return &PurchaseLimitRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// FindByEventID - Builder ile etkinliğin limitleri (tanımlı değilse nil, nil)
+func (r *PurchaseLimitRepository) FindByEventID(eventID int64) (*models.PurchaseLimit, error) {
+	var limit models.PurchaseLimit
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("event_purchase_limits").
+		Where("event_id", "=", eventID).
+		First(&limit)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find purchase limit: %w", err)
+	}
+
+	return &limit, nil
+}
+
+// Upsert - Raw SQL (INSERT ... ON DUPLICATE KEY UPDATE builder'da yok)
+func (r *PurchaseLimitRepository) Upsert(limit *models.PurchaseLimit) error {
+	query := `
+		INSERT INTO event_purchase_limits (event_id, max_per_user, max_per_card, max_per_address, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			max_per_user = VALUES(max_per_user),
+			max_per_card = VALUES(max_per_card),
+			max_per_address = VALUES(max_per_address),
+			updated_at = VALUES(updated_at)
+	`
+
+	now := time.Now()
+	_, err := r.db.Exec(query, limit.EventID, limit.MaxPerUser, limit.MaxPerCard, limit.MaxPerAddress, now, now)
+	if err != nil {
+		return fmt.Errorf("failed to save purchase limit: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByEventID - Builder ile etkinliğin limitlerini kaldırır
+func (r *PurchaseLimitRepository) DeleteByEventID(eventID int64) error {
+	_, err := database.NewBuilder(r.db, r.grammar).
+		Table("event_purchase_limits").
+		Where("event_id", "=", eventID).
+		ExecDelete()
+
+	if err != nil {
+		return fmt.Errorf("failed to delete purchase limit: %w", err)
+	}
+
+	return nil
+}
+
+// LockByEventID - SELECT ... FOR UPDATE (raw SQL, transaction içinde)
+// Limit satırını kilitleyerek aynı etkinlik için eşzamanlı rezervasyonların
+// sayım + kayıt adımlarını sıraya sokar. Limit tanımlı değilse nil, nil döner.
+func (r *PurchaseLimitRepository) LockByEventID(tx *sql.Tx, eventID int64) (*models.PurchaseLimit, error) {
+	query := `
+		SELECT id, event_id, max_per_user, max_per_card, max_per_address
+		FROM event_purchase_limits
+		WHERE event_id = ?
+		FOR UPDATE
+	`
+
+	var limit models.PurchaseLimit
+	var maxPerUser, maxPerCard, maxPerAddress sql.NullInt64
+
+	err := tx.QueryRow(query, eventID).Scan(&limit.ID, &limit.EventID, &maxPerUser, &maxPerCard, &maxPerAddress)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock purchase limit: %w", err)
+	}
+
+	limit.MaxPerUser = nullIntPtr(maxPerUser)
+	limit.MaxPerCard = nullIntPtr(maxPerCard)
+	limit.MaxPerAddress = nullIntPtr(maxPerAddress)
+
+	return &limit, nil
+}
+
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int64)
+	return &n
+}
@@ -10,8 +10,9 @@ import (
 )
 
 type ReservationRepository struct {
-	db      *sql.DB
-	grammar database.Grammar
+	db          *sql.DB
+	grammar     database.Grammar
+	organizerID int64 // 0 = kısıtsız; > 0 ise sadece organizatörün etkinliklerinin ödemeleri / bekleme listeleri
 }
 
 func NewReservationRepository(db *sql.DB) *ReservationRepository {
@@ -21,8 +22,55 @@ func NewReservationRepository(db *sql.DB) *ReservationRepository {
 	}
 }
 
+// ForOrganizer - organizatöre kısıtlı kopya döndürür (0 = kısıtsız)
+func (r *ReservationRepository) ForOrganizer(organizerID int64) *ReservationRepository {
+	scoped := *r
+	scoped.organizerID = organizerID
+	return &scoped
+}
+
+// query - payments / waiting_lists için Builder; kısıtlıysa organizatörün etkinlikleriyle sınırlanır
+func (r *ReservationRepository) query(table string) *database.QueryBuilder {
+	builder := database.NewBuilder(r.db, r.grammar).Table(table)
+	if r.organizerID == 0 {
+		return builder
+	}
+
+	return builder.WhereInSub("event_id", organizerEvents(r.db, r.grammar, r.organizerID))
+}
+
+// scopeSQL - raw SQL için organizatör kısıtı
+func (r *ReservationRepository) scopeSQL(query string, args ...interface{}) (string, []interface{}) {
+	if r.organizerID > 0 {
+		query += organizerEventCondition
+		args = append(args, r.organizerID)
+	}
+	return query, args
+}
+
 // Payment Repository Methods
 
+// paymentStatusTimestamps - durum değişikliğiyle birlikte yazılan zaman kolonları.
+// processed_at her değişiklikte güncellenir; mutabakat satış / iade / ters ibraz anını ayrı kolonlardan okur.
+func paymentStatusTimestamps(status models.PaymentStatus, now time.Time) map[string]interface{} {
+	values := map[string]interface{}{
+		"status":       status,
+		"processed_at": now,
+		"updated_at":   now,
+	}
+
+	switch status {
+	case models.PaymentStatusCompleted:
+		values["paid_at"] = now
+	case models.PaymentStatusRefunded:
+		values["refunded_at"] = now
+	case models.PaymentStatusChargedBack:
+		values["charged_back_at"] = now
+	}
+
+	return values
+}
+
 // CreatePayment - Conduit-Go Builder ile payment oluşturma
 func (r *ReservationRepository) CreatePayment(payment *models.Payment) (int64, error) {
 	result, err := database.NewBuilder(r.db, r.grammar).
@@ -30,6 +78,7 @@ func (r *ReservationRepository) CreatePayment(payment *models.Payment) (int64, e
 		ExecInsert(map[string]interface{}{
 			"user_id":           payment.UserID,
 			"event_id":          payment.EventID,
+			"ticket_id":         payment.TicketID,
 			"amount":            payment.Amount,
 			"currency":          payment.Currency,
 			"status":            payment.Status,
@@ -56,8 +105,7 @@ func (r *ReservationRepository) CreatePayment(payment *models.Payment) (int64, e
 func (r *ReservationRepository) FindPaymentByID(id int64) (*models.Payment, error) {
 	var payment models.Payment
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("payments").
+	err := r.query("payments").
 		Where("id", "=", id).
 		First(&payment)
 
@@ -75,8 +123,7 @@ func (r *ReservationRepository) FindPaymentByID(id int64) (*models.Payment, erro
 func (r *ReservationRepository) FindPaymentByTransactionID(transactionID string) (*models.Payment, error) {
 	var payment models.Payment
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("payments").
+	err := r.query("payments").
 		Where("transaction_id", "=", transactionID).
 		First(&payment)
 
@@ -90,12 +137,49 @@ func (r *ReservationRepository) FindPaymentByTransactionID(transactionID string)
 	return &payment, nil
 }
 
+// FindCompletedPaymentByTicketID - Builder ile biletin tamamlanmış son ödemesi.
+// Ödeme yoksa nil, nil döner.
+func (r *ReservationRepository) FindCompletedPaymentByTicketID(ticketID int64) (*models.Payment, error) {
+	var payment models.Payment
+
+	err := r.query("payments").
+		Where("ticket_id", "=", ticketID).
+		Where("status", "=", models.PaymentStatusCompleted).
+		OrderBy("id", "DESC").
+		First(&payment)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ticket payment: %w", err)
+	}
+
+	return &payment, nil
+}
+
+// SetPaymentInstrument - Builder ile sağlayıcının bildirdiği kart parmak izi ve adres özetini kaydeder
+func (r *ReservationRepository) SetPaymentInstrument(id int64, cardFingerprint, addressHash *string) error {
+	_, err := r.query("payments").
+		Where("id", "=", id).
+		ExecUpdate(map[string]interface{}{
+			"card_fingerprint": cardFingerprint,
+			"address_hash":     addressHash,
+			"updated_at":       time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to set payment instrument: %w", err)
+	}
+
+	return nil
+}
+
 // FindPaymentsByUserID - Builder ile user payments
 func (r *ReservationRepository) FindPaymentsByUserID(userID int64) ([]*models.Payment, error) {
 	var payments []*models.Payment
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("payments").
+	err := r.query("payments").
 		Where("user_id", "=", userID).
 		OrderBy("created_at", "DESC").
 		Get(&payments)
@@ -107,19 +191,17 @@ func (r *ReservationRepository) FindPaymentsByUserID(userID int64) ([]*models.Pa
 	return payments, nil
 }
 
-// UpdatePaymentStatus - Builder ile payment status güncelleme
-func (r *ReservationRepository) UpdatePaymentStatus(id int64, status models.PaymentStatus, providerResponse string) error {
+// UpdatePaymentStatus - Builder ile version koşullu payment status güncelleme (optimistic locking).
+// Ödeme okunduktan sonra değiştiyse *models.ConflictError döner.
+func (r *ReservationRepository) UpdatePaymentStatus(payment *models.Payment, status models.PaymentStatus, providerResponse string) error {
 	now := time.Now()
 
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("payments").
-		Where("id", "=", id).
-		ExecUpdate(map[string]interface{}{
-			"status":            status,
-			"provider_response": providerResponse,
-			"processed_at":      now,
-			"updated_at":        now,
-		})
+	values := paymentStatusTimestamps(status, now)
+	values["provider_response"] = providerResponse
+
+	result, err := r.query("payments").
+		Where("id", "=", payment.ID).
+		ExecUpdateIfVersion(values, payment.Version)
 
 	if err != nil {
 		return fmt.Errorf("failed to update payment status: %w", err)
@@ -131,12 +213,54 @@ func (r *ReservationRepository) UpdatePaymentStatus(id int64, status models.Paym
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("payment not found")
+		current, err := r.FindPaymentByID(payment.ID)
+		if err != nil {
+			return err
+		}
+		return &models.ConflictError{
+			Entity:   models.AuditEntityPayment,
+			ID:       payment.ID,
+			Expected: payment.Version,
+			Current:  current.Version,
+		}
 	}
 
+	payment.Version++
 	return nil
 }
 
+// TransitionPaymentStatus - Koşullu status güncelleme (compare-and-set)
+// Sadece mevcut status "from" listesindeyse günceller; webhook retry'larında
+// aynı geçişin iki kez uygulanmasını engeller. Güncelleme yapıldıysa true döner.
+func (r *ReservationRepository) TransitionPaymentStatus(id int64, from []models.PaymentStatus, to models.PaymentStatus, providerResponse string) (bool, error) {
+	now := time.Now()
+
+	allowed := make([]interface{}, len(from))
+	for i, status := range from {
+		allowed[i] = status
+	}
+
+	values := paymentStatusTimestamps(to, now)
+	values["provider_response"] = providerResponse
+	values["version"] = database.Increment(1)
+
+	result, err := r.query("payments").
+		Where("id", "=", id).
+		WhereIn("status", allowed).
+		ExecUpdate(values)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to transition payment status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
 // GetTotalRevenueByEvent - SUM query (raw SQL for aggregate functions)
 func (r *ReservationRepository) GetTotalRevenueByEvent(eventID int64) (float64, error) {
 	query := `
@@ -145,8 +269,10 @@ func (r *ReservationRepository) GetTotalRevenueByEvent(eventID int64) (float64,
 		WHERE event_id = ? AND status = ?
 	`
 
+	query, args := r.scopeSQL(query, eventID, models.PaymentStatusCompleted)
+
 	var revenue float64
-	err := r.db.QueryRow(query, eventID, models.PaymentStatusCompleted).Scan(&revenue)
+	err := r.db.QueryRow(query, args...).Scan(&revenue)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get total revenue: %w", err)
 	}
@@ -154,6 +280,45 @@ func (r *ReservationRepository) GetTotalRevenueByEvent(eventID int64) (float64,
 	return revenue, nil
 }
 
+// GetRevenueBreakdownByEvent - SUM query: para birimi bazında satış / iade / ters ibraz dağılımı
+func (r *ReservationRepository) GetRevenueBreakdownByEvent(eventID int64) ([]*models.RevenueBreakdown, error) {
+	query := `
+		SELECT currency,
+		       COUNT(*),
+		       COALESCE(SUM(amount), 0),
+		       COALESCE(SUM(CASE WHEN status = ? THEN amount ELSE 0 END), 0),
+		       COALESCE(SUM(CASE WHEN status = ? THEN amount ELSE 0 END), 0)
+		FROM payments
+		WHERE event_id = ? AND paid_at IS NOT NULL
+	`
+
+	query, args := r.scopeSQL(query, models.PaymentStatusRefunded, models.PaymentStatusChargedBack, eventID)
+	query += ` GROUP BY currency ORDER BY currency`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revenue breakdown: %w", err)
+	}
+	defer rows.Close()
+
+	var breakdowns []*models.RevenueBreakdown
+	for rows.Next() {
+		breakdown := &models.RevenueBreakdown{EventID: eventID}
+		if err := rows.Scan(&breakdown.Currency, &breakdown.PaymentCount, &breakdown.GrossAmount,
+			&breakdown.RefundAmount, &breakdown.ChargebackAmount); err != nil {
+			return nil, fmt.Errorf("failed to scan revenue breakdown: %w", err)
+		}
+		breakdown.NetAmount = breakdown.GrossAmount - breakdown.RefundAmount - breakdown.ChargebackAmount
+		breakdowns = append(breakdowns, breakdown)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate revenue breakdown: %w", err)
+	}
+
+	return breakdowns, nil
+}
+
 // Waiting List Repository Methods
 
 // AddToWaitingList - Conduit-Go Builder ile waiting list ekleme
@@ -185,8 +350,7 @@ func (r *ReservationRepository) AddToWaitingList(waitingList *models.WaitingList
 func (r *ReservationRepository) FindWaitingListByID(id int64) (*models.WaitingList, error) {
 	var waitingList models.WaitingList
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("waiting_lists").
+	err := r.query("waiting_lists").
 		Where("id", "=", id).
 		First(&waitingList)
 
@@ -204,8 +368,7 @@ func (r *ReservationRepository) FindWaitingListByID(id int64) (*models.WaitingLi
 func (r *ReservationRepository) FindWaitingListByEvent(eventID int64, limit int) ([]*models.WaitingList, error) {
 	var waitingLists []*models.WaitingList
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("waiting_lists").
+	err := r.query("waiting_lists").
 		Where("event_id", "=", eventID).
 		Where("status", "=", models.WaitingListStatusWaiting).
 		OrderBy("priority", "DESC").
@@ -224,8 +387,7 @@ func (r *ReservationRepository) FindWaitingListByEvent(eventID int64, limit int)
 func (r *ReservationRepository) FindWaitingListByUser(userID int64) ([]*models.WaitingList, error) {
 	var waitingLists []*models.WaitingList
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("waiting_lists").
+	err := r.query("waiting_lists").
 		Where("user_id", "=", userID).
 		OrderBy("created_at", "DESC").
 		Get(&waitingLists)
@@ -239,8 +401,7 @@ func (r *ReservationRepository) FindWaitingListByUser(userID int64) ([]*models.W
 
 // UpdateWaitingListStatus - Builder ile waiting list status güncelleme
 func (r *ReservationRepository) UpdateWaitingListStatus(id int64, status models.WaitingListStatus) error {
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("waiting_lists").
+	result, err := r.query("waiting_lists").
 		Where("id", "=", id).
 		ExecUpdate(map[string]interface{}{
 			"status":     status,
@@ -267,8 +428,7 @@ func (r *ReservationRepository) UpdateWaitingListStatus(id int64, status models.
 func (r *ReservationRepository) MarkAsNotified(id int64) error {
 	now := time.Now()
 
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("waiting_lists").
+	result, err := r.query("waiting_lists").
 		Where("id", "=", id).
 		Where("status", "=", models.WaitingListStatusWaiting).
 		ExecUpdate(map[string]interface{}{
@@ -293,10 +453,39 @@ func (r *ReservationRepository) MarkAsNotified(id int64) error {
 	return nil
 }
 
+// MarkAsOffered - Builder ile teklif yapıldı işareti
+// Sadece waiting durumundaki kayıt güncellenir; aynı kişiye iki teklif gitmez.
+func (r *ReservationRepository) MarkAsOffered(id int64) error {
+	now := time.Now()
+
+	result, err := r.query("waiting_lists").
+		Where("id", "=", id).
+		Where("status", "=", models.WaitingListStatusWaiting).
+		ExecUpdate(map[string]interface{}{
+			"status":      models.WaitingListStatusOffered,
+			"notified_at": now,
+			"updated_at":  now,
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to mark as offered: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("waiting list entry not found or invalid status")
+	}
+
+	return nil
+}
+
 // RemoveFromWaitingList - Builder ile waiting list silme
 func (r *ReservationRepository) RemoveFromWaitingList(id int64) error {
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("waiting_lists").
+	result, err := r.query("waiting_lists").
 		Where("id", "=", id).
 		ExecDelete()
 
@@ -324,8 +513,10 @@ func (r *ReservationRepository) GetWaitingListCount(eventID int64) (int, error)
 		WHERE event_id = ? AND status = ?
 	`
 
+	query, args := r.scopeSQL(query, eventID, models.WaitingListStatusWaiting)
+
 	var count int
-	err := r.db.QueryRow(query, eventID, models.WaitingListStatusWaiting).Scan(&count)
+	err := r.db.QueryRow(query, args...).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get waiting list count: %w", err)
 	}
@@ -0,0 +1,313 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type SalePhaseRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewSalePhaseRepository(db *sql.DB) *SalePhaseRepository {
+	return &SalePhaseRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// activeTicketCondition - rezerve (süresi dolmamış) + satılmış + kullanılmış biletler
+const activeTicketCondition = `(t.status IN ('sold', 'used') OR (t.status = 'reserved' AND t.reservation_expiry > ?))`
+
+// Create - Conduit-Go Builder ile satış aşaması oluşturma
+func (r *SalePhaseRepository) Create(phase *models.SalePhase) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("sale_phases").
+		ExecInsert(map[string]interface{}{
+			"event_id":    phase.EventID,
+			"name":        phase.Name,
+			"access_type": phase.AccessType,
+			"segment":     phase.Segment,
+			"allocation":  phase.Allocation,
+			"starts_at":   phase.StartsAt,
+			"ends_at":     phase.EndsAt,
+			"sort_order":  phase.SortOrder,
+			"created_at":  phase.CreatedAt,
+			"updated_at":  phase.UpdatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create sale phase: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindByID - Builder ile tek aşama
+func (r *SalePhaseRepository) FindByID(id int64) (*models.SalePhase, error) {
+	var phase models.SalePhase
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("sale_phases").
+		Where("id", "=", id).
+		First(&phase)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("sale phase not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sale phase: %w", err)
+	}
+
+	return &phase, nil
+}
+
+// FindByEventID - Raw SQL: aşamalar + aktif bilet sayısı (aggregate subquery)
+func (r *SalePhaseRepository) FindByEventID(eventID int64) ([]*models.SalePhase, error) {
+	query := `
+		SELECT p.id, p.event_id, p.name, p.access_type, p.segment, p.allocation,
+		       p.starts_at, p.ends_at, p.sort_order, p.created_at, p.updated_at,
+		       (SELECT COUNT(*) FROM tickets t WHERE t.sale_phase_id = p.id AND ` + activeTicketCondition + `) AS sold_count
+		FROM sale_phases p
+		WHERE p.event_id = ?
+		ORDER BY p.starts_at ASC, p.sort_order ASC
+	`
+
+	rows, err := r.db.Query(query, time.Now(), eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sale phases: %w", err)
+	}
+	defer rows.Close()
+
+	var phases []*models.SalePhase
+	for rows.Next() {
+		var phase models.SalePhase
+		var segment sql.NullString
+		var allocation sql.NullInt64
+
+		err := rows.Scan(&phase.ID, &phase.EventID, &phase.Name, &phase.AccessType, &segment, &allocation,
+			&phase.StartsAt, &phase.EndsAt, &phase.SortOrder, &phase.CreatedAt, &phase.UpdatedAt, &phase.SoldCount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan sale phase: %w", err)
+		}
+
+		if segment.Valid {
+			phase.Segment = &segment.String
+		}
+		phase.Allocation = nullIntPtr(allocation)
+
+		phases = append(phases, &phase)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sale phases: %w", err)
+	}
+
+	return phases, nil
+}
+
+// Delete - Builder ile aşama silme (kodlar ve allow-list cascade ile silinir)
+func (r *SalePhaseRepository) Delete(id int64) error {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("sale_phases").
+		Where("id", "=", id).
+		ExecDelete()
+
+	if err != nil {
+		return fmt.Errorf("failed to delete sale phase: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("sale phase not found")
+	}
+
+	return nil
+}
+
+// LockAllocation - SELECT ... FOR UPDATE (transaction içinde)
+// Aşama satırını kilitler ve aktif bilet sayısını döndürür; kota kontrolü ile
+// bilet kaydı aynı kilit altında yapılır.
+func (r *SalePhaseRepository) LockAllocation(tx *sql.Tx, phaseID int64) (int, error) {
+	var id int64
+	if err := tx.QueryRow(`SELECT id FROM sale_phases WHERE id = ? FOR UPDATE`, phaseID).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("sale phase not found")
+		}
+		return 0, fmt.Errorf("failed to lock sale phase: %w", err)
+	}
+
+	query := `SELECT COUNT(*) FROM tickets t WHERE t.sale_phase_id = ? AND ` + activeTicketCondition
+
+	var count int
+	// Sayım kilitten sonra en güncel commit'lenmiş veriyi görmek için tx snapshot'ı dışında yapılır
+	if err := r.db.QueryRow(query, phaseID, time.Now()).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count phase tickets: %w", err)
+	}
+
+	return count, nil
+}
+
+// CreateCodes - Builder ile toplu kod oluşturma
+func (r *SalePhaseRepository) CreateCodes(phaseID int64, codes []string, maxUses int) error {
+	now := time.Now()
+
+	for _, code := range codes {
+		_, err := database.NewBuilder(r.db, r.grammar).
+			Table("sale_phase_codes").
+			ExecInsert(map[string]interface{}{
+				"sale_phase_id": phaseID,
+				"code":          code,
+				"max_uses":      maxUses,
+				"created_at":    now,
+				"updated_at":    now,
+			})
+
+		if err != nil {
+			return fmt.Errorf("failed to create sale phase code: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// FindCodesForEvent - Raw SQL (JOIN): etkinliğin aşamalarındaki eşleşen kodlar
+func (r *SalePhaseRepository) FindCodesForEvent(eventID int64, code string) ([]*models.SalePhaseCode, error) {
+	query := `
+		SELECT c.id, c.sale_phase_id, c.code, c.max_uses, c.user_id, c.created_at, c.updated_at
+		FROM sale_phase_codes c
+		INNER JOIN sale_phases p ON p.id = c.sale_phase_id
+		WHERE p.event_id = ? AND c.code = ?
+	`
+
+	rows, err := r.db.Query(query, eventID, strings.TrimSpace(code))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sale phase codes: %w", err)
+	}
+	defer rows.Close()
+
+	var codes []*models.SalePhaseCode
+	for rows.Next() {
+		var c models.SalePhaseCode
+		var userID sql.NullInt64
+
+		if err := rows.Scan(&c.ID, &c.SalePhaseID, &c.Code, &c.MaxUses, &userID, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan sale phase code: %w", err)
+		}
+		if userID.Valid {
+			c.UserID = &userID.Int64
+		}
+
+		codes = append(codes, &c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sale phase codes: %w", err)
+	}
+
+	return codes, nil
+}
+
+// ClaimCode - SELECT ... FOR UPDATE (transaction içinde)
+// Kodu kilitler, başka kullanıcıya bağlı değilse ve kullanım hakkı kaldıysa
+// kullanıcıya bağlar. Hak yoksa false döner.
+func (r *SalePhaseRepository) ClaimCode(tx *sql.Tx, codeID, userID int64) (bool, error) {
+	var maxUses int
+	var boundUser sql.NullInt64
+
+	err := tx.QueryRow(`SELECT max_uses, user_id FROM sale_phase_codes WHERE id = ? FOR UPDATE`, codeID).
+		Scan(&maxUses, &boundUser)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to lock sale phase code: %w", err)
+	}
+
+	if boundUser.Valid && boundUser.Int64 != userID {
+		return false, nil
+	}
+
+	query := `SELECT COUNT(*) FROM tickets t WHERE t.sale_phase_code_id = ? AND ` + activeTicketCondition
+
+	var used int
+	if err := r.db.QueryRow(query, codeID, time.Now()).Scan(&used); err != nil {
+		return false, fmt.Errorf("failed to count code usage: %w", err)
+	}
+
+	if used >= maxUses {
+		return false, nil
+	}
+
+	if !boundUser.Valid {
+		_, err := tx.Exec(`UPDATE sale_phase_codes SET user_id = ?, updated_at = ? WHERE id = ?`, userID, time.Now(), codeID)
+		if err != nil {
+			return false, fmt.Errorf("failed to bind sale phase code: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+// AddToAllowList - Raw SQL (INSERT IGNORE ile tekrar eden e-postalar atlanır)
+func (r *SalePhaseRepository) AddToAllowList(phaseID int64, emails []string) (int, error) {
+	added := 0
+
+	for _, email := range emails {
+		result, err := r.db.Exec(
+			`INSERT IGNORE INTO sale_phase_allow_list (sale_phase_id, email, created_at) VALUES (?, ?, ?)`,
+			phaseID, strings.ToLower(strings.TrimSpace(email)), time.Now(),
+		)
+		if err != nil {
+			return added, fmt.Errorf("failed to add to allow list: %w", err)
+		}
+
+		if rowsAffected, _ := result.RowsAffected(); rowsAffected > 0 {
+			added++
+		}
+	}
+
+	return added, nil
+}
+
+// FindAllowListedPhaseIDs - Raw SQL: e-postanın listede olduğu aşamalar
+func (r *SalePhaseRepository) FindAllowListedPhaseIDs(eventID int64, email string) ([]int64, error) {
+	query := `
+		SELECT a.sale_phase_id
+		FROM sale_phase_allow_list a
+		INNER JOIN sale_phases p ON p.id = a.sale_phase_id
+		WHERE p.event_id = ? AND a.email = ?
+	`
+
+	rows, err := r.db.Query(query, eventID, strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query allow list: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan allow list: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
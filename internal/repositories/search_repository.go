@@ -0,0 +1,413 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+	"github.com/biyonik/event-ticketing-api/pkg/search"
+)
+
+const (
+	maxSearchTermLength = 64  // search_terms.term kolon uzunluğu
+	maxTermCandidates   = 500 // Yazım hatası için sözlükten çekilen en fazla aday
+)
+
+// SearchRepository, search.Index arayüzünün MySQL FULLTEXT uygulamasıdır.
+// Belgeler event_search_documents tablosunda normalize edilmiş metinle tutulur;
+// yazım hatası toleransı search_terms sözlüğünden aday kelime genişletmesiyle sağlanır.
+type SearchRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+var _ search.Index = (*SearchRepository)(nil)
+
+func NewSearchRepository(db *sql.DB) *SearchRepository {
+	return &SearchRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// Upsert - Raw SQL (INSERT ... ON DUPLICATE KEY UPDATE builder'da yok)
+// Belgenin kelimeleri sözlüğe de eklenir.
+func (r *SearchRepository) Upsert(doc *search.Document) error {
+	if err := r.addTerms(doc); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO event_search_documents (event_id, title, body, venue, city, city_name, type, start_time, price)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			title = VALUES(title),
+			body = VALUES(body),
+			venue = VALUES(venue),
+			city = VALUES(city),
+			city_name = VALUES(city_name),
+			type = VALUES(type),
+			start_time = VALUES(start_time),
+			price = VALUES(price)
+	`
+
+	_, err := r.db.Exec(query,
+		doc.ID,
+		search.Normalize(doc.Title),
+		search.Normalize(doc.Body),
+		search.Normalize(doc.Venue),
+		search.Normalize(doc.City),
+		doc.City,
+		doc.Type,
+		doc.StartTime,
+		doc.Price,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save search document: %w", err)
+	}
+
+	return nil
+}
+
+// addTerms - Raw SQL (INSERT IGNORE): belgenin kelimelerini sözlüğe ekler
+func (r *SearchRepository) addTerms(doc *search.Document) error {
+	seen := make(map[string]bool)
+	var placeholders []string
+	var args []interface{}
+
+	for _, text := range []string{doc.Title, doc.Venue, doc.City, doc.Body} {
+		for _, term := range search.Tokenize(text) {
+			if seen[term] || utf8.RuneCountInString(term) > maxSearchTermLength {
+				continue
+			}
+			seen[term] = true
+			placeholders = append(placeholders, "(?)")
+			args = append(args, term)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := `INSERT IGNORE INTO search_terms (term) VALUES ` + strings.Join(placeholders, ", ")
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("failed to save search terms: %w", err)
+	}
+
+	return nil
+}
+
+// Delete - Builder ile belgeyi indeksten çıkarır
+func (r *SearchRepository) Delete(id int64) error {
+	_, err := database.NewBuilder(r.db, r.grammar).
+		Table("event_search_documents").
+		Where("event_id", "=", id).
+		ExecDelete()
+
+	if err != nil {
+		return fmt.Errorf("failed to delete search document: %w", err)
+	}
+
+	return nil
+}
+
+// searchClause - WHERE koşulu ve parametreleri
+type searchClause struct {
+	sql  string
+	args []interface{}
+}
+
+// Search - Raw SQL (MATCH ... AGAINST, FULLTEXT builder'da yok)
+// Alaka puanı: ad eşleşmesi 3 kat + tüm alanlar. Facet'ler her boyut için o boyutun
+// filtresi hariç tutularak GROUP BY ile sayılır.
+func (r *SearchRepository) Search(query *search.Query) (*search.Result, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := query.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// 1. Text: each token becomes a required group of itself (as prefix) and its typo expansions
+	required, optional, err := r.booleanQuery(search.Tokenize(query.Text))
+	if err != nil {
+		return nil, err
+	}
+
+	base := searchClause{sql: `d.start_time >= ?`, args: []interface{}{now}}
+	scoreSQL := `0`
+	var scoreArgs []interface{}
+	if required != "" {
+		base.sql += ` AND MATCH(d.title, d.body, d.venue, d.city) AGAINST (? IN BOOLEAN MODE)`
+		base.args = append(base.args, required)
+		scoreSQL = `MATCH(d.title) AGAINST (? IN BOOLEAN MODE) * ? + MATCH(d.title, d.body, d.venue, d.city) AGAINST (? IN BOOLEAN MODE)`
+		scoreArgs = []interface{}{optional, search.WeightTitle, optional}
+	}
+
+	// 2. Filters per facet dimension
+	filters := searchFilters(query, now)
+
+	// 3. Hits and total
+	where, args := combineSearchClauses(base, filters, "")
+
+	var total int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM event_search_documents d WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count search results: %w", err)
+	}
+
+	hitsSQL := `SELECT d.event_id, ` + scoreSQL + ` AS score
+		FROM event_search_documents d
+		WHERE ` + where + `
+		ORDER BY score DESC, d.start_time ASC, d.event_id ASC`
+	hitsArgs := append(append([]interface{}{}, scoreArgs...), args...)
+	if query.Limit > 0 {
+		hitsSQL += ` LIMIT ? OFFSET ?`
+		hitsArgs = append(hitsArgs, query.Limit, query.Offset)
+	}
+
+	hits, err := r.scanHits(hitsSQL, hitsArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Facets
+	facets, err := r.facets(base, filters, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &search.Result{Hits: hits, Total: total, Facets: *facets}, nil
+}
+
+// booleanQuery builds the BOOLEAN MODE expressions: "+(konser* konsre)" per token for
+// matching and the same groups without "+" for the title score
+func (r *SearchRepository) booleanQuery(tokens []string) (string, string, error) {
+	var required, optional []string
+
+	for _, token := range tokens {
+		terms := []string{token + "*"}
+
+		if search.MaxEdits(token) > 0 {
+			candidates, err := r.termCandidates(search.VocabularyPrefix(token))
+			if err != nil {
+				return "", "", err
+			}
+			for _, term := range search.Expand(token, candidates) {
+				if !strings.HasPrefix(term, token) {
+					terms = append(terms, term)
+				}
+			}
+		}
+
+		group := "(" + strings.Join(terms, " ") + ")"
+		required = append(required, "+"+group)
+		optional = append(optional, group)
+	}
+
+	return strings.Join(required, " "), strings.Join(optional, " "), nil
+}
+
+// termCandidates - Raw SQL: sözlükten önekle başlayan kelimeler
+func (r *SearchRepository) termCandidates(prefix string) ([]string, error) {
+	rows, err := r.db.Query(`SELECT term FROM search_terms WHERE term LIKE ? LIMIT ?`, prefix+"%", maxTermCandidates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query search terms: %w", err)
+	}
+	defer rows.Close()
+
+	var terms []string
+	for rows.Next() {
+		var term string
+		if err := rows.Scan(&term); err != nil {
+			return nil, fmt.Errorf("failed to scan search term: %w", err)
+		}
+		terms = append(terms, term)
+	}
+
+	return terms, rows.Err()
+}
+
+// searchFilters returns the filter clause of each requested dimension
+func searchFilters(query *search.Query, now time.Time) map[string]searchClause {
+	filters := make(map[string]searchClause)
+
+	if query.Type != "" {
+		filters[search.DimensionType] = searchClause{sql: `d.type = ?`, args: []interface{}{query.Type}}
+	}
+
+	if query.City != "" {
+		filters[search.DimensionCity] = searchClause{sql: `d.city = ?`, args: []interface{}{search.Normalize(query.City)}}
+	}
+
+	if query.DateBucket != "" {
+		var from time.Time
+		for _, bucket := range search.DateBuckets(now) {
+			if bucket.Key != query.DateBucket {
+				from = bucket.Until
+				continue
+			}
+			clause := searchClause{sql: `1 = 1`}
+			if !from.IsZero() {
+				clause.sql = `d.start_time >= ?`
+				clause.args = append(clause.args, from)
+			}
+			if !bucket.Until.IsZero() {
+				clause.sql += ` AND d.start_time < ?`
+				clause.args = append(clause.args, bucket.Until)
+			}
+			filters[search.DimensionDate] = clause
+			break
+		}
+	}
+
+	if priceRange := search.FindPriceRange(query.PriceRange); priceRange != nil {
+		clause := searchClause{sql: `d.price >= ?`, args: []interface{}{priceRange.Min}}
+		if priceRange.Max > 0 {
+			clause.sql += ` AND d.price < ?`
+			clause.args = append(clause.args, priceRange.Max)
+		}
+		filters[search.DimensionPrice] = clause
+	}
+
+	return filters
+}
+
+// combineSearchClauses joins the base clause with every filter except the skipped dimension
+func combineSearchClauses(base searchClause, filters map[string]searchClause, skip string) (string, []interface{}) {
+	where := []string{base.sql}
+	args := append([]interface{}{}, base.args...)
+
+	// Sabit sıra: parametre sırası koşul sırasıyla aynı olmalı
+	for _, dimension := range []string{search.DimensionType, search.DimensionCity, search.DimensionDate, search.DimensionPrice} {
+		clause, ok := filters[dimension]
+		if !ok || dimension == skip {
+			continue
+		}
+		where = append(where, "("+clause.sql+")")
+		args = append(args, clause.args...)
+	}
+
+	return strings.Join(where, " AND "), args
+}
+
+func (r *SearchRepository) scanHits(query string, args []interface{}) ([]search.Hit, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query search results: %w", err)
+	}
+	defer rows.Close()
+
+	hits := []search.Hit{}
+	for rows.Next() {
+		var hit search.Hit
+		if err := rows.Scan(&hit.ID, &hit.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan search result: %w", err)
+		}
+		hits = append(hits, hit)
+	}
+
+	return hits, rows.Err()
+}
+
+// facets - Raw SQL (GROUP BY): her boyut kendi filtresi hariç sayılır
+func (r *SearchRepository) facets(base searchClause, filters map[string]searchClause, now time.Time) (*search.Facets, error) {
+	facets := &search.Facets{}
+
+	// Type
+	where, args := combineSearchClauses(base, filters, search.DimensionType)
+	counts, _, err := r.countFacet(`d.type`, ``, where, args)
+	if err != nil {
+		return nil, err
+	}
+	facets.Types = search.CountFacet(counts, nil)
+
+	// City (normalize edilmiş kolona göre gruplanır, orijinal yazım etiket olur)
+	where, args = combineSearchClauses(base, filters, search.DimensionCity)
+	counts, labels, err := r.countFacet(`d.city`, `MIN(d.city_name)`, where, args)
+	if err != nil {
+		return nil, err
+	}
+	facets.Cities = search.CountFacet(counts, labels)
+
+	// Date bucket
+	dateSQL := `CASE`
+	var dateArgs []interface{}
+	for _, bucket := range search.DateBuckets(now) {
+		if bucket.Until.IsZero() {
+			dateSQL += ` ELSE '` + bucket.Key + `'`
+			continue
+		}
+		dateSQL += ` WHEN d.start_time < ? THEN '` + bucket.Key + `'`
+		dateArgs = append(dateArgs, bucket.Until)
+	}
+	dateSQL += ` END`
+
+	where, args = combineSearchClauses(base, filters, search.DimensionDate)
+	counts, _, err = r.countFacet(dateSQL, ``, where, append(dateArgs, args...))
+	if err != nil {
+		return nil, err
+	}
+	facets.Dates = search.OrderedFacet(search.DimensionDate, counts, now)
+
+	// Price range
+	priceSQL := `CASE`
+	var priceArgs []interface{}
+	for _, priceRange := range search.PriceRanges {
+		if priceRange.Max == 0 {
+			priceSQL += ` ELSE '` + priceRange.Key + `'`
+			continue
+		}
+		priceSQL += ` WHEN d.price < ? THEN '` + priceRange.Key + `'`
+		priceArgs = append(priceArgs, priceRange.Max)
+	}
+	priceSQL += ` END`
+
+	where, args = combineSearchClauses(base, filters, search.DimensionPrice)
+	counts, _, err = r.countFacet(priceSQL, ``, where, append(priceArgs, args...))
+	if err != nil {
+		return nil, err
+	}
+	facets.Prices = search.OrderedFacet(search.DimensionPrice, counts, now)
+
+	return facets, nil
+}
+
+// countFacet groups by the value expression; labelSQL optionally selects a display label
+func (r *SearchRepository) countFacet(valueSQL, labelSQL, where string, args []interface{}) (map[string]int, map[string]string, error) {
+	if labelSQL == "" {
+		labelSQL = `''`
+	}
+
+	query := `SELECT ` + valueSQL + ` AS facet_value, ` + labelSQL + ` AS facet_label, COUNT(*)
+		FROM event_search_documents d
+		WHERE ` + where + `
+		GROUP BY facet_value`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query search facets: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	labels := make(map[string]string)
+	for rows.Next() {
+		var value, label string
+		var count int
+		if err := rows.Scan(&value, &label, &count); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan search facet: %w", err)
+		}
+		counts[value] = count
+		if label != "" {
+			labels[value] = label
+		}
+	}
+
+	return counts, labels, rows.Err()
+}
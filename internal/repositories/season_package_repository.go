@@ -0,0 +1,294 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type SeasonPackageRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewSeasonPackageRepository(db *sql.DB) *SeasonPackageRepository {
+	return &SeasonPackageRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// Create - Conduit-Go Builder ile paket oluşturma
+func (r *SeasonPackageRepository) Create(pkg *models.SeasonPackage) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("season_packages").
+		ExecInsert(map[string]interface{}{
+			"venue_id":            pkg.VenueID,
+			"name":                pkg.Name,
+			"season":              pkg.Season,
+			"price":               pkg.Price,
+			"status":              pkg.Status,
+			"sale_starts_at":      pkg.SaleStartsAt,
+			"sale_ends_at":        pkg.SaleEndsAt,
+			"previous_package_id": pkg.PreviousPackageID,
+			"renewal_deadline":    pkg.RenewalDeadline,
+			"created_at":          pkg.CreatedAt,
+			"updated_at":          pkg.UpdatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create season package: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// AddEvents - Raw SQL (INSERT IGNORE: aynı etkinlik tekrar eklenmez)
+func (r *SeasonPackageRepository) AddEvents(packageID int64, eventIDs []int64) error {
+	for _, eventID := range eventIDs {
+		_, err := r.db.Exec(
+			`INSERT IGNORE INTO season_package_events (season_package_id, event_id, created_at) VALUES (?, ?, ?)`,
+			packageID, eventID, time.Now(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to add season package event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// FindByID - Builder ile tek paket (etkinlik listesiyle birlikte)
+func (r *SeasonPackageRepository) FindByID(id int64) (*models.SeasonPackage, error) {
+	var pkg models.SeasonPackage
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("season_packages").
+		Where("id", "=", id).
+		First(&pkg)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("season package not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find season package: %w", err)
+	}
+
+	eventIDs, err := r.FindEventIDs(id)
+	if err != nil {
+		return nil, err
+	}
+	pkg.EventIDs = eventIDs
+
+	return &pkg, nil
+}
+
+// FindByVenueID - Builder ile mekanın paketleri
+func (r *SeasonPackageRepository) FindByVenueID(venueID int64) ([]*models.SeasonPackage, error) {
+	var packages []*models.SeasonPackage
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("season_packages").
+		Where("venue_id", "=", venueID).
+		OrderBy("sale_starts_at", "DESC").
+		Get(&packages)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query season packages: %w", err)
+	}
+
+	return packages, nil
+}
+
+// FindEventIDs - Raw SQL: paketteki etkinlikler (başlangıç sırasına göre)
+func (r *SeasonPackageRepository) FindEventIDs(packageID int64) ([]int64, error) {
+	query := `
+		SELECT pe.event_id
+		FROM season_package_events pe
+		INNER JOIN events e ON e.id = pe.event_id
+		WHERE pe.season_package_id = ?
+		ORDER BY e.start_time ASC
+	`
+
+	rows, err := r.db.Query(query, packageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query season package events: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan season package event: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// UpdateStatus - Builder ile paket durumu güncelleme
+func (r *SeasonPackageRepository) UpdateStatus(id int64, status models.SeasonPackageStatus) error {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("season_packages").
+		Where("id", "=", id).
+		ExecUpdate(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to update season package status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("season package not found")
+	}
+
+	return nil
+}
+
+// LockByID - SELECT ... FOR UPDATE (transaction içinde)
+// Aynı paketteki kombine satışlarını sıralar; koltuk kontrolü ile kayıt aynı kilit altında yapılır.
+func (r *SeasonPackageRepository) LockByID(tx *sql.Tx, packageID int64) error {
+	var id int64
+	if err := tx.QueryRow(`SELECT id FROM season_packages WHERE id = ? FOR UPDATE`, packageID).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("season package not found")
+		}
+		return fmt.Errorf("failed to lock season package: %w", err)
+	}
+
+	return nil
+}
+
+// CreateMembership - Builder ile transaction içinde kombine oluşturma
+func (r *SeasonPackageRepository) CreateMembership(tx *sql.Tx, membership *models.SeasonMembership) (int64, error) {
+	result, err := database.NewBuilder(tx, r.grammar).
+		Table("season_memberships").
+		ExecInsert(map[string]interface{}{
+			"season_package_id": membership.SeasonPackageID,
+			"user_id":           membership.UserID,
+			"section_id":        membership.SectionID,
+			"seat_id":           membership.SeatID,
+			"price":             membership.Price,
+			"status":            membership.Status,
+			"renewed_from_id":   membership.RenewedFromID,
+			"created_at":        membership.CreatedAt,
+			"updated_at":        membership.UpdatedAt,
+		})
+
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return 0, fmt.Errorf("season membership already renewed")
+		}
+		return 0, fmt.Errorf("failed to create season membership: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindMembershipByID - Builder ile tek kombine
+func (r *SeasonPackageRepository) FindMembershipByID(id int64) (*models.SeasonMembership, error) {
+	var membership models.SeasonMembership
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("season_memberships").
+		Where("id", "=", id).
+		First(&membership)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("season membership not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find season membership: %w", err)
+	}
+
+	return &membership, nil
+}
+
+// FindMembershipsByUserID - Builder ile kullanıcının kombineleri
+func (r *SeasonPackageRepository) FindMembershipsByUserID(userID int64) ([]*models.SeasonMembership, error) {
+	var memberships []*models.SeasonMembership
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("season_memberships").
+		Where("user_id", "=", userID).
+		OrderBy("created_at", "DESC").
+		Get(&memberships)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query season memberships: %w", err)
+	}
+
+	return memberships, nil
+}
+
+// FindActiveSeatHolder - Builder ile paketteki koltuğun aktif kombine sahibi (yoksa nil)
+func (r *SeasonPackageRepository) FindActiveSeatHolder(packageID, seatID int64) (*models.SeasonMembership, error) {
+	var membership models.SeasonMembership
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("season_memberships").
+		Where("season_package_id", "=", packageID).
+		Where("seat_id", "=", seatID).
+		Where("status", "=", models.SeasonMembershipStatusActive).
+		First(&membership)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find seat holder: %w", err)
+	}
+
+	return &membership, nil
+}
+
+// IsSeatHeldForRenewal - Raw SQL (JOIN + NOT EXISTS)
+// Etkinliği kapsayan ve yenileme süresi devam eden bir paket varsa, önceki
+// sezonda bu koltuğa sahip olup henüz yenilemeyen kombine sahibi için koltuk ayrılmıştır.
+func (r *SeasonPackageRepository) IsSeatHeldForRenewal(eventID, seatID int64) (bool, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM season_package_events pe
+		INNER JOIN season_packages p ON p.id = pe.season_package_id
+		INNER JOIN season_memberships m ON m.season_package_id = p.previous_package_id
+		WHERE pe.event_id = ?
+		  AND p.renewal_deadline > ?
+		  AND m.seat_id = ?
+		  AND m.status = ?
+		  AND NOT EXISTS (
+		      SELECT 1 FROM season_memberships r
+		      WHERE r.renewed_from_id = m.id AND r.status = ?
+		  )
+	`
+
+	var count int
+	err := r.db.QueryRow(query, eventID, time.Now(), seatID,
+		models.SeasonMembershipStatusActive, models.SeasonMembershipStatusActive).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check renewal hold: %w", err)
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,235 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type SettlementRepository struct {
+	db          *sql.DB
+	grammar     database.Grammar
+	organizerID int64 // 0 = kısıtsız; > 0 ise sadece organizatörün ekstreleri
+}
+
+func NewSettlementRepository(db *sql.DB) *SettlementRepository {
+	return &SettlementRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// ForOrganizer - organizatöre kısıtlı kopya döndürür (0 = kısıtsız)
+func (r *SettlementRepository) ForOrganizer(organizerID int64) *SettlementRepository {
+	scoped := *r
+	scoped.organizerID = organizerID
+	return &scoped
+}
+
+// query - settlement_statements için Builder (organizatör kısıtı otomatik eklenir)
+func (r *SettlementRepository) query() *database.QueryBuilder {
+	builder := database.NewBuilder(r.db, r.grammar).Table("settlement_statements")
+	if r.organizerID > 0 {
+		builder.Where("organizer_id", "=", r.organizerID)
+	}
+	return builder
+}
+
+// settlementMovementColumns - ödeme hareket türü → hareketin zaman kolonu
+var settlementMovementColumns = []struct {
+	lineType models.SettlementLineType
+	column   string
+}{
+	{models.SettlementLineSale, "paid_at"},
+	{models.SettlementLineRefund, "refunded_at"},
+	{models.SettlementLineChargeback, "charged_back_at"},
+}
+
+// FindUnsettledMovements - Raw SQL (UNION ALL): dönemde gerçekleşmiş ve henüz hiçbir ekstreye
+// girmemiş satış, iade ve ters ibraz hareketleri
+func (r *SettlementRepository) FindUnsettledMovements(organizerID int64, currency string, periodStart, periodEnd time.Time) ([]*models.SettlementMovement, error) {
+	parts := make([]string, 0, len(settlementMovementColumns))
+	args := make([]interface{}, 0, len(settlementMovementColumns)*6)
+
+	for _, movement := range settlementMovementColumns {
+		parts = append(parts, fmt.Sprintf(`
+			SELECT p.id, p.event_id, ? AS line_type, p.amount, p.%[1]s AS occurred_at
+			FROM payments p
+			INNER JOIN events e ON e.id = p.event_id
+			WHERE e.organizer_id = ? AND p.currency = ?
+			  AND p.%[1]s >= ? AND p.%[1]s < ?
+			  AND NOT EXISTS (
+			      SELECT 1 FROM settlement_lines l
+			      WHERE l.payment_id = p.id AND l.line_type = ?
+			  )`, movement.column))
+		args = append(args, movement.lineType, organizerID, currency, periodStart, periodEnd, movement.lineType)
+	}
+
+	query := strings.Join(parts, "\nUNION ALL\n") + "\nORDER BY occurred_at ASC, id ASC"
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query settlement movements: %w", err)
+	}
+	defer rows.Close()
+
+	var movements []*models.SettlementMovement
+	for rows.Next() {
+		var movement models.SettlementMovement
+		if err := rows.Scan(&movement.PaymentID, &movement.EventID, &movement.Type,
+			&movement.Amount, &movement.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan settlement movement: %w", err)
+		}
+		movements = append(movements, &movement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate settlement movements: %w", err)
+	}
+
+	return movements, nil
+}
+
+// CreateStatement - Builder ile transaction içinde ekstre oluşturma
+func (r *SettlementRepository) CreateStatement(tx *sql.Tx, statement *models.SettlementStatement) (int64, error) {
+	result, err := database.NewBuilder(tx, r.grammar).
+		Table("settlement_statements").
+		ExecInsert(map[string]interface{}{
+			"organizer_id":      statement.OrganizerID,
+			"period_start":      statement.PeriodStart,
+			"period_end":        statement.PeriodEnd,
+			"currency":          statement.Currency,
+			"gross_amount":      statement.GrossAmount,
+			"refund_amount":     statement.RefundAmount,
+			"chargeback_amount": statement.ChargebackAmount,
+			"fee_amount":        statement.FeeAmount,
+			"net_amount":        statement.NetAmount,
+			"line_count":        statement.LineCount,
+			"status":            statement.Status,
+			"created_at":        statement.CreatedAt,
+			"updated_at":        statement.UpdatedAt,
+		})
+
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return 0, fmt.Errorf("settlement statement already exists for period")
+		}
+		return 0, fmt.Errorf("failed to create settlement statement: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// CreateLine - Builder ile transaction içinde ekstre kalemi (hareket başka ekstrede varsa duplicate key)
+func (r *SettlementRepository) CreateLine(tx *sql.Tx, line *models.SettlementLine) (int64, error) {
+	result, err := database.NewBuilder(tx, r.grammar).
+		Table("settlement_lines").
+		ExecInsert(map[string]interface{}{
+			"statement_id": line.StatementID,
+			"payment_id":   line.PaymentID,
+			"event_id":     line.EventID,
+			"line_type":    line.LineType,
+			"amount":       line.Amount,
+			"occurred_at":  line.OccurredAt,
+			"created_at":   line.CreatedAt,
+		})
+
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return 0, fmt.Errorf("payment movement already settled")
+		}
+		return 0, fmt.Errorf("failed to create settlement line: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindStatementByID - Builder ile tek ekstre
+func (r *SettlementRepository) FindStatementByID(id int64) (*models.SettlementStatement, error) {
+	var statement models.SettlementStatement
+
+	err := r.query().
+		Where("id", "=", id).
+		First(&statement)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("settlement statement not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find settlement statement: %w", err)
+	}
+
+	return &statement, nil
+}
+
+// FindStatementsByOrganizer - Builder ile organizatörün ekstreleri (yeniden eskiye)
+func (r *SettlementRepository) FindStatementsByOrganizer(organizerID int64) ([]*models.SettlementStatement, error) {
+	var statements []*models.SettlementStatement
+
+	err := r.query().
+		Where("organizer_id", "=", organizerID).
+		OrderBy("period_start", "DESC").
+		Get(&statements)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query settlement statements: %w", err)
+	}
+
+	return statements, nil
+}
+
+// FindLines - Builder ile ekstre kalemleri
+func (r *SettlementRepository) FindLines(statementID int64) ([]*models.SettlementLine, error) {
+	var lines []*models.SettlementLine
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("settlement_lines").
+		Where("statement_id", "=", statementID).
+		OrderBy("occurred_at", "ASC").
+		OrderBy("id", "ASC").
+		Get(&lines)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query settlement lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+// MarkPaid - Koşullu update: sadece issued ekstre ödendi olarak işaretlenir. Güncelleme yapıldıysa true döner.
+func (r *SettlementRepository) MarkPaid(id int64, payoutReference string, paidAt time.Time) (bool, error) {
+	result, err := r.query().
+		Where("id", "=", id).
+		Where("status", "=", models.SettlementStatusIssued).
+		ExecUpdate(map[string]interface{}{
+			"status":           models.SettlementStatusPaid,
+			"paid_at":          paidAt,
+			"payout_reference": payoutReference,
+			"updated_at":       time.Now(),
+		})
+
+	if err != nil {
+		return false, fmt.Errorf("failed to mark settlement statement paid: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -10,8 +10,9 @@ import (
 )
 
 type TicketRepository struct {
-	db      *sql.DB
-	grammar database.Grammar
+	db          *sql.DB
+	grammar     database.Grammar
+	organizerID int64 // 0 = kısıtsız; > 0 ise sadece organizatörün etkinliklerinin biletleri
 }
 
 func NewTicketRepository(db *sql.DB) *TicketRepository {
@@ -21,25 +22,81 @@ func NewTicketRepository(db *sql.DB) *TicketRepository {
 	}
 }
 
+// ForOrganizer - organizatöre kısıtlı kopya döndürür (0 = kısıtsız)
+func (r *TicketRepository) ForOrganizer(organizerID int64) *TicketRepository {
+	scoped := *r
+	scoped.organizerID = organizerID
+	return &scoped
+}
+
+// query - tickets tablosu için Builder; kısıtlıysa organizatörün etkinlikleriyle sınırlanır
+func (r *TicketRepository) query() *database.QueryBuilder {
+	builder := database.NewBuilder(r.db, r.grammar).Table("tickets")
+	if r.organizerID == 0 {
+		return builder
+	}
+
+	return builder.WhereInSub("event_id", organizerEvents(r.db, r.grammar, r.organizerID))
+}
+
+// scopeSQL - raw SQL için organizatör kısıtı
+func (r *TicketRepository) scopeSQL(query string, args ...interface{}) (string, []interface{}) {
+	if r.organizerID > 0 {
+		query += organizerEventCondition
+		args = append(args, r.organizerID)
+	}
+	return query, args
+}
+
 // Create - Conduit-Go Builder ile ticket oluşturma
 func (r *TicketRepository) Create(ticket *models.Ticket) (int64, error) {
-	result, err := database.NewBuilder(r.db, r.grammar).
+	return r.insert(r.db, ticket)
+}
+
+// CreateWithTx - Builder ile transaction içinde ticket oluşturma
+// (ör. kombine biletleri: tüm etkinlik biletleri birlikte commit edilir)
+func (r *TicketRepository) CreateWithTx(tx *sql.Tx, ticket *models.Ticket) (int64, error) {
+	return r.insert(tx, ticket)
+}
+
+func (r *TicketRepository) insert(executor database.QueryExecutor, ticket *models.Ticket) (int64, error) {
+	// Kısıtlı repository başka organizatörün etkinliğine bilet oluşturamaz.
+	// Kontrol, insert ile aynı executor (transaction) üzerinde yapılır.
+	if r.organizerID > 0 {
+		var count int
+		err := executor.QueryRow(`SELECT COUNT(*) FROM events WHERE id = ? AND organizer_id = ?`,
+			ticket.EventID, r.organizerID).Scan(&count)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check event organizer: %w", err)
+		}
+		if count == 0 {
+			return 0, fmt.Errorf("event not found")
+		}
+	}
+
+	result, err := database.NewBuilder(executor, r.grammar).
 		Table("tickets").
 		ExecInsert(map[string]interface{}{
-			"event_id":           ticket.EventID,
-			"user_id":            ticket.UserID,
-			"seat_id":            ticket.SeatID,
-			"section_id":         ticket.SectionID,
-			"ticket_number":      ticket.TicketNumber,
-			"ticket_type":        ticket.TicketType,
-			"status":             ticket.Status,
-			"price":              ticket.Price,
-			"qr_code_data":       ticket.QRCodeData,
-			"qr_code_image":      ticket.QRCodeImage,
-			"verification_code":  ticket.VerificationCode,
-			"reservation_expiry": ticket.ReservationExpiry,
-			"created_at":         ticket.CreatedAt,
-			"updated_at":         ticket.UpdatedAt,
+			"event_id":             ticket.EventID,
+			"user_id":              ticket.UserID,
+			"seat_id":              ticket.SeatID,
+			"section_id":           ticket.SectionID,
+			"ticket_number":        ticket.TicketNumber,
+			"ticket_type":          ticket.TicketType,
+			"status":               ticket.Status,
+			"price":                ticket.Price,
+			"card_fingerprint":     ticket.CardFingerprint,
+			"address_hash":         ticket.AddressHash,
+			"limit_override_by":    ticket.LimitOverrideBy,
+			"sale_phase_id":        ticket.SalePhaseID,
+			"sale_phase_code_id":   ticket.SalePhaseCodeID,
+			"season_membership_id": ticket.SeasonMembershipID,
+			"qr_code_data":         ticket.QRCodeData,
+			"qr_code_image":        ticket.QRCodeImage,
+			"verification_code":    ticket.VerificationCode,
+			"reservation_expiry":   ticket.ReservationExpiry,
+			"created_at":           ticket.CreatedAt,
+			"updated_at":           ticket.UpdatedAt,
 		})
 
 	if err != nil {
@@ -58,8 +115,7 @@ func (r *TicketRepository) Create(ticket *models.Ticket) (int64, error) {
 func (r *TicketRepository) FindByID(id int64) (*models.Ticket, error) {
 	var ticket models.Ticket
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	err := r.query().
 		Where("id", "=", id).
 		First(&ticket)
 
@@ -77,8 +133,7 @@ func (r *TicketRepository) FindByID(id int64) (*models.Ticket, error) {
 func (r *TicketRepository) FindByTicketNumber(ticketNumber string) (*models.Ticket, error) {
 	var ticket models.Ticket
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	err := r.query().
 		Where("ticket_number", "=", ticketNumber).
 		First(&ticket)
 
@@ -96,8 +151,7 @@ func (r *TicketRepository) FindByTicketNumber(ticketNumber string) (*models.Tick
 func (r *TicketRepository) FindByUserID(userID int64) ([]*models.Ticket, error) {
 	var tickets []*models.Ticket
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	err := r.query().
 		Where("user_id", "=", userID).
 		OrderBy("created_at", "DESC").
 		Get(&tickets)
@@ -113,8 +167,7 @@ func (r *TicketRepository) FindByUserID(userID int64) ([]*models.Ticket, error)
 func (r *TicketRepository) FindByEventID(eventID int64) ([]*models.Ticket, error) {
 	var tickets []*models.Ticket
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	err := r.query().
 		Where("event_id", "=", eventID).
 		OrderBy("created_at", "DESC").
 		Get(&tickets)
@@ -126,21 +179,39 @@ func (r *TicketRepository) FindByEventID(eventID int64) ([]*models.Ticket, error
 	return tickets, nil
 }
 
-// Update - Builder ile ticket güncelleme
+// FindBySeasonMembershipID - Builder ile kombineye ait etkinlik biletleri
+func (r *TicketRepository) FindBySeasonMembershipID(membershipID int64) ([]*models.Ticket, error) {
+	var tickets []*models.Ticket
+
+	err := r.query().
+		Where("season_membership_id", "=", membershipID).
+		OrderBy("event_id", "ASC").
+		Get(&tickets)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tickets: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// Update - Builder ile version koşullu ticket güncelleme (optimistic locking).
+// Bilet okunduktan sonra değiştiyse *models.ConflictError döner.
 func (r *TicketRepository) Update(ticket *models.Ticket) error {
 	ticket.UpdatedAt = time.Now()
 
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	result, err := r.query().
 		Where("id", "=", ticket.ID).
-		ExecUpdate(map[string]interface{}{
+		ExecUpdateIfVersion(map[string]interface{}{
 			"status":             ticket.Status,
+			"card_fingerprint":   ticket.CardFingerprint,
+			"address_hash":       ticket.AddressHash,
 			"reservation_expiry": ticket.ReservationExpiry,
 			"purchased_at":       ticket.PurchasedAt,
 			"used_at":            ticket.UsedAt,
 			"cancelled_at":       ticket.CancelledAt,
 			"updated_at":         ticket.UpdatedAt,
-		})
+		}, ticket.Version)
 
 	if err != nil {
 		return fmt.Errorf("failed to update ticket: %w", err)
@@ -152,20 +223,37 @@ func (r *TicketRepository) Update(ticket *models.Ticket) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("ticket not found")
+		return r.versionConflict(ticket.ID, ticket.Version)
 	}
 
+	ticket.Version++
 	return nil
 }
 
+// versionConflict - koşullu güncelleme satır etkilemediğinde biletin bulunamadı mı
+// yoksa başka bir işlem tarafından değiştirilmiş mi olduğunu ayırır
+func (r *TicketRepository) versionConflict(id, expected int64) error {
+	current, err := r.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	return &models.ConflictError{
+		Entity:   models.AuditEntityTicket,
+		ID:       id,
+		Expected: expected,
+		Current:  current.Version,
+	}
+}
+
 // UpdateStatus - Builder ile status güncelleme
 func (r *TicketRepository) UpdateStatus(id int64, status models.TicketStatus) error {
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	result, err := r.query().
 		Where("id", "=", id).
 		ExecUpdate(map[string]interface{}{
 			"status":     status,
 			"updated_at": time.Now(),
+			"version":    database.Increment(1),
 		})
 
 	if err != nil {
@@ -188,8 +276,7 @@ func (r *TicketRepository) UpdateStatus(id int64, status models.TicketStatus) er
 func (r *TicketRepository) MarkAsSold(id int64) error {
 	now := time.Now()
 
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	result, err := r.query().
 		Where("id", "=", id).
 		Where("status", "=", models.TicketStatusReserved).
 		ExecUpdate(map[string]interface{}{
@@ -197,6 +284,7 @@ func (r *TicketRepository) MarkAsSold(id int64) error {
 			"purchased_at":       now,
 			"reservation_expiry": nil,
 			"updated_at":         now,
+			"version":            database.Increment(1),
 		})
 
 	if err != nil {
@@ -219,14 +307,14 @@ func (r *TicketRepository) MarkAsSold(id int64) error {
 func (r *TicketRepository) MarkAsUsed(id int64) error {
 	now := time.Now()
 
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	result, err := r.query().
 		Where("id", "=", id).
 		Where("status", "=", models.TicketStatusSold).
 		ExecUpdate(map[string]interface{}{
 			"status":     models.TicketStatusUsed,
 			"used_at":    now,
 			"updated_at": now,
+			"version":    database.Increment(1),
 		})
 
 	if err != nil {
@@ -249,14 +337,14 @@ func (r *TicketRepository) MarkAsUsed(id int64) error {
 func (r *TicketRepository) MarkAsCancelled(id int64) error {
 	now := time.Now()
 
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	result, err := r.query().
 		Where("id", "=", id).
 		WhereIn("status", []interface{}{models.TicketStatusReserved, models.TicketStatusSold}).
 		ExecUpdate(map[string]interface{}{
 			"status":       models.TicketStatusCancelled,
 			"cancelled_at": now,
 			"updated_at":   now,
+			"version":      database.Increment(1),
 		})
 
 	if err != nil {
@@ -279,8 +367,7 @@ func (r *TicketRepository) MarkAsCancelled(id int64) error {
 func (r *TicketRepository) FindExpiredReservations() ([]*models.Ticket, error) {
 	var tickets []*models.Ticket
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	err := r.query().
 		Where("status", "=", models.TicketStatusReserved).
 		Where("reservation_expiry", "<", time.Now()).
 		Get(&tickets)
@@ -294,14 +381,14 @@ func (r *TicketRepository) FindExpiredReservations() ([]*models.Ticket, error) {
 
 // ExpireReservation - Builder ile reservation expire
 func (r *TicketRepository) ExpireReservation(id int64) error {
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("tickets").
+	result, err := r.query().
 		Where("id", "=", id).
 		Where("status", "=", models.TicketStatusReserved).
 		Where("reservation_expiry", "<", time.Now()).
 		ExecUpdate(map[string]interface{}{
 			"status":     models.TicketStatusExpired,
 			"updated_at": time.Now(),
+			"version":    database.Increment(1),
 		})
 
 	if err != nil {
@@ -328,8 +415,10 @@ func (r *TicketRepository) GetSoldTicketCountByEvent(eventID int64) (int, error)
 		WHERE event_id = ? AND status IN (?, ?)
 	`
 
+	query, args := r.scopeSQL(query, eventID, models.TicketStatusSold, models.TicketStatusUsed)
+
 	var count int
-	err := r.db.QueryRow(query, eventID, models.TicketStatusSold, models.TicketStatusUsed).Scan(&count)
+	err := r.db.QueryRow(query, args...).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get sold ticket count: %w", err)
 	}
@@ -337,6 +426,41 @@ func (r *TicketRepository) GetSoldTicketCountByEvent(eventID int64) (int, error)
 	return count, nil
 }
 
+// CountActiveByBuyerWithTx - COUNT query: alıcı kimliğine göre rezerve + satılmış biletler.
+// Süresi dolmuş ama henüz expire edilmemiş rezervasyonlar sayılmaz. Limit satırını
+// kilitleyen transaction içinde sayılır.
+func (r *TicketRepository) CountActiveByBuyerWithTx(tx *sql.Tx, eventID int64, scope models.PurchaseLimitScope, value interface{}) (int, error) {
+	columns := map[models.PurchaseLimitScope]string{
+		models.PurchaseLimitScopeUser:    "user_id",
+		models.PurchaseLimitScopeCard:    "card_fingerprint",
+		models.PurchaseLimitScopeAddress: "address_hash",
+	}
+
+	column, ok := columns[scope]
+	if !ok {
+		return 0, fmt.Errorf("unknown purchase limit scope: %s", scope)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM tickets
+		WHERE event_id = ? AND %s = ?
+		  AND (status IN (?, ?) OR (status = ? AND reservation_expiry > ?))
+	`, column)
+
+	query, args := r.scopeSQL(query, eventID, value,
+		models.TicketStatusSold, models.TicketStatusUsed,
+		models.TicketStatusReserved, time.Now())
+
+	var count int
+	err := tx.QueryRow(query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count buyer tickets: %w", err)
+	}
+
+	return count, nil
+}
+
 // IsSeatTaken - COUNT query (raw SQL for aggregate)
 func (r *TicketRepository) IsSeatTaken(eventID, seatID int64) (bool, error) {
 	query := `
@@ -345,9 +469,11 @@ func (r *TicketRepository) IsSeatTaken(eventID, seatID int64) (bool, error) {
 		WHERE event_id = ? AND seat_id = ? AND status IN (?, ?, ?)
 	`
 
+	query, args := r.scopeSQL(query, eventID, seatID,
+		models.TicketStatusReserved, models.TicketStatusSold, models.TicketStatusUsed)
+
 	var count int
-	err := r.db.QueryRow(query, eventID, seatID,
-		models.TicketStatusReserved, models.TicketStatusSold, models.TicketStatusUsed).Scan(&count)
+	err := r.db.QueryRow(query, args...).Scan(&count)
 	if err != nil {
 		return false, fmt.Errorf("failed to check seat availability: %w", err)
 	}
@@ -355,6 +481,81 @@ func (r *TicketRepository) IsSeatTaken(eventID, seatID int64) (bool, error) {
 	return count > 0, nil
 }
 
+// IsCompanionSeatHeld - COUNT query (raw SQL JOIN): koltuk, başka bir alıcının aktif bileti olan
+// erişilebilir koltuğun refakatçi koltuğu mu? Erişilebilir koltuğun bileti iptal olduğunda
+// veya süresi dolduğunda tutma kendiliğinden kalkar.
+func (r *TicketRepository) IsCompanionSeatHeld(eventID, seatID, userID int64) (bool, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM seats c
+		INNER JOIN seats w ON w.id = c.companion_seat_id
+		INNER JOIN tickets t ON t.seat_id = w.id
+		WHERE c.id = ? AND t.event_id = ? AND t.user_id != ?
+			AND t.status IN (?, ?, ?)
+			AND FIND_IN_SET(?, w.attributes) > 0
+	`
+
+	var count int
+	err := r.db.QueryRow(query, seatID, eventID, userID,
+		models.TicketStatusReserved, models.TicketStatusSold, models.TicketStatusUsed,
+		models.SeatAttributeWheelchair).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check companion seat: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// FindUnavailableSeats - Raw SQL (UNION): etkinlikte dolu veya tutulan koltuklar.
+// Biletli koltuklar taken; bekleme listesi teklifleri ve userID dışındaki alıcılar için
+// tutulan refakatçi koltukları held döner.
+func (r *TicketRepository) FindUnavailableSeats(eventID, userID int64) (map[int64]models.SeatStatus, error) {
+	query := `
+		SELECT seat_id, ? FROM tickets
+		WHERE event_id = ? AND seat_id IS NOT NULL AND status IN (?, ?, ?)
+		UNION ALL
+		SELECT seat_id, ? FROM waiting_list_offers
+		WHERE event_id = ? AND seat_id IS NOT NULL AND status = ?
+		UNION ALL
+		SELECT w.companion_seat_id, ? FROM tickets t
+		INNER JOIN seats w ON w.id = t.seat_id
+		WHERE t.event_id = ? AND t.user_id != ? AND t.status IN (?, ?, ?)
+			AND w.companion_seat_id IS NOT NULL AND FIND_IN_SET(?, w.attributes) > 0
+	`
+
+	rows, err := r.db.Query(query,
+		models.SeatStatusTaken, eventID,
+		models.TicketStatusReserved, models.TicketStatusSold, models.TicketStatusUsed,
+		models.SeatStatusHeld, eventID, models.WaitingListOfferStatusPending,
+		models.SeatStatusHeld, eventID, userID,
+		models.TicketStatusReserved, models.TicketStatusSold, models.TicketStatusUsed,
+		models.SeatAttributeWheelchair,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unavailable seats: %w", err)
+	}
+	defer rows.Close()
+
+	statuses := make(map[int64]models.SeatStatus)
+	for rows.Next() {
+		var seatID int64
+		var status models.SeatStatus
+		if err := rows.Scan(&seatID, &status); err != nil {
+			return nil, fmt.Errorf("failed to scan unavailable seat: %w", err)
+		}
+		// Bilet, tutmaya göre önceliklidir
+		if statuses[seatID] != models.SeatStatusTaken {
+			statuses[seatID] = status
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate unavailable seats: %w", err)
+	}
+
+	return statuses, nil
+}
+
 // GetRevenueByEvent - SUM query (raw SQL for aggregate)
 func (r *TicketRepository) GetRevenueByEvent(eventID int64) (float64, error) {
 	query := `
@@ -363,8 +564,10 @@ func (r *TicketRepository) GetRevenueByEvent(eventID int64) (float64, error) {
 		WHERE event_id = ? AND status IN (?, ?)
 	`
 
+	query, args := r.scopeSQL(query, eventID, models.TicketStatusSold, models.TicketStatusUsed)
+
 	var revenue float64
-	err := r.db.QueryRow(query, eventID, models.TicketStatusSold, models.TicketStatusUsed).Scan(&revenue)
+	err := r.db.QueryRow(query, args...).Scan(&revenue)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get revenue: %w", err)
 	}
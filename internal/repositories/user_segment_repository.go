@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type UserSegmentRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewUserSegmentRepository(db *sql.DB) *UserSegmentRepository {
+	return &UserSegmentRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// FindByUserID - Raw SQL: kullanıcının segmentleri
+func (r *UserSegmentRepository) FindByUserID(userID int64) ([]string, error) {
+	rows, err := r.db.Query(`SELECT segment FROM user_segments WHERE user_id = ?`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user segments: %w", err)
+	}
+	defer rows.Close()
+
+	var segments []string
+	for rows.Next() {
+		var segment string
+		if err := rows.Scan(&segment); err != nil {
+			return nil, fmt.Errorf("failed to scan user segment: %w", err)
+		}
+		segments = append(segments, segment)
+	}
+
+	return segments, rows.Err()
+}
+
+// Add - Raw SQL (INSERT IGNORE: aynı segment tekrar eklenmez)
+func (r *UserSegmentRepository) Add(userID int64, segment string) error {
+	_, err := r.db.Exec(
+		`INSERT IGNORE INTO user_segments (user_id, segment, created_at) VALUES (?, ?, ?)`,
+		userID, strings.ToLower(strings.TrimSpace(segment)), time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add user segment: %w", err)
+	}
+
+	return nil
+}
+
+// Remove - Builder ile segment kaldırma
+func (r *UserSegmentRepository) Remove(userID int64, segment string) error {
+	_, err := database.NewBuilder(r.db, r.grammar).
+		Table("user_segments").
+		Where("user_id", "=", userID).
+		Where("segment", "=", strings.ToLower(strings.TrimSpace(segment))).
+		ExecDelete()
+
+	if err != nil {
+		return fmt.Errorf("failed to remove user segment: %w", err)
+	}
+
+	return nil
+}
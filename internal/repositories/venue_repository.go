@@ -3,6 +3,7 @@ package repositories
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/biyonik/event-ticketing-api/internal/models"
@@ -10,8 +11,9 @@ import (
 )
 
 type VenueRepository struct {
-	db      *sql.DB
-	grammar database.Grammar
+	db          *sql.DB
+	grammar     database.Grammar
+	organizerID int64 // 0 = kısıtsız; > 0 ise tüm sorgular bu organizatörle sınırlanır
 }
 
 func NewVenueRepository(db *sql.DB) *VenueRepository {
@@ -21,20 +23,42 @@ func NewVenueRepository(db *sql.DB) *VenueRepository {
 	}
 }
 
+// ForOrganizer - organizatöre kısıtlı kopya döndürür (0 = kısıtsız)
+func (r *VenueRepository) ForOrganizer(organizerID int64) *VenueRepository {
+	scoped := *r
+	scoped.organizerID = organizerID
+	return &scoped
+}
+
+// query - venues tablosu için Builder (organizatör kısıtı ve soft delete kapsamı otomatik eklenir)
+func (r *VenueRepository) query() *database.QueryBuilder {
+	builder := database.NewBuilder(r.db, r.grammar).Table("venues").SoftDeletes()
+	if r.organizerID > 0 {
+		builder.Where("organizer_id", "=", r.organizerID)
+	}
+	return builder
+}
+
 // Create - Conduit-Go Builder ile venue oluşturma
 func (r *VenueRepository) Create(venue *models.Venue) (int64, error) {
+	// Kısıtlı repository başka organizatör adına mekan oluşturamaz
+	if r.organizerID > 0 {
+		venue.OrganizerID = r.organizerID
+	}
+
 	result, err := database.NewBuilder(r.db, r.grammar).
 		Table("venues").
 		ExecInsert(map[string]interface{}{
-			"name":       venue.Name,
-			"address":    venue.Address,
-			"city":       venue.City,
-			"country":    venue.Country,
-			"capacity":   venue.Capacity,
-			"latitude":   venue.Latitude,
-			"longitude":  venue.Longitude,
-			"created_at": venue.CreatedAt,
-			"updated_at": venue.UpdatedAt,
+			"organizer_id": venue.OrganizerID,
+			"name":         venue.Name,
+			"address":      venue.Address,
+			"city":         venue.City,
+			"country":      venue.Country,
+			"capacity":     venue.Capacity,
+			"latitude":     venue.Latitude,
+			"longitude":    venue.Longitude,
+			"created_at":   venue.CreatedAt,
+			"updated_at":   venue.UpdatedAt,
 		})
 
 	if err != nil {
@@ -53,10 +77,8 @@ func (r *VenueRepository) Create(venue *models.Venue) (int64, error) {
 func (r *VenueRepository) FindByID(id int64) (*models.Venue, error) {
 	var venue models.Venue
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("venues").
+	err := r.query().
 		Where("id", "=", id).
-		WhereNull("deleted_at").
 		First(&venue)
 
 	if err == sql.ErrNoRows {
@@ -73,9 +95,7 @@ func (r *VenueRepository) FindByID(id int64) (*models.Venue, error) {
 func (r *VenueRepository) FindAll(limit, offset int) ([]*models.Venue, error) {
 	var venues []*models.Venue
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("venues").
-		WhereNull("deleted_at").
+	err := r.query().
 		OrderBy("name", "ASC").
 		Limit(limit).
 		Offset(offset).
@@ -92,10 +112,8 @@ func (r *VenueRepository) FindAll(limit, offset int) ([]*models.Venue, error) {
 func (r *VenueRepository) Update(venue *models.Venue) error {
 	venue.UpdatedAt = time.Now()
 
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("venues").
+	result, err := r.query().
 		Where("id", "=", venue.ID).
-		WhereNull("deleted_at").
 		ExecUpdate(map[string]interface{}{
 			"name":       venue.Name,
 			"address":    venue.Address,
@@ -123,15 +141,11 @@ func (r *VenueRepository) Update(venue *models.Venue) error {
 	return nil
 }
 
-// Delete - Soft delete using Conduit-Go Builder
+// Delete - Soft delete using Conduit-Go Builder (SoftDeletes kapsamında ExecDelete deleted_at'i doldurur)
 func (r *VenueRepository) Delete(id int64) error {
-	result, err := database.NewBuilder(r.db, r.grammar).
-		Table("venues").
+	result, err := r.query().
 		Where("id", "=", id).
-		WhereNull("deleted_at").
-		ExecUpdate(map[string]interface{}{
-			"deleted_at": time.Now(),
-		})
+		ExecDelete()
 
 	if err != nil {
 		return fmt.Errorf("failed to delete venue: %w", err)
@@ -155,9 +169,7 @@ func (r *VenueRepository) SearchByName(keyword string) ([]*models.Venue, error)
 
 	searchTerm := "%" + keyword + "%"
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("venues").
-		WhereNull("deleted_at").
+	err := r.query().
 		Where("name", "LIKE", searchTerm).
 		OrderBy("name", "ASC").
 		Get(&venues)
@@ -173,9 +185,7 @@ func (r *VenueRepository) SearchByName(keyword string) ([]*models.Venue, error)
 func (r *VenueRepository) FindByCity(city string) ([]*models.Venue, error) {
 	var venues []*models.Venue
 
-	err := database.NewBuilder(r.db, r.grammar).
-		Table("venues").
-		WhereNull("deleted_at").
+	err := r.query().
 		Where("city", "=", city).
 		OrderBy("name", "ASC").
 		Get(&venues)
@@ -187,10 +197,153 @@ func (r *VenueRepository) FindByCity(city string) ([]*models.Venue, error) {
 	return venues, nil
 }
 
+// Geo Search Methods
+
+// haversineDistanceSQL - mekanın (alias) merkez noktaya km cinsinden haversine mesafesi.
+// Argümanlar: lat, lat, lng
+func haversineDistanceSQL(alias string) string {
+	return fmt.Sprintf(`(%[2]f * 2 * ASIN(SQRT(
+		POWER(SIN(RADIANS(%[1]s.latitude - ?) / 2), 2) +
+		COS(RADIANS(?)) * COS(RADIANS(%[1]s.latitude)) * POWER(SIN(RADIANS(%[1]s.longitude - ?) / 2), 2)
+	)))`, alias, models.EarthRadiusKm)
+}
+
+// geoBoundsSQL - (latitude, longitude) indeksini kullanan sınır kutusu ön filtresi.
+// Antimeridyeni kesen kutularda boylam iki aralığa bölünür.
+func geoBoundsSQL(alias string, bounds models.GeoBounds) (string, []interface{}) {
+	condition := fmt.Sprintf(` AND %[1]s.latitude IS NOT NULL AND %[1]s.longitude IS NOT NULL
+		AND %[1]s.latitude BETWEEN ? AND ?`, alias)
+	args := []interface{}{bounds.MinLat, bounds.MaxLat}
+
+	if bounds.CrossesAntimeridian() {
+		condition += fmt.Sprintf(` AND (%[1]s.longitude >= ? OR %[1]s.longitude <= ?)`, alias)
+	} else {
+		condition += fmt.Sprintf(` AND %[1]s.longitude BETWEEN ? AND ?`, alias)
+	}
+	args = append(args, bounds.MinLng, bounds.MaxLng)
+
+	return condition, args
+}
+
+// FindNearby - Raw SQL (haversine + sınır kutusu ön filtresi): merkeze radiusKm içindeki
+// mekanlar, yakından uzağa. radiusKm 0 ise sadece kutu uygulanır.
+func (r *VenueRepository) FindNearby(center models.GeoPoint, bounds models.GeoBounds, radiusKm float64, limit int) ([]*models.NearbyVenue, error) {
+	query := `SELECT v.id, ` + haversineDistanceSQL("v") + ` AS distance_km
+		FROM venues v
+		WHERE v.deleted_at IS NULL`
+	args := []interface{}{center.Lat, center.Lat, center.Lng}
+
+	condition, boundsArgs := geoBoundsSQL("v", bounds)
+	query += condition
+	args = append(args, boundsArgs...)
+
+	if r.organizerID > 0 {
+		query += ` AND v.organizer_id = ?`
+		args = append(args, r.organizerID)
+	}
+
+	if radiusKm > 0 {
+		query += ` HAVING distance_km <= ?`
+		args = append(args, radiusKm)
+	}
+
+	query += ` ORDER BY distance_km ASC, v.id ASC LIMIT ?`
+	args = append(args, limit)
+
+	matches, err := scanGeoMatches(r.db, query, args, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query nearby venues: %w", err)
+	}
+
+	venues, err := r.FindByIDs(geoMatchIDs(matches))
+	if err != nil {
+		return nil, err
+	}
+
+	nearby := make([]*models.NearbyVenue, 0, len(matches))
+	for _, match := range matches {
+		if venue, ok := venues[match.ID]; ok {
+			nearby = append(nearby, &models.NearbyVenue{Venue: venue, DistanceKm: match.DistanceKm})
+		}
+	}
+
+	return nearby, nil
+}
+
+// FindByIDs - Builder ile WhereIn: ID'ye göre mekanlar
+func (r *VenueRepository) FindByIDs(ids []interface{}) (map[int64]*models.Venue, error) {
+	venues := make(map[int64]*models.Venue, len(ids))
+	if len(ids) == 0 {
+		return venues, nil
+	}
+
+	var rows []*models.Venue
+	err := r.query().
+		WhereIn("id", ids).
+		Get(&rows)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query venues: %w", err)
+	}
+
+	for _, venue := range rows {
+		venues[venue.ID] = venue
+	}
+
+	return venues, nil
+}
+
+// scanGeoMatches - (id[, venue_id], distance_km) satırlarını okur
+func scanGeoMatches(db *sql.DB, query string, args []interface{}, withVenue bool) ([]*models.GeoMatch, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []*models.GeoMatch
+	for rows.Next() {
+		var match models.GeoMatch
+		if withVenue {
+			err = rows.Scan(&match.ID, &match.VenueID, &match.DistanceKm)
+		} else {
+			err = rows.Scan(&match.ID, &match.DistanceKm)
+			match.VenueID = match.ID
+		}
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, &match)
+	}
+
+	return matches, rows.Err()
+}
+
+func geoMatchIDs(matches []*models.GeoMatch) []interface{} {
+	ids := make([]interface{}, len(matches))
+	for i, match := range matches {
+		ids[i] = match.ID
+	}
+	return ids
+}
+
 // Section Repository Methods
 
+// ensureVenueAccess - kısıtlı repository'de mekanın organizatöre ait olduğunu doğrular
+func (r *VenueRepository) ensureVenueAccess(venueID int64) error {
+	if r.organizerID == 0 {
+		return nil
+	}
+	_, err := r.FindByID(venueID)
+	return err
+}
+
 // CreateSection - Conduit-Go Builder ile section oluşturma
 func (r *VenueRepository) CreateSection(section *models.Section) (int64, error) {
+	if err := r.ensureVenueAccess(section.VenueID); err != nil {
+		return 0, err
+	}
+
 	result, err := database.NewBuilder(r.db, r.grammar).
 		Table("sections").
 		ExecInsert(map[string]interface{}{
@@ -216,6 +369,10 @@ func (r *VenueRepository) CreateSection(section *models.Section) (int64, error)
 
 // FindSectionsByVenueID - Builder ile venue sections
 func (r *VenueRepository) FindSectionsByVenueID(venueID int64) ([]*models.Section, error) {
+	if err := r.ensureVenueAccess(venueID); err != nil {
+		return nil, err
+	}
+
 	var sections []*models.Section
 
 	err := database.NewBuilder(r.db, r.grammar).
@@ -262,6 +419,7 @@ func (r *VenueRepository) CreateSeat(seat *models.Seat) (int64, error) {
 			"section_id": seat.SectionID,
 			"row":        seat.Row,
 			"number":     seat.Number,
+			"attributes": seat.Attributes,
 			"is_active":  seat.IsActive,
 			"created_at": seat.CreatedAt,
 			"updated_at": seat.UpdatedAt,
@@ -369,3 +527,419 @@ func (r *VenueRepository) GetVenueWithSections(venueID int64) (*models.Venue, er
 	venue.Sections = sections
 	return venue, nil
 }
+
+// Seat Attribute Methods
+
+// FindActiveSeats - Raw SQL (JOIN): mekanın silinmemiş bölümlerindeki aktif koltuklar, düzen sırasıyla.
+// sectionID 0 ise tüm bölümlerin koltukları döner.
+func (r *VenueRepository) FindActiveSeats(venueID, sectionID int64) ([]*models.Seat, error) {
+	query := `
+		SELECT st.id, st.section_id, st.row, st.number, st.attributes, st.companion_seat_id
+		FROM seats st
+		INNER JOIN sections s ON s.id = st.section_id
+		WHERE s.venue_id = ? AND s.deleted_at IS NULL
+			AND st.is_active = TRUE AND st.deleted_at IS NULL
+	`
+	args := []interface{}{venueID}
+	if sectionID > 0 {
+		query += ` AND st.section_id = ?`
+		args = append(args, sectionID)
+	}
+	query += ` ORDER BY st.section_id ASC, st.id ASC`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query seats: %w", err)
+	}
+	defer rows.Close()
+
+	var seats []*models.Seat
+	for rows.Next() {
+		var seat models.Seat
+		var companionID sql.NullInt64
+		if err := rows.Scan(&seat.ID, &seat.SectionID, &seat.Row, &seat.Number, &seat.Attributes, &companionID); err != nil {
+			return nil, fmt.Errorf("failed to scan seat: %w", err)
+		}
+		if companionID.Valid {
+			seat.CompanionSeatID = &companionID.Int64
+		}
+		seat.IsActive = true
+		seats = append(seats, &seat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate seats: %w", err)
+	}
+
+	return seats, nil
+}
+
+// LinkCompanionSeats - Builder ile transaction içinde iki koltuğu karşılıklı bağlar.
+// Koltukların önceki eşleşmeleri kaldırılır (bir koltuğun tek refakatçisi olur).
+func (r *VenueRepository) LinkCompanionSeats(tx *sql.Tx, seatID, companionSeatID int64) error {
+	if err := r.UnlinkCompanionSeat(tx, seatID); err != nil {
+		return err
+	}
+	if err := r.UnlinkCompanionSeat(tx, companionSeatID); err != nil {
+		return err
+	}
+
+	for id, companionID := range map[int64]int64{seatID: companionSeatID, companionSeatID: seatID} {
+		_, err := database.NewBuilder(tx, r.grammar).
+			Table("seats").
+			Where("id", "=", id).
+			ExecUpdate(map[string]interface{}{
+				"companion_seat_id": companionID,
+				"updated_at":        time.Now(),
+			})
+
+		if err != nil {
+			return fmt.Errorf("failed to link companion seat: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UnlinkCompanionSeat - Builder ile transaction içinde koltuğun refakatçi bağını iki yönde kaldırır
+func (r *VenueRepository) UnlinkCompanionSeat(tx *sql.Tx, seatID int64) error {
+	_, err := database.NewBuilder(tx, r.grammar).
+		Table("seats").
+		Where("id", "=", seatID).
+		OrWhere("companion_seat_id", "=", seatID).
+		ExecUpdate(map[string]interface{}{
+			"companion_seat_id": nil,
+			"updated_at":        time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to unlink companion seat: %w", err)
+	}
+
+	return nil
+}
+
+// Layout Import Methods
+
+// LockVenue - SELECT ... FOR UPDATE (transaction içinde)
+// Aynı mekana eşzamanlı düzen içe aktarımlarını sıralar.
+func (r *VenueRepository) LockVenue(tx *sql.Tx, venueID int64) error {
+	var id int64
+	if err := tx.QueryRow(`SELECT id FROM venues WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, venueID).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("venue not found")
+		}
+		return fmt.Errorf("failed to lock venue: %w", err)
+	}
+
+	return nil
+}
+
+// FindLayoutSections - Builder ile transaction içinde mekanın bölümleri
+func (r *VenueRepository) FindLayoutSections(tx *sql.Tx, venueID int64) ([]*models.Section, error) {
+	var sections []*models.Section
+
+	err := database.NewBuilder(tx, r.grammar).
+		Table("sections").
+		Where("venue_id", "=", venueID).
+		WhereNull("deleted_at").
+		OrderBy("id", "ASC").
+		Get(&sections)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sections: %w", err)
+	}
+
+	return sections, nil
+}
+
+// FindLayoutSeats - Raw SQL (JOIN): mekanın silinmemiş bölümlerindeki tüm koltuklar.
+// Pasif ve silinmiş koltuklar da döner; unique (section_id, row, number) anahtarı onları da kapsar.
+func (r *VenueRepository) FindLayoutSeats(tx *sql.Tx, venueID int64) ([]*models.ExistingSeat, error) {
+	query := `
+		SELECT st.id, st.section_id, s.name, st.row, st.number, st.attributes, st.is_active,
+			st.deleted_at IS NOT NULL
+		FROM seats st
+		INNER JOIN sections s ON s.id = st.section_id
+		WHERE s.venue_id = ? AND s.deleted_at IS NULL
+		ORDER BY st.section_id ASC, st.id ASC
+	`
+
+	rows, err := tx.Query(query, venueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query layout seats: %w", err)
+	}
+	defer rows.Close()
+
+	var seats []*models.ExistingSeat
+	for rows.Next() {
+		var seat models.ExistingSeat
+		if err := rows.Scan(&seat.ID, &seat.SectionID, &seat.SectionName, &seat.Row, &seat.Number,
+			&seat.Attributes, &seat.IsActive, &seat.Deleted); err != nil {
+			return nil, fmt.Errorf("failed to scan layout seat: %w", err)
+		}
+		seats = append(seats, &seat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate layout seats: %w", err)
+	}
+
+	return seats, nil
+}
+
+// CreateSectionTx - Builder ile transaction içinde section oluşturma
+func (r *VenueRepository) CreateSectionTx(tx *sql.Tx, section *models.Section) (int64, error) {
+	result, err := database.NewBuilder(tx, r.grammar).
+		Table("sections").
+		ExecInsert(map[string]interface{}{
+			"venue_id":      section.VenueID,
+			"name":          section.Name,
+			"row_count":     section.RowCount,
+			"seats_per_row": section.SeatsPerRow,
+			"created_at":    section.CreatedAt,
+			"updated_at":    section.UpdatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create section: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// UpdateSectionShape - Builder ile transaction içinde sıra sayısı ve sıra başına koltuk güncelleme
+func (r *VenueRepository) UpdateSectionShape(tx *sql.Tx, sectionID int64, rowCount, seatsPerRow int) error {
+	_, err := database.NewBuilder(tx, r.grammar).
+		Table("sections").
+		Where("id", "=", sectionID).
+		ExecUpdate(map[string]interface{}{
+			"row_count":     rowCount,
+			"seats_per_row": seatsPerRow,
+			"updated_at":    time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to update section: %w", err)
+	}
+
+	return nil
+}
+
+// seatInsertChunk - çok satırlı INSERT başına koltuk sayısı
+const seatInsertChunk = 500
+
+// InsertSeats - Raw SQL (çok satırlı INSERT): koltukları parça parça transaction içinde ekler
+func (r *VenueRepository) InsertSeats(tx *sql.Tx, seats []*models.Seat) error {
+	for start := 0; start < len(seats); start += seatInsertChunk {
+		end := start + seatInsertChunk
+		if end > len(seats) {
+			end = len(seats)
+		}
+		chunk := seats[start:end]
+
+		placeholders := make([]string, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*7)
+		for i, seat := range chunk {
+			placeholders[i] = "(?, ?, ?, ?, ?, ?, ?)"
+			args = append(args, seat.SectionID, seat.Row, seat.Number, seat.Attributes,
+				seat.IsActive, seat.CreatedAt, seat.UpdatedAt)
+		}
+
+		// row MySQL 8'de ayrılmış kelime; nitelenmemiş kullanımda tırnaklanır
+		query := "INSERT INTO seats (section_id, `row`, number, attributes, is_active, created_at, updated_at) VALUES " +
+			strings.Join(placeholders, ", ")
+
+		if _, err := tx.Exec(query, args...); err != nil {
+			if isDuplicateKeyError(err) {
+				return fmt.Errorf("seat already exists in section")
+			}
+			return fmt.Errorf("failed to insert seats: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RestoreSeat - Builder ile transaction içinde koltuğu özellikleriyle aktif hale getirir (silinmişse geri alır)
+func (r *VenueRepository) RestoreSeat(tx *sql.Tx, seatID int64, attributes string) error {
+	_, err := database.NewBuilder(tx, r.grammar).
+		Table("seats").
+		Where("id", "=", seatID).
+		ExecUpdate(map[string]interface{}{
+			"attributes": attributes,
+			"is_active":  true,
+			"deleted_at": nil,
+			"updated_at": time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to update seat: %w", err)
+	}
+
+	return nil
+}
+
+// DeactivateSeats - Builder ile transaction içinde koltukları pasife alır.
+// Koltuklar silinmez; geçmiş biletler koltuğa bağlı kalır.
+func (r *VenueRepository) DeactivateSeats(tx *sql.Tx, seatIDs []int64) error {
+	for start := 0; start < len(seatIDs); start += seatInsertChunk {
+		end := start + seatInsertChunk
+		if end > len(seatIDs) {
+			end = len(seatIDs)
+		}
+
+		ids := make([]interface{}, 0, end-start)
+		for _, id := range seatIDs[start:end] {
+			ids = append(ids, id)
+		}
+
+		_, err := database.NewBuilder(tx, r.grammar).
+			Table("seats").
+			WhereIn("id", ids).
+			ExecUpdate(map[string]interface{}{
+				"is_active":  false,
+				"updated_at": time.Now(),
+			})
+
+		if err != nil {
+			return fmt.Errorf("failed to deactivate seats: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateCapacity - Builder ile transaction içinde mekan kapasitesi güncelleme
+func (r *VenueRepository) UpdateCapacity(tx *sql.Tx, venueID int64, capacity int) error {
+	_, err := database.NewBuilder(tx, r.grammar).
+		Table("venues").
+		Where("id", "=", venueID).
+		ExecUpdate(map[string]interface{}{
+			"capacity":   capacity,
+			"updated_at": time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to update venue capacity: %w", err)
+	}
+
+	return nil
+}
+
+// Trash (Soft Delete) Methods
+
+// FindTrashed - Builder ile OnlyTrashed: çöp kutusundaki mekanlar (en son silinen önce)
+func (r *VenueRepository) FindTrashed(limit, offset int) ([]*models.Venue, error) {
+	var venues []*models.Venue
+
+	err := r.query().
+		OnlyTrashed().
+		OrderBy("deleted_at", "DESC").
+		Limit(limit).
+		Offset(offset).
+		Get(&venues)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query trashed venues: %w", err)
+	}
+
+	return venues, nil
+}
+
+// FindTrashedByID - Builder ile OnlyTrashed: çöp kutusundaki tek mekan
+func (r *VenueRepository) FindTrashedByID(id int64) (*models.Venue, error) {
+	var venue models.Venue
+
+	err := r.query().
+		OnlyTrashed().
+		Where("id", "=", id).
+		First(&venue)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("venue not found in trash")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trashed venue: %w", err)
+	}
+
+	return &venue, nil
+}
+
+// Restore - Builder ile ExecRestore: silinmiş mekanın deleted_at'ini temizler
+func (r *VenueRepository) Restore(id int64) error {
+	result, err := r.query().
+		Where("id", "=", id).
+		ExecRestore()
+
+	if err != nil {
+		return fmt.Errorf("failed to restore venue: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("venue not found in trash")
+	}
+
+	return nil
+}
+
+// FindPurgeableIDs - Raw SQL (NOT EXISTS): cutoff'tan önce silinmiş ve etkinlik, seri veya
+// sezon paketi tarafından kullanılmayan mekanlar (bu tablolar ON DELETE RESTRICT)
+func (r *VenueRepository) FindPurgeableIDs(cutoff time.Time, limit int) ([]int64, error) {
+	query := `
+		SELECT v.id FROM venues v
+		WHERE v.deleted_at IS NOT NULL AND v.deleted_at < ?
+		AND NOT EXISTS (SELECT 1 FROM events e WHERE e.venue_id = v.id)
+		AND NOT EXISTS (SELECT 1 FROM event_series es WHERE es.venue_id = v.id)
+		AND NOT EXISTS (SELECT 1 FROM season_packages sp WHERE sp.venue_id = v.id)
+		ORDER BY v.deleted_at ASC
+		LIMIT ?`
+
+	rows, err := r.db.Query(query, cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query purgeable venues: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan purgeable venue: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// ForceDelete - Builder ile ExecForceDelete: cutoff'tan önce silinmiş mekanı kalıcı olarak siler
+// (bölümler ve koltuklar ON DELETE CASCADE ile silinir). Bu arada geri yüklenen mekana dokunulmaz.
+func (r *VenueRepository) ForceDelete(id int64, cutoff time.Time) (bool, error) {
+	result, err := r.query().
+		OnlyTrashed().
+		Where("id", "=", id).
+		Where("deleted_at", "<", cutoff).
+		ExecForceDelete()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to purge venue: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,217 @@
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+)
+
+type WaitingListOfferRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewWaitingListOfferRepository(db *sql.DB) *WaitingListOfferRepository {
+	return &WaitingListOfferRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// Create - Conduit-Go Builder ile teklif oluşturma
+func (r *WaitingListOfferRepository) Create(offer *models.WaitingListOffer) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("waiting_list_offers").
+		ExecInsert(map[string]interface{}{
+			"waiting_list_id": offer.WaitingListID,
+			"event_id":        offer.EventID,
+			"user_id":         offer.UserID,
+			"section_id":      offer.SectionID,
+			"seat_id":         offer.SeatID,
+			"price":           offer.Price,
+			"claim_token":     offer.ClaimToken,
+			"status":          offer.Status,
+			"expires_at":      offer.ExpiresAt,
+			"created_at":      offer.CreatedAt,
+			"updated_at":      offer.UpdatedAt,
+		})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to create waiting list offer: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindByClaimToken - Builder ile token'a göre teklif
+func (r *WaitingListOfferRepository) FindByClaimToken(token string) (*models.WaitingListOffer, error) {
+	var offer models.WaitingListOffer
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("waiting_list_offers").
+		Where("claim_token", "=", token).
+		First(&offer)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("waiting list offer not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find waiting list offer: %w", err)
+	}
+
+	return &offer, nil
+}
+
+// FindByUserID - Builder ile kullanıcının teklifleri
+func (r *WaitingListOfferRepository) FindByUserID(userID int64) ([]*models.WaitingListOffer, error) {
+	var offers []*models.WaitingListOffer
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("waiting_list_offers").
+		Where("user_id", "=", userID).
+		OrderBy("created_at", "DESC").
+		Get(&offers)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query waiting list offers: %w", err)
+	}
+
+	return offers, nil
+}
+
+// FindExpiredPending - Builder ile claim window'u dolmuş teklifler
+func (r *WaitingListOfferRepository) FindExpiredPending(limit int) ([]*models.WaitingListOffer, error) {
+	var offers []*models.WaitingListOffer
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("waiting_list_offers").
+		Where("status", "=", models.WaitingListOfferStatusPending).
+		Where("expires_at", "<", time.Now()).
+		OrderBy("expires_at", "ASC").
+		Limit(limit).
+		Get(&offers)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired offers: %w", err)
+	}
+
+	return offers, nil
+}
+
+// MarkClaimed - Koşullu güncelleme: sadece süresi dolmamış pending teklif claim edilebilir
+// Expire job'ı ile eşzamanlı claim'lerde sadece biri başarılı olur.
+func (r *WaitingListOfferRepository) MarkClaimed(id int64) error {
+	now := time.Now()
+
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("waiting_list_offers").
+		Where("id", "=", id).
+		Where("status", "=", models.WaitingListOfferStatusPending).
+		Where("expires_at", ">", now).
+		ExecUpdate(map[string]interface{}{
+			"status":     models.WaitingListOfferStatusClaimed,
+			"claimed_at": now,
+			"updated_at": now,
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to claim offer: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("offer not found or no longer claimable")
+	}
+
+	return nil
+}
+
+// AttachTicket - Claim sonrası oluşturulan rezervasyonu teklife bağlar
+func (r *WaitingListOfferRepository) AttachTicket(id, ticketID int64) error {
+	_, err := database.NewBuilder(r.db, r.grammar).
+		Table("waiting_list_offers").
+		Where("id", "=", id).
+		ExecUpdate(map[string]interface{}{
+			"ticket_id":  ticketID,
+			"updated_at": time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to attach ticket to offer: %w", err)
+	}
+
+	return nil
+}
+
+// Reopen - Rezervasyon oluşturulamadığında claim'i geri alır
+func (r *WaitingListOfferRepository) Reopen(id int64) error {
+	_, err := database.NewBuilder(r.db, r.grammar).
+		Table("waiting_list_offers").
+		Where("id", "=", id).
+		Where("status", "=", models.WaitingListOfferStatusClaimed).
+		WhereNull("ticket_id").
+		ExecUpdate(map[string]interface{}{
+			"status":     models.WaitingListOfferStatusPending,
+			"claimed_at": nil,
+			"updated_at": time.Now(),
+		})
+
+	if err != nil {
+		return fmt.Errorf("failed to reopen offer: %w", err)
+	}
+
+	return nil
+}
+
+// Close - Pending teklifi declined/expired durumuna geçirir.
+// Başka bir süreç teklifi zaten kapattıysa false döner (rollover'ın iki kez yapılmasını engeller).
+func (r *WaitingListOfferRepository) Close(id int64, status models.WaitingListOfferStatus) (bool, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("waiting_list_offers").
+		Where("id", "=", id).
+		Where("status", "=", models.WaitingListOfferStatusPending).
+		ExecUpdate(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now(),
+		})
+
+	if err != nil {
+		return false, fmt.Errorf("failed to close offer: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// IsSeatHeld - COUNT query: koltuk bekleyen bir teklif için tutuluyor mu?
+func (r *WaitingListOfferRepository) IsSeatHeld(eventID, seatID int64) (bool, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM waiting_list_offers
+		WHERE event_id = ? AND seat_id = ? AND status = ?
+	`
+
+	var count int
+	err := r.db.QueryRow(query, eventID, seatID, models.WaitingListOfferStatusPending).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check held seat: %w", err)
+	}
+
+	return count > 0, nil
+}
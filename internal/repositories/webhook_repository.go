@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/pkg/database"
+	"github.com/go-sql-driver/mysql"
+)
+
+// ErrDuplicateWebhookEvent, aynı (provider, provider_event_id) ikilisi daha önce kaydedildiğinde döner.
+var ErrDuplicateWebhookEvent = errors.New("webhook event already recorded")
+
+// mysqlDuplicateEntry, MySQL unique key ihlali hata kodudur.
+const mysqlDuplicateEntry = 1062
+
+type WebhookRepository struct {
+	db      *sql.DB
+	grammar database.Grammar
+}
+
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+	return &WebhookRepository{
+		db:      db,
+		grammar: database.NewMySQLGrammar(),
+	}
+}
+
+// Create - Conduit-Go Builder ile webhook event kaydı
+// Unique key ihlalinde ErrDuplicateWebhookEvent döner (eşzamanlı retry'lar için).
+func (r *WebhookRepository) Create(event *models.WebhookEvent) (int64, error) {
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("webhook_events").
+		ExecInsert(map[string]interface{}{
+			"provider":          event.Provider,
+			"provider_event_id": event.ProviderEventID,
+			"event_type":        event.EventType,
+			"transaction_id":    event.TransactionID,
+			"payload":           event.Payload,
+			"status":            event.Status,
+			"attempts":          event.Attempts,
+			"created_at":        event.CreatedAt,
+			"updated_at":        event.UpdatedAt,
+		})
+
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return 0, ErrDuplicateWebhookEvent
+		}
+		return 0, fmt.Errorf("failed to create webhook event: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindByProviderEventID - Builder ile sağlayıcı olay ID'sine göre arama
+func (r *WebhookRepository) FindByProviderEventID(provider, providerEventID string) (*models.WebhookEvent, error) {
+	var event models.WebhookEvent
+
+	err := database.NewBuilder(r.db, r.grammar).
+		Table("webhook_events").
+		Where("provider", "=", provider).
+		Where("provider_event_id", "=", providerEventID).
+		First(&event)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("webhook event not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook event: %w", err)
+	}
+
+	return &event, nil
+}
+
+// IncrementAttempts - Atomic attempt sayacı (raw SQL)
+func (r *WebhookRepository) IncrementAttempts(id int64) error {
+	query := `UPDATE webhook_events SET attempts = attempts + 1, updated_at = ? WHERE id = ?`
+
+	if _, err := r.db.Exec(query, time.Now(), id); err != nil {
+		return fmt.Errorf("failed to increment webhook attempts: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus - Builder ile webhook işlenme durumunu güncelleme
+func (r *WebhookRepository) UpdateStatus(id int64, status models.WebhookEventStatus, errorMessage string) error {
+	now := time.Now()
+
+	data := map[string]interface{}{
+		"status":        status,
+		"error_message": errorMessage,
+		"updated_at":    now,
+	}
+	if status == models.WebhookEventStatusProcessed || status == models.WebhookEventStatusIgnored {
+		data["processed_at"] = now
+	}
+
+	result, err := database.NewBuilder(r.db, r.grammar).
+		Table("webhook_events").
+		Where("id", "=", id).
+		ExecUpdate(data)
+
+	if err != nil {
+		return fmt.Errorf("failed to update webhook event status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("webhook event not found")
+	}
+
+	return nil
+}
+
+// isDuplicateKeyError, hatanın MySQL unique key ihlali olup olmadığını kontrol eder
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry
+}
@@ -0,0 +1,609 @@
+package services
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/patterns/factory"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+)
+
+// AddOnInput carries the editable fields of an add-on product
+type AddOnInput struct {
+	Name        string
+	Description string
+	Type        models.AddOnType
+	Price       float64
+	Inventory   int
+	Scope       models.AddOnScope
+	MaxQuantity *int
+	Scannable   bool
+	IsActive    bool
+}
+
+// AddOnService sells add-on products (parking, merchandise, hospitality) attached to tickets.
+// Items follow their ticket: sold on purchase, cancelled on expiry, refunded on cancellation
+// (TicketService calls it through the TicketAddOns interface).
+type AddOnService struct {
+	addOnRepo       *repositories.AddOnRepository
+	ticketRepo      *repositories.TicketRepository
+	eventRepo       *repositories.EventRepository
+	ticketFactory   *factory.TicketFactory
+	ticketValidator *factory.TicketValidator
+	db              *sql.DB
+	audit           auditTrail
+	scope           models.OrganizerScope
+}
+
+func NewAddOnService(
+	addOnRepo *repositories.AddOnRepository,
+	ticketRepo *repositories.TicketRepository,
+	eventRepo *repositories.EventRepository,
+	db *sql.DB,
+) *AddOnService {
+	return &AddOnService{
+		addOnRepo:       addOnRepo,
+		ticketRepo:      ticketRepo,
+		eventRepo:       eventRepo,
+		ticketFactory:   factory.NewTicketFactory(),
+		ticketValidator: factory.NewTicketValidator(),
+		db:              db,
+	}
+}
+
+// ForOrganizer returns a copy of the service whose ticket and event queries are
+// restricted to the scope's organizer and whose operations check the member's role.
+// The TicketAddOns hooks are called by TicketService after its own checks and stay unguarded.
+func (s *AddOnService) ForOrganizer(scope models.OrganizerScope) *AddOnService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.ticketRepo = s.ticketRepo.ForOrganizer(scope.OrganizerID)
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose mutations are audited as the given actor
+func (s *AddOnService) WithActor(actor models.AuditActor) *AddOnService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for add-on product changes (optional)
+func (s *AddOnService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// validateAddOnInput validates add-on fields using Conduit-Go Validation
+func validateAddOnInput(input AddOnInput) error {
+	schema := v.Make().Shape(map[string]v.Type{
+		"name": types.String().
+			Required().
+			Min(2).
+			Max(255).
+			Label("Ürün Adı"),
+		"type": types.String().
+			Required().
+			OneOf([]string{"parking", "merchandise", "hospitality", "other"}).
+			Label("Ürün Tipi"),
+		"scope": types.String().
+			Required().
+			OneOf([]string{"order", "ticket"}).
+			Label("Kapsam"),
+		"price": types.Number().
+			Required().
+			Min(0).
+			Label("Fiyat"),
+		"inventory": types.Number().
+			Required().
+			Min(0).
+			Label("Stok"),
+	})
+
+	result := schema.Validate(map[string]any{
+		"name":      input.Name,
+		"type":      string(input.Type),
+		"scope":     string(input.Scope),
+		"price":     input.Price,
+		"inventory": float64(input.Inventory),
+	})
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	if input.MaxQuantity != nil && *input.MaxQuantity < 1 {
+		return fmt.Errorf("max_quantity: en az 1 olmalı")
+	}
+
+	return nil
+}
+
+// CreateAddOn adds an add-on product to an event (admin)
+func (s *AddOnService) CreateAddOn(eventID int64, input AddOnInput) (*models.AddOn, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Validate input
+	if err := validateAddOnInput(input); err != nil {
+		return nil, err
+	}
+
+	// 2. Validate event
+	if _, err := requireEventAccess(s.scope, s.eventRepo, eventID, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 3. Create add-on
+	addOn := &models.AddOn{
+		EventID:     eventID,
+		Name:        input.Name,
+		Description: input.Description,
+		Type:        input.Type,
+		Price:       input.Price,
+		Inventory:   input.Inventory,
+		Scope:       input.Scope,
+		MaxQuantity: input.MaxQuantity,
+		Scannable:   input.Scannable,
+		IsActive:    true,
+	}
+	addOn.Initialize()
+
+	addOnID, err := s.addOnRepo.Create(addOn)
+	if err != nil {
+		return nil, fmt.Errorf("ek ürün oluşturulamadı: %w", err)
+	}
+	addOn.ID = addOnID
+
+	s.audit.record(models.AuditActionCreate, models.AuditEntityAddOn, addOn.ID, nil, addOn)
+
+	return addOn, nil
+}
+
+// UpdateAddOn updates price, inventory, limits and availability of an add-on (admin).
+// Type, scope and scannability are fixed once created because sold items depend on them.
+func (s *AddOnService) UpdateAddOn(addOnID int64, input AddOnInput) (*models.AddOn, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Get add-on
+	addOn, err := s.addOnRepo.FindByID(addOnID)
+	if err != nil {
+		return nil, fmt.Errorf("ek ürün bulunamadı: %w", err)
+	}
+
+	if _, err := requireEventAccess(s.scope, s.eventRepo, addOn.EventID, models.PermissionManageEvents); err != nil {
+		return nil, fmt.Errorf("ek ürün bulunamadı: %w", err)
+	}
+	before := *addOn
+
+	input.Type = addOn.Type
+	input.Scope = addOn.Scope
+	if err := validateAddOnInput(input); err != nil {
+		return nil, err
+	}
+
+	// 2. Business rules
+	if input.Inventory < addOn.SoldCount {
+		return nil, fmt.Errorf("inventory: stok satılan adetten (%d) az olamaz", addOn.SoldCount)
+	}
+
+	// 3. Update
+	addOn.Name = input.Name
+	addOn.Description = input.Description
+	addOn.Price = input.Price
+	addOn.Inventory = input.Inventory
+	addOn.MaxQuantity = input.MaxQuantity
+	addOn.IsActive = input.IsActive
+
+	if err := s.addOnRepo.Update(addOn); err != nil {
+		return nil, fmt.Errorf("ek ürün güncellenemedi: %w", err)
+	}
+
+	s.audit.record(models.AuditActionUpdate, models.AuditEntityAddOn, addOn.ID, &before, addOn)
+
+	return addOn, nil
+}
+
+// ListEventAddOns returns the add-ons of an event with their sold counts
+func (s *AddOnService) ListEventAddOns(eventID int64, onlyActive bool) ([]*models.AddOn, error) {
+	addOns, err := s.addOnRepo.FindByEventID(eventID, onlyActive)
+	if err != nil {
+		return nil, fmt.Errorf("ek ürünler alınamadı: %w", err)
+	}
+
+	return addOns, nil
+}
+
+// AddToTicket buys an add-on for one of the user's tickets.
+// The item follows the ticket status (reserved until the ticket is purchased).
+func (s *AddOnService) AddToTicket(userID, ticketID, addOnID int64, quantity int) (*models.AddOnItem, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
+	// 1. Validate input
+	if quantity < 1 {
+		return nil, fmt.Errorf("quantity: en az 1 olmalı")
+	}
+
+	// 2. Get ticket and add-on
+	ticket, err := s.ticketRepo.FindByID(ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("bilet bulunamadı: %w", err)
+	}
+
+	if ticket.UserID != userID {
+		return nil, fmt.Errorf("bu bilet size ait değil")
+	}
+
+	if ticket.Status != models.TicketStatusReserved && ticket.Status != models.TicketStatusSold {
+		return nil, fmt.Errorf("ek ürün sadece rezerve veya satın alınmış biletlere eklenebilir")
+	}
+	if ticket.IsExpired() {
+		return nil, fmt.Errorf("rezervasyon süresi doldu")
+	}
+
+	addOn, err := s.addOnRepo.FindByID(addOnID)
+	if err != nil {
+		return nil, fmt.Errorf("ek ürün bulunamadı: %w", err)
+	}
+
+	// 3. Business rules
+	if addOn.EventID != ticket.EventID {
+		return nil, fmt.Errorf("ek ürün bu biletin etkinliğine ait değil")
+	}
+
+	if !addOn.IsActive {
+		return nil, fmt.Errorf("ek ürün satışta değil")
+	}
+
+	event, err := s.eventRepo.FindByID(ticket.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+	if !time.Now().Before(event.StartTime) {
+		return nil, fmt.Errorf("etkinlik başladı, ek ürün satılamaz")
+	}
+
+	// 4. Start transaction; add-on lock serializes inventory and limit checks
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	sold, err := s.addOnRepo.LockInventory(tx, addOnID)
+	if err != nil {
+		return nil, fmt.Errorf("ek ürün kilitlenemedi: %w", err)
+	}
+
+	if sold+quantity > addOn.Inventory {
+		return nil, fmt.Errorf("ek ürün stoğu yetersiz (kalan: %d)", max(addOn.Inventory-sold, 0))
+	}
+
+	// 5. Per-order / per-ticket limit
+	if addOn.MaxQuantity != nil {
+		var current int
+		if addOn.Scope == models.AddOnScopeTicket {
+			current, err = s.addOnRepo.CountQuantityForTicket(addOnID, ticketID)
+		} else {
+			current, err = s.addOnRepo.CountQuantityForOrder(addOnID, userID, ticket.EventID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ek ürün limiti kontrol edilemedi: %w", err)
+		}
+
+		if current+quantity > *addOn.MaxQuantity {
+			unit := "sipariş"
+			if addOn.Scope == models.AddOnScopeTicket {
+				unit = "bilet"
+			}
+			return nil, fmt.Errorf("%s başına en fazla %d adet %s alınabilir", unit, *addOn.MaxQuantity, addOn.Name)
+		}
+	}
+
+	// 6. Create item (scannable products get a voucher with QR code)
+	item := &models.AddOnItem{
+		AddOnID:    addOnID,
+		EventID:    ticket.EventID,
+		UserID:     userID,
+		TicketID:   ticketID,
+		Quantity:   quantity,
+		UnitPrice:  addOn.Price,
+		TotalPrice: addOn.Price * float64(quantity),
+		Status:     models.AddOnItemStatusReserved,
+	}
+	if ticket.Status == models.TicketStatusSold {
+		item.Status = models.AddOnItemStatusSold
+	}
+	item.Initialize()
+
+	if addOn.Scannable {
+		if err := s.ticketFactory.IssueAddOnVoucher(item, addOn, ticket.TicketNumber); err != nil {
+			return nil, fmt.Errorf("voucher oluşturulamadı: %w", err)
+		}
+	}
+
+	itemID, err := s.addOnRepo.CreateItem(item)
+	if err != nil {
+		return nil, fmt.Errorf("ek ürün kaydedilemedi: %w", err)
+	}
+	item.ID = itemID
+	item.AddOn = addOn
+
+	// 7. Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+
+	return item, nil
+}
+
+// RefundItem cancels a single add-on of the user's ticket (reserved items are cancelled,
+// sold items refunded) under the same 24-hour policy as ticket cancellation
+func (s *AddOnService) RefundItem(userID, itemID int64) (*models.AddOnItem, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
+	// 1. Get item
+	item, err := s.addOnRepo.FindItemByID(itemID)
+	if err != nil {
+		return nil, fmt.Errorf("ek ürün bulunamadı: %w", err)
+	}
+
+	if item.UserID != userID {
+		return nil, fmt.Errorf("bu ek ürün size ait değil")
+	}
+
+	// 2. Business rules
+	if !item.CanRefund() {
+		return nil, fmt.Errorf("ek ürün iade edilemez durumda: %s", item.Status)
+	}
+
+	event, err := s.eventRepo.FindByID(item.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	if time.Until(event.StartTime) < 24*time.Hour {
+		return nil, fmt.Errorf("etkinlikten 24 saat kala iade yapılamaz")
+	}
+
+	// 3. Update status
+	if err := s.releaseItem(item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// releaseItem cancels a reserved item or refunds a sold one
+func (s *AddOnService) releaseItem(item *models.AddOnItem) error {
+	status := models.AddOnItemStatusCancelled
+	if item.Status == models.AddOnItemStatusSold {
+		status = models.AddOnItemStatusRefunded
+	}
+
+	if err := s.addOnRepo.UpdateItemStatus(item.ID, status); err != nil {
+		return fmt.Errorf("ek ürün durumu güncellenemedi: %w", err)
+	}
+	item.Status = status
+
+	return nil
+}
+
+// FindForTicket returns the active add-ons of a ticket with their products (implements TicketAddOns)
+func (s *AddOnService) FindForTicket(ticketID int64) ([]*models.AddOnItem, error) {
+	items, err := s.addOnRepo.FindItemsByTicketID(ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]*models.AddOnItem, 0, len(items))
+	for _, item := range items {
+		if !item.IsActive() {
+			continue
+		}
+		if err := s.attachAddOn(item); err != nil {
+			return nil, err
+		}
+		active = append(active, item)
+	}
+
+	return active, nil
+}
+
+// ConfirmForTicket marks the reserved add-ons of a purchased ticket as sold (implements TicketAddOns)
+func (s *AddOnService) ConfirmForTicket(ticketID int64) ([]*models.AddOnItem, error) {
+	items, err := s.FindForTicket(ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item.Status != models.AddOnItemStatusReserved {
+			continue
+		}
+		if err := s.addOnRepo.UpdateItemStatus(item.ID, models.AddOnItemStatusSold); err != nil {
+			return nil, fmt.Errorf("ek ürün satışa geçirilemedi: %w", err)
+		}
+		item.Status = models.AddOnItemStatusSold
+	}
+
+	return items, nil
+}
+
+// ReleaseForTicket cancels or refunds the add-ons of a cancelled or expired ticket
+// and returns the affected items (implements TicketAddOns)
+func (s *AddOnService) ReleaseForTicket(ticketID int64) ([]*models.AddOnItem, error) {
+	items, err := s.FindForTicket(ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	released := make([]*models.AddOnItem, 0, len(items))
+	for _, item := range items {
+		if !item.CanRefund() {
+			continue // Used vouchers are not refunded
+		}
+		if err := s.releaseItem(item); err != nil {
+			return released, err
+		}
+		released = append(released, item)
+	}
+
+	return released, nil
+}
+
+// attachAddOn loads the product of an item
+func (s *AddOnService) attachAddOn(item *models.AddOnItem) error {
+	addOn, err := s.addOnRepo.FindByID(item.AddOnID)
+	if err != nil {
+		return fmt.Errorf("ek ürün bulunamadı: %w", err)
+	}
+	item.AddOn = addOn
+
+	return nil
+}
+
+// GetOrderSummary returns the pricing breakdown of the user's order for an event
+// (active tickets and add-ons; cancelled and refunded lines are left out)
+func (s *AddOnService) GetOrderSummary(userID, eventID int64) (*models.OrderSummary, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
+
+	// 1. Get tickets
+	tickets, err := s.ticketRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("biletler getirilemedi: %w", err)
+	}
+
+	summary := &models.OrderSummary{
+		EventID: eventID,
+		UserID:  userID,
+		Lines:   []*models.OrderLine{},
+	}
+
+	for _, ticket := range tickets {
+		if ticket.EventID != eventID || !isActiveTicketStatus(ticket) {
+			continue
+		}
+
+		summary.Lines = append(summary.Lines, &models.OrderLine{
+			Kind:        "ticket",
+			ReferenceID: ticket.ID,
+			TicketID:    ticket.ID,
+			Name:        ticket.TicketNumber,
+			Quantity:    1,
+			UnitPrice:   ticket.Price,
+			Total:       ticket.Price,
+			Status:      string(ticket.Status),
+		})
+		summary.TicketsTotal += ticket.Price
+	}
+
+	// 2. Get add-ons
+	items, err := s.addOnRepo.FindItemsByUserAndEvent(userID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("ek ürünler alınamadı: %w", err)
+	}
+
+	for _, item := range items {
+		if !item.IsActive() {
+			continue
+		}
+		if err := s.attachAddOn(item); err != nil {
+			return nil, err
+		}
+
+		summary.Lines = append(summary.Lines, &models.OrderLine{
+			Kind:        "addon",
+			ReferenceID: item.ID,
+			TicketID:    item.TicketID,
+			Name:        item.AddOn.Name,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Total:       item.TotalPrice,
+			Status:      string(item.Status),
+		})
+		summary.AddOnsTotal += item.TotalPrice
+	}
+
+	// 3. Totals
+	summary.Total = summary.TicketsTotal + summary.AddOnsTotal
+
+	return summary, nil
+}
+
+// isActiveTicketStatus reports whether a ticket belongs to the payable order
+func isActiveTicketStatus(ticket *models.Ticket) bool {
+	switch ticket.Status {
+	case models.TicketStatusSold, models.TicketStatusUsed:
+		return true
+	case models.TicketStatusReserved:
+		return !ticket.IsExpired()
+	}
+	return false
+}
+
+// ValidateVoucher validates a scannable add-on voucher at the gate (e.g. parking entrance)
+func (s *AddOnService) ValidateVoucher(voucherCode, verificationCode string) (*models.AddOnItem, error) {
+	if err := requirePermission(s.scope, models.PermissionScanTickets); err != nil {
+		return nil, err
+	}
+
+	// 1. Find voucher (only vouchers of the scope's events can be scanned)
+	item, err := s.addOnRepo.FindItemByVoucherCode(voucherCode)
+	if err != nil {
+		return nil, fmt.Errorf("voucher bulunamadı: %w", err)
+	}
+
+	if _, err := requireEventAccess(s.scope, s.eventRepo, item.EventID, models.PermissionScanTickets); err != nil {
+		return nil, fmt.Errorf("voucher bulunamadı: %w", err)
+	}
+
+	// 2. Validate verification code
+	if !s.ticketValidator.ValidateAddOnVerificationCode(verificationCode, item) {
+		return nil, fmt.Errorf("doğrulama kodu hatalı")
+	}
+
+	// 3. Business rules
+	if item.Status == models.AddOnItemStatusUsed {
+		return nil, fmt.Errorf("voucher daha önce kullanılmış")
+	}
+
+	if !item.CanUse() {
+		return nil, fmt.Errorf("voucher kullanılamaz durumda: %s", item.Status)
+	}
+
+	if err := s.attachAddOn(item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// UseVoucher marks a scannable add-on voucher as used
+func (s *AddOnService) UseVoucher(voucherCode, verificationCode string) (*models.AddOnItem, error) {
+	item, err := s.ValidateVoucher(voucherCode, verificationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.addOnRepo.UpdateItemStatus(item.ID, models.AddOnItemStatusUsed); err != nil {
+		return nil, fmt.Errorf("voucher kullanıldı olarak işaretlenemedi: %w", err)
+	}
+	item.Status = models.AddOnItemStatusUsed
+
+	return item, nil
+}
@@ -0,0 +1,288 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/patterns/observer"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+)
+
+// maxAnalyticsBuckets caps the length of a time series (e.g. ~41 days hourly)
+const maxAnalyticsBuckets = 1000
+
+// AnalyticsService records sales activity and serves time-series reports.
+// It implements observer.AnalyticsService, so attaching an AnalyticsObserver
+// backed by it records ticket purchases, cancellations and check-ins as they
+// happen; page views are recorded directly via TrackPageView.
+type AnalyticsService struct {
+	analyticsRepo *repositories.AnalyticsRepository
+	eventRepo     *repositories.EventRepository
+	scope         models.OrganizerScope
+}
+
+func NewAnalyticsService(
+	analyticsRepo *repositories.AnalyticsRepository,
+	eventRepo *repositories.EventRepository,
+) *AnalyticsService {
+	return &AnalyticsService{
+		analyticsRepo: analyticsRepo,
+		eventRepo:     eventRepo,
+	}
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer events
+func (s *AnalyticsService) ForOrganizer(scope models.OrganizerScope) *AnalyticsService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// TrackEvent implements observer.AnalyticsService. Ticket purchases, cancellations
+// and check-ins are recorded once per ticket (per session for check-ins); other
+// event types are ignored.
+func (s *AnalyticsService) TrackEvent(eventType, userID string, properties map[string]interface{}) error {
+	// 1. Map observer event to analytics activity
+	var activity models.AnalyticsActivity
+	switch observer.EventType(eventType) {
+	case observer.EventTypeTicketPurchased:
+		activity = models.AnalyticsActivityPurchase
+	case observer.EventTypeTicketCancelled:
+		activity = models.AnalyticsActivityCancellation
+	case observer.EventTypeTicketUsed:
+		activity = models.AnalyticsActivityCheckIn
+	default:
+		return nil
+	}
+
+	ticketID := int64Property(properties, "ticket_id")
+	if ticketID == 0 {
+		return fmt.Errorf("analitik olayında ticket_id yok: %s", eventType)
+	}
+
+	occurredAt := time.Now()
+	if timestamp, ok := properties["timestamp"].(time.Time); ok && !timestamp.IsZero() {
+		occurredAt = timestamp
+	}
+
+	// 2. Build the dedup key; observers may be notified more than once for the same ticket
+	dedupKey := fmt.Sprintf("%s:%d", activity, ticketID)
+	if sessionID := int64Property(properties, "session_id"); sessionID > 0 {
+		dedupKey = fmt.Sprintf("%s:%d", dedupKey, sessionID)
+	}
+
+	// 3. Record with the ticket's section, type, code and channel
+	if _, err := s.analyticsRepo.RecordTicketActivity(activity, ticketID, dedupKey, occurredAt); err != nil {
+		return fmt.Errorf("analitik olayı kaydedilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// int64Property reads an integer observer property regardless of its concrete type
+func int64Property(properties map[string]interface{}, key string) int64 {
+	switch value := properties[key].(type) {
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	case float64:
+		return int64(value)
+	}
+	return 0
+}
+
+// TrackPageView records a view of an event page (userID 0 = anonymous)
+func (s *AnalyticsService) TrackPageView(eventID, userID int64, channel string) error {
+	// 1. Validate
+	channel = strings.TrimSpace(channel)
+	if channel == "" {
+		channel = models.SalesChannelOnline
+	}
+	if len(channel) > 20 {
+		return fmt.Errorf("channel: en fazla 20 karakter olmalı")
+	}
+
+	if _, err := s.eventRepo.FindByID(eventID); err != nil {
+		return fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	// 2. Record
+	var viewer *int64
+	if userID > 0 {
+		viewer = &userID
+	}
+
+	if err := s.analyticsRepo.RecordPageView(eventID, viewer, channel, time.Now()); err != nil {
+		return fmt.Errorf("sayfa görüntüleme kaydedilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// reportEvent authorizes a report and returns its event
+func (s *AnalyticsService) reportEvent(eventID int64) (*models.Event, error) {
+	if err := requirePermission(s.scope, models.PermissionViewReports); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	return event, nil
+}
+
+// normalizeRange fills an open date range: from defaults to the event's creation, to to now
+func normalizeRange(event *models.Event, from, to time.Time) (time.Time, time.Time, error) {
+	if from.IsZero() {
+		from = event.CreatedAt
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if !to.After(from) {
+		return from, to, fmt.Errorf("to: bitiş başlangıçtan sonra olmalı")
+	}
+	return from, to, nil
+}
+
+// GetSalesTimeSeries returns purchases, cancellations, check-ins and page views per
+// time bucket of the query's range. Empty buckets are included so the series is continuous.
+func (s *AnalyticsService) GetSalesTimeSeries(query models.SalesReportQuery) ([]*models.SalesBucket, error) {
+	event, err := s.reportEvent(query.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, buckets, err := s.salesSeries(event, &query)
+	return buckets, err
+}
+
+// salesSeries validates the query and rolls hourly activity up to the requested granularity
+func (s *AnalyticsService) salesSeries(event *models.Event, query *models.SalesReportQuery) (*models.SalesReportQuery, []*models.SalesBucket, error) {
+	// 1. Validate and fill defaults
+	if query.Granularity == "" {
+		query.Granularity = models.GranularityDay
+	}
+	if !query.Granularity.IsValid() {
+		return nil, nil, fmt.Errorf("granularity: hour, day, week veya month olmalı")
+	}
+	if query.Location == nil {
+		query.Location = time.UTC
+	}
+
+	from, to, err := normalizeRange(event, query.From, query.To)
+	if err != nil {
+		return nil, nil, err
+	}
+	query.From, query.To = from, to
+
+	// 2. Build empty buckets covering [from, to)
+	var buckets []*models.SalesBucket
+	index := make(map[int64]*models.SalesBucket)
+	for start := query.Granularity.Truncate(from, query.Location); start.Before(to); start = query.Granularity.Next(start) {
+		if len(buckets) == maxAnalyticsBuckets {
+			return nil, nil, fmt.Errorf("rapor en fazla %d dilim içerebilir; aralığı daraltın veya daha büyük bir zaman birimi seçin", maxAnalyticsBuckets)
+		}
+		bucket := &models.SalesBucket{Period: start}
+		buckets = append(buckets, bucket)
+		index[start.Unix()] = bucket
+	}
+
+	// 3. Roll hourly activity up into the buckets
+	activities, err := s.analyticsRepo.FindHourlyActivity(event.ID, from, to)
+	if err != nil {
+		return nil, nil, fmt.Errorf("satış hareketleri alınamadı: %w", err)
+	}
+
+	for _, activity := range activities {
+		if bucket, ok := index[query.Granularity.Truncate(activity.Hour, query.Location).Unix()]; ok {
+			bucket.Add(activity)
+		}
+	}
+
+	for _, bucket := range buckets {
+		bucket.Revenue = roundAmount(bucket.Revenue)
+		bucket.CancelledRevenue = roundAmount(bucket.CancelledRevenue)
+		bucket.NetRevenue = roundAmount(bucket.NetRevenue)
+	}
+
+	return query, buckets, nil
+}
+
+// GetSellThrough returns the cumulative net tickets sold at the end of each time
+// bucket and their share of the event capacity
+func (s *AnalyticsService) GetSellThrough(query models.SalesReportQuery) ([]*models.SellThroughPoint, error) {
+	// 1. Build the series
+	event, err := s.reportEvent(query.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized, buckets, err := s.salesSeries(event, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Start from the tickets sold before the range
+	cumulative, err := s.analyticsRepo.CountNetSoldBefore(event.ID, normalized.From)
+	if err != nil {
+		return nil, fmt.Errorf("önceki satışlar alınamadı: %w", err)
+	}
+
+	// 3. Accumulate
+	points := make([]*models.SellThroughPoint, 0, len(buckets))
+	for _, bucket := range buckets {
+		cumulative += bucket.NetTickets
+
+		point := &models.SellThroughPoint{
+			Period:            bucket.Period,
+			CumulativeTickets: cumulative,
+			Capacity:          event.TotalCapacity,
+		}
+		if event.TotalCapacity > 0 {
+			point.SellThrough = roundAmount(float64(cumulative) / float64(event.TotalCapacity) * 100)
+		}
+		points = append(points, point)
+	}
+
+	return points, nil
+}
+
+// GetSalesBreakdown returns the sales of a date range grouped by section,
+// ticket type, presale code or channel, highest revenue first
+func (s *AnalyticsService) GetSalesBreakdown(eventID int64, dimension models.AnalyticsDimension, from, to time.Time) ([]*models.SalesBreakdown, error) {
+	// 1. Validate
+	if !dimension.IsValid() {
+		return nil, fmt.Errorf("dimension: section, ticket_type, promo_code veya channel olmalı")
+	}
+
+	event, err := s.reportEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err = normalizeRange(event, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Aggregate
+	breakdown, err := s.analyticsRepo.FindBreakdown(event.ID, dimension, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("satış dağılımı alınamadı: %w", err)
+	}
+
+	for _, row := range breakdown {
+		row.Revenue = roundAmount(row.Revenue)
+		row.CancelledRevenue = roundAmount(row.CancelledRevenue)
+		row.NetRevenue = roundAmount(row.NetRevenue)
+	}
+
+	return breakdown, nil
+}
@@ -0,0 +1,396 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	"github.com/biyonik/event-ticketing-api/pkg/mail"
+	"github.com/biyonik/event-ticketing-api/pkg/storage"
+	"github.com/biyonik/event-ticketing-api/pkg/token"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+	"github.com/biyonik/event-ticketing-api/pkg/xlsx"
+)
+
+// ErrExportLinkInvalid is returned for tampered, expired or not yet usable download links
+var ErrExportLinkInvalid = errors.New("indirme bağlantısı geçersiz veya süresi dolmuş")
+
+// AttendeeExportDispatcher queues an export for background processing
+type AttendeeExportDispatcher interface {
+	DispatchAttendeeExport(exportID int64) error
+}
+
+// AttendeeExportOptions configures export files and download links
+type AttendeeExportOptions struct {
+	BaseURL     string         // Public API URL the download link points to
+	LinkSecret  string         // HMAC secret of download links
+	LinkTTL     time.Duration  // How long a download link stays valid
+	FromAddress string         // Sender of the link e-mail
+	Location    *time.Location // Time zone of check-in times in the file (nil = server local)
+}
+
+// AttendeeExportService produces attendee lists (guest lists) of an event for
+// security and accreditation staff. Exports run in the background: the file is
+// streamed from the database into storage, and the requester receives a signed
+// download link by e-mail that stops working after LinkTTL.
+type AttendeeExportService struct {
+	exportRepo *repositories.AttendeeExportRepository
+	eventRepo  *repositories.EventRepository
+	storage    storage.Storage
+	mailer     mail.Mailer
+	options    AttendeeExportOptions
+	dispatcher AttendeeExportDispatcher
+	scope      models.OrganizerScope
+}
+
+func NewAttendeeExportService(
+	exportRepo *repositories.AttendeeExportRepository,
+	eventRepo *repositories.EventRepository,
+	store storage.Storage,
+	mailer mail.Mailer,
+	options AttendeeExportOptions,
+) *AttendeeExportService {
+	if options.Location == nil {
+		options.Location = time.Local
+	}
+
+	return &AttendeeExportService{
+		exportRepo: exportRepo,
+		eventRepo:  eventRepo,
+		storage:    store,
+		mailer:     mailer,
+		options:    options,
+	}
+}
+
+// SetDispatcher sets the queue used for exports. Without it exports are processed inline.
+func (s *AttendeeExportService) SetDispatcher(dispatcher AttendeeExportDispatcher) {
+	s.dispatcher = dispatcher
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer events
+func (s *AttendeeExportService) ForOrganizer(scope models.OrganizerScope) *AttendeeExportService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.exportRepo = s.exportRepo.ForOrganizer(scope.OrganizerID)
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// RequestExport queues an attendee export of an event; the download link is e-mailed to the
+// requester (email is the authenticated user's address, never a client-supplied one)
+func (s *AttendeeExportService) RequestExport(eventID, requestedBy int64, email string, format models.AttendeeExportFormat) (*models.AttendeeExport, error) {
+	if err := requirePermission(s.scope, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
+
+	// 1. Validate input using Conduit-Go Validation
+	schema := v.Make().Shape(map[string]v.Type{
+		"email": types.String().
+			Required().
+			Email().
+			Label("E-posta"),
+	})
+
+	result := schema.Validate(map[string]any{"email": email})
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	if format == "" {
+		format = models.AttendeeExportCSV
+	}
+	if !format.IsValid() {
+		return nil, fmt.Errorf("format: csv veya xlsx olmalı")
+	}
+
+	// 2. Check event access
+	if _, err := s.eventRepo.FindByID(eventID); err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	// 3. Create export request
+	export := &models.AttendeeExport{
+		EventID:     eventID,
+		RequestedBy: requestedBy,
+		Email:       email,
+		Format:      format,
+		Status:      models.AttendeeExportPending,
+	}
+	export.Initialize()
+
+	id, err := s.exportRepo.Create(export)
+	if err != nil {
+		return nil, fmt.Errorf("dışa aktarım oluşturulamadı: %w", err)
+	}
+	export.ID = id
+
+	// 4. Queue (or process inline without a queue)
+	if s.dispatcher == nil {
+		if err := s.ProcessExport(id); err != nil {
+			s.exportRepo.MarkFailed(id, err.Error())
+			return nil, err
+		}
+		return s.exportRepo.FindByID(id)
+	}
+
+	if err := s.dispatcher.DispatchAttendeeExport(id); err != nil {
+		s.exportRepo.MarkFailed(id, err.Error())
+		return nil, fmt.Errorf("dışa aktarım kuyruğa eklenemedi: %w", err)
+	}
+
+	return export, nil
+}
+
+// ProcessExport writes the export file to storage and e-mails the download link.
+// Called by the queue job (under models.SystemScope()); safe to retry, a completed export is not produced again.
+func (s *AttendeeExportService) ProcessExport(exportID int64) error {
+	if err := requireScope(s.scope); err != nil {
+		return err
+	}
+
+	// 1. Claim the export
+	export, err := s.exportRepo.FindByID(exportID)
+	if err != nil {
+		return fmt.Errorf("dışa aktarım bulunamadı: %w", err)
+	}
+
+	claimed, err := s.exportRepo.MarkProcessing(exportID)
+	if err != nil {
+		return fmt.Errorf("dışa aktarım güncellenemedi: %w", err)
+	}
+	if !claimed {
+		return nil // Zaten tamamlanmış
+	}
+
+	event, err := s.eventRepo.FindByID(export.EventID)
+	if err != nil {
+		return fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	// 2. Stream attendees into storage; the random part keeps file paths unguessable
+	suffix, err := token.GenerateSecureTokenHex(16)
+	if err != nil {
+		return fmt.Errorf("dosya adı üretilemedi: %w", err)
+	}
+	path := fmt.Sprintf("exports/attendees/%d/%d-%s.%s", export.EventID, export.ID, suffix, export.Format)
+
+	rowCount, err := s.writeToStorage(path, export)
+	if err != nil {
+		s.storage.Delete(path)
+		return fmt.Errorf("katılımcı listesi yazılamadı: %w", err)
+	}
+
+	// 3. E-mail the link before completing, so a failed e-mail is retried with a new file
+	completedAt := time.Now()
+	expiresAt := completedAt.Add(s.options.LinkTTL).Truncate(time.Second)
+
+	message := mail.NewMessage().
+		From(s.options.FromAddress, "").
+		To(export.Email, "").
+		Subject(fmt.Sprintf("Katılımcı listesi hazır: %s", event.Name)).
+		Body(fmt.Sprintf(
+			"%s etkinliğinin katılımcı listesi (%d bilet, %s) hazır.\n\nİndirme bağlantısı %s tarihine kadar geçerlidir:\n%s\n",
+			event.Name, rowCount, strings.ToUpper(string(export.Format)),
+			expiresAt.In(s.options.Location).Format("02.01.2006 15:04"),
+			s.DownloadURL(export.ID, expiresAt),
+		))
+
+	if err := s.mailer.Send(message); err != nil {
+		s.storage.Delete(path)
+		return fmt.Errorf("indirme bağlantısı gönderilemedi: %w", err)
+	}
+
+	// 4. Complete
+	if err := s.exportRepo.MarkCompleted(export.ID, path, rowCount, completedAt, expiresAt); err != nil {
+		return fmt.Errorf("dışa aktarım tamamlanamadı: %w", err)
+	}
+
+	return nil
+}
+
+// writeToStorage streams the export file through a pipe into storage without buffering it
+func (s *AttendeeExportService) writeToStorage(path string, export *models.AttendeeExport) (int, error) {
+	reader, writer := io.Pipe()
+
+	type writeResult struct {
+		rows int
+		err  error
+	}
+	done := make(chan writeResult, 1)
+
+	go func() {
+		rows, err := s.writeAttendees(writer, export)
+		writer.CloseWithError(err)
+		done <- writeResult{rows, err}
+	}()
+
+	putErr := s.storage.PutFile(path, reader)
+	reader.CloseWithError(putErr) // Yazan goroutine'i storage hatasında serbest bırakır
+
+	result := <-done
+	if result.err != nil {
+		return 0, result.err
+	}
+	if putErr != nil {
+		return 0, putErr
+	}
+
+	return result.rows, nil
+}
+
+// writeAttendees writes the header and attendee rows in the export's format
+func (s *AttendeeExportService) writeAttendees(w io.Writer, export *models.AttendeeExport) (int, error) {
+	switch export.Format {
+	case models.AttendeeExportXLSX:
+		sheet, err := xlsx.NewWriter(w, "Katılımcılar")
+		if err != nil {
+			return 0, err
+		}
+		if err := sheet.WriteHeader(models.AttendeeExportColumns); err != nil {
+			return 0, err
+		}
+
+		rows, err := s.exportRepo.StreamAttendees(export.EventID, func(row *models.AttendeeRow) error {
+			return sheet.WriteRow(row.Record(s.options.Location))
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return rows, sheet.Close()
+
+	default:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(models.AttendeeExportColumns); err != nil {
+			return 0, err
+		}
+
+		rows, err := s.exportRepo.StreamAttendees(export.EventID, func(row *models.AttendeeRow) error {
+			record := row.Record(s.options.Location)
+			for i := range record {
+				record[i] = csvSafe(record[i])
+			}
+			return writer.Write(record)
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		writer.Flush()
+		return rows, writer.Error()
+	}
+}
+
+// csvSafe neutralizes values a spreadsheet would run as a formula (CSV injection)
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// signExportLink computes the download link signature of an export and expiry
+func (s *AttendeeExportService) signExportLink(exportID int64, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(s.options.LinkSecret))
+	fmt.Fprintf(mac, "attendee-export.%d.%d", exportID, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// DownloadURL returns the signed download link of an export valid until expiresAt
+func (s *AttendeeExportService) DownloadURL(exportID int64, expiresAt time.Time) string {
+	expires := expiresAt.Unix()
+	return fmt.Sprintf("%s/attendee-exports/%d/download?expires=%d&signature=%s",
+		strings.TrimRight(s.options.BaseURL, "/"), exportID, expires, s.signExportLink(exportID, expires))
+}
+
+// OpenDownload verifies a download link and opens the export file. The caller must close the stream.
+func (s *AttendeeExportService) OpenDownload(exportID int64, expires string, signature string) (*models.AttendeeExport, io.ReadCloser, error) {
+	// 1. Verify signature and expiry (constant-time comparison)
+	expiresUnix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return nil, nil, ErrExportLinkInvalid
+	}
+
+	expected := s.signExportLink(exportID, expiresUnix)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, nil, ErrExportLinkInvalid
+	}
+
+	now := time.Now()
+	if now.Unix() >= expiresUnix {
+		return nil, nil, ErrExportLinkInvalid
+	}
+
+	// 2. The link must belong to the export's current file
+	export, err := s.exportRepo.FindByID(exportID)
+	if err != nil {
+		return nil, nil, ErrExportLinkInvalid
+	}
+	if !export.IsDownloadable(now) || export.ExpiresAt.Unix() != expiresUnix {
+		return nil, nil, ErrExportLinkInvalid
+	}
+
+	// 3. Open file
+	stream, err := s.storage.GetStream(*export.FilePath)
+	if err != nil {
+		log.Printf("⚠️  Dışa aktarım dosyası açılamadı (export %d): %v", export.ID, err)
+		return nil, nil, ErrExportLinkInvalid
+	}
+
+	return export, stream, nil
+}
+
+// GetExport returns the status of an export
+func (s *AttendeeExportService) GetExport(exportID int64) (*models.AttendeeExport, error) {
+	if err := requirePermission(s.scope, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
+
+	export, err := s.exportRepo.FindByID(exportID)
+	if err != nil {
+		return nil, fmt.Errorf("dışa aktarım bulunamadı: %w", err)
+	}
+
+	return export, nil
+}
+
+// ListExports returns the exports of an event, newest first
+func (s *AttendeeExportService) ListExports(eventID int64) ([]*models.AttendeeExport, error) {
+	if err := requirePermission(s.scope, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.eventRepo.FindByID(eventID); err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	exports, err := s.exportRepo.FindByEvent(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("dışa aktarımlar alınamadı: %w", err)
+	}
+
+	return exports, nil
+}
+
+// MarkExportFailed records the final failure of an export job
+func (s *AttendeeExportService) MarkExportFailed(exportID int64, cause error) error {
+	if err := requireScope(s.scope); err != nil {
+		return err
+	}
+
+	return s.exportRepo.MarkFailed(exportID, cause.Error())
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	"github.com/biyonik/event-ticketing-api/pkg/audit"
+)
+
+// auditPurgeBatchSize limits how many rows a single retention DELETE removes
+const auditPurgeBatchSize = 1000
+
+// AuditRecorder appends an audit entry for a mutation (AuditService implements it)
+type AuditRecorder interface {
+	Record(actor models.AuditActor, action models.AuditAction, entityType models.AuditEntityType, entityID int64, before, after interface{}) error
+}
+
+type AuditService struct {
+	auditRepo *repositories.AuditLogRepository
+	retention time.Duration
+	scope     models.OrganizerScope
+}
+
+// NewAuditService creates the audit service; retention <= 0 keeps entries forever
+func NewAuditService(auditRepo *repositories.AuditLogRepository, retention time.Duration) *AuditService {
+	return &AuditService{
+		auditRepo: auditRepo,
+		retention: retention,
+	}
+}
+
+// ForOrganizer returns a copy of the service bound to the caller's scope;
+// the audit trail can only be queried by platform admins
+func (s *AuditService) ForOrganizer(scope models.OrganizerScope) *AuditService {
+	scoped := *s
+	scoped.scope = scope
+	return &scoped
+}
+
+// Record appends an audit entry with the field diff between before and after.
+// Steps:
+// 1. Diff the JSON representations (nil before = create, nil after = delete)
+// 2. Skip updates that changed nothing
+// 3. Append the entry (actions without an actor are attributed to "system")
+func (s *AuditService) Record(
+	actor models.AuditActor,
+	action models.AuditAction,
+	entityType models.AuditEntityType,
+	entityID int64,
+	before, after interface{},
+) error {
+	// 1. Diff
+	changes, err := audit.Diff(before, after)
+	if err != nil {
+		return fmt.Errorf("denetim farkı hesaplanamadı: %w", err)
+	}
+
+	// 2. Nothing changed
+	if len(changes) == 0 && action == models.AuditActionUpdate {
+		return nil
+	}
+
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("denetim farkı kodlanamadı: %w", err)
+	}
+
+	// 3. Append
+	if actor.IsZero() {
+		actor = models.SystemActor("system")
+	}
+
+	entry := &models.AuditLog{
+		ActorType:  actor.Type,
+		ActorID:    actor.ID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		ChangesRaw: string(encoded),
+		IPAddress:  actor.IP,
+		RequestID:  actor.RequestID,
+		CreatedAt:  time.Now(),
+	}
+
+	if _, err := s.auditRepo.Append(entry); err != nil {
+		return fmt.Errorf("denetim kaydı yazılamadı: %w", err)
+	}
+
+	return nil
+}
+
+// Query returns audit entries matching the filter, newest first (platform admins only)
+func (s *AuditService) Query(filter *models.AuditLogFilter) ([]*models.AuditLog, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return nil, err
+	}
+
+	filter.Normalize()
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.auditRepo.Find(filter)
+	if err != nil {
+		return nil, fmt.Errorf("denetim kayıtları getirilemedi: %w", err)
+	}
+
+	for _, entry := range entries {
+		if err := json.Unmarshal([]byte(entry.ChangesRaw), &entry.Changes); err != nil {
+			return nil, fmt.Errorf("denetim kaydı #%d okunamadı: %w", entry.ID, err)
+		}
+	}
+
+	return entries, nil
+}
+
+// PurgeExpired deletes entries older than the retention period in batches
+// and returns how many were removed
+func (s *AuditService) PurgeExpired() (int64, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return 0, err
+	}
+
+	if s.retention <= 0 {
+		return 0, nil
+	}
+
+	cutoff := time.Now().Add(-s.retention)
+
+	var total int64
+	for {
+		deleted, err := s.auditRepo.DeleteOlderThan(cutoff, auditPurgeBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("eski denetim kayıtları silinemedi: %w", err)
+		}
+
+		total += deleted
+		if deleted < auditPurgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// auditTrail is held by services that audit their mutations; it carries
+// the optional recorder and the actor of the current request
+type auditTrail struct {
+	recorder AuditRecorder
+	actor    models.AuditActor
+}
+
+// record appends an audit entry; audit failures are logged and do not fail the change
+func (a auditTrail) record(action models.AuditAction, entityType models.AuditEntityType, entityID int64, before, after interface{}) {
+	if a.recorder == nil {
+		return
+	}
+	if err := a.recorder.Record(a.actor, action, entityType, entityID, before, after); err != nil {
+		log.Printf("⚠️  Denetim kaydı yazılamadı (%s %d): %v", entityType, entityID, err)
+	}
+}
@@ -0,0 +1,478 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	"github.com/biyonik/event-ticketing-api/pkg/recurrence"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+)
+
+// EventSeriesInput carries the fields of a new event series
+type EventSeriesInput struct {
+	Name            string
+	Description     string
+	Type            models.EventType
+	VenueID         int64
+	BasePrice       float64
+	ImageURL        string
+	DurationMinutes int
+	RecurrenceRule  string    // e.g. FREQ=WEEKLY;BYDAY=TU,WE,FR,SA;UNTIL=20250630
+	FirstStartTime  time.Time // First performance; its wall-clock time in Timezone is kept for all
+	Timezone        string
+	Exceptions      []time.Time // Calendar days without a performance
+}
+
+// EventSeriesUpdate carries a bulk edit applied to the series and all of its
+// future, non-cancelled performances. Nil fields are left untouched.
+type EventSeriesUpdate struct {
+	Name            *string
+	Description     *string
+	BasePrice       *float64
+	ImageURL        *string
+	DurationMinutes *int
+	Publish         bool // Publish future draft performances
+}
+
+// EventSeriesUpdateResult reports how many performances a bulk edit touched
+type EventSeriesUpdateResult struct {
+	Series           *models.EventSeries `json:"series"`
+	UpdatedEvents    int64               `json:"updated_events"`
+	PublishedEvents  int                 `json:"published_events"`
+	PublishFailedIDs []int64             `json:"publish_failed_ids,omitempty"`
+}
+
+// EventSeriesService manages recurring event series: it expands the recurrence
+// rule into Event instances sharing the series' description, venue and pricing,
+// and applies edits across all future performances.
+type EventSeriesService struct {
+	seriesRepo   *repositories.EventSeriesRepository
+	eventRepo    *repositories.EventRepository
+	venueRepo    *repositories.VenueRepository
+	eventService *EventService
+	scope        models.OrganizerScope
+}
+
+func NewEventSeriesService(
+	seriesRepo *repositories.EventSeriesRepository,
+	eventRepo *repositories.EventRepository,
+	venueRepo *repositories.VenueRepository,
+	eventService *EventService,
+) *EventSeriesService {
+	return &EventSeriesService{
+		seriesRepo:   seriesRepo,
+		eventRepo:    eventRepo,
+		venueRepo:    venueRepo,
+		eventService: eventService,
+	}
+}
+
+// ForOrganizer returns a copy of the service whose event and venue access is restricted
+// to the scope's organizer; performances are changed through an EventService with the same scope
+func (s *EventSeriesService) ForOrganizer(scope models.OrganizerScope) *EventSeriesService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	scoped.venueRepo = s.venueRepo.ForOrganizer(scope.OrganizerID)
+	scoped.eventService = s.eventService.ForOrganizer(scope)
+	return &scoped
+}
+
+// CreateSeries creates a series and generates its draft performances (admin)
+func (s *EventSeriesService) CreateSeries(input EventSeriesInput) (*models.EventSeries, []*models.Event, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, nil, err
+	}
+
+	// 1. Validate input using Conduit-Go Validation
+	schema := v.Make().Shape(map[string]v.Type{
+		"name": types.String().
+			Required().
+			Min(3).
+			Max(255).
+			Label("Seri Adı"),
+		"description": types.String().
+			Required().
+			Min(10).
+			Max(2000).
+			Label("Açıklama"),
+		"type": types.String().
+			Required().
+			OneOf([]string{"concert", "theater", "sports", "conference", "festival"}).
+			Label("Etkinlik Tipi"),
+		"base_price": types.Number().
+			Required().
+			Min(0.01).
+			Label("Temel Fiyat"),
+		"duration_minutes": types.Number().
+			Required().
+			Min(1).
+			Max(1440).
+			Label("Süre (dakika)"),
+		"image_url": types.String().
+			URL().
+			Max(500).
+			Label("Görsel URL"),
+	})
+
+	result := schema.Validate(map[string]any{
+		"name":             input.Name,
+		"description":      input.Description,
+		"type":             string(input.Type),
+		"base_price":       input.BasePrice,
+		"duration_minutes": float64(input.DurationMinutes),
+		"image_url":        input.ImageURL,
+	})
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return nil, nil, fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	// 2. Recurrence rule and timezone
+	rule, err := recurrence.Parse(input.RecurrenceRule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("recurrence_rule: geçersiz tekrar kuralı: %w", err)
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = models.DefaultSeriesTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, nil, fmt.Errorf("timezone: geçersiz saat dilimi")
+	}
+
+	if input.FirstStartTime.Before(time.Now()) {
+		return nil, nil, fmt.Errorf("ilk gösterim zamanı geçmişte olamaz")
+	}
+
+	// 3. Verify venue exists
+	if _, err := s.venueRepo.FindByID(input.VenueID); err != nil {
+		return nil, nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	// 4. Create series
+	validData := result.ValidData()
+	series := &models.EventSeries{
+		Name:            validData["name"].(string),
+		Description:     validData["description"].(string),
+		Type:            input.Type,
+		VenueID:         input.VenueID,
+		BasePrice:       validData["base_price"].(float64),
+		ImageURL:        input.ImageURL,
+		DurationMinutes: input.DurationMinutes,
+		RecurrenceRule:  rule.String(),
+		FirstStartTime:  input.FirstStartTime,
+		Timezone:        timezone,
+	}
+	series.Initialize()
+
+	seriesID, err := s.seriesRepo.Create(series)
+	if err != nil {
+		return nil, nil, fmt.Errorf("seri oluşturulamadı: %w", err)
+	}
+	series.ID = seriesID
+
+	for _, date := range input.Exceptions {
+		if err := s.seriesRepo.AddException(seriesID, date); err != nil {
+			return nil, nil, fmt.Errorf("istisna günü eklenemedi: %w", err)
+		}
+		series.Exceptions = append(series.Exceptions, date)
+	}
+
+	// 5. Generate performances
+	events, err := s.generate(series, rule)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return series, events, nil
+}
+
+// GetSeries returns a series with its exception dates
+func (s *EventSeriesService) GetSeries(id int64) (*models.EventSeries, error) {
+	series, err := s.seriesRepo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("seri bulunamadı: %w", err)
+	}
+
+	return series, nil
+}
+
+// GenerateInstances creates the missing future performances of a series.
+// It is idempotent: existing start times and past dates are skipped.
+func (s *EventSeriesService) GenerateInstances(seriesID int64) ([]*models.Event, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	series, err := s.seriesRepo.FindByID(seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("seri bulunamadı: %w", err)
+	}
+
+	rule, err := recurrence.Parse(series.RecurrenceRule)
+	if err != nil {
+		return nil, fmt.Errorf("serinin tekrar kuralı geçersiz: %w", err)
+	}
+
+	return s.generate(series, rule)
+}
+
+// generate creates a draft Event for every occurrence that has no performance yet
+func (s *EventSeriesService) generate(series *models.EventSeries, rule *recurrence.Rule) ([]*models.Event, error) {
+	// 1. Capacity comes from the venue, like single events
+	venue, err := s.venueRepo.FindByID(series.VenueID)
+	if err != nil {
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	// 2. Existing performances (including cancelled ones, so they are not recreated)
+	existing, err := s.seriesRepo.FindEvents(series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := make(map[int64]bool, len(existing))
+	for _, event := range existing {
+		taken[event.StartTime.Unix()] = true
+	}
+
+	// 3. Expand the rule in the series' timezone so DST keeps the wall-clock time
+	loc := series.Location()
+	first := series.FirstStartTime.In(loc)
+	dtstart := time.Date(first.Year(), first.Month(), first.Day(), first.Hour(), first.Minute(), 0, 0, loc)
+
+	now := time.Now()
+	var created []*models.Event
+
+	for _, start := range rule.Occurrences(dtstart, series.Exceptions, 0) {
+		if start.Before(now) || taken[start.Unix()] {
+			continue
+		}
+
+		event := &models.Event{
+			Name:           series.Name,
+			Description:    series.Description,
+			Type:           series.Type,
+			Status:         models.EventStatusDraft,
+			VenueID:        series.VenueID,
+			SeriesID:       &series.ID,
+			StartTime:      start,
+			EndTime:        start.Add(series.Duration()),
+			BasePrice:      series.BasePrice,
+			TotalCapacity:  venue.Capacity,
+			AvailableSeats: venue.Capacity,
+			ImageURL:       series.ImageURL,
+		}
+		event.Initialize()
+
+		eventID, err := s.eventRepo.Create(event)
+		if err != nil {
+			return created, fmt.Errorf("gösterim oluşturulamadı (%s): %w", start.Format(time.RFC3339), err)
+		}
+
+		event.ID = eventID
+		created = append(created, event)
+	}
+
+	return created, nil
+}
+
+// AddException records a no-show date. A draft performance on that date is
+// deleted; a published one is cancelled through EventService.
+func (s *EventSeriesService) AddException(seriesID int64, date time.Time) (*models.EventSeries, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Get series
+	series, err := s.seriesRepo.FindByID(seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("seri bulunamadı: %w", err)
+	}
+
+	// 2. Record exception
+	if err := s.seriesRepo.AddException(seriesID, date); err != nil {
+		return nil, fmt.Errorf("istisna günü eklenemedi: %w", err)
+	}
+
+	// 3. Remove the performance on that day, if any
+	events, err := s.seriesRepo.FindEvents(seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := series.Location()
+	day := date.Format("2006-01-02")
+
+	for _, event := range events {
+		if event.StartTime.In(loc).Format("2006-01-02") != day {
+			continue
+		}
+
+		switch event.Status {
+		case models.EventStatusDraft:
+			err = s.eventService.DeleteEvent(event.ID)
+		case models.EventStatusCancelled, models.EventStatusCompleted:
+			continue
+		default:
+			err = s.eventService.CancelEvent(event.ID)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("gösterim kaldırılamadı (%d): %w", event.ID, err)
+		}
+	}
+
+	return s.seriesRepo.FindByID(seriesID)
+}
+
+// BulkUpdate applies an edit to the series and all of its future performances (admin)
+func (s *EventSeriesService) BulkUpdate(seriesID int64, update EventSeriesUpdate) (*EventSeriesUpdateResult, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Get series
+	series, err := s.seriesRepo.FindByID(seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("seri bulunamadı: %w", err)
+	}
+
+	// 2. Business rules
+	data := map[string]interface{}{}
+
+	if update.Name != nil {
+		if len(*update.Name) < 3 || len(*update.Name) > 255 {
+			return nil, fmt.Errorf("name: 3-255 karakter olmalı")
+		}
+		series.Name = *update.Name
+		data["name"] = series.Name
+	}
+
+	if update.Description != nil {
+		if len(*update.Description) < 10 || len(*update.Description) > 2000 {
+			return nil, fmt.Errorf("description: 10-2000 karakter olmalı")
+		}
+		series.Description = *update.Description
+		data["description"] = series.Description
+	}
+
+	if update.BasePrice != nil {
+		if *update.BasePrice <= 0 {
+			return nil, fmt.Errorf("base_price: fiyat pozitif olmalı")
+		}
+		series.BasePrice = *update.BasePrice
+		data["base_price"] = series.BasePrice
+	}
+
+	if update.ImageURL != nil {
+		series.ImageURL = *update.ImageURL
+		data["image_url"] = series.ImageURL
+	}
+
+	if update.DurationMinutes != nil {
+		if *update.DurationMinutes < 1 || *update.DurationMinutes > 1440 {
+			return nil, fmt.Errorf("duration_minutes: 1-1440 dakika olmalı")
+		}
+		series.DurationMinutes = *update.DurationMinutes
+	}
+
+	if len(data) == 0 && update.DurationMinutes == nil && !update.Publish {
+		return nil, fmt.Errorf("güncellenecek alan yok")
+	}
+
+	// 3. Update series and future performances
+	series.UpdatedAt = time.Now()
+	if err := s.seriesRepo.Update(series); err != nil {
+		return nil, fmt.Errorf("seri güncellenemedi: %w", err)
+	}
+
+	res := &EventSeriesUpdateResult{Series: series}
+
+	if len(data) > 0 {
+		affected, err := s.seriesRepo.BulkUpdateFutureEvents(seriesID, data)
+		if err != nil {
+			return nil, fmt.Errorf("gösterimler güncellenemedi: %w", err)
+		}
+		res.UpdatedEvents = affected
+	}
+
+	if update.DurationMinutes != nil {
+		affected, err := s.seriesRepo.UpdateFutureEventDuration(seriesID, series.DurationMinutes)
+		if err != nil {
+			return nil, fmt.Errorf("gösterim süreleri güncellenemedi: %w", err)
+		}
+		if affected > res.UpdatedEvents {
+			res.UpdatedEvents = affected
+		}
+	}
+
+	// 4. Publish future drafts (EventService keeps the status rules and notifications)
+	if update.Publish {
+		events, err := s.seriesRepo.FindEvents(seriesID)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		for _, event := range events {
+			if event.Status != models.EventStatusDraft || !event.StartTime.After(now) {
+				continue
+			}
+			if err := s.eventService.PublishEvent(event.ID); err != nil {
+				res.PublishFailedIDs = append(res.PublishFailedIDs, event.ID)
+				continue
+			}
+			res.PublishedEvents++
+		}
+	}
+
+	return res, nil
+}
+
+// ListPerformances returns the "select a performance" list of a series
+func (s *EventSeriesService) ListPerformances(seriesID int64, from, to time.Time) ([]*models.Performance, error) {
+	// 1. Default window: from now, one year ahead
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = from.AddDate(1, 0, 0)
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("bitiş tarihi başlangıçtan önce olamaz")
+	}
+
+	if _, err := s.seriesRepo.FindByID(seriesID); err != nil {
+		return nil, fmt.Errorf("seri bulunamadı: %w", err)
+	}
+
+	// 2. Query performances
+	events, err := s.seriesRepo.FindPerformances(seriesID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("gösterimler getirilemedi: %w", err)
+	}
+
+	// 3. Map to list rows
+	performances := make([]*models.Performance, 0, len(events))
+	for _, event := range events {
+		performances = append(performances, &models.Performance{
+			EventID:        event.ID,
+			StartTime:      event.StartTime,
+			EndTime:        event.EndTime,
+			Status:         event.Status,
+			AvailableSeats: event.AvailableSeats,
+			TotalCapacity:  event.TotalCapacity,
+			BasePrice:      event.BasePrice,
+			IsSoldOut:      event.IsSoldOut(),
+		})
+	}
+
+	return performances, nil
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/biyonik/event-ticketing-api/internal/models"
@@ -12,11 +13,24 @@ import (
 	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
 )
 
+// trashPurgeBatchSize limits how many trashed events / venues one purge query loads
+const trashPurgeBatchSize = 100
+
+// EventIndexer keeps a search index in sync after an event changes
+// (SearchService upserts public events and removes the rest)
+type EventIndexer interface {
+	IndexEvent(eventID int64) error
+}
+
 type EventService struct {
 	eventRepo      *repositories.EventRepository
 	venueRepo      *repositories.VenueRepository
 	pricingFactory *strategy.PricingStrategyFactory
 	eventPublisher *observer.EventPublisher
+	states         *models.EventStateMachine
+	indexer        EventIndexer
+	audit          auditTrail
+	scope          models.OrganizerScope
 }
 
 func NewEventService(
@@ -29,6 +43,44 @@ func NewEventService(
 		venueRepo:      venueRepo,
 		pricingFactory: strategy.NewPricingStrategyFactory(),
 		eventPublisher: eventPublisher,
+		states:         newEventStates(eventPublisher),
+	}
+}
+
+// ForOrganizer returns a copy of the service whose event and venue queries are
+// restricted to the scope's organizer and whose mutations check the member's role
+func (s *EventService) ForOrganizer(scope models.OrganizerScope) *EventService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	scoped.venueRepo = s.venueRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose mutations are audited as the given actor
+func (s *EventService) WithActor(actor models.AuditActor) *EventService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for event mutations (optional)
+func (s *EventService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// SetEventIndexer registers the search index sync hook (optional)
+func (s *EventService) SetEventIndexer(indexer EventIndexer) {
+	s.indexer = indexer
+}
+
+// syncSearchIndex re-indexes the event; index failures are logged and do not fail the change
+func (s *EventService) syncSearchIndex(eventID int64) {
+	if s.indexer == nil {
+		return
+	}
+	if err := s.indexer.IndexEvent(eventID); err != nil {
+		log.Printf("⚠️  Arama indeksi güncellenemedi (event %d): %v", eventID, err)
 	}
 }
 
@@ -43,6 +95,10 @@ func (s *EventService) CreateEvent(
 	featured bool,
 	metadata string,
 ) (*models.Event, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
 	// 1. Validation - Conduit-Go Validation Schema kullanımı
 	schema := v.Make().Shape(map[string]v.Type{
 		"name": types.String().
@@ -100,7 +156,7 @@ func (s *EventService) CreateEvent(
 		return nil, fmt.Errorf("bitiş zamanı başlangıç zamanından önce olamaz")
 	}
 
-	// 3. Verify venue exists
+	// 3. Verify venue exists (scoped copies only see the organizer's own venues)
 	venue, err := s.venueRepo.FindByID(venueID)
 	if err != nil {
 		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
@@ -113,6 +169,7 @@ func (s *EventService) CreateEvent(
 		Type:           eventType,
 		Status:         models.EventStatusDraft,
 		VenueID:        venueID,
+		OrganizerId:    venue.OrganizerID,
 		StartTime:      startTime,
 		EndTime:        endTime,
 		BasePrice:      validatedPrice,
@@ -131,6 +188,8 @@ func (s *EventService) CreateEvent(
 	}
 
 	event.ID = eventID
+	s.audit.record(models.AuditActionCreate, models.AuditEntityEvent, event.ID, nil, event)
+
 	return event, nil
 }
 
@@ -143,29 +202,68 @@ func (s *EventService) GetEventByID(id int64) (*models.Event, error) {
 	return event, nil
 }
 
-func (s *EventService) ListEvents(filters map[string]interface{}, page, pageSize int) ([]*models.Event, error) {
-	if page < 1 {
-		page = 1
+// ListEvents lists events matching the typed query with keyset pagination.
+// Steps:
+// 1. Apply defaults, validate filters and decode the cursor
+// 2. Fetch one extra row to detect the next page
+// 3. Build next_cursor from the last event and count the total if requested
+func (s *EventService) ListEvents(query *models.EventQuery) (*models.EventPage, error) {
+	// 1. Validate
+	query.Normalize()
+	if err := query.Validate(); err != nil {
+		return nil, err
 	}
-	if pageSize < 1 || pageSize > 100 {
-		pageSize = 20
+
+	cursor, err := query.DecodeCursor()
+	if err != nil {
+		return nil, err
 	}
 
-	offset := (page - 1) * pageSize
-	events, err := s.eventRepo.FindAll(filters, pageSize, offset)
+	// 2. Fetch limit + 1
+	events, err := s.eventRepo.FindByQuery(query, cursor, query.Limit+1)
 	if err != nil {
 		return nil, fmt.Errorf("etkinlikler listelenemedi: %w", err)
 	}
 
-	return events, nil
+	page := &models.EventPage{Events: events}
+	page.Meta.Limit = query.Limit
+
+	// 3. Meta
+	if len(events) > query.Limit {
+		page.Events = events[:query.Limit]
+		page.Meta.HasMore = true
+		page.Meta.NextCursor = query.NextCursor(page.Events[query.Limit-1])
+	}
+
+	if query.IncludeTotal {
+		total, err := s.eventRepo.CountByQuery(query)
+		if err != nil {
+			return nil, fmt.Errorf("etkinlikler sayılamadı: %w", err)
+		}
+		page.Meta.Total = &total
+	}
+
+	return page, nil
 }
 
-func (s *EventService) UpdateEvent(id int64, updates map[string]interface{}) (*models.Event, error) {
-	// 1. Get existing event
+// UpdateEvent applies partial updates to an event with optimistic locking.
+// expectedVersion is the version the client read (If-Match); 0 skips the precondition.
+// Either way the write itself is conditional on the version loaded here, so a concurrent
+// update returns *models.ConflictError instead of being silently overwritten.
+func (s *EventService) UpdateEvent(id int64, expectedVersion int64, updates map[string]interface{}) (*models.Event, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Get existing event and check the client's precondition
 	event, err := s.eventRepo.FindByID(id)
 	if err != nil {
 		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
 	}
+	if err := models.CheckVersion(models.AuditEntityEvent, id, expectedVersion, event.Version); err != nil {
+		return nil, err
+	}
+	before := *event
 
 	// 2. Validate updates with Conduit-Go validation
 	if name, ok := updates["name"].(string); ok {
@@ -219,15 +317,22 @@ func (s *EventService) UpdateEvent(id int64, updates map[string]interface{}) (*m
 		event.Metadata = metadata
 	}
 
-	// 3. Update in database
+	// 3. Update in database (conditional on the loaded version)
 	if err := s.eventRepo.Update(event); err != nil {
 		return nil, fmt.Errorf("etkinlik güncellenemedi: %w", err)
 	}
 
+	s.audit.record(models.AuditActionUpdate, models.AuditEntityEvent, id, &before, event)
+	s.syncSearchIndex(id)
+
 	return event, nil
 }
 
 func (s *EventService) DeleteEvent(id int64) error {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return err
+	}
+
 	// 1. Check if event exists
 	event, err := s.eventRepo.FindByID(id)
 	if err != nil {
@@ -244,102 +349,224 @@ func (s *EventService) DeleteEvent(id int64) error {
 		return fmt.Errorf("etkinlik silinemedi: %w", err)
 	}
 
+	s.audit.record(models.AuditActionDelete, models.AuditEntityEvent, id, event, nil)
+	s.syncSearchIndex(id)
+
 	return nil
 }
 
-func (s *EventService) PublishEvent(id int64) error {
-	// 1. Get event
+// ListTrashedEvents returns soft-deleted events, most recently deleted first
+func (s *EventService) ListTrashedEvents(limit, offset int) ([]*models.Event, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	events, err := s.eventRepo.FindTrashed(limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("çöp kutusundaki etkinlikler getirilemedi: %w", err)
+	}
+
+	return events, nil
+}
+
+// RestoreEvent brings a soft-deleted event back from the trash.
+// Steps:
+// 1. Find the event in the trash
+// 2. Business rule: its venue must not be in the trash
+// 3. Restore, audit and re-index
+func (s *EventService) RestoreEvent(id int64) (*models.Event, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Find in trash
+	trashed, err := s.eventRepo.FindTrashedByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlik çöp kutusunda bulunamadı: %w", err)
+	}
+
+	// 2. Venue must be active
+	if _, err := s.venueRepo.FindByID(trashed.VenueID); err != nil {
+		return nil, fmt.Errorf("etkinliğin mekanı silinmiş, önce mekanı geri yükleyin")
+	}
+
+	// 3. Restore
+	if err := s.eventRepo.Restore(id); err != nil {
+		return nil, fmt.Errorf("etkinlik geri yüklenemedi: %w", err)
+	}
+
 	event, err := s.eventRepo.FindByID(id)
 	if err != nil {
-		return fmt.Errorf("etkinlik bulunamadı: %w", err)
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
 	}
 
-	// 2. Business rules
-	if event.Status != models.EventStatusDraft {
-		return fmt.Errorf("sadece taslak etkinlikler yayınlanabilir")
+	s.audit.record(models.AuditActionRestore, models.AuditEntityEvent, id, trashed, event)
+	s.syncSearchIndex(id)
+
+	return event, nil
+}
+
+// PurgeTrashedEvents permanently deletes events that were soft-deleted before
+// the cutoff (platform only). Events with tickets or payments are kept since
+// those rows reference them. Returns how many events were removed.
+func (s *EventService) PurgeTrashedEvents(cutoff time.Time) (int, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for {
+		ids, err := s.eventRepo.FindPurgeableIDs(cutoff, trashPurgeBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("silinecek etkinlikler getirilemedi: %w", err)
+		}
+
+		batchPurged := 0
+		for _, id := range ids {
+			deleted, err := s.eventRepo.ForceDelete(id, cutoff)
+			if err != nil {
+				log.Printf("⚠️  Etkinlik kalıcı olarak silinemedi (event %d): %v", id, err)
+				continue
+			}
+			if deleted {
+				batchPurged++
+				s.audit.record(models.AuditActionPurge, models.AuditEntityEvent, id, nil, nil)
+			}
+		}
+
+		purged += batchPurged
+
+		// Last batch, or nothing could be deleted (avoid looping over the same rows)
+		if len(ids) < trashPurgeBatchSize || batchPurged == 0 {
+			return purged, nil
+		}
+	}
+}
+
+func (s *EventService) PublishEvent(id int64) error {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return err
 	}
 
-	if event.StartTime.Before(time.Now()) {
-		return fmt.Errorf("geçmiş tarihli etkinlik yayınlanamaz")
+	// 1. Get event
+	event, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return fmt.Errorf("etkinlik bulunamadı: %w", err)
 	}
 
-	// 3. Update status
-	if err := s.eventRepo.UpdateStatus(id, models.EventStatusPublished); err != nil {
+	// 2. Transition draft → published (guard: start time in the future);
+	// the state machine notifies observers
+	if err := s.transition(event, models.EventStatusPublished); err != nil {
 		return fmt.Errorf("etkinlik yayınlanamadı: %w", err)
 	}
 
-	// 4. Publish event notification
-	s.eventPublisher.Notify(&observer.EventData{
-		Type:      observer.EventTypeEventStatusChanged,
-		Timestamp: time.Now(),
-		Data: map[string]interface{}{
-			"event_id":   id,
-			"event_name": event.Name,
-			"new_status": models.EventStatusPublished,
-			"start_time": event.StartTime,
-		},
-	})
+	s.syncSearchIndex(id)
 
 	return nil
 }
 
 func (s *EventService) ActivateSale(id int64) error {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return err
+	}
+
 	// 1. Get event
 	event, err := s.eventRepo.FindByID(id)
 	if err != nil {
 		return fmt.Errorf("etkinlik bulunamadı: %w", err)
 	}
 
-	// 2. Business rules
-	if event.Status != models.EventStatusPublished {
-		return fmt.Errorf("sadece yayınlanmış etkinliklerin satışı aktif edilebilir")
-	}
-
-	// 3. Update status
-	if err := s.eventRepo.UpdateStatus(id, models.EventStatusSaleActive); err != nil {
+	// 2. Transition published / sold_out → sale_active
+	if err := s.transition(event, models.EventStatusSaleActive); err != nil {
 		return fmt.Errorf("satış aktif edilemedi: %w", err)
 	}
 
+	s.syncSearchIndex(id)
+
 	return nil
 }
 
 func (s *EventService) MarkAsSoldOut(id int64) error {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return err
+	}
+
 	// 1. Get event
 	event, err := s.eventRepo.FindByID(id)
 	if err != nil {
 		return fmt.Errorf("etkinlik bulunamadı: %w", err)
 	}
 
-	// 2. Verify actually sold out
-	if event.AvailableSeats > 0 {
-		return fmt.Errorf("etkinlikte hala müsait koltuk var")
-	}
-
-	// 3. Update status
-	if err := s.eventRepo.UpdateStatus(id, models.EventStatusSoldOut); err != nil {
+	// 2. Transition → sold_out (guard: no seats left)
+	if err := s.transition(event, models.EventStatusSoldOut); err != nil {
 		return fmt.Errorf("tükendi işareti eklenemedi: %w", err)
 	}
 
+	s.syncSearchIndex(id)
+
 	return nil
 }
 
 func (s *EventService) CancelEvent(id int64) error {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return err
+	}
+
 	// 1. Get event
 	event, err := s.eventRepo.FindByID(id)
 	if err != nil {
 		return fmt.Errorf("etkinlik bulunamadı: %w", err)
 	}
 
-	// 2. Business rules
-	if event.Status == models.EventStatusCompleted || event.Status == models.EventStatusCancelled {
-		return fmt.Errorf("tamamlanmış veya iptal edilmiş etkinlik tekrar iptal edilemez")
+	// 2. Transition → cancelled (completed and cancelled events are final)
+	if err := s.transition(event, models.EventStatusCancelled); err != nil {
+		return fmt.Errorf("etkinlik iptal edilemedi: %w", err)
 	}
 
-	// 3. Update status
-	if err := s.eventRepo.UpdateStatus(id, models.EventStatusCancelled); err != nil {
-		return fmt.Errorf("etkinlik iptal edilemedi: %w", err)
+	s.syncSearchIndex(id)
+
+	return nil
+}
+
+// CompleteEvent marks a finished event as completed; no status change is possible afterwards
+func (s *EventService) CompleteEvent(id int64) error {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return err
+	}
+
+	// 1. Get event
+	event, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	// 2. Transition → completed (guard: end time has passed)
+	if err := s.transition(event, models.EventStatusCompleted); err != nil {
+		return fmt.Errorf("etkinlik tamamlanamadı: %w", err)
+	}
+
+	s.syncSearchIndex(id)
+
+	return nil
+}
+
+// transition moves the event to a new status through the state machine, persists and audits it
+func (s *EventService) transition(event *models.Event, to models.EventStatus) error {
+	before := *event
+	if err := s.states.Fire(event, to, func() error {
+		return s.eventRepo.UpdateStatus(event.ID, to)
+	}); err != nil {
+		return err
 	}
 
+	s.audit.record(models.AuditActionStatusChange, models.AuditEntityEvent, event.ID, &before, event)
 	return nil
 }
 
@@ -445,3 +672,138 @@ func (s *EventService) CalculateTicketPrice(eventID int64, sectionType string) (
 
 	return finalPrice, nil
 }
+
+// validateGeoSearch checks the search point and radius using Conduit-Go Validation
+func validateGeoSearch(center models.GeoPoint, radiusKm float64) error {
+	schema := v.Make().Shape(map[string]v.Type{
+		"lat": types.Number().Required().Min(-90).Max(90).Label("Enlem"),
+		"lng": types.Number().Required().Min(-180).Max(180).Label("Boylam"),
+		"radius_km": types.Number().
+			Required().
+			Min(0.1).
+			Max(models.MaxGeoRadiusKm).
+			Label("Yarıçap (km)"),
+	})
+
+	result := schema.Validate(map[string]any{
+		"lat":       center.Lat,
+		"lng":       center.Lng,
+		"radius_km": radiusKm,
+	})
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	return nil
+}
+
+// geoPage normalizes page / page_size for the distance-ordered geo searches
+func geoPage(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	return pageSize, (page - 1) * pageSize
+}
+
+// FindNearbyEvents returns upcoming events whose venue is within radiusKm of the point,
+// nearest first. filters accepts status, type, featured, start_date and end_date.
+func (s *EventService) FindNearbyEvents(center models.GeoPoint, radiusKm float64, filters map[string]interface{}, page, pageSize int) ([]*models.NearbyEvent, error) {
+	// 1. Validate input
+	if err := validateGeoSearch(center, radiusKm); err != nil {
+		return nil, err
+	}
+
+	// 2. Bounding box pre-filter + haversine distance
+	limit, offset := geoPage(page, pageSize)
+	events, err := s.eventRepo.FindNearby(center, models.BoundsAround(center, radiusKm), radiusKm, filters, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("yakındaki etkinlikler getirilemedi: %w", err)
+	}
+
+	// 3. Attach venues
+	if err := s.attachVenues(events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// FindEventsInBounds returns upcoming events whose venue lies in the bounding box,
+// nearest to the box center first
+func (s *EventService) FindEventsInBounds(bounds models.GeoBounds, filters map[string]interface{}, page, pageSize int) ([]*models.NearbyEvent, error) {
+	if err := bounds.Validate(); err != nil {
+		return nil, err
+	}
+
+	limit, offset := geoPage(page, pageSize)
+	events, err := s.eventRepo.FindNearby(bounds.Center(), bounds, 0, filters, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("bölgedeki etkinlikler getirilemedi: %w", err)
+	}
+
+	if err := s.attachVenues(events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// attachVenues loads the venues of the found events in one query
+func (s *EventService) attachVenues(events []*models.NearbyEvent) error {
+	ids := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.VenueID)
+	}
+
+	venues, err := s.venueRepo.FindByIDs(ids)
+	if err != nil {
+		return fmt.Errorf("mekanlar getirilemedi: %w", err)
+	}
+
+	for _, event := range events {
+		event.Venue = venues[event.VenueID]
+	}
+
+	return nil
+}
+
+// FindNearbyVenues returns venues within radiusKm of the point, nearest first
+func (s *EventService) FindNearbyVenues(center models.GeoPoint, radiusKm float64, limit int) ([]*models.NearbyVenue, error) {
+	if err := validateGeoSearch(center, radiusKm); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	venues, err := s.venueRepo.FindNearby(center, models.BoundsAround(center, radiusKm), radiusKm, limit)
+	if err != nil {
+		return nil, fmt.Errorf("yakındaki mekanlar getirilemedi: %w", err)
+	}
+
+	return venues, nil
+}
+
+// FindVenuesInBounds returns venues in the bounding box, nearest to the box center first
+func (s *EventService) FindVenuesInBounds(bounds models.GeoBounds, limit int) ([]*models.NearbyVenue, error) {
+	if err := bounds.Validate(); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	venues, err := s.venueRepo.FindNearby(bounds.Center(), bounds, 0, limit)
+	if err != nil {
+		return nil, fmt.Errorf("bölgedeki mekanlar getirilemedi: %w", err)
+	}
+
+	return venues, nil
+}
@@ -0,0 +1,421 @@
+package services
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+)
+
+// EventSessionInput carries the editable fields of a festival day / session
+type EventSessionInput struct {
+	Name         string
+	DoorsOpenAt  *time.Time
+	StartsAt     time.Time
+	EndsAt       time.Time
+	Capacity     int
+	AllowReentry bool
+	IsActive     bool
+}
+
+// EventSessionService manages the days / sessions of multi-day events and the
+// session entitlements of their tickets. A festival pass is one ticket granting
+// entry to several sessions; capacity is tracked per session and check-in is
+// validated against the session currently running (TicketService calls it
+// through the TicketSessionGate interface).
+type EventSessionService struct {
+	sessionRepo *repositories.EventSessionRepository
+	eventRepo   *repositories.EventRepository
+	ticketRepo  *repositories.TicketRepository
+	db          *sql.DB
+	audit       auditTrail
+	scope       models.OrganizerScope
+}
+
+func NewEventSessionService(
+	sessionRepo *repositories.EventSessionRepository,
+	eventRepo *repositories.EventRepository,
+	ticketRepo *repositories.TicketRepository,
+	db *sql.DB,
+) *EventSessionService {
+	return &EventSessionService{
+		sessionRepo: sessionRepo,
+		eventRepo:   eventRepo,
+		ticketRepo:  ticketRepo,
+		db:          db,
+	}
+}
+
+// ForOrganizer returns a copy of the service whose event and ticket queries are
+// restricted to the scope's organizer and whose operations check the member's role.
+// The TicketSessionGate hooks are called by TicketService after its own checks and stay unguarded.
+func (s *EventSessionService) ForOrganizer(scope models.OrganizerScope) *EventSessionService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	scoped.ticketRepo = s.ticketRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose mutations are audited as the given actor
+func (s *EventSessionService) WithActor(actor models.AuditActor) *EventSessionService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for session changes (optional)
+func (s *EventSessionService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// validateSessionInput validates session fields against the event window
+func validateSessionInput(input EventSessionInput, event *models.Event) error {
+	schema := v.Make().Shape(map[string]v.Type{
+		"name": types.String().
+			Required().
+			Min(2).
+			Max(255).
+			Label("Oturum Adı"),
+		"capacity": types.Number().
+			Required().
+			Min(1).
+			Label("Kapasite"),
+	})
+
+	result := schema.Validate(map[string]any{
+		"name":     input.Name,
+		"capacity": float64(input.Capacity),
+	})
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	if !input.EndsAt.After(input.StartsAt) {
+		return fmt.Errorf("oturum bitiş zamanı başlangıçtan sonra olmalı")
+	}
+
+	if input.DoorsOpenAt != nil && input.DoorsOpenAt.After(input.StartsAt) {
+		return fmt.Errorf("doors_open_at: kapı açılışı oturum başlangıcından sonra olamaz")
+	}
+
+	if input.StartsAt.Before(event.StartTime) || input.EndsAt.After(event.EndTime) {
+		return fmt.Errorf("oturum etkinlik tarihleri içinde olmalı")
+	}
+
+	if input.Capacity > event.TotalCapacity {
+		return fmt.Errorf("capacity: oturum kapasitesi etkinlik kapasitesini (%d) aşamaz", event.TotalCapacity)
+	}
+
+	return nil
+}
+
+// CreateSession adds a day / session to an event (admin)
+func (s *EventSessionService) CreateSession(eventID int64, input EventSessionInput) (*models.EventSession, error) {
+	// 1. Get event
+	event, err := requireEventAccess(s.scope, s.eventRepo, eventID, models.PermissionManageEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validate input
+	if err := validateSessionInput(input, event); err != nil {
+		return nil, err
+	}
+
+	// 3. Create session
+	session := &models.EventSession{
+		EventID:      eventID,
+		Name:         input.Name,
+		DoorsOpenAt:  input.DoorsOpenAt,
+		StartsAt:     input.StartsAt,
+		EndsAt:       input.EndsAt,
+		Capacity:     input.Capacity,
+		AllowReentry: input.AllowReentry,
+		IsActive:     true,
+	}
+	session.Initialize()
+
+	sessionID, err := s.sessionRepo.Create(session)
+	if err != nil {
+		return nil, fmt.Errorf("oturum oluşturulamadı: %w", err)
+	}
+	session.ID = sessionID
+
+	s.audit.record(models.AuditActionCreate, models.AuditEntityEventSession, session.ID, nil, session)
+
+	return session, nil
+}
+
+// UpdateSession updates times, capacity and entry rules of a session (admin)
+func (s *EventSessionService) UpdateSession(sessionID int64, input EventSessionInput) (*models.EventSession, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Get session and event
+	session, err := s.sessionRepo.FindByID(sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("oturum bulunamadı: %w", err)
+	}
+
+	event, err := requireEventAccess(s.scope, s.eventRepo, session.EventID, models.PermissionManageEvents)
+	if err != nil {
+		return nil, fmt.Errorf("oturum bulunamadı: %w", err)
+	}
+	before := *session
+
+	// 2. Validate input
+	if err := validateSessionInput(input, event); err != nil {
+		return nil, err
+	}
+
+	if input.Capacity < session.SoldCount {
+		return nil, fmt.Errorf("capacity: kapasite verilmiş giriş hakkından (%d) az olamaz", session.SoldCount)
+	}
+
+	// 3. Update
+	session.Name = input.Name
+	session.DoorsOpenAt = input.DoorsOpenAt
+	session.StartsAt = input.StartsAt
+	session.EndsAt = input.EndsAt
+	session.Capacity = input.Capacity
+	session.AllowReentry = input.AllowReentry
+	session.IsActive = input.IsActive
+
+	if err := s.sessionRepo.Update(session); err != nil {
+		return nil, fmt.Errorf("oturum güncellenemedi: %w", err)
+	}
+
+	s.audit.record(models.AuditActionUpdate, models.AuditEntityEventSession, session.ID, &before, session)
+
+	return session, nil
+}
+
+// ListEventSessions returns the sessions of an event with entitlement and check-in counts
+func (s *EventSessionService) ListEventSessions(eventID int64) ([]*models.EventSession, error) {
+	sessions, err := s.sessionRepo.FindByEventID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("oturumlar alınamadı: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// GrantEntitlements grants a reserved or sold ticket entry to the given sessions.
+// An empty list grants every active session of the event (full festival pass).
+func (s *EventSessionService) GrantEntitlements(userID, ticketID int64, sessionIDs []int64) ([]*models.TicketEntitlement, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
+	// 1. Get ticket
+	ticket, err := s.ticketRepo.FindByID(ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("bilet bulunamadı: %w", err)
+	}
+
+	if ticket.UserID != userID {
+		return nil, fmt.Errorf("bu bilet size ait değil")
+	}
+
+	if ticket.Status != models.TicketStatusReserved && ticket.Status != models.TicketStatusSold {
+		return nil, fmt.Errorf("giriş hakkı sadece rezerve veya satın alınmış biletlere verilebilir")
+	}
+	if ticket.IsExpired() {
+		return nil, fmt.Errorf("rezervasyon süresi doldu")
+	}
+
+	// 2. Resolve sessions
+	sessions, err := s.sessionRepo.FindByEventID(ticket.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("oturumlar alınamadı: %w", err)
+	}
+	if len(sessions) == 0 {
+		return nil, fmt.Errorf("etkinliğin oturumu yok")
+	}
+
+	byID := make(map[int64]*models.EventSession, len(sessions))
+	for _, session := range sessions {
+		byID[session.ID] = session
+	}
+
+	var selected []*models.EventSession
+	if len(sessionIDs) == 0 {
+		for _, session := range sessions {
+			if session.IsActive {
+				selected = append(selected, session)
+			}
+		}
+	} else {
+		for _, sessionID := range sessionIDs {
+			session, ok := byID[sessionID]
+			if !ok {
+				return nil, fmt.Errorf("oturum %d bu biletin etkinliğine ait değil", sessionID)
+			}
+			if !session.IsActive {
+				return nil, fmt.Errorf("oturum satışta değil: %s", session.Name)
+			}
+			selected = append(selected, session)
+		}
+	}
+
+	now := time.Now()
+	for _, session := range selected {
+		if !now.Before(session.EndsAt) {
+			return nil, fmt.Errorf("oturum sona erdi: %s", session.Name)
+		}
+	}
+
+	// 3. Start transaction; session locks (in ID order to avoid deadlocks) serialize capacity checks
+	sort.Slice(selected, func(i, j int) bool { return selected[i].ID < selected[j].ID })
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, session := range selected {
+		sold, err := s.sessionRepo.LockCapacity(tx, session.ID)
+		if err != nil {
+			return nil, fmt.Errorf("oturum kilitlenemedi: %w", err)
+		}
+		if sold >= session.Capacity {
+			return nil, fmt.Errorf("oturum kapasitesi dolu: %s", session.Name)
+		}
+	}
+
+	// 4. Create entitlements (existing ones are kept as they are)
+	for _, session := range selected {
+		if _, err := s.sessionRepo.CreateEntitlement(ticketID, session.ID); err != nil {
+			return nil, fmt.Errorf("giriş hakkı oluşturulamadı: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+
+	return s.ticketEntitlements(ticketID)
+}
+
+// GetTicketEntitlements returns the sessions a ticket grants entry to
+// (the ticket holder, or organizer members who may view tickets)
+func (s *EventSessionService) GetTicketEntitlements(ticketID int64) ([]*models.TicketEntitlement, error) {
+	if err := requireScope(s.scope); err != nil {
+		return nil, err
+	}
+
+	ticket, err := s.ticketRepo.FindByID(ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("bilet bulunamadı: %w", err)
+	}
+
+	if err := requireActingFor(s.scope, ticket.UserID, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
+
+	return s.ticketEntitlements(ticketID)
+}
+
+// ticketEntitlements loads the entitlements of a ticket with their sessions
+func (s *EventSessionService) ticketEntitlements(ticketID int64) ([]*models.TicketEntitlement, error) {
+	entitlements, err := s.sessionRepo.FindEntitlementsByTicketID(ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("giriş hakları alınamadı: %w", err)
+	}
+
+	for _, entitlement := range entitlements {
+		session, err := s.sessionRepo.FindByID(entitlement.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("oturum bulunamadı: %w", err)
+		}
+		entitlement.Session = session
+	}
+
+	return entitlements, nil
+}
+
+// HasSessions reports whether entry to the event is managed per session
+func (s *EventSessionService) HasSessions(eventID int64) (bool, error) {
+	count, err := s.sessionRepo.CountByEventID(eventID)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// ValidateEntry checks the ticket against the session running at the given time
+// and attaches its entitlements and the matched session to the ticket
+func (s *EventSessionService) ValidateEntry(ticket *models.Ticket, at time.Time) (*models.EventSession, error) {
+	// 1. Sessions open for entry right now
+	running, err := s.sessionRepo.FindRunning(ticket.EventID, at)
+	if err != nil {
+		return nil, fmt.Errorf("açık oturumlar alınamadı: %w", err)
+	}
+	if len(running) == 0 {
+		return nil, fmt.Errorf("şu anda girişi açık oturum yok")
+	}
+
+	// 2. Ticket entitlements
+	entitlements, err := s.sessionRepo.FindEntitlementsByTicketID(ticket.ID)
+	if err != nil {
+		return nil, fmt.Errorf("giriş hakları alınamadı: %w", err)
+	}
+	ticket.Entitlements = entitlements
+
+	granted := make(map[int64]*models.TicketEntitlement, len(entitlements))
+	for _, entitlement := range entitlements {
+		granted[entitlement.SessionID] = entitlement
+	}
+
+	// 3. Match a running session the ticket is entitled to
+	names := make([]string, 0, len(running))
+	for _, session := range running {
+		entitlement, ok := granted[session.ID]
+		if !ok {
+			names = append(names, session.Name)
+			continue
+		}
+
+		if entitlement.HasEntered() && !session.AllowReentry {
+			return nil, fmt.Errorf("bilet bu oturumda daha önce kullanılmış: %s", session.Name)
+		}
+
+		entitlement.Session = session
+		ticket.CurrentSession = session
+		return session, nil
+	}
+
+	return nil, fmt.Errorf("bilet açık oturum için geçerli değil: %s", strings.Join(names, ", "))
+}
+
+// CheckIn validates the ticket against the running session and records the entry
+func (s *EventSessionService) CheckIn(ticket *models.Ticket, at time.Time) (*models.EventSession, error) {
+	// 1. Validate against the running session
+	session, err := s.ValidateEntry(ticket, at)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Record entry atomically (a concurrent scan of the same ticket loses here)
+	recorded, err := s.sessionRepo.RecordEntry(ticket.ID, session.ID, session.AllowReentry)
+	if err != nil {
+		return nil, fmt.Errorf("giriş kaydedilemedi: %w", err)
+	}
+	if !recorded {
+		return nil, fmt.Errorf("bilet bu oturumda daha önce kullanılmış: %s", session.Name)
+	}
+
+	return session, nil
+}
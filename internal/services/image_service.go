@@ -0,0 +1,249 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	"github.com/biyonik/event-ticketing-api/pkg/imaging"
+	"github.com/biyonik/event-ticketing-api/pkg/storage"
+)
+
+// imageStoragePrefix is the storage directory of content-addressed image variants
+const imageStoragePrefix = "images"
+
+// ImageOptions configures image upload limits
+type ImageOptions struct {
+	MaxUploadSize int64 // Largest accepted upload in bytes
+	MaxPixels     int   // Largest accepted image (width × height)
+}
+
+// ImageService handles event and venue image uploads. An upload is validated by
+// extension and content, decoded once, and re-encoded into the thumbnail, card
+// and hero variants; re-encoding drops EXIF metadata such as GPS location.
+// Variants are stored under content-addressed paths, so the same picture is
+// written once no matter how often it is uploaded, and the owner's ImageURL
+// points to the hero variant.
+type ImageService struct {
+	imageRepo *repositories.ImageRepository
+	eventRepo *repositories.EventRepository
+	venueRepo *repositories.VenueRepository
+	storage   storage.Storage
+	options   ImageOptions
+	scope     models.OrganizerScope
+	db        *sql.DB
+}
+
+func NewImageService(
+	imageRepo *repositories.ImageRepository,
+	eventRepo *repositories.EventRepository,
+	venueRepo *repositories.VenueRepository,
+	store storage.Storage,
+	options ImageOptions,
+	db *sql.DB,
+) *ImageService {
+	if options.MaxUploadSize <= 0 {
+		options.MaxUploadSize = 10 << 20
+	}
+	if options.MaxPixels <= 0 {
+		options.MaxPixels = imaging.DefaultMaxPixels
+	}
+
+	return &ImageService{
+		imageRepo: imageRepo,
+		eventRepo: eventRepo,
+		venueRepo: venueRepo,
+		storage:   store,
+		options:   options,
+		db:        db,
+	}
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer events and venues
+func (s *ImageService) ForOrganizer(scope models.OrganizerScope) *ImageService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	scoped.venueRepo = s.venueRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// MaxUploadSize returns the largest accepted upload in bytes
+func (s *ImageService) MaxUploadSize() int64 {
+	return s.options.MaxUploadSize
+}
+
+// UploadEventImage replaces the image of an event and returns the new variants
+func (s *ImageService) UploadEventImage(eventID int64, filename string, data []byte) ([]*models.ImageVariant, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.eventRepo.FindByID(eventID); err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	return s.upload(models.ImageEntityEvent, eventID, filename, data)
+}
+
+// UploadVenueImage replaces the image of a venue and returns the new variants
+func (s *ImageService) UploadVenueImage(venueID int64, filename string, data []byte) ([]*models.ImageVariant, error) {
+	if err := requirePermission(s.scope, models.PermissionManageVenues); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.venueRepo.FindByID(venueID); err != nil {
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	return s.upload(models.ImageEntityVenue, venueID, filename, data)
+}
+
+// GetEventImages returns the image variants of an event
+func (s *ImageService) GetEventImages(eventID int64) ([]*models.ImageVariant, error) {
+	if _, err := s.eventRepo.FindByID(eventID); err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	return s.imageRepo.FindByEntity(models.ImageEntityEvent, eventID)
+}
+
+// GetVenueImages returns the image variants of a venue
+func (s *ImageService) GetVenueImages(venueID int64) ([]*models.ImageVariant, error) {
+	if _, err := s.venueRepo.FindByID(venueID); err != nil {
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	return s.imageRepo.FindByEntity(models.ImageEntityVenue, venueID)
+}
+
+// upload processes the image, stores its variants and links them to the owner.
+// 1. Validate extension and size
+// 2. Decode, strip metadata and resize (content is checked, not just the extension)
+// 3. Store variants under content-addressed paths
+// 4. Replace variant rows and ImageURL in one transaction
+// 5. Delete files of the previous image that nothing references any more
+func (s *ImageService) upload(entityType models.ImageEntityType, entityID int64, filename string, data []byte) ([]*models.ImageVariant, error) {
+	// 1. Validate extension and size
+	if !storage.IsImage(filename) {
+		return nil, fmt.Errorf("image: desteklenmeyen dosya tipi (jpeg, png veya gif yükleyin)")
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("image: dosya boş")
+	}
+	if int64(len(data)) > s.options.MaxUploadSize {
+		return nil, fmt.Errorf("image: dosya en fazla %d MB olabilir", s.options.MaxUploadSize>>20)
+	}
+
+	// 2. Decode, strip metadata and resize
+	result, err := imaging.Process(data, imaging.DefaultVariants, s.options.MaxPixels)
+	switch {
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		return nil, fmt.Errorf("image: desteklenmeyen görsel biçimi (jpeg, png veya gif yükleyin)")
+	case errors.Is(err, imaging.ErrImageTooLarge):
+		return nil, fmt.Errorf("image: görsel çözünürlüğü çok yüksek")
+	case errors.Is(err, imaging.ErrInvalidImage):
+		return nil, fmt.Errorf("image: görsel okunamadı")
+	case err != nil:
+		return nil, fmt.Errorf("görsel işlenemedi: %w", err)
+	}
+
+	// 3. Store variants under content-addressed paths
+	variants := make([]*models.ImageVariant, 0, len(result.Variants))
+	heroURL := ""
+	for _, out := range result.Variants {
+		path := imaging.ContentPath(imageStoragePrefix, out.Data, out.Extension)
+
+		exists, err := s.storage.Exists(path)
+		if err != nil {
+			return nil, fmt.Errorf("görsel kaydedilemedi: %w", err)
+		}
+		if !exists {
+			if err := s.storage.Put(path, out.Data); err != nil {
+				return nil, fmt.Errorf("görsel kaydedilemedi: %w", err)
+			}
+		}
+
+		variant := &models.ImageVariant{
+			Variant:     out.Name,
+			Path:        path,
+			URL:         s.storage.Url(path),
+			ContentType: out.ContentType,
+			Width:       out.Width,
+			Height:      out.Height,
+			SizeBytes:   len(out.Data),
+		}
+		variant.Initialize()
+		variants = append(variants, variant)
+
+		if out.Name == imaging.VariantHero {
+			heroURL = variant.URL
+		}
+	}
+
+	previous, err := s.imageRepo.FindByEntity(entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Replace variant rows and ImageURL in one transaction
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.imageRepo.ReplaceVariants(tx, entityType, entityID, variants); err != nil {
+		return nil, err
+	}
+
+	if entityType == models.ImageEntityEvent {
+		err = s.imageRepo.UpdateEventImageURL(tx, entityID, heroURL)
+	} else {
+		err = s.imageRepo.UpdateVenueImageURL(tx, entityID, heroURL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// 5. Delete unreferenced files of the previous image
+	s.deleteOrphans(previous, variants)
+
+	return variants, nil
+}
+
+// deleteOrphans removes files of replaced variants. Content-addressed files may be
+// shared with other events or venues, so a file is only deleted when no row points to it.
+func (s *ImageService) deleteOrphans(previous, current []*models.ImageVariant) {
+	kept := make(map[string]bool, len(current))
+	for _, variant := range current {
+		kept[variant.Path] = true
+	}
+
+	for _, variant := range previous {
+		if kept[variant.Path] {
+			continue
+		}
+		kept[variant.Path] = true
+
+		count, err := s.imageRepo.CountByPath(variant.Path)
+		if err != nil {
+			log.Printf("⚠️  Image cleanup failed for %s: %v", variant.Path, err)
+			continue
+		}
+		if count > 0 {
+			continue
+		}
+
+		if err := s.storage.Delete(variant.Path); err != nil {
+			log.Printf("⚠️  Image cleanup failed for %s: %v", variant.Path, err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+)
+
+// reconcileBatchSize bounds how many events one keyset page of a reconciliation run checks
+const reconcileBatchSize = 100
+
+// InventoryService reconciles the denormalized events.available_seats counter with
+// the inventory it summarizes: total capacity minus blocked (inactive) seats, active
+// tickets and seats held by pending waiting list offers. ReserveTicket writes the counter
+// and the ticket in one transaction; the counter drifts when a cancellation or an expired
+// reservation fails between the ticket update and the counter update.
+// A dry run only reports; otherwise each discrepancy is re-checked and corrected
+// while the event row is locked.
+type InventoryService struct {
+	db        *sql.DB
+	eventRepo *repositories.EventRepository
+	audit     auditTrail
+	scope     models.OrganizerScope
+}
+
+func NewInventoryService(db *sql.DB, eventRepo *repositories.EventRepository) *InventoryService {
+	return &InventoryService{
+		db:        db,
+		eventRepo: eventRepo,
+	}
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer events
+func (s *InventoryService) ForOrganizer(scope models.OrganizerScope) *InventoryService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose corrections are audited as the given actor
+func (s *InventoryService) WithActor(actor models.AuditActor) *InventoryService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for counter corrections (optional)
+func (s *InventoryService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// Reconcile checks every event whose counter still affects sales (draft, published,
+// on sale, sold out) and reports the ones that drifted.
+// Steps:
+// 1. Page through the events by ID
+// 2. Recount each event's inventory and compare with the counter
+// 3. Unless dryRun is set, correct the discrepancies under lock
+func (s *InventoryService) Reconcile(dryRun bool) (*models.ReconciliationReport, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	report := newReconciliationReport(dryRun)
+
+	// 1. Page through events
+	var afterID int64
+	for {
+		ids, err := s.eventRepo.FindReconcilableIDs(afterID, reconcileBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("etkinlikler getirilemedi: %w", err)
+		}
+
+		// 2-3. Check (and correct) each event; one failing event does not stop the run
+		for _, id := range ids {
+			if err := s.reconcileEvent(report, id); err != nil {
+				log.Printf("⚠️  Envanter kontrol edilemedi (event %d): %v", id, err)
+			}
+		}
+
+		if len(ids) < reconcileBatchSize {
+			break
+		}
+		afterID = ids[len(ids)-1]
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// ReconcileEvent checks (and unless dryRun is set, corrects) a single event's counter
+func (s *InventoryService) ReconcileEvent(eventID int64, dryRun bool) (*models.ReconciliationReport, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	report := newReconciliationReport(dryRun)
+	if err := s.reconcileEvent(report, eventID); err != nil {
+		return nil, fmt.Errorf("etkinlik envanteri kontrol edilemedi: %w", err)
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// newReconciliationReport starts an empty report
+func newReconciliationReport(dryRun bool) *models.ReconciliationReport {
+	return &models.ReconciliationReport{
+		DryRun:        dryRun,
+		Discrepancies: []*models.InventoryDiscrepancy{},
+		StartedAt:     time.Now(),
+	}
+}
+
+// reconcileEvent recounts one event and adds it to the report if its counter drifted.
+// Correction failures are recorded on the discrepancy, not returned.
+func (s *InventoryService) reconcileEvent(report *models.ReconciliationReport, eventID int64) error {
+	count, err := s.eventRepo.CountInventory(eventID)
+	if err != nil {
+		return err
+	}
+	report.Checked++
+
+	if count.Drift() == 0 {
+		return nil
+	}
+
+	discrepancy := models.NewInventoryDiscrepancy(count)
+	report.Discrepancies = append(report.Discrepancies, discrepancy)
+
+	if report.DryRun {
+		return nil
+	}
+
+	if err := s.correct(discrepancy); err != nil {
+		discrepancy.Error = err.Error()
+		log.Printf("⚠️  Boş koltuk sayacı düzeltilemedi (event %d): %v", eventID, err)
+		return nil
+	}
+
+	if discrepancy.Corrected {
+		report.Corrected++
+	}
+	return nil
+}
+
+// correct locks the event row, recounts and overwrites the counter with the expected value.
+// Reservations decrement the counter inside their own transaction, so the lock waits for
+// one in flight to commit its ticket. A cancellation in flight (ticket cancelled, counter not
+// yet incremented) still looks like drift for a moment, so the counter is only corrected if
+// the recount under lock shows the same drift as the report; otherwise the next run checks it again.
+func (s *InventoryService) correct(discrepancy *models.InventoryDiscrepancy) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 1. Lock the counter: reservations in flight commit first, new ones and cancellations wait
+	recorded, err := s.eventRepo.LockAvailableSeats(tx, discrepancy.EventID)
+	if err != nil {
+		return fmt.Errorf("etkinlik kilitlenemedi: %w", err)
+	}
+
+	// 2. Recount (outside the tx snapshot to see the latest committed tickets)
+	count, err := s.eventRepo.CountInventory(discrepancy.EventID)
+	if err != nil {
+		return fmt.Errorf("envanter sayılamadı: %w", err)
+	}
+	count.AvailableSeats = recorded
+
+	if count.Drift() == 0 {
+		return nil // Resolved itself in the meantime
+	}
+	if count.Drift() != discrepancy.Drift {
+		return fmt.Errorf("fark kilit altında doğrulanamadı (rapor: %d, kilit altında: %d)", discrepancy.Drift, count.Drift())
+	}
+
+	// 3. Overwrite the counter
+	expected := count.ExpectedAvailable()
+	if err := s.eventRepo.SetAvailableSeatsWithTx(tx, discrepancy.EventID, expected); err != nil {
+		return fmt.Errorf("boş koltuk sayısı güncellenemedi: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+
+	discrepancy.Corrected = true
+	s.audit.record(models.AuditActionReconcile, models.AuditEntityEvent, discrepancy.EventID,
+		map[string]interface{}{"available_seats": recorded},
+		map[string]interface{}{"available_seats": expected})
+
+	return nil
+}
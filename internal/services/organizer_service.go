@@ -0,0 +1,326 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+)
+
+// OrganizerService manages organizer accounts and their member users.
+// Data access of the other services is restricted to an organizer through
+// their ForOrganizer copies; this service resolves which organizer and role
+// a request runs under (see middleware.Organizer).
+type OrganizerService struct {
+	organizerRepo *repositories.OrganizerRepository
+}
+
+func NewOrganizerService(organizerRepo *repositories.OrganizerRepository) *OrganizerService {
+	return &OrganizerService{
+		organizerRepo: organizerRepo,
+	}
+}
+
+// requireScope refuses calls on a service that was not given a scope through ForOrganizer
+func requireScope(scope models.OrganizerScope) error {
+	return scope.Validate()
+}
+
+// requirePermission checks the caller's organizer role (platform scope is always allowed)
+func requirePermission(scope models.OrganizerScope, permission models.OrganizerPermission) error {
+	if err := requireScope(scope); err != nil {
+		return err
+	}
+	if !scope.Can(permission) {
+		return fmt.Errorf("bu işlem için yetkiniz yok")
+	}
+
+	return nil
+}
+
+// requireActingFor lets customers act only for themselves; organizer members need the permission
+func requireActingFor(scope models.OrganizerScope, userID int64, permission models.OrganizerPermission) error {
+	if err := requireScope(scope); err != nil {
+		return err
+	}
+	if !scope.CanActFor(userID, permission) {
+		return fmt.Errorf("bu işlem için yetkiniz yok")
+	}
+
+	return nil
+}
+
+// requirePlatform restricts an operation to platform admins and system jobs
+func requirePlatform(scope models.OrganizerScope) error {
+	if err := requireScope(scope); err != nil {
+		return err
+	}
+	if !scope.IsPlatform() {
+		return fmt.Errorf("bu işlem için yetkiniz yok")
+	}
+
+	return nil
+}
+
+// requireEventAccess loads an event through the (scoped) event repository and checks that
+// the caller may change it with the given permission. Events of other organizers are
+// reported as not found, the same as the scoped repository does.
+func requireEventAccess(scope models.OrganizerScope, eventRepo *repositories.EventRepository, eventID int64, permission models.OrganizerPermission) (*models.Event, error) {
+	if err := requirePermission(scope, permission); err != nil {
+		return nil, err
+	}
+
+	event, err := eventRepo.FindByID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+	if !scope.CanManage(event.OrganizerId, permission) {
+		return nil, fmt.Errorf("etkinlik bulunamadı")
+	}
+
+	return event, nil
+}
+
+// authorizeMembers checks that the caller may manage the members of the given organizer
+func authorizeMembers(scope models.OrganizerScope, organizerID int64) error {
+	if !scope.IsPlatform() && scope.OrganizerID != organizerID {
+		return fmt.Errorf("organizatör bulunamadı")
+	}
+
+	return requirePermission(scope, models.PermissionManageMembers)
+}
+
+// CreateOrganizer creates an organizer account; the creating user becomes its owner
+func (s *OrganizerService) CreateOrganizer(userID int64, name, slug, contactEmail string) (*models.Organizer, error) {
+	// 1. Validation
+	schema := v.Make().Shape(map[string]v.Type{
+		"name": types.String().
+			Required().
+			Min(2).
+			Max(255).
+			Label("Organizatör Adı"),
+		"slug": types.String().
+			Required().
+			Min(2).
+			Max(100).
+			Label("Kısa Ad"),
+		"contact_email": types.String().
+			Email().
+			Max(255).
+			Label("İletişim E-postası"),
+	})
+
+	rawData := map[string]any{
+		"name": name,
+		"slug": slug,
+	}
+	if contactEmail != "" {
+		rawData["contact_email"] = contactEmail
+	}
+
+	result := schema.Validate(rawData)
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	for _, r := range slug {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return nil, fmt.Errorf("slug: sadece küçük harf, rakam ve tire içerebilir")
+		}
+	}
+
+	// 2. Create organizer
+	organizer := &models.Organizer{
+		Name:   name,
+		Slug:   slug,
+		Status: models.OrganizerStatusActive,
+	}
+	if contactEmail != "" {
+		organizer.ContactEmail = &contactEmail
+	}
+	organizer.Initialize()
+
+	organizerID, err := s.organizerRepo.Create(organizer)
+	if err != nil {
+		return nil, fmt.Errorf("organizatör oluşturulamadı: %w", err)
+	}
+	organizer.ID = organizerID
+
+	// 3. Creator becomes the owner
+	owner := &models.OrganizerMember{
+		OrganizerID: organizerID,
+		UserID:      userID,
+		Role:        models.OrganizerRoleOwner,
+	}
+	owner.Initialize()
+
+	if _, err := s.organizerRepo.AddMember(owner); err != nil {
+		return nil, fmt.Errorf("organizatör sahibi eklenemedi: %w", err)
+	}
+
+	return organizer, nil
+}
+
+// GetUserOrganizers returns the organizers a user is a member of, with the user's role
+func (s *OrganizerService) GetUserOrganizers(userID int64) ([]*models.OrganizerMember, error) {
+	memberships, err := s.organizerRepo.FindMembershipsByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("üyelikler alınamadı: %w", err)
+	}
+
+	for _, membership := range memberships {
+		organizer, err := s.organizerRepo.FindByID(membership.OrganizerID)
+		if err != nil {
+			return nil, fmt.Errorf("organizatör bulunamadı: %w", err)
+		}
+		membership.Organizer = organizer
+	}
+
+	return memberships, nil
+}
+
+// ResolveRole returns the role of the user in the organizer; used by middleware.Organizer
+// to build the request scope. Suspended organizers cannot be accessed.
+func (s *OrganizerService) ResolveRole(userID, organizerID int64) (string, error) {
+	organizer, err := s.organizerRepo.FindByID(organizerID)
+	if err != nil {
+		return "", fmt.Errorf("organizatör bulunamadı: %w", err)
+	}
+
+	if !organizer.IsActive() {
+		return "", fmt.Errorf("organizatör hesabı askıya alınmış")
+	}
+
+	member, err := s.organizerRepo.FindMember(organizerID, userID)
+	if err != nil {
+		return "", fmt.Errorf("bu organizatörün üyesi değilsiniz")
+	}
+
+	return string(member.Role), nil
+}
+
+// ListMembers returns the members of an organizer (owner)
+func (s *OrganizerService) ListMembers(scope models.OrganizerScope, organizerID int64) ([]*models.OrganizerMember, error) {
+	if err := authorizeMembers(scope, organizerID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.organizerRepo.FindMembers(organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("üyeler alınamadı: %w", err)
+	}
+
+	return members, nil
+}
+
+// AddMember adds a user to an organizer with the given role (owner)
+func (s *OrganizerService) AddMember(scope models.OrganizerScope, organizerID, userID int64, role models.OrganizerRole) (*models.OrganizerMember, error) {
+	// 1. Authorize
+	if err := authorizeMembers(scope, organizerID); err != nil {
+		return nil, err
+	}
+
+	if !role.IsValid() {
+		return nil, fmt.Errorf("role: geçersiz organizatör rolü: %s", role)
+	}
+
+	// 2. Add member
+	member := &models.OrganizerMember{
+		OrganizerID: organizerID,
+		UserID:      userID,
+		Role:        role,
+	}
+	member.Initialize()
+
+	memberID, err := s.organizerRepo.AddMember(member)
+	if err != nil {
+		return nil, fmt.Errorf("üye eklenemedi: %w", err)
+	}
+	member.ID = memberID
+
+	return member, nil
+}
+
+// UpdateMemberRole changes the role of a member (owner). The last owner cannot be demoted.
+func (s *OrganizerService) UpdateMemberRole(scope models.OrganizerScope, organizerID, userID int64, role models.OrganizerRole) (*models.OrganizerMember, error) {
+	// 1. Authorize
+	if err := authorizeMembers(scope, organizerID); err != nil {
+		return nil, err
+	}
+
+	if !role.IsValid() {
+		return nil, fmt.Errorf("role: geçersiz organizatör rolü: %s", role)
+	}
+
+	// 2. Get member
+	member, err := s.organizerRepo.FindMember(organizerID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("üye bulunamadı: %w", err)
+	}
+
+	// 3. Protect the last owner
+	if member.Role == models.OrganizerRoleOwner && role != models.OrganizerRoleOwner {
+		if err := s.ensureAnotherOwner(organizerID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. Update
+	if err := s.organizerRepo.UpdateMemberRole(organizerID, userID, role); err != nil {
+		return nil, fmt.Errorf("üye rolü güncellenemedi: %w", err)
+	}
+
+	member.Role = role
+	member.UpdatedAt = time.Now()
+
+	return member, nil
+}
+
+// RemoveMember removes a user from an organizer (owner). The last owner cannot be removed.
+func (s *OrganizerService) RemoveMember(scope models.OrganizerScope, organizerID, userID int64) error {
+	// 1. Authorize
+	if err := authorizeMembers(scope, organizerID); err != nil {
+		return err
+	}
+
+	// 2. Get member
+	member, err := s.organizerRepo.FindMember(organizerID, userID)
+	if err != nil {
+		return fmt.Errorf("üye bulunamadı: %w", err)
+	}
+
+	// 3. Protect the last owner
+	if member.Role == models.OrganizerRoleOwner {
+		if err := s.ensureAnotherOwner(organizerID); err != nil {
+			return err
+		}
+	}
+
+	// 4. Remove
+	if err := s.organizerRepo.RemoveMember(organizerID, userID); err != nil {
+		return fmt.Errorf("üye çıkarılamadı: %w", err)
+	}
+
+	return nil
+}
+
+// ensureAnotherOwner fails if the organizer has a single owner left
+func (s *OrganizerService) ensureAnotherOwner(organizerID int64) error {
+	owners, err := s.organizerRepo.CountOwners(organizerID)
+	if err != nil {
+		return fmt.Errorf("sahip sayısı alınamadı: %w", err)
+	}
+
+	if owners <= 1 {
+		return fmt.Errorf("organizatörün son sahibi çıkarılamaz veya rolü değiştirilemez")
+	}
+
+	return nil
+}
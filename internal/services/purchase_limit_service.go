@@ -0,0 +1,155 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+)
+
+// maxPurchaseLimit is the upper bound accepted for any per-event limit
+const maxPurchaseLimit = 1000
+
+// PurchaseLimitService manages per-event purchase limits (admin).
+// Enforcement happens in TicketService.ReserveTicketWithOptions.
+type PurchaseLimitService struct {
+	purchaseLimitRepo *repositories.PurchaseLimitRepository
+	eventRepo         *repositories.EventRepository
+	audit             auditTrail
+	scope             models.OrganizerScope
+}
+
+func NewPurchaseLimitService(
+	purchaseLimitRepo *repositories.PurchaseLimitRepository,
+	eventRepo *repositories.EventRepository,
+) *PurchaseLimitService {
+	return &PurchaseLimitService{
+		purchaseLimitRepo: purchaseLimitRepo,
+		eventRepo:         eventRepo,
+	}
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer events
+// and whose mutations check the member's role
+func (s *PurchaseLimitService) ForOrganizer(scope models.OrganizerScope) *PurchaseLimitService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose mutations are audited as the given actor
+func (s *PurchaseLimitService) WithActor(actor models.AuditActor) *PurchaseLimitService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for purchase limit changes (optional)
+func (s *PurchaseLimitService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// SetLimits creates or replaces the limits of an event (nil = no limit for that scope)
+func (s *PurchaseLimitService) SetLimits(eventID int64, maxPerUser, maxPerCard, maxPerAddress *int) (*models.PurchaseLimit, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Validate input using Conduit-Go Validation
+	schema := v.Make().Shape(map[string]v.Type{
+		"max_per_user": types.Number().
+			Min(1).
+			Max(maxPurchaseLimit).
+			Label("Kullanıcı Başına Limit"),
+		"max_per_card": types.Number().
+			Min(1).
+			Max(maxPurchaseLimit).
+			Label("Kart Başına Limit"),
+		"max_per_address": types.Number().
+			Min(1).
+			Max(maxPurchaseLimit).
+			Label("Adres Başına Limit"),
+	})
+
+	rawData := map[string]any{}
+	for field, value := range map[string]*int{
+		"max_per_user":    maxPerUser,
+		"max_per_card":    maxPerCard,
+		"max_per_address": maxPerAddress,
+	} {
+		if value != nil {
+			rawData[field] = float64(*value)
+		}
+	}
+
+	result := schema.Validate(rawData)
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	// 2. Validate event
+	if _, err := requireEventAccess(s.scope, s.eventRepo, eventID, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	before, err := s.purchaseLimitRepo.FindByEventID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("alım limitleri alınamadı: %w", err)
+	}
+
+	// 3. Save limits
+	limit := &models.PurchaseLimit{
+		EventID:       eventID,
+		MaxPerUser:    maxPerUser,
+		MaxPerCard:    maxPerCard,
+		MaxPerAddress: maxPerAddress,
+	}
+
+	if err := s.purchaseLimitRepo.Upsert(limit); err != nil {
+		return nil, fmt.Errorf("alım limitleri kaydedilemedi: %w", err)
+	}
+
+	after, err := s.purchaseLimitRepo.FindByEventID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("alım limitleri alınamadı: %w", err)
+	}
+
+	s.audit.record(models.AuditActionUpdate, models.AuditEntityPurchaseLimit, eventID, before, after)
+
+	return after, nil
+}
+
+// GetLimits returns the limits of an event (nil if none are configured)
+func (s *PurchaseLimitService) GetLimits(eventID int64) (*models.PurchaseLimit, error) {
+	limit, err := s.purchaseLimitRepo.FindByEventID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("alım limitleri alınamadı: %w", err)
+	}
+
+	return limit, nil
+}
+
+// RemoveLimits removes all limits of an event
+func (s *PurchaseLimitService) RemoveLimits(eventID int64) error {
+	if _, err := requireEventAccess(s.scope, s.eventRepo, eventID, models.PermissionManageEvents); err != nil {
+		return err
+	}
+
+	before, err := s.purchaseLimitRepo.FindByEventID(eventID)
+	if err != nil {
+		return fmt.Errorf("alım limitleri alınamadı: %w", err)
+	}
+
+	if err := s.purchaseLimitRepo.DeleteByEventID(eventID); err != nil {
+		return fmt.Errorf("alım limitleri kaldırılamadı: %w", err)
+	}
+
+	s.audit.record(models.AuditActionDelete, models.AuditEntityPurchaseLimit, eventID, before, nil)
+
+	return nil
+}
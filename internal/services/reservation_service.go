@@ -1,12 +1,14 @@
 package services
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/biyonik/event-ticketing-api/internal/models"
 	"github.com/biyonik/event-ticketing-api/internal/patterns/observer"
 	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	"github.com/biyonik/event-ticketing-api/pkg/statemachine"
 	v "github.com/biyonik/event-ticketing-api/pkg/validation"
 	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
 )
@@ -16,6 +18,9 @@ type ReservationService struct {
 	eventRepo       *repositories.EventRepository
 	ticketRepo      *repositories.TicketRepository
 	eventPublisher  *observer.EventPublisher
+	paymentStates   *models.PaymentStateMachine
+	audit           auditTrail
+	scope           models.OrganizerScope
 }
 
 func NewReservationService(
@@ -29,17 +34,45 @@ func NewReservationService(
 		eventRepo:       eventRepo,
 		ticketRepo:      ticketRepo,
 		eventPublisher:  eventPublisher,
+		paymentStates:   newPaymentStates(eventPublisher),
 	}
 }
 
-// CreatePayment creates a payment record
+// ForOrganizer returns a copy of the service whose payment, waiting list, event and
+// ticket queries are restricted to the scope's organizer
+func (s *ReservationService) ForOrganizer(scope models.OrganizerScope) *ReservationService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.reservationRepo = s.reservationRepo.ForOrganizer(scope.OrganizerID)
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	scoped.ticketRepo = s.ticketRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose mutations are audited as the given actor
+func (s *ReservationService) WithActor(actor models.AuditActor) *ReservationService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for payment and waiting list mutations (optional)
+func (s *ReservationService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// CreatePayment creates a payment record for a reserved ticket
 func (s *ReservationService) CreatePayment(
-	userID, eventID int64,
+	userID, eventID, ticketID int64,
 	amount float64,
 	currency string,
 	paymentMethod models.PaymentMethod,
 	transactionID string,
 ) (*models.Payment, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"user_id": types.Number().
@@ -50,6 +83,10 @@ func (s *ReservationService) CreatePayment(
 			Required().
 			Min(1).
 			Label("Etkinlik ID"),
+		"ticket_id": types.Number().
+			Required().
+			Min(1).
+			Label("Bilet ID"),
 		"amount": types.Number().
 			Required().
 			Min(0.01).
@@ -73,6 +110,7 @@ func (s *ReservationService) CreatePayment(
 	rawData := map[string]any{
 		"user_id":        float64(userID),
 		"event_id":       float64(eventID),
+		"ticket_id":      float64(ticketID),
 		"amount":         amount,
 		"currency":       currency,
 		"transaction_id": transactionID,
@@ -89,6 +127,7 @@ func (s *ReservationService) CreatePayment(
 	payment := &models.Payment{
 		UserID:        userID,
 		EventID:       eventID,
+		TicketID:      ticketID,
 		Amount:        amount,
 		Currency:      currency,
 		Status:        models.PaymentStatusPending,
@@ -104,11 +143,17 @@ func (s *ReservationService) CreatePayment(
 	}
 
 	payment.ID = paymentID
+	s.audit.record(models.AuditActionCreate, models.AuditEntityPayment, payment.ID, nil, payment)
+
 	return payment, nil
 }
 
-// ProcessPayment processes a payment (simulated payment gateway)
+// ProcessPayment processes a payment (simulated payment gateway, platform / system only)
 func (s *ReservationService) ProcessPayment(paymentID int64, userEmail string) error {
+	if err := requirePlatform(s.scope); err != nil {
+		return err
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"payment_id": types.Number().
@@ -148,7 +193,7 @@ func (s *ReservationService) ProcessPayment(paymentID int64, userEmail string) e
 	// For demo, we'll just mark as completed
 	providerResponse := fmt.Sprintf("Payment processed successfully. Amount: %.2f %s", payment.Amount, payment.Currency)
 
-	if err := s.reservationRepo.UpdatePaymentStatus(paymentID, models.PaymentStatusCompleted, providerResponse); err != nil {
+	if err := s.transitionPayment(payment, models.PaymentStatusCompleted, providerResponse); err != nil {
 		return fmt.Errorf("ödeme durumu güncellenemedi: %w", err)
 	}
 
@@ -168,8 +213,12 @@ func (s *ReservationService) ProcessPayment(paymentID int64, userEmail string) e
 	return nil
 }
 
-// FailPayment marks a payment as failed
+// FailPayment marks a payment as failed (simulated payment gateway, platform / system only)
 func (s *ReservationService) FailPayment(paymentID int64, userEmail, errorMessage string) error {
+	if err := requirePlatform(s.scope); err != nil {
+		return err
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"payment_id": types.Number().
@@ -206,9 +255,9 @@ func (s *ReservationService) FailPayment(paymentID int64, userEmail, errorMessag
 		return fmt.Errorf("ödeme bulunamadı: %w", err)
 	}
 
-	// 3. Update status
+	// 3. Update status (pending → failed)
 	providerResponse := fmt.Sprintf("Payment failed: %s", errorMessage)
-	if err := s.reservationRepo.UpdatePaymentStatus(paymentID, models.PaymentStatusFailed, providerResponse); err != nil {
+	if err := s.transitionPayment(payment, models.PaymentStatusFailed, providerResponse); err != nil {
 		return fmt.Errorf("ödeme durumu güncellenemedi: %w", err)
 	}
 
@@ -228,8 +277,13 @@ func (s *ReservationService) FailPayment(paymentID int64, userEmail, errorMessag
 	return nil
 }
 
-// RefundPayment processes a refund
-func (s *ReservationService) RefundPayment(paymentID int64, userEmail string) error {
+// RefundPayment processes a refund.
+// expectedVersion is the version the client read (If-Match); 0 skips the precondition.
+func (s *ReservationService) RefundPayment(paymentID, expectedVersion int64, userEmail string) (*models.Payment, error) {
+	if err := requirePermission(s.scope, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"payment_id": types.Number().
@@ -250,33 +304,250 @@ func (s *ReservationService) RefundPayment(paymentID int64, userEmail string) er
 	result := schema.Validate(rawData)
 	if result.HasErrors() {
 		for field, errs := range result.Errors() {
-			return fmt.Errorf("%s: %s", field, errs[0])
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
 		}
 	}
 
-	// 2. Get payment
+	// 2. Get payment and check the client's precondition
 	payment, err := s.reservationRepo.FindPaymentByID(paymentID)
 	if err != nil {
-		return fmt.Errorf("ödeme bulunamadı: %w", err)
+		return nil, fmt.Errorf("ödeme bulunamadı: %w", err)
+	}
+	if err := models.CheckVersion(models.AuditEntityPayment, payment.ID, expectedVersion, payment.Version); err != nil {
+		return nil, err
 	}
 
 	// 3. Business rules
-	if payment.Status != models.PaymentStatusCompleted {
-		return fmt.Errorf("sadece tamamlanmış ödemeler iade edilebilir")
+	if !payment.CanRefund() {
+		return nil, fmt.Errorf("sadece tamamlanmış ödemeler iade edilebilir")
 	}
 
 	// 4. Process refund (in real app, call payment gateway)
 	providerResponse := fmt.Sprintf("Refund processed. Amount: %.2f %s", payment.Amount, payment.Currency)
 
-	if err := s.reservationRepo.UpdatePaymentStatus(paymentID, models.PaymentStatusRefunded, providerResponse); err != nil {
-		return fmt.Errorf("iade işlemi yapılamadı: %w", err)
+	if err := s.transitionPayment(payment, models.PaymentStatusRefunded, providerResponse); err != nil {
+		return nil, fmt.Errorf("iade işlemi yapılamadı: %w", err)
+	}
+
+	return payment, nil
+}
+
+// CompletePaymentByTransactionID marks a payment as completed from a provider webhook and
+// records the card fingerprint and billing address the provider reported (used for the
+// card/address purchase limits when the ticket is purchased).
+// Idempotent: returns false without error if the payment was already completed or refunded.
+func (s *ReservationService) CompletePaymentByTransactionID(transactionID, providerResponse string, instrument models.PaymentInstrument) (bool, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return false, err
+	}
+
+	// 1. Get payment
+	payment, err := s.reservationRepo.FindPaymentByTransactionID(transactionID)
+	if err != nil {
+		return false, fmt.Errorf("ödeme bulunamadı: %w", err)
+	}
+
+	// 2. Business rules (retry / out-of-order delivery)
+	if payment.Status == models.PaymentStatusCompleted || payment.Status == models.PaymentStatusRefunded {
+		return false, nil
+	}
+
+	// 3. Record the instrument before completing, so a completed payment always carries it
+	cardFingerprint, addressHash := instrument.Identifiers()
+	if cardFingerprint != nil || addressHash != nil {
+		if err := s.reservationRepo.SetPaymentInstrument(payment.ID, cardFingerprint, addressHash); err != nil {
+			return false, fmt.Errorf("ödeme kartı bilgisi kaydedilemedi: %w", err)
+		}
+	}
+
+	// 4. Conditional update - concurrent retries cannot both succeed
+	applied, err := s.transitionPaymentOnce(payment, models.PaymentStatusCompleted, providerResponse)
+	if err != nil {
+		return false, fmt.Errorf("ödeme durumu güncellenemedi: %w", err)
+	}
+	if !applied {
+		return false, nil
 	}
 
+	// 5. Notify observers
+	s.eventPublisher.Notify(&observer.EventData{
+		Type:      observer.EventTypePaymentCompleted,
+		Timestamp: time.Now(),
+		Data: &observer.PaymentData{
+			UserID:        payment.UserID,
+			UserEmail:     fmt.Sprintf("user_%d@email.com", payment.UserID), // In real app, fetch from user service
+			Amount:        payment.Amount,
+			TransactionID: payment.TransactionID,
+			Timestamp:     time.Now(),
+		},
+	})
+
+	return true, nil
+}
+
+// FailPaymentByTransactionID marks a pending payment as failed from a provider webhook.
+// A failure notification for an already completed payment is ignored.
+func (s *ReservationService) FailPaymentByTransactionID(transactionID, errorMessage string) (bool, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return false, err
+	}
+
+	// 1. Get payment
+	payment, err := s.reservationRepo.FindPaymentByTransactionID(transactionID)
+	if err != nil {
+		return false, fmt.Errorf("ödeme bulunamadı: %w", err)
+	}
+
+	// 2. Business rules
+	if payment.Status != models.PaymentStatusPending {
+		return false, nil
+	}
+
+	// 3. Conditional update
+	providerResponse := fmt.Sprintf("Payment failed: %s", errorMessage)
+	applied, err := s.transitionPaymentOnce(payment, models.PaymentStatusFailed, providerResponse)
+	if err != nil {
+		return false, fmt.Errorf("ödeme durumu güncellenemedi: %w", err)
+	}
+	if !applied {
+		return false, nil
+	}
+
+	// 4. Notify observers
+	s.eventPublisher.Notify(&observer.EventData{
+		Type:      observer.EventTypePaymentFailed,
+		Timestamp: time.Now(),
+		Data: &observer.PaymentData{
+			UserID:       payment.UserID,
+			UserEmail:    fmt.Sprintf("user_%d@email.com", payment.UserID), // In real app, fetch from user service
+			Amount:       payment.Amount,
+			Timestamp:    time.Now(),
+			ErrorMessage: errorMessage,
+		},
+	})
+
+	return true, nil
+}
+
+// RefundPaymentByTransactionID marks a completed payment as refunded from a provider webhook.
+// Idempotent: returns false without error if the payment was already refunded.
+func (s *ReservationService) RefundPaymentByTransactionID(transactionID, providerResponse string) (bool, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return false, err
+	}
+
+	// 1. Get payment
+	payment, err := s.reservationRepo.FindPaymentByTransactionID(transactionID)
+	if err != nil {
+		return false, fmt.Errorf("ödeme bulunamadı: %w", err)
+	}
+
+	// 2. Business rules
+	if payment.Status == models.PaymentStatusRefunded {
+		return false, nil
+	}
+	if payment.Status != models.PaymentStatusCompleted {
+		// Sağlayıcı iadeyi tamamlanma bildiriminden önce göndermiş olabilir; retry edilsin
+		return false, fmt.Errorf("sadece tamamlanmış ödemeler iade edilebilir")
+	}
+
+	// 3. Conditional update
+	applied, err := s.transitionPaymentOnce(payment, models.PaymentStatusRefunded, providerResponse)
+	if err != nil {
+		return false, fmt.Errorf("iade işlemi yapılamadı: %w", err)
+	}
+
+	return applied, nil
+}
+
+// ChargebackPaymentByTransactionID marks a completed payment as charged back from a provider webhook.
+// Idempotent: returns false without error if the chargeback was already recorded.
+func (s *ReservationService) ChargebackPaymentByTransactionID(transactionID, providerResponse string) (bool, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return false, err
+	}
+
+	// 1. Get payment
+	payment, err := s.reservationRepo.FindPaymentByTransactionID(transactionID)
+	if err != nil {
+		return false, fmt.Errorf("ödeme bulunamadı: %w", err)
+	}
+
+	// 2. Business rules
+	if payment.Status == models.PaymentStatusChargedBack {
+		return false, nil
+	}
+	if payment.Status != models.PaymentStatusCompleted {
+		return false, fmt.Errorf("sadece tamamlanmış ödemeler ters ibraz edilebilir")
+	}
+
+	// 3. Conditional update
+	applied, err := s.transitionPaymentOnce(payment, models.PaymentStatusChargedBack, providerResponse)
+	if err != nil {
+		return false, fmt.Errorf("ters ibraz işlenemedi: %w", err)
+	}
+
+	return applied, nil
+}
+
+// transitionPayment moves the payment to a new status through the state machine, persists and audits it
+func (s *ReservationService) transitionPayment(payment *models.Payment, to models.PaymentStatus, providerResponse string) error {
+	before := *payment
+	if err := s.paymentStates.Fire(payment, to, func() error {
+		return s.reservationRepo.UpdatePaymentStatus(payment, to, providerResponse)
+	}); err != nil {
+		return err
+	}
+
+	s.audit.record(models.AuditActionStatusChange, models.AuditEntityPayment, payment.ID, &before, payment)
 	return nil
 }
 
+// transitionPaymentOnce is transitionPayment with a conditional update for provider webhooks:
+// the row only changes if it is still in a status the state machine allows to move to "to".
+// Returns false without error for out-of-order notifications the machine does not allow
+// and when a concurrent request already changed the payment.
+func (s *ReservationService) transitionPaymentOnce(payment *models.Payment, to models.PaymentStatus, providerResponse string) (bool, error) {
+	before := *payment
+	err := s.paymentStates.Fire(payment, to, func() error {
+		applied, err := s.reservationRepo.TransitionPaymentStatus(payment.ID, s.paymentStates.Sources(to), to, providerResponse)
+		if err == nil && !applied {
+			return statemachine.ErrAborted
+		}
+		return err
+	})
+	if errors.Is(err, statemachine.ErrAborted) || errors.Is(err, statemachine.ErrIllegalTransition) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	payment.Version++ // TransitionPaymentStatus version'ı da artırır
+
+	s.audit.record(models.AuditActionStatusChange, models.AuditEntityPayment, payment.ID, &before, payment)
+	return true, nil
+}
+
+// GetPaymentByID retrieves a payment by ID (ETag source for refunds)
+func (s *ReservationService) GetPaymentByID(paymentID int64) (*models.Payment, error) {
+	if err := requirePermission(s.scope, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
+	payment, err := s.reservationRepo.FindPaymentByID(paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("ödeme bulunamadı: %w", err)
+	}
+
+	return payment, nil
+}
+
 // GetUserPayments retrieves all payments for a user
 func (s *ReservationService) GetUserPayments(userID int64) ([]*models.Payment, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionViewReports); err != nil {
+		return nil, err
+	}
+
 	payments, err := s.reservationRepo.FindPaymentsByUserID(userID)
 	if err != nil {
 		return nil, fmt.Errorf("ödemeler getirilemedi: %w", err)
@@ -287,6 +558,10 @@ func (s *ReservationService) GetUserPayments(userID int64) ([]*models.Payment, e
 
 // AddToWaitingList adds a user to the waiting list for a sold-out event
 func (s *ReservationService) AddToWaitingList(userID, eventID int64, priority int) (*models.WaitingList, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"user_id": types.Number().
@@ -353,6 +628,7 @@ func (s *ReservationService) AddToWaitingList(userID, eventID int64, priority in
 	}
 
 	waitingList.ID = id
+	s.audit.record(models.AuditActionCreate, models.AuditEntityWaitingList, waitingList.ID, nil, waitingList)
 
 	// 5. Notify observers
 	s.eventPublisher.Notify(&observer.EventData{
@@ -369,15 +645,33 @@ func (s *ReservationService) AddToWaitingList(userID, eventID int64, priority in
 
 // RemoveFromWaitingList removes a user from the waiting list
 func (s *ReservationService) RemoveFromWaitingList(waitingListID int64) error {
+	if err := requireScope(s.scope); err != nil {
+		return err
+	}
+
+	entry, err := s.reservationRepo.FindWaitingListByID(waitingListID)
+	if err != nil {
+		return fmt.Errorf("bekleme listesi kaydı bulunamadı: %w", err)
+	}
+	if err := requireActingFor(s.scope, entry.UserID, models.PermissionSellTickets); err != nil {
+		return err
+	}
+
 	if err := s.reservationRepo.RemoveFromWaitingList(waitingListID); err != nil {
 		return fmt.Errorf("bekleme listesinden kaldırılamadı: %w", err)
 	}
 
+	s.audit.record(models.AuditActionDelete, models.AuditEntityWaitingList, waitingListID, entry, nil)
+
 	return nil
 }
 
 // NotifyWaitingList notifies users in the waiting list when tickets become available
 func (s *ReservationService) NotifyWaitingList(eventID int64, availableSeats int) error {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return err
+	}
+
 	// 1. Get event
 	event, err := s.eventRepo.FindByID(eventID)
 	if err != nil {
@@ -399,10 +693,16 @@ func (s *ReservationService) NotifyWaitingList(eventID int64, availableSeats int
 	// 4. Notify each user in the waiting list
 	for _, entry := range waitingList {
 		// Mark as notified
+		before := *entry
 		if err := s.reservationRepo.MarkAsNotified(entry.ID); err != nil {
 			continue // Log error but continue notifying others
 		}
 
+		now := time.Now()
+		entry.Status = models.WaitingListStatusNotified
+		entry.NotifiedAt = &now
+		s.audit.record(models.AuditActionStatusChange, models.AuditEntityWaitingList, entry.ID, &before, entry)
+
 		// Notify observers
 		s.eventPublisher.Notify(&observer.EventData{
 			Type:      observer.EventTypeWaitingListNotify,
@@ -422,6 +722,10 @@ func (s *ReservationService) NotifyWaitingList(eventID int64, availableSeats int
 
 // GetWaitingListPosition gets a user's position in the waiting list
 func (s *ReservationService) GetWaitingListPosition(eventID, userID int64) (int, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionViewReports); err != nil {
+		return 0, err
+	}
+
 	// Get all waiting list entries for the event
 	waitingList, err := s.reservationRepo.FindWaitingListByEvent(eventID, 1000) // High limit
 	if err != nil {
@@ -440,6 +744,10 @@ func (s *ReservationService) GetWaitingListPosition(eventID, userID int64) (int,
 
 // GetUserWaitingLists retrieves all waiting list entries for a user
 func (s *ReservationService) GetUserWaitingLists(userID int64) ([]*models.WaitingList, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionViewReports); err != nil {
+		return nil, err
+	}
+
 	waitingLists, err := s.reservationRepo.FindWaitingListByUser(userID)
 	if err != nil {
 		return nil, fmt.Errorf("bekleme listeleri getirilemedi: %w", err)
@@ -450,6 +758,10 @@ func (s *ReservationService) GetUserWaitingLists(userID int64) ([]*models.Waitin
 
 // GetEventRevenue calculates total revenue for an event
 func (s *ReservationService) GetEventRevenue(eventID int64) (float64, error) {
+	if err := requirePermission(s.scope, models.PermissionViewReports); err != nil {
+		return 0, err
+	}
+
 	revenue, err := s.reservationRepo.GetTotalRevenueByEvent(eventID)
 	if err != nil {
 		return 0, fmt.Errorf("gelir hesaplanamadı: %w", err)
@@ -458,8 +770,26 @@ func (s *ReservationService) GetEventRevenue(eventID int64) (float64, error) {
 	return revenue, nil
 }
 
+// GetEventRevenueBreakdown returns gross sales, refunds and chargebacks of an event per currency
+func (s *ReservationService) GetEventRevenueBreakdown(eventID int64) ([]*models.RevenueBreakdown, error) {
+	if err := requirePermission(s.scope, models.PermissionViewReports); err != nil {
+		return nil, err
+	}
+
+	breakdowns, err := s.reservationRepo.GetRevenueBreakdownByEvent(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("gelir dağılımı alınamadı: %w", err)
+	}
+
+	return breakdowns, nil
+}
+
 // GetWaitingListCount returns the number of users in the waiting list
 func (s *ReservationService) GetWaitingListCount(eventID int64) (int, error) {
+	if err := requirePermission(s.scope, models.PermissionViewReports); err != nil {
+		return 0, err
+	}
+
 	count, err := s.reservationRepo.GetWaitingListCount(eventID)
 	if err != nil {
 		return 0, fmt.Errorf("bekleme listesi sayısı alınamadı: %w", err)
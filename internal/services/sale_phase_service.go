@@ -0,0 +1,305 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	"github.com/biyonik/event-ticketing-api/pkg/token"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+)
+
+// maxGeneratedCodes bounds a single code generation request
+const maxGeneratedCodes = 10000
+
+// SalePhaseService manages sale phases (presales, member windows, general sale).
+// Phase evaluation for reservations happens in TicketService.ReserveTicketWithOptions.
+type SalePhaseService struct {
+	salePhaseRepo   *repositories.SalePhaseRepository
+	userSegmentRepo *repositories.UserSegmentRepository
+	eventRepo       *repositories.EventRepository
+	audit           auditTrail
+	scope           models.OrganizerScope
+}
+
+func NewSalePhaseService(
+	salePhaseRepo *repositories.SalePhaseRepository,
+	userSegmentRepo *repositories.UserSegmentRepository,
+	eventRepo *repositories.EventRepository,
+) *SalePhaseService {
+	return &SalePhaseService{
+		salePhaseRepo:   salePhaseRepo,
+		userSegmentRepo: userSegmentRepo,
+		eventRepo:       eventRepo,
+	}
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer events
+// and whose mutations check the member's role
+func (s *SalePhaseService) ForOrganizer(scope models.OrganizerScope) *SalePhaseService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose mutations are audited as the given actor
+func (s *SalePhaseService) WithActor(actor models.AuditActor) *SalePhaseService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for sale phase mutations (optional)
+func (s *SalePhaseService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// findManagedPhase loads a phase and checks that the caller may change its event
+func (s *SalePhaseService) findManagedPhase(phaseID int64) (*models.SalePhase, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	phase, err := s.salePhaseRepo.FindByID(phaseID)
+	if err != nil {
+		return nil, fmt.Errorf("satış aşaması bulunamadı: %w", err)
+	}
+
+	if _, err := requireEventAccess(s.scope, s.eventRepo, phase.EventID, models.PermissionManageEvents); err != nil {
+		return nil, fmt.Errorf("satış aşaması bulunamadı: %w", err)
+	}
+
+	return phase, nil
+}
+
+// CreatePhase adds a sale phase to an event (admin)
+func (s *SalePhaseService) CreatePhase(
+	eventID int64,
+	name string,
+	accessType models.SalePhaseAccessType,
+	segment string,
+	allocation *int,
+	startsAt, endsAt time.Time,
+	sortOrder int,
+) (*models.SalePhase, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Validate input using Conduit-Go Validation
+	schema := v.Make().Shape(map[string]v.Type{
+		"name": types.String().
+			Required().
+			Min(2).
+			Max(255).
+			Label("Aşama Adı"),
+		"access_type": types.String().
+			Required().
+			OneOf([]string{"public", "code", "segment", "allow_list"}).
+			Label("Erişim Tipi"),
+		"segment": types.String().
+			Max(100).
+			Label("Segment"),
+	})
+
+	result := schema.Validate(map[string]any{
+		"name":        name,
+		"access_type": string(accessType),
+		"segment":     segment,
+	})
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	// 2. Business rules
+	if !endsAt.After(startsAt) {
+		return nil, fmt.Errorf("aşama bitiş zamanı başlangıçtan sonra olmalı")
+	}
+
+	if accessType == models.SalePhaseAccessSegment && strings.TrimSpace(segment) == "" {
+		return nil, fmt.Errorf("segment: segment erişimli aşama için segment zorunludur")
+	}
+
+	if allocation != nil && *allocation < 1 {
+		return nil, fmt.Errorf("allocation: kota en az 1 olmalı")
+	}
+
+	event, err := requireEventAccess(s.scope, s.eventRepo, eventID, models.PermissionManageEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	if allocation != nil && *allocation > event.TotalCapacity {
+		return nil, fmt.Errorf("allocation: kota etkinlik kapasitesini (%d) aşamaz", event.TotalCapacity)
+	}
+
+	if endsAt.After(event.StartTime) {
+		return nil, fmt.Errorf("satış aşaması etkinlik başlangıcından sonra bitemez")
+	}
+
+	// 3. Create phase
+	phase := &models.SalePhase{
+		EventID:    eventID,
+		Name:       result.ValidData()["name"].(string),
+		AccessType: accessType,
+		Allocation: allocation,
+		StartsAt:   startsAt,
+		EndsAt:     endsAt,
+		SortOrder:  sortOrder,
+	}
+	if accessType == models.SalePhaseAccessSegment {
+		normalized := strings.ToLower(strings.TrimSpace(segment))
+		phase.Segment = &normalized
+	}
+	phase.Initialize()
+
+	phaseID, err := s.salePhaseRepo.Create(phase)
+	if err != nil {
+		return nil, fmt.Errorf("satış aşaması oluşturulamadı: %w", err)
+	}
+	phase.ID = phaseID
+
+	s.audit.record(models.AuditActionCreate, models.AuditEntitySalePhase, phase.ID, nil, phase)
+
+	return phase, nil
+}
+
+// ListPhases returns the sale phases of an event with their sold counts
+func (s *SalePhaseService) ListPhases(eventID int64) ([]*models.SalePhase, error) {
+	phases, err := s.salePhaseRepo.FindByEventID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("satış aşamaları alınamadı: %w", err)
+	}
+
+	return phases, nil
+}
+
+// DeletePhase removes a sale phase with its codes and allow-list (admin)
+func (s *SalePhaseService) DeletePhase(phaseID int64) error {
+	phase, err := s.findManagedPhase(phaseID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.salePhaseRepo.Delete(phaseID); err != nil {
+		return fmt.Errorf("satış aşaması silinemedi: %w", err)
+	}
+
+	s.audit.record(models.AuditActionDelete, models.AuditEntitySalePhase, phaseID, phase, nil)
+
+	return nil
+}
+
+// GenerateCodes creates unique access codes for a code-protected phase (admin)
+func (s *SalePhaseService) GenerateCodes(phaseID int64, count, maxUses int) ([]string, error) {
+	// 1. Validate input
+	if count < 1 || count > maxGeneratedCodes {
+		return nil, fmt.Errorf("count: 1 ile %d arasında olmalı", maxGeneratedCodes)
+	}
+	if maxUses < 1 {
+		maxUses = 1
+	}
+
+	// 2. Get phase
+	phase, err := s.findManagedPhase(phaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if phase.AccessType != models.SalePhaseAccessCode {
+		return nil, fmt.Errorf("sadece kod erişimli aşamalar için kod üretilebilir")
+	}
+
+	// 3. Generate codes (10 hex chars, uppercase for readability)
+	codes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		code, err := token.GenerateSecureTokenHex(5)
+		if err != nil {
+			return nil, fmt.Errorf("kod üretilemedi: %w", err)
+		}
+		codes = append(codes, strings.ToUpper(code))
+	}
+
+	// 4. Save codes
+	if err := s.salePhaseRepo.CreateCodes(phaseID, codes, maxUses); err != nil {
+		return nil, fmt.Errorf("kodlar kaydedilemedi: %w", err)
+	}
+
+	s.audit.record(models.AuditActionUpdate, models.AuditEntitySalePhase, phaseID, nil, map[string]int{"codes_generated": count, "max_uses": maxUses})
+
+	return codes, nil
+}
+
+// AddToAllowList adds emails to an allow-list phase (admin). Returns how many were new.
+func (s *SalePhaseService) AddToAllowList(phaseID int64, emails []string) (int, error) {
+	// 1. Validate emails
+	emailSchema := v.Make().Shape(map[string]v.Type{
+		"email": types.String().Required().Email().Label("E-posta"),
+	})
+
+	for _, email := range emails {
+		result := emailSchema.Validate(map[string]any{"email": email})
+		if result.HasErrors() {
+			return 0, fmt.Errorf("email: %s geçersiz (%s)", email, result.Errors()["email"][0])
+		}
+	}
+
+	// 2. Get phase
+	phase, err := s.findManagedPhase(phaseID)
+	if err != nil {
+		return 0, err
+	}
+
+	if phase.AccessType != models.SalePhaseAccessAllowList {
+		return 0, fmt.Errorf("sadece liste erişimli aşamalara e-posta eklenebilir")
+	}
+
+	// 3. Save
+	added, err := s.salePhaseRepo.AddToAllowList(phaseID, emails)
+	if err != nil {
+		return added, fmt.Errorf("erişim listesi güncellenemedi: %w", err)
+	}
+
+	s.audit.record(models.AuditActionUpdate, models.AuditEntitySalePhase, phaseID, nil, map[string]int{"allow_list_added": added})
+
+	return added, nil
+}
+
+// AssignSegment adds a user to a segment such as fan_club (platform admin; segments are shared by all organizers)
+func (s *SalePhaseService) AssignSegment(userID int64, segment string) error {
+	if err := requirePlatform(s.scope); err != nil {
+		return err
+	}
+
+	if userID <= 0 {
+		return fmt.Errorf("user_id: geçersiz kullanıcı")
+	}
+	if strings.TrimSpace(segment) == "" {
+		return fmt.Errorf("segment: segment zorunludur")
+	}
+
+	if err := s.userSegmentRepo.Add(userID, segment); err != nil {
+		return fmt.Errorf("segment atanamadı: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveSegment removes a user from a segment (platform admin)
+func (s *SalePhaseService) RemoveSegment(userID int64, segment string) error {
+	if err := requirePlatform(s.scope); err != nil {
+		return err
+	}
+
+	if err := s.userSegmentRepo.Remove(userID, segment); err != nil {
+		return fmt.Errorf("segment kaldırılamadı: %w", err)
+	}
+
+	return nil
+}
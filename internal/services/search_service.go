@@ -0,0 +1,228 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	"github.com/biyonik/event-ticketing-api/pkg/search"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+)
+
+const reindexBatchSize = 200
+
+// SearchRequest carries the full-text query and facet filters
+type SearchRequest struct {
+	Text       string
+	Type       string
+	City       string
+	DateBucket string
+	PriceRange string
+	Page       int
+	PageSize   int
+}
+
+// SearchService keeps the search index in sync with events and serves ranked,
+// faceted full-text search. The index is MySQL FULLTEXT in production
+// (repositories.SearchRepository) and search.MemoryIndex in tests.
+type SearchService struct {
+	index     search.Index
+	eventRepo *repositories.EventRepository
+	venueRepo *repositories.VenueRepository
+	scope     models.OrganizerScope
+}
+
+func NewSearchService(
+	index search.Index,
+	eventRepo *repositories.EventRepository,
+	venueRepo *repositories.VenueRepository,
+) *SearchService {
+	return &SearchService{
+		index:     index,
+		eventRepo: eventRepo,
+		venueRepo: venueRepo,
+	}
+}
+
+// ForOrganizer returns a copy of the service bound to the member's scope
+// (search itself is public; the scope only authorizes ReindexAll)
+func (s *SearchService) ForOrganizer(scope models.OrganizerScope) *SearchService {
+	scoped := *s
+	scoped.scope = scope
+	return &scoped
+}
+
+// IndexEvent writes the event to the index, or removes it when it is deleted or
+// no longer public (draft, cancelled, completed)
+func (s *SearchService) IndexEvent(eventID int64) error {
+	// 1. Load event (deleted events are not returned)
+	events, err := s.eventRepo.FindByIDs([]interface{}{eventID})
+	if err != nil {
+		return fmt.Errorf("etkinlik yüklenemedi: %w", err)
+	}
+
+	event, ok := events[eventID]
+	if !ok || !event.IsSearchable() {
+		if err := s.index.Delete(eventID); err != nil {
+			return fmt.Errorf("etkinlik arama indeksinden çıkarılamadı: %w", err)
+		}
+		return nil
+	}
+
+	// 2. Build document with venue name and city
+	doc, err := s.buildDocument(event)
+	if err != nil {
+		return err
+	}
+
+	// 3. Upsert
+	if err := s.index.Upsert(doc); err != nil {
+		return fmt.Errorf("etkinlik arama indeksine yazılamadı: %w", err)
+	}
+
+	return nil
+}
+
+// ReindexAll rebuilds the index from every event and returns the number of searchable events (platform admin)
+func (s *SearchService) ReindexAll() (int, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return 0, err
+	}
+
+	indexed := 0
+
+	for offset := 0; ; offset += reindexBatchSize {
+		events, err := s.eventRepo.FindAll(map[string]interface{}{}, reindexBatchSize, offset)
+		if err != nil {
+			return indexed, fmt.Errorf("etkinlikler listelenemedi: %w", err)
+		}
+
+		for _, event := range events {
+			if !event.IsSearchable() {
+				if err := s.index.Delete(event.ID); err != nil {
+					return indexed, fmt.Errorf("etkinlik arama indeksinden çıkarılamadı: %w", err)
+				}
+				continue
+			}
+
+			doc, err := s.buildDocument(event)
+			if err != nil {
+				return indexed, err
+			}
+			if err := s.index.Upsert(doc); err != nil {
+				return indexed, fmt.Errorf("etkinlik arama indeksine yazılamadı: %w", err)
+			}
+			indexed++
+		}
+
+		if len(events) < reindexBatchSize {
+			return indexed, nil
+		}
+	}
+}
+
+func (s *SearchService) buildDocument(event *models.Event) (*search.Document, error) {
+	doc := &search.Document{
+		ID:        event.ID,
+		Title:     event.Name,
+		Body:      event.Description,
+		Type:      string(event.Type),
+		StartTime: event.StartTime,
+		Price:     event.BasePrice,
+	}
+
+	venue, err := s.venueRepo.FindByID(event.VenueID)
+	if err != nil {
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+	doc.Venue = venue.Name
+	doc.City = venue.City
+
+	return doc, nil
+}
+
+// Search runs a full-text query over upcoming public events.
+// Steps:
+// 1. Validate text and paging
+// 2. Query the index for ranked IDs and facet counts
+// 3. Load events and venues, keeping the relevance order
+func (s *SearchService) Search(req SearchRequest) (*models.EventSearchResult, error) {
+	// 1. Validation
+	schema := v.Make().Shape(map[string]v.Type{
+		"q": types.String().Max(100).Label("Arama Kelimesi"),
+	})
+
+	result := schema.Validate(map[string]any{"q": strings.TrimSpace(req.Text)})
+	if result.HasErrors() {
+		return nil, fmt.Errorf("q: %s", result.Errors()["q"][0])
+	}
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
+	// 2. Query index
+	found, err := s.index.Search(&search.Query{
+		Text:       req.Text,
+		Type:       req.Type,
+		City:       req.City,
+		DateBucket: req.DateBucket,
+		PriceRange: req.PriceRange,
+		Now:        time.Now(),
+		Limit:      req.PageSize,
+		Offset:     (req.Page - 1) * req.PageSize,
+	})
+	if errors.Is(err, search.ErrInvalidQuery) {
+		return nil, fmt.Errorf("geçersiz arama filtresi")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("arama yapılamadı: %w", err)
+	}
+
+	// 3. Load events in relevance order
+	ids := make([]interface{}, len(found.Hits))
+	for i, hit := range found.Hits {
+		ids[i] = hit.ID
+	}
+
+	events, err := s.eventRepo.FindByIDs(ids)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlikler yüklenemedi: %w", err)
+	}
+
+	venueIDs := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		venueIDs = append(venueIDs, event.VenueID)
+	}
+
+	venues, err := s.venueRepo.FindByIDs(venueIDs)
+	if err != nil {
+		return nil, fmt.Errorf("mekanlar yüklenemedi: %w", err)
+	}
+
+	hits := make([]*models.EventSearchHit, 0, len(found.Hits))
+	for _, hit := range found.Hits {
+		// İndeks ile tablo arasında gecikme olabilir; silinmiş etkinlik atlanır
+		event, ok := events[hit.ID]
+		if !ok {
+			continue
+		}
+		event.Venue = venues[event.VenueID]
+		hits = append(hits, &models.EventSearchHit{Event: event, Score: hit.Score})
+	}
+
+	return &models.EventSearchResult{
+		Events:   hits,
+		Total:    found.Total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Facets:   found.Facets,
+	}, nil
+}
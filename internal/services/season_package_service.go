@@ -0,0 +1,502 @@
+package services
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/patterns/factory"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+)
+
+// SeasonPackageInput carries the fields of a new season package
+type SeasonPackageInput struct {
+	VenueID           int64
+	Name              string
+	Season            string
+	Price             float64
+	EventIDs          []int64
+	SaleStartsAt      time.Time
+	SaleEndsAt        time.Time
+	PreviousPackageID *int64     // Renewal: previous season's package
+	RenewalDeadline   *time.Time // Renewal: seats stay reserved for previous holders until this time
+}
+
+// SeasonPackageService sells season packages: one seat across all events of the
+// package, one entry ticket per event, with seat priority for renewing holders.
+type SeasonPackageService struct {
+	seasonPackageRepo *repositories.SeasonPackageRepository
+	eventRepo         *repositories.EventRepository
+	venueRepo         *repositories.VenueRepository
+	ticketRepo        *repositories.TicketRepository
+	ticketFactory     *factory.TicketFactory
+	db                *sql.DB
+	audit             auditTrail
+	scope             models.OrganizerScope
+}
+
+func NewSeasonPackageService(
+	seasonPackageRepo *repositories.SeasonPackageRepository,
+	eventRepo *repositories.EventRepository,
+	venueRepo *repositories.VenueRepository,
+	ticketRepo *repositories.TicketRepository,
+	db *sql.DB,
+) *SeasonPackageService {
+	return &SeasonPackageService{
+		seasonPackageRepo: seasonPackageRepo,
+		eventRepo:         eventRepo,
+		venueRepo:         venueRepo,
+		ticketRepo:        ticketRepo,
+		ticketFactory:     factory.NewTicketFactory(),
+		db:                db,
+	}
+}
+
+// ForOrganizer returns a copy of the service whose event, venue and ticket queries are
+// restricted to the scope's organizer and whose operations check the member's role.
+// The RenewalSeatGuard hook is called by TicketService and stays unguarded.
+func (s *SeasonPackageService) ForOrganizer(scope models.OrganizerScope) *SeasonPackageService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	scoped.venueRepo = s.venueRepo.ForOrganizer(scope.OrganizerID)
+	scoped.ticketRepo = s.ticketRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose mutations are audited as the given actor
+func (s *SeasonPackageService) WithActor(actor models.AuditActor) *SeasonPackageService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for season package changes (optional)
+func (s *SeasonPackageService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// CreatePackage creates a draft season package grouping events of one venue (admin)
+func (s *SeasonPackageService) CreatePackage(input SeasonPackageInput) (*models.SeasonPackage, error) {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return nil, err
+	}
+
+	// 1. Validate input using Conduit-Go Validation
+	schema := v.Make().Shape(map[string]v.Type{
+		"venue_id": types.Number().
+			Required().
+			Min(1).
+			Label("Mekan ID"),
+		"name": types.String().
+			Required().
+			Min(2).
+			Max(255).
+			Label("Paket Adı"),
+		"season": types.String().
+			Required().
+			Min(2).
+			Max(50).
+			Label("Sezon"),
+		"price": types.Number().
+			Required().
+			Min(0.01).
+			Label("Fiyat"),
+	})
+
+	result := schema.Validate(map[string]any{
+		"venue_id": float64(input.VenueID),
+		"name":     input.Name,
+		"season":   input.Season,
+		"price":    input.Price,
+	})
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	// 2. Business rules
+	if len(input.EventIDs) == 0 {
+		return nil, fmt.Errorf("event_ids: en az bir etkinlik seçilmeli")
+	}
+
+	if !input.SaleEndsAt.After(input.SaleStartsAt) {
+		return nil, fmt.Errorf("satış bitiş zamanı başlangıçtan sonra olmalı")
+	}
+
+	if _, err := s.venueRepo.FindByID(input.VenueID); err != nil {
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	for _, eventID := range input.EventIDs {
+		event, err := requireEventAccess(s.scope, s.eventRepo, eventID, models.PermissionManageEvents)
+		if err != nil {
+			return nil, fmt.Errorf("etkinlik bulunamadı (%d): %w", eventID, err)
+		}
+		if event.VenueID != input.VenueID {
+			return nil, fmt.Errorf("etkinlik %d paketin mekanında değil", eventID)
+		}
+		if event.Status == models.EventStatusCancelled {
+			return nil, fmt.Errorf("iptal edilmiş etkinlik (%d) pakete eklenemez", eventID)
+		}
+	}
+
+	// 3. Renewal settings
+	if input.PreviousPackageID != nil {
+		previous, err := s.seasonPackageRepo.FindByID(*input.PreviousPackageID)
+		if err != nil {
+			return nil, fmt.Errorf("önceki sezon paketi bulunamadı: %w", err)
+		}
+		if previous.VenueID != input.VenueID {
+			return nil, fmt.Errorf("önceki sezon paketi aynı mekanda olmalı")
+		}
+		if input.RenewalDeadline == nil {
+			return nil, fmt.Errorf("renewal_deadline: yenileme için son tarih zorunludur")
+		}
+		if input.RenewalDeadline.Before(input.SaleStartsAt) || input.RenewalDeadline.After(input.SaleEndsAt) {
+			return nil, fmt.Errorf("renewal_deadline: satış penceresi içinde olmalı")
+		}
+	} else if input.RenewalDeadline != nil {
+		return nil, fmt.Errorf("renewal_deadline: önceki sezon paketi olmadan kullanılamaz")
+	}
+
+	// 4. Create package
+	pkg := &models.SeasonPackage{
+		VenueID:           input.VenueID,
+		Name:              result.ValidData()["name"].(string),
+		Season:            result.ValidData()["season"].(string),
+		Price:             input.Price,
+		Status:            models.SeasonPackageStatusDraft,
+		SaleStartsAt:      input.SaleStartsAt,
+		SaleEndsAt:        input.SaleEndsAt,
+		PreviousPackageID: input.PreviousPackageID,
+		RenewalDeadline:   input.RenewalDeadline,
+	}
+	pkg.Initialize()
+
+	packageID, err := s.seasonPackageRepo.Create(pkg)
+	if err != nil {
+		return nil, fmt.Errorf("paket oluşturulamadı: %w", err)
+	}
+	pkg.ID = packageID
+
+	if err := s.seasonPackageRepo.AddEvents(packageID, input.EventIDs); err != nil {
+		return nil, fmt.Errorf("paket etkinlikleri kaydedilemedi: %w", err)
+	}
+	pkg.EventIDs = input.EventIDs
+
+	s.audit.record(models.AuditActionCreate, models.AuditEntitySeasonPackage, pkg.ID, nil, pkg)
+
+	return pkg, nil
+}
+
+// UpdateStatus opens, closes or drafts a package (admin)
+func (s *SeasonPackageService) UpdateStatus(packageID int64, status models.SeasonPackageStatus) error {
+	if err := requirePermission(s.scope, models.PermissionManageEvents); err != nil {
+		return err
+	}
+
+	switch status {
+	case models.SeasonPackageStatusDraft, models.SeasonPackageStatusOnSale, models.SeasonPackageStatusClosed:
+	default:
+		return fmt.Errorf("status: geçersiz paket durumu")
+	}
+
+	// Every event of the package must belong to the caller's organizer
+	pkg, err := s.seasonPackageRepo.FindByID(packageID)
+	if err != nil {
+		return fmt.Errorf("paket bulunamadı: %w", err)
+	}
+
+	for _, eventID := range pkg.EventIDs {
+		if _, err := requireEventAccess(s.scope, s.eventRepo, eventID, models.PermissionManageEvents); err != nil {
+			return fmt.Errorf("paket bulunamadı: %w", err)
+		}
+	}
+
+	if err := s.seasonPackageRepo.UpdateStatus(packageID, status); err != nil {
+		return fmt.Errorf("paket durumu güncellenemedi: %w", err)
+	}
+
+	s.audit.record(models.AuditActionUpdate, models.AuditEntitySeasonPackage, packageID,
+		map[string]any{"status": pkg.Status}, map[string]any{"status": status})
+
+	return nil
+}
+
+// GetPackage returns a package with its events
+func (s *SeasonPackageService) GetPackage(packageID int64) (*models.SeasonPackage, error) {
+	pkg, err := s.seasonPackageRepo.FindByID(packageID)
+	if err != nil {
+		return nil, fmt.Errorf("paket bulunamadı: %w", err)
+	}
+
+	return pkg, nil
+}
+
+// ListVenuePackages returns the packages of a venue
+func (s *SeasonPackageService) ListVenuePackages(venueID int64) ([]*models.SeasonPackage, error) {
+	packages, err := s.seasonPackageRepo.FindByVenueID(venueID)
+	if err != nil {
+		return nil, fmt.Errorf("paketler alınamadı: %w", err)
+	}
+
+	return packages, nil
+}
+
+// Purchase sells a season package: reserves the seat in every event of the package
+// and issues one entry ticket per event
+func (s *SeasonPackageService) Purchase(userID, packageID, sectionID int64, seatID *int64) (*models.SeasonMembership, error) {
+	if userID <= 0 {
+		return nil, fmt.Errorf("user_id: geçersiz kullanıcı")
+	}
+
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
+	pkg, err := s.seasonPackageRepo.FindByID(packageID)
+	if err != nil {
+		return nil, fmt.Errorf("paket bulunamadı: %w", err)
+	}
+
+	return s.sell(userID, pkg, sectionID, seatID, nil)
+}
+
+// Renew renews a previous season membership into the next package with the same seat.
+// Holders have priority on their seat until the package's renewal deadline.
+func (s *SeasonPackageService) Renew(userID, membershipID, packageID int64) (*models.SeasonMembership, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
+	// 1. Get previous membership
+	previous, err := s.seasonPackageRepo.FindMembershipByID(membershipID)
+	if err != nil {
+		return nil, fmt.Errorf("kombine bulunamadı: %w", err)
+	}
+
+	if previous.UserID != userID {
+		return nil, fmt.Errorf("bu kombine size ait değil")
+	}
+
+	if !previous.IsActive() {
+		return nil, fmt.Errorf("sadece aktif kombineler yenilenebilir")
+	}
+
+	// 2. Get next season package
+	pkg, err := s.seasonPackageRepo.FindByID(packageID)
+	if err != nil {
+		return nil, fmt.Errorf("paket bulunamadı: %w", err)
+	}
+
+	if pkg.PreviousPackageID == nil || *pkg.PreviousPackageID != previous.SeasonPackageID {
+		return nil, fmt.Errorf("bu paket kombinenizin yenilemesi değil")
+	}
+
+	if !pkg.InRenewalPeriod(time.Now()) {
+		return nil, fmt.Errorf("yenileme süresi doldu")
+	}
+
+	// 3. Sell with the same section and seat
+	return s.sell(userID, pkg, previous.SectionID, previous.SeatID, previous)
+}
+
+// sell creates the membership and its per-event tickets in one transaction
+func (s *SeasonPackageService) sell(
+	userID int64,
+	pkg *models.SeasonPackage,
+	sectionID int64,
+	seatID *int64,
+	renewedFrom *models.SeasonMembership,
+) (*models.SeasonMembership, error) {
+	now := time.Now()
+
+	// 1. Business rules
+	if !pkg.IsOnSale(now) {
+		return nil, fmt.Errorf("paket satışı aktif değil")
+	}
+
+	section, err := s.venueRepo.FindSectionByID(sectionID)
+	if err != nil {
+		return nil, fmt.Errorf("bölüm bulunamadı: %w", err)
+	}
+	if section.VenueID != pkg.VenueID {
+		return nil, fmt.Errorf("bölüm paketin mekanında değil")
+	}
+
+	seatInfo := section.Name
+	if seatID != nil {
+		seat, err := s.venueRepo.FindSeatByID(*seatID)
+		if err != nil {
+			return nil, fmt.Errorf("koltuk bulunamadı: %w", err)
+		}
+		if seat.SectionID != sectionID || !seat.IsActive {
+			return nil, fmt.Errorf("koltuk bu bölümde kullanılamaz")
+		}
+		seatInfo = fmt.Sprintf("%s - Sıra: %s, Koltuk: %s", section.Name, seat.Row, seat.Number)
+	}
+
+	// 2. Seat priority for previous season holders
+	if seatID != nil && pkg.InRenewalPeriod(now) {
+		holder, err := s.seasonPackageRepo.FindActiveSeatHolder(*pkg.PreviousPackageID, *seatID)
+		if err != nil {
+			return nil, fmt.Errorf("koltuk kontrolü yapılamadı: %w", err)
+		}
+		if holder != nil && (renewedFrom == nil || renewedFrom.ID != holder.ID) {
+			return nil, fmt.Errorf("koltuk yenileme süresi boyunca mevcut kombine sahibine ayrıldı")
+		}
+	}
+
+	// 3. Collect upcoming events of the package
+	venue, err := s.venueRepo.FindByID(pkg.VenueID)
+	if err != nil {
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	var events []factory.SeasonEventInfo
+	for _, eventID := range pkg.EventIDs {
+		event, err := s.eventRepo.FindByID(eventID)
+		if err != nil {
+			return nil, fmt.Errorf("etkinlik bulunamadı (%d): %w", eventID, err)
+		}
+		if event.Status == models.EventStatusCancelled || !now.Before(event.StartTime) {
+			continue
+		}
+		events = append(events, factory.SeasonEventInfo{EventID: event.ID, EventName: event.Name})
+	}
+
+	if len(events) == 0 {
+		return nil, fmt.Errorf("pakette yaklaşan etkinlik yok")
+	}
+
+	// 4. Start transaction; package lock serializes season sales
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.seasonPackageRepo.LockByID(tx, pkg.ID); err != nil {
+		return nil, fmt.Errorf("paket kilitlenemedi: %w", err)
+	}
+
+	// 5. The same seat must be free in every event
+	if seatID != nil {
+		for _, event := range events {
+			isTaken, err := s.ticketRepo.IsSeatTaken(event.EventID, *seatID)
+			if err != nil {
+				return nil, fmt.Errorf("koltuk kontrolü yapılamadı: %w", err)
+			}
+			if isTaken {
+				return nil, fmt.Errorf("koltuk %s etkinliğinde dolu", event.EventName)
+			}
+		}
+	}
+
+	// 6. Create membership
+	membership := &models.SeasonMembership{
+		SeasonPackageID: pkg.ID,
+		UserID:          userID,
+		SectionID:       sectionID,
+		SeatID:          seatID,
+		Price:           pkg.Price,
+		Status:          models.SeasonMembershipStatusActive,
+	}
+	if renewedFrom != nil {
+		membership.RenewedFromID = &renewedFrom.ID
+	}
+	membership.Initialize()
+
+	membershipID, err := s.seasonPackageRepo.CreateMembership(tx, membership)
+	if err != nil {
+		return nil, fmt.Errorf("kombine oluşturulamadı: %w", err)
+	}
+	membership.ID = membershipID
+
+	// 7. Decrement available seats of every event
+	for _, event := range events {
+		if err := s.eventRepo.DecrementAvailableSeatsWithTx(tx, event.EventID, 1); err != nil {
+			return nil, fmt.Errorf("%s etkinliğinde yer ayrılamadı: %w", event.EventName, err)
+		}
+	}
+
+	// 8. Issue per-event entry tickets using Factory pattern
+	tickets, err := s.ticketFactory.CreateSeasonPackageTickets(&factory.TicketCreationRequest{
+		UserID:    userID,
+		SeatID:    seatID,
+		SectionID: sectionID,
+		Price:     pkg.Price,
+		VenueName: venue.Name,
+		SeatInfo:  seatInfo,
+	}, events)
+	if err != nil {
+		return nil, fmt.Errorf("kombine biletleri oluşturulamadı: %w", err)
+	}
+
+	for _, ticket := range tickets {
+		ticket.SeasonMembershipID = &membership.ID
+
+		ticketID, err := s.ticketRepo.CreateWithTx(tx, ticket)
+		if err != nil {
+			return nil, fmt.Errorf("bilet kaydedilemedi: %w", err)
+		}
+		ticket.ID = ticketID
+	}
+	membership.Tickets = tickets
+
+	// 9. Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+
+	return membership, nil
+}
+
+// GetUserMemberships returns the season memberships of a user
+func (s *SeasonPackageService) GetUserMemberships(userID int64) ([]*models.SeasonMembership, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
+
+	memberships, err := s.seasonPackageRepo.FindMembershipsByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("kombineler alınamadı: %w", err)
+	}
+
+	return memberships, nil
+}
+
+// GetMembership returns a membership with its per-event entry tickets
+func (s *SeasonPackageService) GetMembership(userID, membershipID int64) (*models.SeasonMembership, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
+
+	membership, err := s.seasonPackageRepo.FindMembershipByID(membershipID)
+	if err != nil {
+		return nil, fmt.Errorf("kombine bulunamadı: %w", err)
+	}
+
+	if membership.UserID != userID {
+		return nil, fmt.Errorf("bu kombine size ait değil")
+	}
+
+	tickets, err := s.ticketRepo.FindBySeasonMembershipID(membershipID)
+	if err != nil {
+		return nil, fmt.Errorf("kombine biletleri alınamadı: %w", err)
+	}
+	membership.Tickets = tickets
+
+	return membership, nil
+}
+
+// IsSeatHeldForRenewal reports whether a single-event sale must skip the seat because
+// a previous season holder can still renew it (implements RenewalSeatGuard)
+func (s *SeasonPackageService) IsSeatHeldForRenewal(eventID, seatID int64) (bool, error) {
+	return s.seasonPackageRepo.IsSeatHeldForRenewal(eventID, seatID)
+}
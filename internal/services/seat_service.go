@@ -0,0 +1,314 @@
+package services
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+)
+
+// MaxBestAvailableSeats bounds how many adjacent seats one best-available request allocates
+const MaxBestAvailableSeats = 10
+
+// SeatService serves the per-event seat map and best-available allocation filtered by
+// seat attributes, and manages the links between accessible seats and their companions.
+// A companion seat is held automatically for the buyer of its accessible seat while that
+// ticket is reserved or sold (see TicketRepository.IsCompanionSeatHeld).
+type SeatService struct {
+	venueRepo  *repositories.VenueRepository
+	eventRepo  *repositories.EventRepository
+	ticketRepo *repositories.TicketRepository
+	scope      models.OrganizerScope
+	db         *sql.DB
+}
+
+func NewSeatService(
+	venueRepo *repositories.VenueRepository,
+	eventRepo *repositories.EventRepository,
+	ticketRepo *repositories.TicketRepository,
+	db *sql.DB,
+) *SeatService {
+	return &SeatService{
+		venueRepo:  venueRepo,
+		eventRepo:  eventRepo,
+		ticketRepo: ticketRepo,
+		db:         db,
+	}
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer venues
+func (s *SeatService) ForOrganizer(scope models.OrganizerScope) *SeatService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.venueRepo = s.venueRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// GetSeatMap returns the event's seats that match the filter with their sale status.
+// Companion seats held for userID (the buyer of the accessible seat) are shown as available to them.
+func (s *SeatService) GetSeatMap(eventID, sectionID, userID int64, filter models.SeatFilter) (*models.SeatMap, error) {
+	// 1. Get event
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	// 2. Load sections, seats and statuses
+	sections, err := s.venueRepo.FindSectionsByVenueID(event.VenueID)
+	if err != nil {
+		return nil, fmt.Errorf("bölümler alınamadı: %w", err)
+	}
+
+	seats, err := s.loadSeats(event, sectionID, sections)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := s.ticketRepo.FindUnavailableSeats(eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("koltuk durumları alınamadı: %w", err)
+	}
+
+	// 3. Build map in layout order
+	seatMap := &models.SeatMap{EventID: eventID, VenueID: event.VenueID, Filter: filter}
+	bySection := make(map[int64]*models.SeatMapSection, len(sections))
+	for _, section := range sections {
+		if sectionID > 0 && section.ID != sectionID {
+			continue
+		}
+		mapSection := &models.SeatMapSection{SectionID: section.ID, Name: section.Name, Seats: []*models.SeatMapSeat{}}
+		bySection[section.ID] = mapSection
+		seatMap.Sections = append(seatMap.Sections, mapSection)
+	}
+
+	for _, seat := range seats {
+		mapSection, ok := bySection[seat.SectionID]
+		if !ok || !filter.Matches(seat) {
+			continue
+		}
+
+		mapSeat := newSeatMapSeat(seat, statuses)
+		if mapSeat.Status == models.SeatStatusAvailable {
+			mapSection.Available++
+		}
+		mapSection.Seats = append(mapSection.Seats, mapSeat)
+	}
+
+	return seatMap, nil
+}
+
+// FindBestAvailable allocates quantity adjacent seats in one row that are available and match
+// the filter, preferring sections and rows in layout order (front rows first). It suggests seats
+// only; the buyer reserves them through the ticket endpoints.
+func (s *SeatService) FindBestAvailable(eventID, sectionID, userID int64, quantity int, filter models.SeatFilter) ([]*models.SeatMapSeat, error) {
+	// 1. Validate input
+	if quantity < 1 || quantity > MaxBestAvailableSeats {
+		return nil, fmt.Errorf("quantity: 1 ile %d arasında olmalı", MaxBestAvailableSeats)
+	}
+
+	// 2. Get event, seats and statuses
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	if event.AvailableSeats < quantity {
+		return nil, fmt.Errorf("yeterli boş koltuk yok")
+	}
+
+	sections, err := s.venueRepo.FindSectionsByVenueID(event.VenueID)
+	if err != nil {
+		return nil, fmt.Errorf("bölümler alınamadı: %w", err)
+	}
+
+	seats, err := s.loadSeats(event, sectionID, sections)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := s.ticketRepo.FindUnavailableSeats(eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("koltuk durumları alınamadı: %w", err)
+	}
+
+	// 3. Take the first block of adjacent matching seats (seats come in layout order)
+	block := models.FindAdjacentSeats(seats, statuses, quantity, filter)
+	if block == nil {
+		return nil, fmt.Errorf("filtreye uyan %d yan yana boş koltuk bulunamadı", quantity)
+	}
+
+	allocated := make([]*models.SeatMapSeat, 0, len(block))
+	for _, seat := range block {
+		allocated = append(allocated, newSeatMapSeat(seat, statuses))
+	}
+
+	return allocated, nil
+}
+
+// loadSeats returns the active seats of the event's venue, optionally for one section
+func (s *SeatService) loadSeats(event *models.Event, sectionID int64, sections []*models.Section) ([]*models.Seat, error) {
+	if sectionID > 0 {
+		found := false
+		for _, section := range sections {
+			if section.ID == sectionID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("bölüm bu etkinliğin mekanında değil")
+		}
+	}
+
+	seats, err := s.venueRepo.FindActiveSeats(event.VenueID, sectionID)
+	if err != nil {
+		return nil, fmt.Errorf("koltuklar alınamadı: %w", err)
+	}
+
+	return seats, nil
+}
+
+// newSeatMapSeat builds the seat map entry with the seat's sale status
+func newSeatMapSeat(seat *models.Seat, statuses map[int64]models.SeatStatus) *models.SeatMapSeat {
+	status, ok := statuses[seat.ID]
+	if !ok {
+		status = models.SeatStatusAvailable
+	}
+
+	return &models.SeatMapSeat{
+		ID:              seat.ID,
+		SectionID:       seat.SectionID,
+		Row:             seat.Row,
+		Number:          seat.Number,
+		Attributes:      seat.AttributeList(),
+		CompanionSeatID: seat.CompanionSeatID,
+		Status:          status,
+	}
+}
+
+// LinkCompanion links a wheelchair seat with its companion seat in both directions.
+// Previous links of either seat are removed.
+func (s *SeatService) LinkCompanion(seatID, companionSeatID int64) error {
+	// 1. Authorize and validate
+	if err := requirePermission(s.scope, models.PermissionManageVenues); err != nil {
+		return err
+	}
+
+	if seatID == companionSeatID {
+		return fmt.Errorf("koltuk kendisinin refakatçisi olamaz")
+	}
+
+	seat, err := s.findManagedSeat(seatID)
+	if err != nil {
+		return err
+	}
+
+	companion, err := s.findManagedSeat(companionSeatID)
+	if err != nil {
+		return err
+	}
+
+	// 2. Business rules
+	if !seat.IsAccessible() {
+		return fmt.Errorf("koltuk %s özelliğine sahip olmalı", models.SeatAttributeWheelchair)
+	}
+
+	if !companion.HasAttribute(models.SeatAttributeCompanion) {
+		return fmt.Errorf("refakatçi koltuğu %s özelliğine sahip olmalı", models.SeatAttributeCompanion)
+	}
+
+	if seat.Section.VenueID != companion.Section.VenueID {
+		return fmt.Errorf("koltuklar aynı mekanda olmalı")
+	}
+
+	// 3. Link in a transaction
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.venueRepo.LinkCompanionSeats(tx, seat.ID, companion.ID); err != nil {
+		return fmt.Errorf("refakatçi koltuğu bağlanamadı: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// UnlinkCompanion removes the companion link of a seat in both directions
+func (s *SeatService) UnlinkCompanion(seatID int64) error {
+	if err := requirePermission(s.scope, models.PermissionManageVenues); err != nil {
+		return err
+	}
+
+	if _, err := s.findManagedSeat(seatID); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.venueRepo.UnlinkCompanionSeat(tx, seatID); err != nil {
+		return fmt.Errorf("refakatçi bağı kaldırılamadı: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+
+	return nil
+}
+
+// findManagedSeat loads a seat with its section and checks the venue is visible in the scope
+func (s *SeatService) findManagedSeat(seatID int64) (*models.Seat, error) {
+	seat, err := s.venueRepo.FindSeatByID(seatID)
+	if err != nil {
+		return nil, fmt.Errorf("koltuk bulunamadı: %w", err)
+	}
+
+	section, err := s.venueRepo.FindSectionByID(seat.SectionID)
+	if err != nil {
+		return nil, fmt.Errorf("bölüm bulunamadı: %w", err)
+	}
+
+	if _, err := s.venueRepo.FindByID(section.VenueID); err != nil {
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	seat.Section = section
+	return seat, nil
+}
+
+// RestrictedViewPricing discounts obstructed and restricted view seats by a fixed
+// percentage (implements SeatPriceAdjuster)
+type RestrictedViewPricing struct {
+	discountPercent float64
+}
+
+func NewRestrictedViewPricing(discountPercent float64) *RestrictedViewPricing {
+	return &RestrictedViewPricing{
+		discountPercent: discountPercent,
+	}
+}
+
+// AdjustSeatPrice returns the discounted price for restricted view seats, otherwise the price unchanged
+func (p *RestrictedViewPricing) AdjustSeatPrice(eventID int64, seat *models.Seat, price float64) (float64, error) {
+	if p.discountPercent <= 0 || !seat.HasRestrictedView() {
+		return price, nil
+	}
+
+	adjusted := roundAmount(price * (100 - p.discountPercent) / 100)
+	if adjusted < 0.01 {
+		adjusted = 0.01
+	}
+
+	return adjusted, nil
+}
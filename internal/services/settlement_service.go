@@ -0,0 +1,291 @@
+package services
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+)
+
+// DefaultSettlementCurrency is used when a statement is generated without a currency
+const DefaultSettlementCurrency = "TRY"
+
+// SettlementFees configures what the platform keeps from an organizer's payout
+type SettlementFees struct {
+	Percent    float64 // Commission on each completed sale (5 = %5)
+	Fixed      float64 // Fixed fee per completed sale
+	Chargeback float64 // Fixed fee per chargeback
+}
+
+// SettlementService produces organizer payout statements. A statement covers
+// the completed sales, refunds and chargebacks that happened in a period and
+// were not settled before, and deducts platform fees from them. Statements are
+// immutable once issued; the only later change is recording the payout.
+type SettlementService struct {
+	settlementRepo *repositories.SettlementRepository
+	organizerRepo  *repositories.OrganizerRepository
+	fees           SettlementFees
+	scope          models.OrganizerScope
+	db             *sql.DB
+}
+
+func NewSettlementService(
+	settlementRepo *repositories.SettlementRepository,
+	organizerRepo *repositories.OrganizerRepository,
+	fees SettlementFees,
+	db *sql.DB,
+) *SettlementService {
+	return &SettlementService{
+		settlementRepo: settlementRepo,
+		organizerRepo:  organizerRepo,
+		fees:           fees,
+		db:             db,
+	}
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer statements
+func (s *SettlementService) ForOrganizer(scope models.OrganizerScope) *SettlementService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.settlementRepo = s.settlementRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// roundAmount rounds a money amount to cents
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// GenerateStatement builds and stores the statement of an organizer for a closed period (platform admin).
+// The period is half-open: periodStart <= movement time < periodEnd.
+func (s *SettlementService) GenerateStatement(organizerID int64, currency string, periodStart, periodEnd time.Time) (*models.SettlementStatement, error) {
+	// 1. Authorize and validate
+	if err := requirePlatform(s.scope); err != nil {
+		return nil, err
+	}
+
+	if !periodEnd.After(periodStart) {
+		return nil, fmt.Errorf("dönem bitişi başlangıçtan sonra olmalı")
+	}
+	if periodEnd.After(time.Now()) {
+		return nil, fmt.Errorf("dönem henüz kapanmadı")
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = DefaultSettlementCurrency
+	}
+	if len(currency) != 3 {
+		return nil, fmt.Errorf("currency: geçersiz para birimi: %s", currency)
+	}
+
+	if _, err := s.organizerRepo.FindByID(organizerID); err != nil {
+		return nil, fmt.Errorf("organizatör bulunamadı: %w", err)
+	}
+
+	// 2. Collect unsettled movements of the period
+	movements, err := s.settlementRepo.FindUnsettledMovements(organizerID, currency, periodStart, periodEnd)
+	if err != nil {
+		return nil, fmt.Errorf("ödeme hareketleri alınamadı: %w", err)
+	}
+	if len(movements) == 0 {
+		return nil, fmt.Errorf("dönemde mutabakata girecek ödeme hareketi yok")
+	}
+
+	// 3. Build statement lines and totals
+	statement := &models.SettlementStatement{
+		OrganizerID: organizerID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Currency:    currency,
+		Status:      models.SettlementStatusIssued,
+	}
+	statement.Initialize()
+
+	for _, movement := range movements {
+		for _, line := range s.linesFor(movement) {
+			line.CreatedAt = statement.CreatedAt
+			statement.AddLine(line)
+		}
+	}
+
+	statement.GrossAmount = roundAmount(statement.GrossAmount)
+	statement.RefundAmount = roundAmount(statement.RefundAmount)
+	statement.ChargebackAmount = roundAmount(statement.ChargebackAmount)
+	statement.FeeAmount = roundAmount(statement.FeeAmount)
+	statement.NetAmount = roundAmount(statement.NetAmount)
+
+	// 4. Store statement and lines atomically; a movement settled concurrently fails the whole statement
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	statementID, err := s.settlementRepo.CreateStatement(tx, statement)
+	if err != nil {
+		return nil, fmt.Errorf("ekstre oluşturulamadı: %w", err)
+	}
+	statement.ID = statementID
+
+	for _, line := range statement.Lines {
+		line.StatementID = statementID
+		lineID, err := s.settlementRepo.CreateLine(tx, line)
+		if err != nil {
+			return nil, fmt.Errorf("ekstre kalemi oluşturulamadı: %w", err)
+		}
+		line.ID = lineID
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+
+	return statement, nil
+}
+
+// linesFor converts a payment movement into signed statement lines (with its fee line, if any)
+func (s *SettlementService) linesFor(movement *models.SettlementMovement) []*models.SettlementLine {
+	line := func(lineType models.SettlementLineType, amount float64) *models.SettlementLine {
+		return &models.SettlementLine{
+			PaymentID:  movement.PaymentID,
+			EventID:    movement.EventID,
+			LineType:   lineType,
+			Amount:     roundAmount(amount),
+			OccurredAt: movement.OccurredAt,
+		}
+	}
+
+	switch movement.Type {
+	case models.SettlementLineSale:
+		lines := []*models.SettlementLine{line(models.SettlementLineSale, movement.Amount)}
+		// Komisyon satışta kesilir; iade veya ters ibrazda geri verilmez
+		if fee := roundAmount(movement.Amount*s.fees.Percent/100 + s.fees.Fixed); fee > 0 {
+			lines = append(lines, line(models.SettlementLineFee, -fee))
+		}
+		return lines
+	case models.SettlementLineRefund:
+		return []*models.SettlementLine{line(models.SettlementLineRefund, -movement.Amount)}
+	case models.SettlementLineChargeback:
+		lines := []*models.SettlementLine{line(models.SettlementLineChargeback, -movement.Amount)}
+		if s.fees.Chargeback > 0 {
+			lines = append(lines, line(models.SettlementLineChargebackFee, -s.fees.Chargeback))
+		}
+		return lines
+	}
+
+	return nil
+}
+
+// GetStatement returns a statement with its line detail
+func (s *SettlementService) GetStatement(statementID int64) (*models.SettlementStatement, error) {
+	if err := requirePermission(s.scope, models.PermissionViewReports); err != nil {
+		return nil, err
+	}
+
+	statement, err := s.settlementRepo.FindStatementByID(statementID)
+	if err != nil {
+		return nil, fmt.Errorf("ekstre bulunamadı: %w", err)
+	}
+
+	lines, err := s.settlementRepo.FindLines(statementID)
+	if err != nil {
+		return nil, fmt.Errorf("ekstre kalemleri alınamadı: %w", err)
+	}
+	statement.Lines = lines
+
+	return statement, nil
+}
+
+// ListStatements returns the statements of an organizer, newest period first.
+// Organizer members always see their own organizer's statements.
+func (s *SettlementService) ListStatements(organizerID int64) ([]*models.SettlementStatement, error) {
+	if err := requirePermission(s.scope, models.PermissionViewReports); err != nil {
+		return nil, err
+	}
+
+	if !s.scope.IsPlatform() {
+		organizerID = s.scope.OrganizerID
+	}
+
+	statements, err := s.settlementRepo.FindStatementsByOrganizer(organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("ekstreler alınamadı: %w", err)
+	}
+
+	return statements, nil
+}
+
+// MarkPaid records the payout of an issued statement (platform admin)
+func (s *SettlementService) MarkPaid(statementID int64, payoutReference string) (*models.SettlementStatement, error) {
+	// 1. Authorize and validate
+	if err := requirePlatform(s.scope); err != nil {
+		return nil, err
+	}
+
+	payoutReference = strings.TrimSpace(payoutReference)
+	if payoutReference == "" {
+		return nil, fmt.Errorf("payout_reference: ödeme referansı gerekli")
+	}
+
+	// 2. Conditional update (issued -> paid)
+	paidAt := time.Now()
+	updated, err := s.settlementRepo.MarkPaid(statementID, payoutReference, paidAt)
+	if err != nil {
+		return nil, fmt.Errorf("ekstre güncellenemedi: %w", err)
+	}
+
+	if !updated {
+		statement, err := s.settlementRepo.FindStatementByID(statementID)
+		if err != nil {
+			return nil, fmt.Errorf("ekstre bulunamadı: %w", err)
+		}
+		if statement.IsPaid() {
+			return nil, fmt.Errorf("ekstre zaten ödenmiş")
+		}
+		return nil, fmt.Errorf("ekstre ödendi olarak işaretlenemedi")
+	}
+
+	return s.GetStatement(statementID)
+}
+
+// WriteStatementCSV writes the line detail of a statement as CSV
+func WriteStatementCSV(w io.Writer, statement *models.SettlementStatement) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{
+		"statement_id", "line_id", "payment_id", "event_id", "line_type", "amount", "currency", "occurred_at",
+	}); err != nil {
+		return fmt.Errorf("CSV yazılamadı: %w", err)
+	}
+
+	for _, line := range statement.Lines {
+		record := []string{
+			strconv.FormatInt(statement.ID, 10),
+			strconv.FormatInt(line.ID, 10),
+			strconv.FormatInt(line.PaymentID, 10),
+			strconv.FormatInt(line.EventID, 10),
+			string(line.LineType),
+			strconv.FormatFloat(line.Amount, 'f', 2, 64),
+			statement.Currency,
+			line.OccurredAt.UTC().Format(time.RFC3339),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("CSV yazılamadı: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("CSV yazılamadı: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/patterns/observer"
+	"github.com/biyonik/event-ticketing-api/pkg/statemachine"
+)
+
+// newEventStates builds the event state machine whose transitions are published
+// to observers as EventTypeEventStatusChanged
+func newEventStates(publisher *observer.EventPublisher) *models.EventStateMachine {
+	machine := models.NewEventStateMachine()
+	machine.OnTransition(func(t statemachine.Transition[models.EventStatus, *models.Event]) {
+		publishStatusChange(publisher, observer.EventTypeEventStatusChanged, t.Machine, t.Subject.ID, string(t.From), string(t.To), t.At)
+	})
+	return machine
+}
+
+// newTicketStates builds the ticket state machine whose transitions are published
+// to observers as EventTypeTicketStatusChanged
+func newTicketStates(publisher *observer.EventPublisher) *models.TicketStateMachine {
+	machine := models.NewTicketStateMachine()
+	machine.OnTransition(func(t statemachine.Transition[models.TicketStatus, *models.Ticket]) {
+		publishStatusChange(publisher, observer.EventTypeTicketStatusChanged, t.Machine, t.Subject.ID, string(t.From), string(t.To), t.At)
+	})
+	return machine
+}
+
+// newPaymentStates builds the payment state machine whose transitions are published
+// to observers as EventTypePaymentStatusChanged
+func newPaymentStates(publisher *observer.EventPublisher) *models.PaymentStateMachine {
+	machine := models.NewPaymentStateMachine()
+	machine.OnTransition(func(t statemachine.Transition[models.PaymentStatus, *models.Payment]) {
+		publishStatusChange(publisher, observer.EventTypePaymentStatusChanged, t.Machine, t.Subject.ID, string(t.From), string(t.To), t.At)
+	})
+	return machine
+}
+
+func publishStatusChange(publisher *observer.EventPublisher, eventType observer.EventType, entity string, id int64, from, to string, at time.Time) {
+	if publisher == nil {
+		return
+	}
+
+	publisher.Notify(&observer.EventData{
+		Type:      eventType,
+		Timestamp: at,
+		Data: &observer.StatusChangeData{
+			Entity:   entity,
+			EntityID: id,
+			From:     from,
+			To:       to,
+		},
+	})
+}
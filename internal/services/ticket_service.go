@@ -3,6 +3,8 @@ package services
 import (
 	"database/sql"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/biyonik/event-ticketing-api/internal/models"
@@ -13,36 +15,187 @@ import (
 	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
 )
 
+// SeatReleaser decides what happens to a seat freed by a cancellation or an expired reservation
+// (e.g. WaitingListOfferService offers it to the waiting list before returning it to public sale)
+type SeatReleaser interface {
+	ReleaseSeat(eventID, sectionID int64, seatID *int64, price float64) error
+	IsSeatHeld(eventID, seatID int64) (bool, error)
+}
+
+// RenewalSeatGuard reports seats kept for season package holders during the renewal period
+// (implemented by SeasonPackageService)
+type RenewalSeatGuard interface {
+	IsSeatHeldForRenewal(eventID, seatID int64) (bool, error)
+}
+
+// TicketAddOns keeps add-on items in step with their ticket (implemented by AddOnService)
+type TicketAddOns interface {
+	FindForTicket(ticketID int64) ([]*models.AddOnItem, error)
+	ConfirmForTicket(ticketID int64) ([]*models.AddOnItem, error)
+	ReleaseForTicket(ticketID int64) ([]*models.AddOnItem, error)
+}
+
+// TicketSessionGate validates multi-day tickets against the session currently running
+// instead of the event as a whole (implemented by EventSessionService)
+type TicketSessionGate interface {
+	HasSessions(eventID int64) (bool, error)
+	ValidateEntry(ticket *models.Ticket, at time.Time) (*models.EventSession, error)
+	CheckIn(ticket *models.Ticket, at time.Time) (*models.EventSession, error)
+}
+
+// SeatPriceAdjuster adjusts the price of a specific seat at reservation time
+// (e.g. RestrictedViewPricing discounts obstructed and restricted view seats)
+type SeatPriceAdjuster interface {
+	AdjustSeatPrice(eventID int64, seat *models.Seat, price float64) (float64, error)
+}
+
+// ReserveOptions carries sale phase access and box-office overrides for a reservation.
+// Card and address limits are not taken from the request: they are checked at purchase
+// against the instrument the payment provider reported for the ticket's payment.
+type ReserveOptions struct {
+	OverrideLimits bool   // Box office: skip purchase limits (admin only)
+	OverrideBy     int64  // Admin user performing the override
+	Email          string // Authenticated buyer's email (never the request body), checked against sale phase allow-lists
+	AccessCode     string // Presale access code for code-protected sale phases
+	HeldOfferID    int64  // Waiting list offer being claimed: its seat is already out of available_seats at the offered price
+}
+
 type TicketService struct {
-	ticketRepo     *repositories.TicketRepository
-	eventRepo      *repositories.EventRepository
-	venueRepo      *repositories.VenueRepository
-	ticketFactory  *factory.TicketFactory
-	ticketValidator *factory.TicketValidator
-	eventPublisher *observer.EventPublisher
-	db             *sql.DB
+	ticketRepo        *repositories.TicketRepository
+	purchaseLimitRepo *repositories.PurchaseLimitRepository
+	reservationRepo   *repositories.ReservationRepository
+	salePhaseRepo     *repositories.SalePhaseRepository
+	userSegmentRepo   *repositories.UserSegmentRepository
+	eventRepo         *repositories.EventRepository
+	venueRepo         *repositories.VenueRepository
+	ticketFactory     *factory.TicketFactory
+	ticketValidator   *factory.TicketValidator
+	eventPublisher    *observer.EventPublisher
+	ticketStates      *models.TicketStateMachine
+	eventStates       *models.EventStateMachine
+	seatReleaser      SeatReleaser
+	renewalSeatGuard  RenewalSeatGuard
+	ticketAddOns      TicketAddOns
+	sessionGate       TicketSessionGate
+	seatPriceAdjuster SeatPriceAdjuster
+	audit             auditTrail
+	scope             models.OrganizerScope
+	db                *sql.DB
 }
 
 func NewTicketService(
 	ticketRepo *repositories.TicketRepository,
 	eventRepo *repositories.EventRepository,
 	venueRepo *repositories.VenueRepository,
+	purchaseLimitRepo *repositories.PurchaseLimitRepository,
+	reservationRepo *repositories.ReservationRepository,
+	salePhaseRepo *repositories.SalePhaseRepository,
+	userSegmentRepo *repositories.UserSegmentRepository,
 	eventPublisher *observer.EventPublisher,
 	db *sql.DB,
 ) *TicketService {
 	return &TicketService{
-		ticketRepo:      ticketRepo,
-		eventRepo:       eventRepo,
-		venueRepo:       venueRepo,
-		ticketFactory:   factory.NewTicketFactory(),
-		ticketValidator: factory.NewTicketValidator(),
-		eventPublisher:  eventPublisher,
-		db:              db,
+		ticketRepo:        ticketRepo,
+		purchaseLimitRepo: purchaseLimitRepo,
+		reservationRepo:   reservationRepo,
+		salePhaseRepo:     salePhaseRepo,
+		userSegmentRepo:   userSegmentRepo,
+		eventRepo:         eventRepo,
+		venueRepo:         venueRepo,
+		ticketFactory:     factory.NewTicketFactory(),
+		ticketValidator:   factory.NewTicketValidator(),
+		eventPublisher:    eventPublisher,
+		ticketStates:      newTicketStates(eventPublisher),
+		eventStates:       newEventStates(eventPublisher),
+		db:                db,
+	}
+}
+
+// ForOrganizer returns a copy of the service whose ticket, event and venue queries are
+// restricted to the scope's organizer and whose operations check the member's role
+func (s *TicketService) ForOrganizer(scope models.OrganizerScope) *TicketService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.ticketRepo = s.ticketRepo.ForOrganizer(scope.OrganizerID)
+	scoped.reservationRepo = s.reservationRepo.ForOrganizer(scope.OrganizerID)
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	scoped.venueRepo = s.venueRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose mutations are audited as the given actor
+func (s *TicketService) WithActor(actor models.AuditActor) *TicketService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for ticket mutations (optional)
+func (s *TicketService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// SetSeatReleaser registers the handler for freed seats (optional)
+func (s *TicketService) SetSeatReleaser(releaser SeatReleaser) {
+	s.seatReleaser = releaser
+}
+
+// SetRenewalSeatGuard registers the season renewal seat check (optional)
+func (s *TicketService) SetRenewalSeatGuard(guard RenewalSeatGuard) {
+	s.renewalSeatGuard = guard
+}
+
+// SetTicketAddOns registers the add-on handler for ticket lifecycle changes (optional)
+func (s *TicketService) SetTicketAddOns(addOns TicketAddOns) {
+	s.ticketAddOns = addOns
+}
+
+// SetTicketSessionGate registers the per-session check-in for multi-day events (optional)
+func (s *TicketService) SetTicketSessionGate(gate TicketSessionGate) {
+	s.sessionGate = gate
+}
+
+// SetSeatPriceAdjuster registers the seat price adjustment hook (optional)
+func (s *TicketService) SetSeatPriceAdjuster(adjuster SeatPriceAdjuster) {
+	s.seatPriceAdjuster = adjuster
+}
+
+// usesSessions reports whether check-in for the event runs per session
+func (s *TicketService) usesSessions(eventID int64) (bool, error) {
+	if s.sessionGate == nil {
+		return false, nil
+	}
+
+	return s.sessionGate.HasSessions(eventID)
+}
+
+// releaseSeat returns a freed seat to the waiting list or to public inventory
+func (s *TicketService) releaseSeat(ticket *models.Ticket) error {
+	if s.seatReleaser != nil {
+		return s.seatReleaser.ReleaseSeat(ticket.EventID, ticket.SectionID, ticket.SeatID, ticket.Price)
 	}
+
+	return s.eventRepo.IncrementAvailableSeats(ticket.EventID, 1)
 }
 
 // ReserveTicket reserves a ticket for a limited time
 func (s *TicketService) ReserveTicket(userID, eventID, sectionID int64, seatID *int64, price float64) (*models.Ticket, error) {
+	return s.ReserveTicketWithOptions(userID, eventID, sectionID, seatID, price, ReserveOptions{})
+}
+
+// ReserveTicketWithOptions reserves a ticket enforcing the per-user purchase limit
+// (unless overridden by an admin); card and address limits apply at purchase
+func (s *TicketService) ReserveTicketWithOptions(userID, eventID, sectionID int64, seatID *int64, price float64, opts ReserveOptions) (*models.Ticket, error) {
+	// Customers reserve for themselves; box office sales and limit overrides need sell_tickets
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+	if opts.OverrideLimits {
+		if err := requirePermission(s.scope, models.PermissionSellTickets); err != nil {
+			return nil, err
+		}
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"user_id": types.Number().
@@ -83,17 +236,39 @@ func (s *TicketService) ReserveTicket(userID, eventID, sectionID int64, seatID *
 		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
 	}
 
-	// 3. Business rules
-	if !event.IsSaleActive() {
-		return nil, fmt.Errorf("bilet satışı aktif değil")
+	// 3. Business rules - evaluate the sale phase available to this buyer
+	buyer, phaseCodes, err := s.loadSalePhases(event, userID, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	if event.IsSoldOut() {
+	if opts.HeldOfferID == 0 && event.IsSoldOut() {
 		return nil, fmt.Errorf("etkinlik tükendi")
 	}
 
+	if !event.IsSaleActiveFor(buyer) {
+		if hasOpenPhase(event) {
+			return nil, fmt.Errorf("bu satış aşamasına erişim yetkiniz yok")
+		}
+		return nil, fmt.Errorf("bilet satışı aktif değil")
+	}
+
+	phase := event.ActivePhaseFor(buyer, time.Now())
+
 	// 4. Check seat availability if specific seat requested
+	var seat *models.Seat
 	if seatID != nil {
+		seat, err = s.venueRepo.FindSeatByID(*seatID)
+		if err != nil {
+			return nil, fmt.Errorf("koltuk bulunamadı: %w", err)
+		}
+		if seat.SectionID != sectionID {
+			return nil, fmt.Errorf("koltuk bu bölümde değil")
+		}
+		if !seat.IsActive {
+			return nil, fmt.Errorf("koltuk satışa kapalı")
+		}
+
 		isTaken, err := s.ticketRepo.IsSeatTaken(eventID, *seatID)
 		if err != nil {
 			return nil, fmt.Errorf("koltuk kontrolü yapılamadı: %w", err)
@@ -101,21 +276,48 @@ func (s *TicketService) ReserveTicket(userID, eventID, sectionID int64, seatID *
 		if isTaken {
 			return nil, fmt.Errorf("koltuk dolu")
 		}
-	}
 
-	// 5. Start transaction to prevent double booking
-	tx, err := s.db.Begin()
-	if err != nil {
-		return nil, fmt.Errorf("transaction başlatılamadı: %w", err)
-	}
-	defer tx.Rollback()
+		// Seat may be held for a waiting-list offer
+		if s.seatReleaser != nil {
+			isHeld, err := s.seatReleaser.IsSeatHeld(eventID, *seatID)
+			if err != nil {
+				return nil, fmt.Errorf("koltuk kontrolü yapılamadı: %w", err)
+			}
+			if isHeld {
+				return nil, fmt.Errorf("koltuk bekleme listesindeki bir kullanıcı için ayrıldı")
+			}
+		}
 
-	// 6. Decrement available seats
-	if err := s.eventRepo.DecrementAvailableSeats(eventID, 1); err != nil {
-		return nil, fmt.Errorf("koltuk rezervasyonu yapılamadı: %w", err)
+		// Seat may be kept for a season package holder who can still renew
+		if s.renewalSeatGuard != nil {
+			isHeld, err := s.renewalSeatGuard.IsSeatHeldForRenewal(eventID, *seatID)
+			if err != nil {
+				return nil, fmt.Errorf("koltuk kontrolü yapılamadı: %w", err)
+			}
+			if isHeld {
+				return nil, fmt.Errorf("koltuk yenileme süresi boyunca kombine sahibine ayrıldı")
+			}
+		}
+
+		// Companion seat is held for the buyer of its accessible seat
+		isHeld, err := s.ticketRepo.IsCompanionSeatHeld(eventID, *seatID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("koltuk kontrolü yapılamadı: %w", err)
+		}
+		if isHeld {
+			return nil, fmt.Errorf("koltuk engelli izleyicinin refakatçisi için ayrıldı")
+		}
+
+		// Seat attributes may adjust the price (e.g. restricted view); offers keep the offered price
+		if s.seatPriceAdjuster != nil && opts.HeldOfferID == 0 {
+			price, err = s.seatPriceAdjuster.AdjustSeatPrice(eventID, seat, price)
+			if err != nil {
+				return nil, fmt.Errorf("koltuk fiyatı hesaplanamadı: %w", err)
+			}
+		}
 	}
 
-	// 7. Get venue and section info for ticket
+	// 5. Get venue and section info for ticket
 	venue, err := s.venueRepo.FindByID(event.VenueID)
 	if err != nil {
 		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
@@ -127,15 +329,11 @@ func (s *TicketService) ReserveTicket(userID, eventID, sectionID int64, seatID *
 	}
 
 	seatInfo := section.Name
-	if seatID != nil {
-		seat, err := s.venueRepo.FindSeatByID(*seatID)
-		if err != nil {
-			return nil, fmt.Errorf("koltuk bulunamadı: %w", err)
-		}
+	if seat != nil {
 		seatInfo = fmt.Sprintf("%s - Sıra: %s, Koltuk: %s", section.Name, seat.Row, seat.Number)
 	}
 
-	// 8. Create ticket using Factory pattern
+	// 6. Create ticket using Factory pattern
 	ticketReq := &factory.TicketCreationRequest{
 		EventID:    eventID,
 		UserID:     userID,
@@ -153,23 +351,255 @@ func (s *TicketService) ReserveTicket(userID, eventID, sectionID int64, seatID *
 		return nil, fmt.Errorf("bilet oluşturulamadı: %w", err)
 	}
 
-	// 9. Save ticket to database
-	ticketID, err := s.ticketRepo.Create(ticket)
+	if opts.OverrideLimits {
+		ticket.LimitOverrideBy = &opts.OverrideBy
+	}
+
+	// 7. Start transaction to prevent double booking
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 8. Enforce the per-user limit (limit row stays locked until commit)
+	if err := s.enforceUserLimit(tx, userID, eventID, opts); err != nil {
+		return nil, err
+	}
+
+	// 9. Claim sale phase allocation and access code under lock
+	if phase != nil {
+		phaseCodeID, err := s.claimSalePhase(tx, phase, phaseCodes[phase.ID], userID)
+		if err != nil {
+			return nil, err
+		}
+		ticket.SalePhaseID = &phase.ID
+		ticket.SalePhaseCodeID = phaseCodeID
+	}
+
+	// 10. Decrement available seats in the transaction: the event row stays locked until the
+	// ticket is written, so the counter and the ticket are committed together (a claimed offer's
+	// seat was never returned to sale; the row is only locked)
+	if opts.HeldOfferID == 0 {
+		if err := s.eventRepo.DecrementAvailableSeatsWithTx(tx, eventID, 1); err != nil {
+			return nil, fmt.Errorf("koltuk rezervasyonu yapılamadı: %w", err)
+		}
+	} else if _, err := s.eventRepo.LockAvailableSeats(tx, eventID); err != nil {
+		return nil, fmt.Errorf("koltuk rezervasyonu yapılamadı: %w", err)
+	}
+
+	// 11. Save ticket in the same transaction
+	ticketID, err := s.ticketRepo.CreateWithTx(tx, ticket)
 	if err != nil {
 		return nil, fmt.Errorf("bilet kaydedilemedi: %w", err)
 	}
 	ticket.ID = ticketID
 
-	// 10. Commit transaction
+	// 12. Commit transaction
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("transaction commit edilemedi: %w", err)
 	}
 
+	s.audit.record(models.AuditActionCreate, models.AuditEntityTicket, ticket.ID, nil, ticket)
+
 	return ticket, nil
 }
 
-// PurchaseTicket completes a ticket purchase
-func (s *TicketService) PurchaseTicket(ticketID int64, userEmail, userPhone string) error {
+// loadSalePhases attaches the event's sale phases and resolves the buyer's access
+// (segments, allow-listed email, presale code). Returns nil buyer if the event has no phases.
+func (s *TicketService) loadSalePhases(event *models.Event, userID int64, opts ReserveOptions) (*models.SaleBuyer, map[int64]*models.SalePhaseCode, error) {
+	phases, err := s.salePhaseRepo.FindByEventID(event.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("satış aşamaları alınamadı: %w", err)
+	}
+	event.SalePhases = phases
+
+	if len(phases) == 0 {
+		return nil, nil, nil
+	}
+
+	buyer := &models.SaleBuyer{
+		UserID:       userID,
+		AllowListed:  make(map[int64]bool),
+		CodeValidFor: make(map[int64]bool),
+	}
+
+	segments, err := s.userSegmentRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("kullanıcı segmentleri alınamadı: %w", err)
+	}
+	buyer.Segments = segments
+
+	if opts.Email != "" {
+		phaseIDs, err := s.salePhaseRepo.FindAllowListedPhaseIDs(event.ID, opts.Email)
+		if err != nil {
+			return nil, nil, fmt.Errorf("erişim listesi kontrol edilemedi: %w", err)
+		}
+		for _, id := range phaseIDs {
+			buyer.AllowListed[id] = true
+		}
+	}
+
+	phaseCodes := make(map[int64]*models.SalePhaseCode)
+	if code := strings.TrimSpace(opts.AccessCode); code != "" {
+		codes, err := s.salePhaseRepo.FindCodesForEvent(event.ID, code)
+		if err != nil {
+			return nil, nil, fmt.Errorf("erişim kodu kontrol edilemedi: %w", err)
+		}
+		for _, c := range codes {
+			// Codes bound to another user are not valid for this buyer
+			if c.UserID != nil && *c.UserID != userID {
+				continue
+			}
+			buyer.CodeValidFor[c.SalePhaseID] = true
+			phaseCodes[c.SalePhaseID] = c
+		}
+	}
+
+	return buyer, phaseCodes, nil
+}
+
+// claimSalePhase checks the phase allocation and redeems the access code under row locks.
+// Returns the redeemed code ID (nil for phases without codes).
+func (s *TicketService) claimSalePhase(tx *sql.Tx, phase *models.SalePhase, code *models.SalePhaseCode, userID int64) (*int64, error) {
+	if phase.Allocation != nil {
+		sold, err := s.salePhaseRepo.LockAllocation(tx, phase.ID)
+		if err != nil {
+			return nil, fmt.Errorf("satış aşaması kotası kontrol edilemedi: %w", err)
+		}
+		if sold >= *phase.Allocation {
+			return nil, fmt.Errorf("%s satış aşamasının kotası doldu", phase.Name)
+		}
+	}
+
+	if phase.AccessType != models.SalePhaseAccessCode {
+		return nil, nil
+	}
+
+	if code == nil {
+		return nil, fmt.Errorf("access_code: bu satış aşaması için erişim kodu gereklidir")
+	}
+
+	claimed, err := s.salePhaseRepo.ClaimCode(tx, code.ID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("erişim kodu kullanılamadı: %w", err)
+	}
+	if !claimed {
+		return nil, fmt.Errorf("access_code: erişim kodu geçersiz veya kullanım hakkı dolmuş")
+	}
+
+	return &code.ID, nil
+}
+
+// hasOpenPhase reports whether the event has an open sale phase with inventory left
+// (used to tell "no access" apart from "sale not active")
+func hasOpenPhase(event *models.Event) bool {
+	if event.Status != models.EventStatusSaleActive && event.Status != models.EventStatusPublished {
+		return false
+	}
+
+	now := time.Now()
+	for _, phase := range event.SalePhases {
+		if phase.IsOpen(now) && phase.HasInventory() {
+			return true
+		}
+	}
+	return false
+}
+
+// enforceUserLimit locks the event's purchase limit row and checks the buyer's active
+// tickets against max_per_user. Box-office overrides skip the check.
+func (s *TicketService) enforceUserLimit(tx *sql.Tx, userID, eventID int64, opts ReserveOptions) error {
+	if opts.OverrideLimits {
+		if opts.OverrideBy <= 0 {
+			return fmt.Errorf("limit aşımı için yetkili kullanıcı belirtilmeli")
+		}
+		return nil
+	}
+
+	limit, err := s.purchaseLimitRepo.LockByEventID(tx, eventID)
+	if err != nil {
+		return fmt.Errorf("alım limitleri kontrol edilemedi: %w", err)
+	}
+	if limit == nil {
+		return nil
+	}
+
+	return s.checkBuyerLimit(tx, limit, eventID, models.PurchaseLimitScopeUser, userID)
+}
+
+// enforcePaymentLimits copies the card fingerprint and address hash reported by the payment
+// provider onto the ticket and checks them against max_per_card / max_per_address while the
+// limit row is locked. Tickets sold over the limit at the box office are recorded but not checked.
+func (s *TicketService) enforcePaymentLimits(tx *sql.Tx, ticket *models.Ticket) error {
+	payment, err := s.reservationRepo.FindCompletedPaymentByTicketID(ticket.ID)
+	if err != nil {
+		return fmt.Errorf("bilet ödemesi bulunamadı: %w", err)
+	}
+	if payment != nil {
+		ticket.CardFingerprint = payment.CardFingerprint
+		ticket.AddressHash = payment.AddressHash
+	}
+
+	if ticket.LimitOverrideBy != nil {
+		return nil
+	}
+
+	limit, err := s.purchaseLimitRepo.LockByEventID(tx, ticket.EventID)
+	if err != nil {
+		return fmt.Errorf("alım limitleri kontrol edilemedi: %w", err)
+	}
+	if limit == nil {
+		return nil
+	}
+
+	// Card/address limits require the provider to have reported the identifier
+	if limit.MaxPerCard != nil && ticket.CardFingerprint == nil {
+		return fmt.Errorf("bu etkinlik için ödeme sağlayıcısından kart bilgisi alınmış tamamlanmış bir ödeme gereklidir")
+	}
+	if limit.MaxPerAddress != nil && ticket.AddressHash == nil {
+		return fmt.Errorf("bu etkinlik için ödeme sağlayıcısından fatura adresi alınmış tamamlanmış bir ödeme gereklidir")
+	}
+
+	if err := s.checkBuyerLimit(tx, limit, ticket.EventID, models.PurchaseLimitScopeCard, ticket.CardFingerprint); err != nil {
+		return err
+	}
+
+	return s.checkBuyerLimit(tx, limit, ticket.EventID, models.PurchaseLimitScopeAddress, ticket.AddressHash)
+}
+
+// checkBuyerLimit counts the buyer's active tickets through the transaction holding the
+// limit row lock and returns a PurchaseLimitError if one more ticket would exceed the limit
+func (s *TicketService) checkBuyerLimit(tx *sql.Tx, limit *models.PurchaseLimit, eventID int64, scope models.PurchaseLimitScope, value interface{}) error {
+	max := limit.LimitFor(scope)
+	if max == nil {
+		return nil
+	}
+
+	current, err := s.ticketRepo.CountActiveByBuyerWithTx(tx, eventID, scope, value)
+	if err != nil {
+		return fmt.Errorf("alım limitleri kontrol edilemedi: %w", err)
+	}
+
+	if current+1 > *max {
+		return &models.PurchaseLimitError{
+			Scope:     scope,
+			Limit:     *max,
+			Current:   current,
+			Requested: 1,
+		}
+	}
+
+	return nil
+}
+
+// PurchaseTicket completes a ticket purchase.
+// expectedVersion is the version the client read (If-Match); 0 skips the precondition.
+func (s *TicketService) PurchaseTicket(ticketID, expectedVersion int64, userEmail, userPhone string) (*models.Ticket, error) {
+	if err := requireScope(s.scope); err != nil {
+		return nil, err
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"ticket_id": types.Number().
@@ -196,40 +626,68 @@ func (s *TicketService) PurchaseTicket(ticketID int64, userEmail, userPhone stri
 	result := schema.Validate(rawData)
 	if result.HasErrors() {
 		for field, errs := range result.Errors() {
-			return fmt.Errorf("%s: %s", field, errs[0])
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
 		}
 	}
 
-	// 2. Get ticket
+	// 2. Get ticket (customers only their own) and check the client's precondition
 	ticket, err := s.ticketRepo.FindByID(ticketID)
 	if err != nil {
-		return fmt.Errorf("bilet bulunamadı: %w", err)
+		return nil, fmt.Errorf("bilet bulunamadı: %w", err)
+	}
+	if err := requireActingFor(s.scope, ticket.UserID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+	if err := models.CheckVersion(models.AuditEntityTicket, ticket.ID, expectedVersion, ticket.Version); err != nil {
+		return nil, err
 	}
 
-	// 3. Business rules - use State Pattern methods
-	if !ticket.CanPurchase() {
-		return fmt.Errorf("bilet satın alınamaz durumda")
+	// 3. Enforce card/address limits with the instrument from the ticket's payment
+	// (the limit row stays locked until the sale is written)
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("transaction başlatılamadı: %w", err)
 	}
+	defer tx.Rollback()
 
-	// 4. Mark as sold
-	if err := ticket.MarkAsSold(); err != nil {
-		return fmt.Errorf("bilet satış durumuna geçirilemedi: %w", err)
+	if err := s.enforcePaymentLimits(tx, ticket); err != nil {
+		return nil, err
 	}
 
-	// 5. Update in database
-	if err := s.ticketRepo.Update(ticket); err != nil {
-		return fmt.Errorf("bilet güncellenemedi: %w", err)
+	// 4-5. Transition reserved → sold through the state machine (guard: reservation
+	// not expired) and persist it
+	if err := s.transitionTicket(ticket, models.TicketStatusSold, func() error {
+		return s.ticketRepo.Update(ticket)
+	}); err != nil {
+		return nil, fmt.Errorf("bilet satın alınamaz durumda: %w", err)
 	}
 
-	// 6. Get event details for notification
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+
+	// 6. Sell the ticket's add-ons with it
+	var addOns []*models.AddOnItem
+	total := ticket.Price
+	if s.ticketAddOns != nil {
+		addOns, err = s.ticketAddOns.ConfirmForTicket(ticket.ID)
+		if err != nil {
+			return nil, fmt.Errorf("ek ürünler satışa geçirilemedi: %w", err)
+		}
+		for _, item := range addOns {
+			total += item.TotalPrice
+		}
+	}
+
+	// 7. Get event details for notification
 	event, err := s.eventRepo.FindByID(ticket.EventID)
 	if err != nil {
-		return fmt.Errorf("etkinlik bulunamadı: %w", err)
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
 	}
 
 	venue, err := s.venueRepo.FindByID(event.VenueID)
 	if err != nil {
-		return fmt.Errorf("mekan bulunamadı: %w", err)
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
 	}
 
 	seatInfo := "Genel"
@@ -243,11 +701,12 @@ func (s *TicketService) PurchaseTicket(ticketID int64, userEmail, userPhone stri
 		}
 	}
 
-	// 7. Notify observers using Observer pattern
+	// 8. Notify observers using Observer pattern
 	s.eventPublisher.Notify(&observer.EventData{
 		Type:      observer.EventTypeTicketPurchased,
 		Timestamp: time.Now(),
 		Data: &observer.TicketPurchaseData{
+			TicketID:         ticket.ID,
 			UserID:           ticket.UserID,
 			UserEmail:        userEmail,
 			UserPhone:        userPhone,
@@ -259,19 +718,33 @@ func (s *TicketService) PurchaseTicket(ticketID int64, userEmail, userPhone stri
 			VerificationCode: ticket.VerificationCode,
 			SeatInfo:         seatInfo,
 			Price:            ticket.Price,
+			AddOns:           addOns,
+			Total:            total,
 		},
 	})
 
-	// 8. Check if event sold out
-	if event.AvailableSeats == 0 {
-		s.eventRepo.UpdateStatus(event.ID, models.EventStatusSoldOut)
+	// 9. Check if event sold out
+	if event.IsSoldOut() && s.eventStates.Can(event.Status, models.EventStatusSoldOut) {
+		before := *event
+		if err := s.eventStates.Fire(event, models.EventStatusSoldOut, func() error {
+			return s.eventRepo.UpdateStatus(event.ID, models.EventStatusSoldOut)
+		}); err != nil {
+			log.Printf("⚠️  Etkinlik tükendi olarak işaretlenemedi (event %d): %v", event.ID, err)
+		} else {
+			s.audit.record(models.AuditActionStatusChange, models.AuditEntityEvent, event.ID, &before, event)
+		}
 	}
 
-	return nil
+	return ticket, nil
 }
 
-// CancelTicket cancels a ticket and refunds
-func (s *TicketService) CancelTicket(ticketID int64, userEmail string) error {
+// CancelTicket cancels a ticket and refunds.
+// expectedVersion is the version the client read (If-Match); 0 skips the precondition.
+func (s *TicketService) CancelTicket(ticketID, expectedVersion int64, userEmail string) (*models.Ticket, error) {
+	if err := requireScope(s.scope); err != nil {
+		return nil, err
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"ticket_id": types.Number().
@@ -292,63 +765,85 @@ func (s *TicketService) CancelTicket(ticketID int64, userEmail string) error {
 	result := schema.Validate(rawData)
 	if result.HasErrors() {
 		for field, errs := range result.Errors() {
-			return fmt.Errorf("%s: %s", field, errs[0])
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
 		}
 	}
 
-	// 2. Get ticket
+	// 2. Get ticket (customers only their own) and check the client's precondition
 	ticket, err := s.ticketRepo.FindByID(ticketID)
 	if err != nil {
-		return fmt.Errorf("bilet bulunamadı: %w", err)
+		return nil, fmt.Errorf("bilet bulunamadı: %w", err)
+	}
+	if err := requireActingFor(s.scope, ticket.UserID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+	if err := models.CheckVersion(models.AuditEntityTicket, ticket.ID, expectedVersion, ticket.Version); err != nil {
+		return nil, err
 	}
 
-	// 3. Business rules - use State Pattern methods
-	if !ticket.CanCancel() {
-		return fmt.Errorf("bilet iptal edilemez durumda")
+	// 3. Business rules - the state machine must allow the cancellation
+	if err := s.ticketStates.Check(ticket, models.TicketStatusCancelled); err != nil {
+		return nil, fmt.Errorf("bilet iptal edilemez durumda: %w", err)
 	}
 
 	// 4. Get event to check cancellation policy
 	event, err := s.eventRepo.FindByID(ticket.EventID)
 	if err != nil {
-		return fmt.Errorf("etkinlik bulunamadı: %w", err)
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
 	}
 
 	// Business rule: Can't cancel within 24 hours of event
 	if time.Until(event.StartTime) < 24*time.Hour {
-		return fmt.Errorf("etkinlikten 24 saat kala iptal yapılamaz")
+		return nil, fmt.Errorf("etkinlikten 24 saat kala iptal yapılamaz")
 	}
 
-	// 5. Mark as cancelled
-	if err := ticket.MarkAsCancelled(); err != nil {
-		return fmt.Errorf("bilet iptal durumuna geçirilemedi: %w", err)
+	// 5-6. Transition → cancelled and persist it
+	if err := s.transitionTicket(ticket, models.TicketStatusCancelled, func() error {
+		return s.ticketRepo.Update(ticket)
+	}); err != nil {
+		return nil, fmt.Errorf("bilet iptal durumuna geçirilemedi: %w", err)
 	}
 
-	// 6. Update in database
-	if err := s.ticketRepo.Update(ticket); err != nil {
-		return fmt.Errorf("bilet güncellenemedi: %w", err)
+	// 7. Release seat (waiting list offer or public inventory)
+	if err := s.releaseSeat(ticket); err != nil {
+		return nil, fmt.Errorf("koltuk serbest bırakılamadı: %w", err)
 	}
 
-	// 7. Increment available seats
-	if err := s.eventRepo.IncrementAvailableSeats(ticket.EventID, 1); err != nil {
-		return fmt.Errorf("koltuk sayısı artırılamadı: %w", err)
+	// 8. Refund the ticket's add-ons with it
+	refundAmount := ticket.Price
+	if s.ticketAddOns != nil {
+		released, err := s.ticketAddOns.ReleaseForTicket(ticket.ID)
+		if err != nil {
+			return nil, fmt.Errorf("ek ürünler iade edilemedi: %w", err)
+		}
+		for _, item := range released {
+			refundAmount += item.TotalPrice
+		}
 	}
 
-	// 8. Notify observers
+	// 9. Notify observers
 	s.eventPublisher.Notify(&observer.EventData{
 		Type:      observer.EventTypeTicketCancelled,
 		Timestamp: time.Now(),
 		Data: &observer.TicketCancellationData{
+			TicketID:     ticket.ID,
+			UserID:       ticket.UserID,
+			EventID:      ticket.EventID,
 			UserEmail:    userEmail,
 			TicketNumber: ticket.TicketNumber,
-			RefundAmount: ticket.Price,
+			RefundAmount: refundAmount,
 		},
 	})
 
-	return nil
+	return ticket, nil
 }
 
 // ValidateTicket validates a ticket at venue entrance
 func (s *TicketService) ValidateTicket(ticketNumber, verificationCode string) (*models.Ticket, error) {
+	if err := requirePermission(s.scope, models.PermissionScanTickets); err != nil {
+		return nil, err
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"ticket_number": types.String().
@@ -396,11 +891,35 @@ func (s *TicketService) ValidateTicket(ticketNumber, verificationCode string) (*
 		return nil, fmt.Errorf("bilet daha önce kullanılmış")
 	}
 
+	// 6. Multi-day events: the ticket must be valid for the session running now
+	hasSessions, err := s.usesSessions(ticket.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("oturumlar kontrol edilemedi: %w", err)
+	}
+	if hasSessions {
+		if _, err := s.sessionGate.ValidateEntry(ticket, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	// 7. Attach add-ons (e.g. hospitality upgrade shown at the gate)
+	if s.ticketAddOns != nil {
+		addOns, err := s.ticketAddOns.FindForTicket(ticket.ID)
+		if err != nil {
+			return nil, fmt.Errorf("ek ürünler alınamadı: %w", err)
+		}
+		ticket.AddOns = addOns
+	}
+
 	return ticket, nil
 }
 
 // UseTicket marks a ticket as used
 func (s *TicketService) UseTicket(ticketNumber string) error {
+	if err := requirePermission(s.scope, models.PermissionScanTickets); err != nil {
+		return err
+	}
+
 	// 1. Validate input using Conduit-Go Validation
 	schema := v.Make().Shape(map[string]v.Type{
 		"ticket_number": types.String().
@@ -432,17 +951,41 @@ func (s *TicketService) UseTicket(ticketNumber string) error {
 		return fmt.Errorf("bilet kullanılamaz durumda")
 	}
 
-	// 4. Mark as used
-	if err := ticket.MarkAsUsed(); err != nil {
-		return fmt.Errorf("bilet kullanıldı olarak işaretlenemedi: %w", err)
+	// 4. Multi-day events: record entry to the running session; the ticket stays
+	// valid for its other sessions, so it is not marked as used
+	hasSessions, err := s.usesSessions(ticket.EventID)
+	if err != nil {
+		return fmt.Errorf("oturumlar kontrol edilemedi: %w", err)
 	}
+	if hasSessions {
+		session, err := s.sessionGate.CheckIn(ticket, time.Now())
+		if err != nil {
+			return err
+		}
 
-	// 5. Update in database
-	if err := s.ticketRepo.Update(ticket); err != nil {
-		return fmt.Errorf("bilet güncellenemedi: %w", err)
+		s.eventPublisher.Notify(&observer.EventData{
+			Type:      observer.EventTypeTicketUsed,
+			Timestamp: time.Now(),
+			Data: map[string]interface{}{
+				"ticket_id":     ticket.ID,
+				"ticket_number": ticket.TicketNumber,
+				"event_id":      ticket.EventID,
+				"session_id":    session.ID,
+				"session_name":  session.Name,
+			},
+		})
+
+		return nil
 	}
 
-	// 6. Notify observers
+	// 5-6. Transition sold → used and persist it
+	if err := s.transitionTicket(ticket, models.TicketStatusUsed, func() error {
+		return s.ticketRepo.Update(ticket)
+	}); err != nil {
+		return fmt.Errorf("bilet kullanıldı olarak işaretlenemedi: %w", err)
+	}
+
+	// 7. Notify observers
 	s.eventPublisher.Notify(&observer.EventData{
 		Type:      observer.EventTypeTicketUsed,
 		Timestamp: time.Now(),
@@ -458,6 +1001,10 @@ func (s *TicketService) UseTicket(ticketNumber string) error {
 
 // GetUserTickets retrieves all tickets for a user
 func (s *TicketService) GetUserTickets(userID int64) ([]*models.Ticket, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
+
 	tickets, err := s.ticketRepo.FindByUserID(userID)
 	if err != nil {
 		return nil, fmt.Errorf("biletler getirilemedi: %w", err)
@@ -466,18 +1013,32 @@ func (s *TicketService) GetUserTickets(userID int64) ([]*models.Ticket, error) {
 	return tickets, nil
 }
 
-// GetTicketByID retrieves a ticket by ID
+// GetTicketByID retrieves a ticket by ID (customers only their own tickets)
 func (s *TicketService) GetTicketByID(ticketID int64) (*models.Ticket, error) {
+	if err := requireScope(s.scope); err != nil {
+		return nil, err
+	}
+
 	ticket, err := s.ticketRepo.FindByID(ticketID)
 	if err != nil {
 		return nil, fmt.Errorf("bilet bulunamadı: %w", err)
 	}
+	if err := requireActingFor(s.scope, ticket.UserID, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
 
 	return ticket, nil
 }
 
 // ExpireReservations expires all reservations that have passed their expiry time
+// (run under models.SystemScope(), audited as the expire_reservations system job)
 func (s *TicketService) ExpireReservations() error {
+	if err := requirePlatform(s.scope); err != nil {
+		return err
+	}
+
+	job := s.WithActor(models.SystemActor("expire_reservations"))
+
 	// 1. Find expired reservations
 	expiredTickets, err := s.ticketRepo.FindExpiredReservations()
 	if err != nil {
@@ -486,13 +1047,24 @@ func (s *TicketService) ExpireReservations() error {
 
 	// 2. Process each expired ticket
 	for _, ticket := range expiredTickets {
-		// Mark as expired
-		if err := s.ticketRepo.ExpireReservation(ticket.ID); err != nil {
+		// Mark as expired (reserved → expired)
+		if err := job.transitionTicket(ticket, models.TicketStatusExpired, func() error {
+			return s.ticketRepo.ExpireReservation(ticket.ID)
+		}); err != nil {
 			continue // Log error but continue processing others
 		}
 
-		// Increment available seats
-		s.eventRepo.IncrementAvailableSeats(ticket.EventID, 1)
+		// Release seat (waiting list offer or public inventory)
+		if err := s.releaseSeat(ticket); err != nil {
+			log.Printf("⚠️  Koltuk serbest bırakılamadı (ticket %d): %v", ticket.ID, err)
+		}
+
+		// Cancel reserved add-ons
+		if s.ticketAddOns != nil {
+			if _, err := s.ticketAddOns.ReleaseForTicket(ticket.ID); err != nil {
+				log.Printf("⚠️  Ek ürünler iptal edilemedi (ticket %d): %v", ticket.ID, err)
+			}
+		}
 
 		// Notify observers
 		s.eventPublisher.Notify(&observer.EventData{
@@ -500,7 +1072,7 @@ func (s *TicketService) ExpireReservations() error {
 			Timestamp: time.Now(),
 			Data: &observer.ReservationExpiredData{
 				UserEmail:     fmt.Sprintf("user_%d@email.com", ticket.UserID), // In real app, fetch from user service
-				EventName:     "Event", // In real app, fetch event name
+				EventName:     "Event",                                         // In real app, fetch event name
 				ReservationID: ticket.TicketNumber,
 			},
 		})
@@ -509,8 +1081,32 @@ func (s *TicketService) ExpireReservations() error {
 	return nil
 }
 
+// discardHeldReservation expires a reservation made for a waiting list offer that could not be
+// linked to the offer. The seat is not released: it stays held by the reopened offer.
+func (s *TicketService) discardHeldReservation(ticket *models.Ticket) error {
+	return s.transitionTicket(ticket, models.TicketStatusExpired, func() error {
+		return s.ticketRepo.ExpireReservation(ticket.ID)
+	})
+}
+
+// transitionTicket moves the ticket to a new status through the state machine,
+// persists it with the given function and audits the change
+func (s *TicketService) transitionTicket(ticket *models.Ticket, to models.TicketStatus, persist func() error) error {
+	before := *ticket
+	if err := s.ticketStates.Fire(ticket, to, persist); err != nil {
+		return err
+	}
+
+	s.audit.record(models.AuditActionStatusChange, models.AuditEntityTicket, ticket.ID, &before, ticket)
+	return nil
+}
+
 // GetEventRevenue calculates total revenue for an event
 func (s *TicketService) GetEventRevenue(eventID int64) (float64, error) {
+	if err := requirePermission(s.scope, models.PermissionViewReports); err != nil {
+		return 0, err
+	}
+
 	revenue, err := s.ticketRepo.GetRevenueByEvent(eventID)
 	if err != nil {
 		return 0, fmt.Errorf("gelir hesaplanamadı: %w", err)
@@ -519,8 +1115,13 @@ func (s *TicketService) GetEventRevenue(eventID int64) (float64, error) {
 	return revenue, nil
 }
 
-// GetEventSalesStats returns sales statistics for an event
-func (s *TicketService) GetEventSalesStats(eventID int64) (map[string]interface{}, error) {
+// GetEventSalesStats returns a snapshot of an event's sales.
+// Time-series and breakdown reports are served by AnalyticsService.
+func (s *TicketService) GetEventSalesStats(eventID int64) (*models.EventSalesStats, error) {
+	if err := requirePermission(s.scope, models.PermissionViewReports); err != nil {
+		return nil, err
+	}
+
 	// 1. Get event
 	event, err := s.eventRepo.FindByID(eventID)
 	if err != nil {
@@ -539,20 +1140,18 @@ func (s *TicketService) GetEventSalesStats(eventID int64) (map[string]interface{
 		return nil, fmt.Errorf("gelir alınamadı: %w", err)
 	}
 
-	// 4. Calculate stats
-	occupancyRate := event.GetOccupancyRate()
-
-	stats := map[string]interface{}{
-		"total_capacity":  event.TotalCapacity,
-		"available_seats": event.AvailableSeats,
-		"sold_tickets":    soldCount,
-		"occupancy_rate":  fmt.Sprintf("%.2f%%", occupancyRate*100),
-		"total_revenue":   revenue,
-		"average_price":   0.0,
+	// 4. Calculate stats (GetOccupancyRate already returns a percentage)
+	stats := &models.EventSalesStats{
+		EventID:        event.ID,
+		TotalCapacity:  event.TotalCapacity,
+		AvailableSeats: event.AvailableSeats,
+		SoldTickets:    soldCount,
+		OccupancyRate:  roundAmount(event.GetOccupancyRate()),
+		TotalRevenue:   revenue,
 	}
 
 	if soldCount > 0 {
-		stats["average_price"] = revenue / float64(soldCount)
+		stats.AveragePrice = roundAmount(revenue / float64(soldCount))
 	}
 
 	return stats, nil
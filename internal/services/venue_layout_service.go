@@ -0,0 +1,479 @@
+package services
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+)
+
+// VenueLayoutService imports a whole venue layout (sections, rows, seat numbering,
+// gaps and seat attributes) in one transaction instead of thousands of
+// CreateSection / CreateSeat calls. The document describes the target layout:
+// missing sections and seats are created, changed attributes are updated and
+// seats that are no longer in the document are deactivated, never deleted, so
+// existing tickets keep their seats. A dry run returns the same diff without
+// writing anything.
+type VenueLayoutService struct {
+	venueRepo *repositories.VenueRepository
+	scope     models.OrganizerScope
+	db        *sql.DB
+}
+
+func NewVenueLayoutService(venueRepo *repositories.VenueRepository, db *sql.DB) *VenueLayoutService {
+	return &VenueLayoutService{
+		venueRepo: venueRepo,
+		db:        db,
+	}
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer venues
+func (s *VenueLayoutService) ForOrganizer(scope models.OrganizerScope) *VenueLayoutService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.venueRepo = s.venueRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// ParseVenueLayoutJSON reads a layout document in JSON
+func ParseVenueLayoutJSON(r io.Reader) (*models.VenueLayout, error) {
+	var layout models.VenueLayout
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&layout); err != nil {
+		return nil, fmt.Errorf("düzen belgesi okunamadı: %w", err)
+	}
+
+	return &layout, nil
+}
+
+// ParseVenueLayoutCSV reads a layout with one seat per line:
+// section,row,number[,attributes] where attributes are separated by "|"
+func ParseVenueLayoutCSV(r io.Reader) (*models.VenueLayout, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("CSV başlığı okunamadı: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"section", "row", "number"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV başlığında %s kolonu yok", required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	layout := &models.VenueLayout{}
+	sections := make(map[string]*models.LayoutSection)
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CSV satır %d okunamadı: %w", line, err)
+		}
+
+		name := field(record, "section")
+		section, ok := sections[name]
+		if !ok {
+			section = &models.LayoutSection{Name: name}
+			sections[name] = section
+			layout.Sections = append(layout.Sections, section)
+		}
+
+		seat := &models.LayoutSeat{Row: field(record, "row"), Number: field(record, "number")}
+		if attributes := field(record, "attributes"); attributes != "" {
+			seat.Attributes = strings.Split(attributes, "|")
+		}
+		section.Seats = append(section.Seats, seat)
+	}
+
+	return layout, nil
+}
+
+// plannedSection is a section of the validated target layout
+type plannedSection struct {
+	name        string
+	seats       []*models.LayoutSeat
+	rowCount    int
+	seatsPerRow int
+}
+
+// validateLayout expands the document and checks it against the sections / seats columns
+// and the unique (section_id, row, number) key
+func validateLayout(layout *models.VenueLayout) ([]*plannedSection, error) {
+	if layout == nil || len(layout.Sections) == 0 {
+		return nil, fmt.Errorf("sections: en az bir bölüm gerekli")
+	}
+
+	planned := make([]*plannedSection, 0, len(layout.Sections))
+	names := make(map[string]bool, len(layout.Sections))
+	total := 0
+
+	for i, section := range layout.Sections {
+		name := strings.TrimSpace(section.Name)
+		if name == "" {
+			return nil, fmt.Errorf("sections[%d]: bölüm adı gerekli", i)
+		}
+		if len([]rune(name)) > models.MaxSectionNameLength {
+			return nil, fmt.Errorf("sections[%d]: bölüm adı en fazla %d karakter olabilir", i, models.MaxSectionNameLength)
+		}
+		// sections.name karşılaştırması büyük / küçük harf duyarsızdır (utf8mb4_unicode_ci)
+		if names[strings.ToLower(name)] {
+			return nil, fmt.Errorf("sections[%d]: %s bölümü birden fazla tanımlanmış", i, name)
+		}
+		names[strings.ToLower(name)] = true
+
+		seats, err := section.ExpandSeats()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if len(seats) == 0 {
+			return nil, fmt.Errorf("%s: bölümde koltuk yok", name)
+		}
+
+		total += len(seats)
+		if total > models.MaxLayoutSeats {
+			return nil, fmt.Errorf("düzen en fazla %d koltuk içerebilir", models.MaxLayoutSeats)
+		}
+
+		keys := make(map[string]bool, len(seats))
+		rows := make(map[string]int)
+		for _, seat := range seats {
+			if err := validateLayoutSeat(seat); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			if keys[seat.Key()] {
+				return nil, fmt.Errorf("%s: sıra %s koltuk %s birden fazla tanımlanmış", name, seat.Row, seat.Number)
+			}
+			keys[seat.Key()] = true
+			rows[seat.Row]++
+		}
+
+		plan := &plannedSection{name: name, seats: seats, rowCount: len(rows)}
+		for _, count := range rows {
+			if count > plan.seatsPerRow {
+				plan.seatsPerRow = count
+			}
+		}
+		planned = append(planned, plan)
+	}
+
+	return planned, nil
+}
+
+// validateLayoutSeat checks a single seat against the seats columns
+func validateLayoutSeat(seat *models.LayoutSeat) error {
+	if seat.Row == "" || seat.Number == "" {
+		return fmt.Errorf("sıra ve koltuk numarası gerekli")
+	}
+	if len([]rune(seat.Row)) > models.MaxSeatLabelLength || len([]rune(seat.Number)) > models.MaxSeatLabelLength {
+		return fmt.Errorf("sıra %s koltuk %s: sıra ve numara en fazla %d karakter olabilir", seat.Row, seat.Number, models.MaxSeatLabelLength)
+	}
+
+	attributes := seat.AttributeString()
+	if len(attributes) > models.MaxSeatAttributesLength {
+		return fmt.Errorf("sıra %s koltuk %s: özellikler en fazla %d karakter olabilir", seat.Row, seat.Number, models.MaxSeatAttributesLength)
+	}
+	if attributes != "" {
+		for _, attribute := range strings.Split(attributes, ",") {
+			if !models.SeatAttribute(attribute).IsValid() {
+				return fmt.Errorf("sıra %s koltuk %s: geçersiz özellik: %s", seat.Row, seat.Number, attribute)
+			}
+		}
+	}
+
+	return nil
+}
+
+// layoutPlan holds the writes an import performs
+type layoutPlan struct {
+	diff          *models.VenueLayoutDiff
+	newSections   []*newSectionPlan
+	shapeUpdates  []*models.Section
+	inserts       []*models.Seat
+	restores      map[int64]string // seat ID → attributes
+	deactivations []int64
+}
+
+// newSectionPlan is a section created by the import with its seats
+type newSectionPlan struct {
+	section *models.Section
+	seats   []*models.Seat
+	diff    *models.SectionLayoutDiff
+}
+
+// ImportLayout compares the layout with the venue's current sections and seats and,
+// unless dryRun is set, applies the difference in a single transaction
+func (s *VenueLayoutService) ImportLayout(venueID int64, layout *models.VenueLayout, dryRun bool) (*models.VenueLayoutDiff, error) {
+	// 1. Authorize and validate
+	if err := requirePermission(s.scope, models.PermissionManageVenues); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.venueRepo.FindByID(venueID); err != nil {
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	planned, err := validateLayout(layout)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Lock the venue and read the current layout; dry runs roll back without writing
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.venueRepo.LockVenue(tx, venueID); err != nil {
+		return nil, fmt.Errorf("mekan kilitlenemedi: %w", err)
+	}
+
+	sections, err := s.venueRepo.FindLayoutSections(tx, venueID)
+	if err != nil {
+		return nil, fmt.Errorf("bölümler alınamadı: %w", err)
+	}
+
+	seats, err := s.venueRepo.FindLayoutSeats(tx, venueID)
+	if err != nil {
+		return nil, fmt.Errorf("koltuklar alınamadı: %w", err)
+	}
+
+	// 3. Diff
+	plan, err := planLayout(venueID, planned, sections, seats)
+	if err != nil {
+		return nil, err
+	}
+	plan.diff.DryRun = dryRun
+
+	if dryRun || !plan.diff.HasChanges() {
+		return plan.diff, nil
+	}
+
+	// 4. Apply
+	if err := s.applyLayout(tx, venueID, plan); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+
+	return plan.diff, nil
+}
+
+// planLayout matches planned sections by name and seats by (row, number) against the current layout
+func planLayout(venueID int64, planned []*plannedSection, sections []*models.Section, seats []*models.ExistingSeat) (*layoutPlan, error) {
+	now := time.Now()
+	plan := &layoutPlan{
+		diff:     &models.VenueLayoutDiff{VenueID: venueID},
+		restores: make(map[int64]string),
+	}
+
+	existingSections := make(map[string]*models.Section, len(sections))
+	for _, section := range sections {
+		key := strings.ToLower(section.Name)
+		if _, ok := existingSections[key]; ok {
+			return nil, fmt.Errorf("mekanda aynı adlı birden fazla bölüm var: %s", section.Name)
+		}
+		existingSections[key] = section
+	}
+
+	existingSeats := make(map[int64]map[string]*models.ExistingSeat)
+	for _, seat := range seats {
+		if existingSeats[seat.SectionID] == nil {
+			existingSeats[seat.SectionID] = make(map[string]*models.ExistingSeat)
+		}
+		existingSeats[seat.SectionID][models.SeatKey(seat.Row, seat.Number)] = seat
+	}
+
+	matched := make(map[int64]bool)
+
+	for _, target := range planned {
+		sectionDiff := &models.SectionLayoutDiff{
+			Name:        target.name,
+			RowCount:    target.rowCount,
+			SeatsPerRow: target.seatsPerRow,
+			Seats:       len(target.seats),
+		}
+		plan.diff.Sections = append(plan.diff.Sections, sectionDiff)
+		plan.diff.Capacity += len(target.seats)
+
+		section, exists := existingSections[strings.ToLower(target.name)]
+
+		// New section: every seat is created
+		if !exists {
+			sectionDiff.Action = models.LayoutCreate
+			newSection := &newSectionPlan{
+				section: &models.Section{VenueID: venueID, Name: target.name, RowCount: target.rowCount, SeatsPerRow: target.seatsPerRow},
+				diff:    sectionDiff,
+			}
+			newSection.section.Initialize()
+
+			for _, seat := range target.seats {
+				newSection.seats = append(newSection.seats, newLayoutSeat(0, seat, now))
+				plan.diff.AddChange(&models.SeatLayoutChange{
+					Section: target.name, Row: seat.Row, Number: seat.Number,
+					Action: models.LayoutCreate, Attributes: seat.AttributeString(),
+				})
+			}
+			plan.newSections = append(plan.newSections, newSection)
+			continue
+		}
+
+		// Existing section: match seats by (row, number)
+		matched[section.ID] = true
+		sectionDiff.SectionID = section.ID
+		sectionDiff.Action = models.LayoutUnchanged
+		if section.RowCount != target.rowCount || section.SeatsPerRow != target.seatsPerRow {
+			sectionDiff.Action = models.LayoutUpdate
+			plan.shapeUpdates = append(plan.shapeUpdates, &models.Section{
+				BaseModel: models.BaseModel{ID: section.ID}, RowCount: target.rowCount, SeatsPerRow: target.seatsPerRow,
+			})
+		}
+
+		current := existingSeats[section.ID]
+		wanted := make(map[string]bool, len(target.seats))
+
+		for _, seat := range target.seats {
+			wanted[seat.Key()] = true
+			attributes := seat.AttributeString()
+			change := &models.SeatLayoutChange{
+				Section: target.name, Row: seat.Row, Number: seat.Number, Attributes: attributes,
+			}
+
+			existing := current[seat.Key()]
+			switch {
+			case existing == nil:
+				change.Action = models.LayoutCreate
+				plan.inserts = append(plan.inserts, newLayoutSeat(section.ID, seat, now))
+			case existing.Deleted || !existing.IsActive:
+				change.Action = models.LayoutReactivate
+				change.Previous = existing.Attributes
+				plan.restores[existing.ID] = attributes
+			case existing.Attributes != attributes:
+				change.Action = models.LayoutUpdate
+				change.Previous = existing.Attributes
+				plan.restores[existing.ID] = attributes
+			default:
+				change.Action = models.LayoutUnchanged
+			}
+			plan.diff.AddChange(change)
+		}
+
+		for key, existing := range current {
+			if !wanted[key] && existing.IsActive && !existing.Deleted {
+				plan.deactivations = append(plan.deactivations, existing.ID)
+				plan.diff.AddChange(&models.SeatLayoutChange{
+					Section: target.name, Row: existing.Row, Number: existing.Number,
+					Action: models.LayoutDeactivate, Previous: existing.Attributes,
+				})
+			}
+		}
+	}
+
+	// Sections missing from the document: their seats are deactivated
+	for _, section := range sections {
+		if matched[section.ID] {
+			continue
+		}
+
+		plan.diff.Sections = append(plan.diff.Sections, &models.SectionLayoutDiff{
+			SectionID:   section.ID,
+			Name:        section.Name,
+			Action:      models.LayoutDeactivate,
+			RowCount:    section.RowCount,
+			SeatsPerRow: section.SeatsPerRow,
+		})
+
+		for _, existing := range existingSeats[section.ID] {
+			if existing.IsActive && !existing.Deleted {
+				plan.deactivations = append(plan.deactivations, existing.ID)
+				plan.diff.AddChange(&models.SeatLayoutChange{
+					Section: section.Name, Row: existing.Row, Number: existing.Number,
+					Action: models.LayoutDeactivate, Previous: existing.Attributes,
+				})
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// newLayoutSeat builds an active seat row for insertion
+func newLayoutSeat(sectionID int64, seat *models.LayoutSeat, now time.Time) *models.Seat {
+	return &models.Seat{
+		BaseModel:  models.BaseModel{CreatedAt: now, UpdatedAt: now},
+		SectionID:  sectionID,
+		Row:        seat.Row,
+		Number:     seat.Number,
+		Attributes: seat.AttributeString(),
+		IsActive:   true,
+	}
+}
+
+// applyLayout performs the planned writes inside the import transaction
+func (s *VenueLayoutService) applyLayout(tx *sql.Tx, venueID int64, plan *layoutPlan) error {
+	for _, newSection := range plan.newSections {
+		sectionID, err := s.venueRepo.CreateSectionTx(tx, newSection.section)
+		if err != nil {
+			return fmt.Errorf("%s bölümü oluşturulamadı: %w", newSection.section.Name, err)
+		}
+		newSection.diff.SectionID = sectionID
+
+		for _, seat := range newSection.seats {
+			seat.SectionID = sectionID
+		}
+		plan.inserts = append(plan.inserts, newSection.seats...)
+	}
+
+	for _, section := range plan.shapeUpdates {
+		if err := s.venueRepo.UpdateSectionShape(tx, section.ID, section.RowCount, section.SeatsPerRow); err != nil {
+			return fmt.Errorf("bölüm güncellenemedi: %w", err)
+		}
+	}
+
+	if err := s.venueRepo.InsertSeats(tx, plan.inserts); err != nil {
+		return fmt.Errorf("koltuklar oluşturulamadı: %w", err)
+	}
+
+	for seatID, attributes := range plan.restores {
+		if err := s.venueRepo.RestoreSeat(tx, seatID, attributes); err != nil {
+			return fmt.Errorf("koltuk güncellenemedi: %w", err)
+		}
+	}
+
+	if err := s.venueRepo.DeactivateSeats(tx, plan.deactivations); err != nil {
+		return fmt.Errorf("koltuklar pasife alınamadı: %w", err)
+	}
+
+	if err := s.venueRepo.UpdateCapacity(tx, venueID, plan.diff.Capacity); err != nil {
+		return fmt.Errorf("mekan kapasitesi güncellenemedi: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+)
+
+// VenueService manages the venue lifecycle: soft delete, trash listing,
+// restore and permanent purge of venues past the trash retention period.
+// Layout changes live in VenueLayoutService.
+type VenueService struct {
+	venueRepo *repositories.VenueRepository
+	eventRepo *repositories.EventRepository
+	audit     auditTrail
+	scope     models.OrganizerScope
+}
+
+func NewVenueService(venueRepo *repositories.VenueRepository, eventRepo *repositories.EventRepository) *VenueService {
+	return &VenueService{
+		venueRepo: venueRepo,
+		eventRepo: eventRepo,
+	}
+}
+
+// ForOrganizer returns a copy of the service restricted to the scope's organizer venues
+func (s *VenueService) ForOrganizer(scope models.OrganizerScope) *VenueService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.venueRepo = s.venueRepo.ForOrganizer(scope.OrganizerID)
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	return &scoped
+}
+
+// WithActor returns a copy of the service whose mutations are audited as the given actor
+func (s *VenueService) WithActor(actor models.AuditActor) *VenueService {
+	scoped := *s
+	scoped.audit.actor = actor
+	return &scoped
+}
+
+// SetAuditRecorder registers the audit trail for venue mutations (optional)
+func (s *VenueService) SetAuditRecorder(recorder AuditRecorder) {
+	s.audit.recorder = recorder
+}
+
+// DeleteVenue moves a venue to the trash.
+// Steps:
+// 1. Check that the venue exists
+// 2. Business rule: venues with events (not in the trash) cannot be deleted
+// 3. Soft delete and audit
+func (s *VenueService) DeleteVenue(id int64) error {
+	if err := requirePermission(s.scope, models.PermissionManageVenues); err != nil {
+		return err
+	}
+
+	// 1. Check if venue exists
+	venue, err := s.venueRepo.FindByID(id)
+	if err != nil {
+		return fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	// 2. Venue must not host events
+	count, err := s.eventRepo.CountByVenue(id)
+	if err != nil {
+		return fmt.Errorf("mekan etkinlikleri kontrol edilemedi: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("mekanda %d etkinlik var, önce etkinlikleri silin", count)
+	}
+
+	// 3. Delete
+	if err := s.venueRepo.Delete(id); err != nil {
+		return fmt.Errorf("mekan silinemedi: %w", err)
+	}
+
+	s.audit.record(models.AuditActionDelete, models.AuditEntityVenue, id, venue, nil)
+
+	return nil
+}
+
+// ListTrashedVenues returns soft-deleted venues, most recently deleted first
+func (s *VenueService) ListTrashedVenues(limit, offset int) ([]*models.Venue, error) {
+	if err := requirePermission(s.scope, models.PermissionManageVenues); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	venues, err := s.venueRepo.FindTrashed(limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("çöp kutusundaki mekanlar getirilemedi: %w", err)
+	}
+
+	return venues, nil
+}
+
+// RestoreVenue brings a soft-deleted venue back from the trash
+func (s *VenueService) RestoreVenue(id int64) (*models.Venue, error) {
+	if err := requirePermission(s.scope, models.PermissionManageVenues); err != nil {
+		return nil, err
+	}
+
+	// 1. Find in trash
+	trashed, err := s.venueRepo.FindTrashedByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("mekan çöp kutusunda bulunamadı: %w", err)
+	}
+
+	// 2. Restore
+	if err := s.venueRepo.Restore(id); err != nil {
+		return nil, fmt.Errorf("mekan geri yüklenemedi: %w", err)
+	}
+
+	venue, err := s.venueRepo.FindByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("mekan bulunamadı: %w", err)
+	}
+
+	s.audit.record(models.AuditActionRestore, models.AuditEntityVenue, id, trashed, venue)
+
+	return venue, nil
+}
+
+// PurgeTrashedVenues permanently deletes venues that were soft-deleted before
+// the cutoff (platform only); their sections and seats are removed with them.
+// Venues still referenced by events, series or season packages are kept.
+// Returns how many venues were removed.
+func (s *VenueService) PurgeTrashedVenues(cutoff time.Time) (int, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for {
+		ids, err := s.venueRepo.FindPurgeableIDs(cutoff, trashPurgeBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("silinecek mekanlar getirilemedi: %w", err)
+		}
+
+		batchPurged := 0
+		for _, id := range ids {
+			deleted, err := s.venueRepo.ForceDelete(id, cutoff)
+			if err != nil {
+				log.Printf("⚠️  Mekan kalıcı olarak silinemedi (venue %d): %v", id, err)
+				continue
+			}
+			if deleted {
+				batchPurged++
+				s.audit.record(models.AuditActionPurge, models.AuditEntityVenue, id, nil, nil)
+			}
+		}
+
+		purged += batchPurged
+
+		// Last batch, or nothing could be deleted (avoid looping over the same rows)
+		if len(ids) < trashPurgeBatchSize || batchPurged == 0 {
+			return purged, nil
+		}
+	}
+}
@@ -0,0 +1,394 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/patterns/observer"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	"github.com/biyonik/event-ticketing-api/pkg/token"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+)
+
+// DefaultClaimWindow is how long a waiting-list offer stays claimable
+const DefaultClaimWindow = 15 * time.Minute
+
+// expireOffersBatchSize limits how many offers are rolled over per run
+const expireOffersBatchSize = 100
+
+// offerMaxAttempts bounds retries when concurrent releases race for the same entry
+const offerMaxAttempts = 3
+
+// WaitingListOfferService offers freed seats to the waiting list automatically.
+// It implements SeatReleaser so TicketService hands cancelled/expired seats to it
+// instead of returning them to public sale. Claimed offers are reserved through
+// TicketService, so purchase limits and sale phases apply to them as well.
+type WaitingListOfferService struct {
+	offerRepo       *repositories.WaitingListOfferRepository
+	reservationRepo *repositories.ReservationRepository
+	eventRepo       *repositories.EventRepository
+	venueRepo       *repositories.VenueRepository
+	ticketService   *TicketService
+	eventPublisher  *observer.EventPublisher
+	claimWindow     time.Duration
+	scope           models.OrganizerScope
+}
+
+func NewWaitingListOfferService(
+	offerRepo *repositories.WaitingListOfferRepository,
+	reservationRepo *repositories.ReservationRepository,
+	eventRepo *repositories.EventRepository,
+	venueRepo *repositories.VenueRepository,
+	ticketService *TicketService,
+	eventPublisher *observer.EventPublisher,
+	claimWindow time.Duration,
+) *WaitingListOfferService {
+	if claimWindow <= 0 {
+		claimWindow = DefaultClaimWindow
+	}
+
+	return &WaitingListOfferService{
+		offerRepo:       offerRepo,
+		reservationRepo: reservationRepo,
+		eventRepo:       eventRepo,
+		venueRepo:       venueRepo,
+		ticketService:   ticketService,
+		eventPublisher:  eventPublisher,
+		claimWindow:     claimWindow,
+	}
+}
+
+// ForOrganizer returns a copy of the service running under the given scope
+// (claims are reserved through a TicketService with the same scope)
+func (s *WaitingListOfferService) ForOrganizer(scope models.OrganizerScope) *WaitingListOfferService {
+	scoped := *s
+	scoped.scope = scope
+	scoped.reservationRepo = s.reservationRepo.ForOrganizer(scope.OrganizerID)
+	scoped.eventRepo = s.eventRepo.ForOrganizer(scope.OrganizerID)
+	scoped.venueRepo = s.venueRepo.ForOrganizer(scope.OrganizerID)
+	scoped.ticketService = s.ticketService.ForOrganizer(scope)
+	return &scoped
+}
+
+// ReleaseSeat offers a freed seat to the next waiting user.
+// Called by TicketService after it has authorized the cancellation / expiry.
+// If nobody is waiting, the seat goes back to public sale. If the offer fails
+// while users are waiting, the seat is not sold past them: the error is returned
+// and the seat stays out of available_seats until inventory reconciliation.
+func (s *WaitingListOfferService) ReleaseSeat(eventID, sectionID int64, seatID *int64, price float64) error {
+	offered, err := s.offerToNext(eventID, sectionID, seatID, price)
+	if err != nil {
+		return fmt.Errorf("koltuk bekleme listesine teklif edilemedi: %w", err)
+	}
+	if offered {
+		// Seat stays held for the offer; available_seats is not incremented
+		return nil
+	}
+
+	// Nobody waiting - return seat to public inventory
+	if err := s.eventRepo.IncrementAvailableSeats(eventID, 1); err != nil {
+		return fmt.Errorf("koltuk sayısı artırılamadı: %w", err)
+	}
+
+	return nil
+}
+
+// IsSeatHeld reports whether a seat is held by a pending offer
+func (s *WaitingListOfferService) IsSeatHeld(eventID, seatID int64) (bool, error) {
+	held, err := s.offerRepo.IsSeatHeld(eventID, seatID)
+	if err != nil {
+		return false, fmt.Errorf("teklif kontrolü yapılamadı: %w", err)
+	}
+
+	return held, nil
+}
+
+// ClaimOffer turns a pending offer into a reservation for the waiting user.
+// email is the authenticated user's email, used for sale phase allow-lists.
+func (s *WaitingListOfferService) ClaimOffer(userID int64, email, claimToken string) (*models.Ticket, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return nil, err
+	}
+
+	// 1. Validate input using Conduit-Go Validation
+	schema := v.Make().Shape(map[string]v.Type{
+		"user_id": types.Number().
+			Required().
+			Min(1).
+			Label("Kullanıcı ID"),
+		"claim_token": types.String().
+			Required().
+			Min(16).
+			Max(64).
+			Label("Teklif Kodu"),
+	})
+
+	rawData := map[string]any{
+		"user_id":     float64(userID),
+		"claim_token": claimToken,
+	}
+
+	result := schema.Validate(rawData)
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	// 2. Get offer
+	offer, err := s.offerRepo.FindByClaimToken(claimToken)
+	if err != nil {
+		return nil, fmt.Errorf("teklif bulunamadı: %w", err)
+	}
+
+	// 3. Business rules
+	if offer.UserID != userID {
+		return nil, fmt.Errorf("teklif bu kullanıcıya ait değil")
+	}
+
+	if !offer.CanClaim() {
+		return nil, fmt.Errorf("teklifin süresi dolmuş veya teklif zaten kullanılmış")
+	}
+
+	// 4. Claim atomically (expire job may be racing)
+	if err := s.offerRepo.MarkClaimed(offer.ID); err != nil {
+		return nil, fmt.Errorf("teklif kullanılamadı: %w", err)
+	}
+
+	// 5. Create reservation on the held seat
+	ticket, err := s.createReservation(offer, email)
+	if err != nil {
+		s.reopenOffer(offer) // Give the user another chance within the window
+		return nil, err
+	}
+
+	if err := s.offerRepo.AttachTicket(offer.ID, ticket.ID); err != nil {
+		// Do not leave a reservation that is not linked to its offer: expire it
+		// and reopen the offer (the seat stays held for the user)
+		if discardErr := s.ticketService.discardHeldReservation(ticket); discardErr != nil {
+			log.Printf("⚠️  Teklif rezervasyonu geri alınamadı (offer %d, ticket %d): %v", offer.ID, ticket.ID, discardErr)
+		}
+		s.reopenOffer(offer)
+		return nil, fmt.Errorf("teklif güncellenemedi: %w", err)
+	}
+
+	// 6. Close waiting list entry
+	if err := s.reservationRepo.UpdateWaitingListStatus(offer.WaitingListID, models.WaitingListStatusPurchased); err != nil {
+		return nil, fmt.Errorf("bekleme listesi güncellenemedi: %w", err)
+	}
+
+	return ticket, nil
+}
+
+// DeclineOffer lets the user pass; the seat rolls over immediately
+func (s *WaitingListOfferService) DeclineOffer(userID int64, claimToken string) error {
+	if err := requireActingFor(s.scope, userID, models.PermissionSellTickets); err != nil {
+		return err
+	}
+
+	// 1. Get offer
+	offer, err := s.offerRepo.FindByClaimToken(claimToken)
+	if err != nil {
+		return fmt.Errorf("teklif bulunamadı: %w", err)
+	}
+
+	// 2. Business rules
+	if offer.UserID != userID {
+		return fmt.Errorf("teklif bu kullanıcıya ait değil")
+	}
+
+	// 3. Close offer & roll over
+	closed, err := s.offerRepo.Close(offer.ID, models.WaitingListOfferStatusDeclined)
+	if err != nil {
+		return fmt.Errorf("teklif kapatılamadı: %w", err)
+	}
+	if !closed {
+		return fmt.Errorf("teklif artık geçerli değil")
+	}
+
+	// The seat is rolled over even if the entry status cannot be updated
+	s.updateWaitingListStatus(offer.WaitingListID, models.WaitingListStatusCancelled)
+
+	return s.ReleaseSeat(offer.EventID, offer.SectionID, offer.SeatID, offer.Price)
+}
+
+// ExpireOffers rolls unclaimed offers over to the next person in line.
+// Should be run periodically under models.SystemScope() (see jobs.ExpireWaitingListOffersJob).
+func (s *WaitingListOfferService) ExpireOffers() (int, error) {
+	if err := requirePlatform(s.scope); err != nil {
+		return 0, err
+	}
+
+	// 1. Find offers past their claim window
+	offers, err := s.offerRepo.FindExpiredPending(expireOffersBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("süresi dolan teklifler bulunamadı: %w", err)
+	}
+
+	// 2. Roll each one over
+	expired := 0
+	for _, offer := range offers {
+		closed, err := s.offerRepo.Close(offer.ID, models.WaitingListOfferStatusExpired)
+		if err != nil || !closed {
+			continue // Claimed in the meantime or already rolled over
+		}
+
+		s.updateWaitingListStatus(offer.WaitingListID, models.WaitingListStatusExpired)
+
+		if err := s.ReleaseSeat(offer.EventID, offer.SectionID, offer.SeatID, offer.Price); err != nil {
+			log.Printf("⚠️  Teklif devredilemedi (offer %d): %v", offer.ID, err)
+			continue
+		}
+
+		expired++
+	}
+
+	return expired, nil
+}
+
+// GetUserOffers returns all offers made to a user
+func (s *WaitingListOfferService) GetUserOffers(userID int64) ([]*models.WaitingListOffer, error) {
+	if err := requireActingFor(s.scope, userID, models.PermissionViewTickets); err != nil {
+		return nil, err
+	}
+
+	offers, err := s.offerRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("teklifler getirilemedi: %w", err)
+	}
+
+	return offers, nil
+}
+
+// offerToNext creates an offer for the highest-priority waiting entry.
+// Returns false if the waiting list is empty.
+func (s *WaitingListOfferService) offerToNext(eventID, sectionID int64, seatID *int64, price float64) (bool, error) {
+	for attempt := 0; attempt < offerMaxAttempts; attempt++ {
+		// 1. Next entry (priority DESC, created_at ASC)
+		entries, err := s.reservationRepo.FindWaitingListByEvent(eventID, 1)
+		if err != nil {
+			return false, fmt.Errorf("bekleme listesi getirilemedi: %w", err)
+		}
+		if len(entries) == 0 {
+			return false, nil
+		}
+		entry := entries[0]
+
+		// 2. Reserve the entry; another release may have picked it concurrently
+		if err := s.reservationRepo.MarkAsOffered(entry.ID); err != nil {
+			continue
+		}
+
+		// 3. Create offer with claim token
+		claimToken, err := token.GenerateSecureTokenHex(24)
+		if err != nil {
+			return false, fmt.Errorf("teklif kodu oluşturulamadı: %w", err)
+		}
+
+		offer := &models.WaitingListOffer{
+			WaitingListID: entry.ID,
+			EventID:       eventID,
+			UserID:        entry.UserID,
+			SectionID:     sectionID,
+			SeatID:        seatID,
+			Price:         price,
+			ClaimToken:    claimToken,
+			Status:        models.WaitingListOfferStatusPending,
+			ExpiresAt:     time.Now().Add(s.claimWindow),
+		}
+		offer.Initialize()
+
+		offerID, err := s.offerRepo.Create(offer)
+		if err != nil {
+			s.updateWaitingListStatus(entry.ID, models.WaitingListStatusWaiting)
+			return false, fmt.Errorf("teklif oluşturulamadı: %w", err)
+		}
+		offer.ID = offerID
+
+		// 4. Notify observers
+		s.notifyOffer(offer)
+
+		return true, nil
+	}
+
+	return false, fmt.Errorf("bekleme listesinde teklif yapılacak kayıt ayrılamadı")
+}
+
+// reopenOffer makes a claimed offer claimable again after a failed claim
+func (s *WaitingListOfferService) reopenOffer(offer *models.WaitingListOffer) {
+	if err := s.offerRepo.Reopen(offer.ID); err != nil {
+		log.Printf("⚠️  Teklif yeniden açılamadı (offer %d): %v", offer.ID, err)
+	}
+}
+
+// updateWaitingListStatus updates a waiting list entry whose offer has already changed;
+// a failure is logged, the offer flow itself continues
+func (s *WaitingListOfferService) updateWaitingListStatus(waitingListID int64, status models.WaitingListStatus) {
+	if err := s.reservationRepo.UpdateWaitingListStatus(waitingListID, status); err != nil {
+		log.Printf("⚠️  Bekleme listesi güncellenemedi (entry %d → %s): %v", waitingListID, status, err)
+	}
+}
+
+// createReservation reserves the held seat for a claimed offer through TicketService
+// (purchase limits, sale phases and seat holds are checked as for any reservation)
+func (s *WaitingListOfferService) createReservation(offer *models.WaitingListOffer, email string) (*models.Ticket, error) {
+	ticket, err := s.ticketService.ReserveTicketWithOptions(offer.UserID, offer.EventID, offer.SectionID, offer.SeatID, offer.Price, ReserveOptions{
+		Email:       email,
+		HeldOfferID: offer.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rezervasyon yapılamadı: %w", err)
+	}
+
+	return ticket, nil
+}
+
+// notifyOffer publishes the offer to email/SMS observers
+func (s *WaitingListOfferService) notifyOffer(offer *models.WaitingListOffer) {
+	event, err := s.eventRepo.FindByID(offer.EventID)
+	if err != nil {
+		return
+	}
+
+	venueName := ""
+	if venue, err := s.venueRepo.FindByID(event.VenueID); err == nil {
+		venueName = venue.Name
+	}
+
+	s.eventPublisher.Notify(&observer.EventData{
+		Type:      observer.EventTypeWaitingListOffer,
+		Timestamp: time.Now(),
+		Data: &observer.WaitingListOfferData{
+			UserID:        offer.UserID,
+			UserEmail:     fmt.Sprintf("user_%d@email.com", offer.UserID), // In real app, fetch from user service
+			UserPhone:     fmt.Sprintf("+90555%07d", offer.UserID),        // In real app, fetch from user service
+			EventID:       event.ID,
+			EventName:     event.Name,
+			VenueName:     venueName,
+			EventDateTime: event.StartTime.Format("02.01.2006 15:04"),
+			SeatInfo:      s.seatInfo(offer),
+			Price:         offer.Price,
+			ClaimToken:    offer.ClaimToken,
+			ExpiresAt:     offer.ExpiresAt,
+		},
+	})
+}
+
+// seatInfo builds a human readable seat description
+func (s *WaitingListOfferService) seatInfo(offer *models.WaitingListOffer) string {
+	section, err := s.venueRepo.FindSectionByID(offer.SectionID)
+	if err != nil {
+		return "Genel"
+	}
+
+	if offer.SeatID != nil {
+		if seat, err := s.venueRepo.FindSeatByID(*offer.SeatID); err == nil {
+			return fmt.Sprintf("%s - Sıra: %s, Koltuk: %s", section.Name, seat.Row, seat.Number)
+		}
+	}
+
+	return section.Name
+}
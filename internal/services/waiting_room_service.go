@@ -0,0 +1,172 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+	"github.com/biyonik/event-ticketing-api/pkg/waitingroom"
+)
+
+// WaitingRoomService manages virtual waiting rooms for high-demand on-sales.
+// Users join the queue per event, poll their position/ETA and receive a signed
+// admission token once admitted; middleware.WaitingRoom enforces the token on
+// the reserve endpoints.
+type WaitingRoomService struct {
+	eventRepo     *repositories.EventRepository
+	manager       *waitingroom.Manager
+	ratePerMinute int
+	burst         int
+}
+
+func NewWaitingRoomService(
+	eventRepo *repositories.EventRepository,
+	manager *waitingroom.Manager,
+	ratePerMinute, burst int,
+) *WaitingRoomService {
+	return &WaitingRoomService{
+		eventRepo:     eventRepo,
+		manager:       manager,
+		ratePerMinute: ratePerMinute,
+		burst:         burst,
+	}
+}
+
+// NewWaitingRoomManager builds the queue manager for the configured driver.
+// The "redis" driver falls back to the in-memory store when Redis is unreachable.
+func NewWaitingRoomManager(driver string, client *redis.Client, prefix, tokenSecret string, tokenTTL time.Duration) *waitingroom.Manager {
+	signer := waitingroom.NewTokenSigner(tokenSecret, tokenTTL)
+
+	if driver == "memory" {
+		return waitingroom.NewManager(waitingroom.NewMemoryStore(), signer)
+	}
+
+	store, fallback := waitingroom.NewStoreWithFallback(client, prefix)
+	if fallback {
+		log.Println("⚠️  WaitingRoom: Redis erişilemiyor, bellek içi kuyruk kullanılıyor")
+	}
+
+	return waitingroom.NewManager(store, signer)
+}
+
+// OpenRoom enables the waiting room for an event (admin).
+// opensAt defaults to the event's sale start time; users arriving before it are
+// shuffled fairly, later arrivals are queued FIFO. Zero rate/burst use config defaults.
+func (s *WaitingRoomService) OpenRoom(eventID int64, opensAt *time.Time, ratePerMinute, burst int) (*waitingroom.Room, error) {
+	if ratePerMinute == 0 {
+		ratePerMinute = s.ratePerMinute
+	}
+	if burst == 0 {
+		burst = s.burst
+	}
+
+	// 1. Validate input using Conduit-Go Validation
+	schema := v.Make().Shape(map[string]v.Type{
+		"rate_per_minute": types.Number().
+			Required().
+			Min(1).
+			Max(100000).
+			Label("Dakikadaki Kabul Sayısı"),
+		"burst": types.Number().
+			Min(0).
+			Max(100000).
+			Label("Açılış Kabul Sayısı"),
+	})
+
+	result := schema.Validate(map[string]any{
+		"rate_per_minute": ratePerMinute,
+		"burst":           burst,
+	})
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return nil, fmt.Errorf("%s: %s", field, errs[0])
+		}
+	}
+
+	// 2. Get event
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	if event.Status == models.EventStatusCancelled || event.Status == models.EventStatusCompleted {
+		return nil, fmt.Errorf("iptal edilmiş veya tamamlanmış etkinlik için bekleme odası açılamaz")
+	}
+
+	// 3. Determine opening time
+	room := &waitingroom.Room{
+		ID:            waitingroom.EventRoomID(eventID),
+		RatePerMinute: ratePerMinute,
+		Burst:         burst,
+	}
+	switch {
+	case opensAt != nil:
+		room.OpensAt = *opensAt
+	case event.SaleStartTime != nil:
+		room.OpensAt = *event.SaleStartTime
+	}
+
+	// 4. Save room (existing queue is kept when settings are updated)
+	if err := s.manager.Open(room); err != nil {
+		return nil, fmt.Errorf("bekleme odası açılamadı: %w", err)
+	}
+
+	return room, nil
+}
+
+// CloseRoom disables the waiting room; reserve endpoints become open again (admin)
+func (s *WaitingRoomService) CloseRoom(eventID int64) error {
+	if err := s.manager.Close(waitingroom.EventRoomID(eventID)); err != nil {
+		return fmt.Errorf("bekleme odası kapatılamadı: %w", err)
+	}
+
+	return nil
+}
+
+// Join puts the user in the event's queue and returns the current status.
+// Joining again keeps the original position.
+func (s *WaitingRoomService) Join(userID, eventID int64) (*waitingroom.Status, error) {
+	// 1. Get event
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("etkinlik bulunamadı: %w", err)
+	}
+
+	if event.Status == models.EventStatusCancelled {
+		return nil, fmt.Errorf("etkinlik iptal edilmiş")
+	}
+
+	// 2. Enqueue
+	status, err := s.manager.Join(waitingroom.EventRoomID(eventID), waitingroom.UserVisitorID(userID))
+	if err == waitingroom.ErrRoomNotFound {
+		return nil, fmt.Errorf("bu etkinlik için bekleme odası aktif değil")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("bekleme odasına katılınamadı: %w", err)
+	}
+
+	return status, nil
+}
+
+// GetStatus returns the user's position and ETA (polling endpoint).
+// Once admitted, the response carries a fresh admission token.
+func (s *WaitingRoomService) GetStatus(userID, eventID int64) (*waitingroom.Status, error) {
+	status, err := s.manager.Status(waitingroom.EventRoomID(eventID), waitingroom.UserVisitorID(userID))
+	if err == waitingroom.ErrRoomNotFound {
+		return nil, fmt.Errorf("bu etkinlik için bekleme odası aktif değil")
+	}
+	if err == waitingroom.ErrNotInQueue {
+		return nil, fmt.Errorf("bekleme odasında değilsiniz")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("bekleme odası durumu alınamadı: %w", err)
+	}
+
+	return status, nil
+}
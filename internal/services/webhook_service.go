@@ -0,0 +1,205 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/biyonik/event-ticketing-api/internal/models"
+	"github.com/biyonik/event-ticketing-api/internal/repositories"
+	v "github.com/biyonik/event-ticketing-api/pkg/validation"
+	"github.com/biyonik/event-ticketing-api/pkg/validation/types"
+	"github.com/biyonik/event-ticketing-api/pkg/webhook"
+)
+
+// ErrInvalidWebhookPayload, imzası geçerli fakat içeriği işlenemeyen webhook'lar için döner.
+var ErrInvalidWebhookPayload = errors.New("geçersiz webhook içeriği")
+
+// ErrWebhookNotConfigured, imza secret'ı tanımlanmadığı için webhook'lar doğrulanamadığında döner.
+var ErrWebhookNotConfigured = errors.New("ödeme webhook'u yapılandırılmamış")
+
+// WebhookService handles payment provider callbacks
+type WebhookService struct {
+	webhookRepo        *repositories.WebhookRepository
+	reservationService *ReservationService
+	verifier           *webhook.Verifier
+}
+
+// NewWebhookService creates the service; verifier is nil when PAYMENT_WEBHOOK_SECRET is not set
+// (webhook.NewVerifier refuses an empty secret) and every delivery is then rejected.
+func NewWebhookService(
+	webhookRepo *repositories.WebhookRepository,
+	reservationService *ReservationService,
+	verifier *webhook.Verifier,
+) *WebhookService {
+	return &WebhookService{
+		webhookRepo:        webhookRepo,
+		reservationService: reservationService,
+		verifier:           verifier,
+	}
+}
+
+// PaymentWebhookPayload is the provider's event envelope
+type PaymentWebhookPayload struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		TransactionID string  `json:"transaction_id"`
+		Amount        float64 `json:"amount"`
+		Currency      string  `json:"currency"`
+		FailureReason string  `json:"failure_reason"`
+		// Reported on payment.completed; card and address purchase limits are counted against these
+		CardFingerprint string `json:"card_fingerprint"`
+		BillingAddress  string `json:"billing_address"`
+	} `json:"data"`
+}
+
+// WebhookResult describes how a webhook delivery was handled
+type WebhookResult struct {
+	EventID   string                    `json:"event_id"`
+	Status    models.WebhookEventStatus `json:"status"`
+	Duplicate bool                      `json:"duplicate"`
+	Applied   bool                      `json:"applied"`
+}
+
+// HandlePaymentWebhook verifies, records and applies a payment webhook.
+// Deliveries are deduplicated by provider event ID; already processed events are acknowledged without side effects.
+func (s *WebhookService) HandlePaymentWebhook(provider string, body []byte, signature string) (*WebhookResult, error) {
+	// 1. Verify signature (HMAC + timestamp tolerance)
+	if s.verifier == nil {
+		return nil, ErrWebhookNotConfigured
+	}
+	if err := s.verifier.Verify(body, signature); err != nil {
+		return nil, err
+	}
+
+	// 2. Parse & validate payload
+	var payload PaymentWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWebhookPayload, err)
+	}
+
+	schema := v.Make().Shape(map[string]v.Type{
+		"provider": types.String().
+			Required().
+			Min(2).
+			Max(50).
+			Label("Sağlayıcı"),
+		"id": types.String().
+			Required().
+			Max(255).
+			Label("Olay ID"),
+		"type": types.String().
+			Required().
+			Max(100).
+			Label("Olay Tipi"),
+		"transaction_id": types.String().
+			Required().
+			Max(255).
+			Label("İşlem ID"),
+	})
+
+	result := schema.Validate(map[string]any{
+		"provider":       provider,
+		"id":             payload.ID,
+		"type":           payload.Type,
+		"transaction_id": payload.Data.TransactionID,
+	})
+	if result.HasErrors() {
+		for field, errs := range result.Errors() {
+			return nil, fmt.Errorf("%w: %s: %s", ErrInvalidWebhookPayload, field, errs[0])
+		}
+	}
+
+	// 3. Record event (dedupe by provider event ID)
+	record := &models.WebhookEvent{
+		Provider:        provider,
+		ProviderEventID: payload.ID,
+		EventType:       payload.Type,
+		TransactionID:   payload.Data.TransactionID,
+		Payload:         string(body),
+		Status:          models.WebhookEventStatusReceived,
+	}
+	record.Initialize()
+
+	id, err := s.webhookRepo.Create(record)
+	if errors.Is(err, repositories.ErrDuplicateWebhookEvent) {
+		existing, findErr := s.webhookRepo.FindByProviderEventID(provider, payload.ID)
+		if findErr != nil {
+			return nil, fmt.Errorf("webhook kaydı getirilemedi: %w", findErr)
+		}
+
+		if existing.IsFinal() {
+			return &WebhookResult{EventID: payload.ID, Status: existing.Status, Duplicate: true}, nil
+		}
+
+		// Önceki deneme başarısız olmuş; aynı kayıt üzerinden yeniden işle
+		id = existing.ID
+	} else if err != nil {
+		return nil, fmt.Errorf("webhook kaydedilemedi: %w", err)
+	}
+
+	if err := s.webhookRepo.IncrementAttempts(id); err != nil {
+		return nil, fmt.Errorf("webhook güncellenemedi: %w", err)
+	}
+
+	// 4. Apply through ReservationService (conditional updates)
+	applied, status, applyErr := s.apply(&payload)
+	if applyErr != nil {
+		if err := s.webhookRepo.UpdateStatus(id, models.WebhookEventStatusFailed, applyErr.Error()); err != nil {
+			return nil, fmt.Errorf("webhook güncellenemedi: %w", err)
+		}
+		return nil, fmt.Errorf("webhook işlenemedi: %w", applyErr)
+	}
+
+	if err := s.webhookRepo.UpdateStatus(id, status, ""); err != nil {
+		return nil, fmt.Errorf("webhook güncellenemedi: %w", err)
+	}
+
+	return &WebhookResult{EventID: payload.ID, Status: status, Applied: applied}, nil
+}
+
+// apply dispatches the payload to the matching payment transition; the signature is verified,
+// so it runs under the system scope and is audited as the payment_webhook system actor
+// with the provider event ID as request ID
+func (s *WebhookService) apply(payload *PaymentWebhookPayload) (bool, models.WebhookEventStatus, error) {
+	providerResponse := fmt.Sprintf("Webhook %s (%s) at %s", payload.Type, payload.ID, time.Now().Format(time.RFC3339))
+
+	actor := models.SystemActor("payment_webhook")
+	actor.RequestID = payload.ID
+	reservations := s.reservationService.ForOrganizer(models.SystemScope()).WithActor(actor)
+
+	var (
+		applied bool
+		err     error
+	)
+
+	switch payload.Type {
+	case models.WebhookTypePaymentCompleted:
+		applied, err = reservations.CompletePaymentByTransactionID(payload.Data.TransactionID, providerResponse, models.PaymentInstrument{
+			CardFingerprint: payload.Data.CardFingerprint,
+			BillingAddress:  payload.Data.BillingAddress,
+		})
+	case models.WebhookTypePaymentFailed:
+		reason := payload.Data.FailureReason
+		if reason == "" {
+			reason = "sağlayıcı ödemeyi reddetti"
+		}
+		applied, err = reservations.FailPaymentByTransactionID(payload.Data.TransactionID, reason)
+	case models.WebhookTypePaymentRefunded:
+		applied, err = reservations.RefundPaymentByTransactionID(payload.Data.TransactionID, providerResponse)
+	case models.WebhookTypePaymentChargedBack:
+		applied, err = reservations.ChargebackPaymentByTransactionID(payload.Data.TransactionID, providerResponse)
+	default:
+		// Desteklenmeyen olay tipleri kaydedilir ama uygulanmaz
+		return false, models.WebhookEventStatusIgnored, nil
+	}
+
+	if err != nil {
+		return false, models.WebhookEventStatusFailed, err
+	}
+
+	return applied, models.WebhookEventStatusProcessed, nil
+}
@@ -0,0 +1,21 @@
+-- Create webhook_events table
+-- Ödeme sağlayıcısından gelen her webhook kaydedilir; (provider, provider_event_id)
+-- üzerindeki unique key sayesinde aynı olay tekrar gönderildiğinde ikinci kez işlenmez.
+CREATE TABLE IF NOT EXISTS webhook_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    provider VARCHAR(50) NOT NULL,
+    provider_event_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    transaction_id VARCHAR(255) NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'received', -- received, processed, ignored, failed
+    error_message TEXT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    processed_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_transaction_id (transaction_id),
+    INDEX idx_status (status),
+    INDEX idx_created_at (created_at),
+    UNIQUE KEY unique_provider_event (provider, provider_event_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
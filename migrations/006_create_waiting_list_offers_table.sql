@@ -0,0 +1,30 @@
+-- Create waiting_list_offers table
+-- İptal edilen veya süresi dolan bir rezervasyonun koltuğu, bekleme listesindeki
+-- sıradaki kullanıcıya claim token ile teklif edilir. Teklif claim penceresi
+-- içinde kullanılmazsa sıradaki kişiye devredilir.
+CREATE TABLE IF NOT EXISTS waiting_list_offers (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    waiting_list_id BIGINT NOT NULL,
+    event_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    section_id BIGINT NOT NULL,
+    seat_id BIGINT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    claim_token VARCHAR(64) NOT NULL UNIQUE,
+    status VARCHAR(50) NOT NULL DEFAULT 'pending', -- pending, claimed, declined, expired
+    expires_at TIMESTAMP NOT NULL,
+    claimed_at TIMESTAMP NULL,
+    ticket_id BIGINT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (waiting_list_id) REFERENCES waiting_lists(id) ON DELETE CASCADE,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE SET NULL,
+    INDEX idx_event_id (event_id),
+    INDEX idx_user_id (user_id),
+    INDEX idx_status_expires (status, expires_at),
+    INDEX idx_event_seat (event_id, seat_id, status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Bekleme listesine 'offered' ve 'expired' durumları eklendi (status VARCHAR olduğu için şema değişikliği gerekmez):
+-- waiting, notified, offered, purchased, expired, cancelled
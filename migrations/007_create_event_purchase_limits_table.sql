@@ -0,0 +1,24 @@
+-- Create event_purchase_limits table
+-- Etkinlik bazında bilet alım limitleri: kullanıcı, ödeme kartı (fingerprint)
+-- ve teslimat/fatura adresi başına. NULL olan limit uygulanmaz.
+CREATE TABLE IF NOT EXISTS event_purchase_limits (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id BIGINT NOT NULL UNIQUE,
+    max_per_user INT NULL,
+    max_per_card INT NULL,
+    max_per_address INT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Limit sayımı için biletlere alıcı kimliği eklenir.
+-- address_hash: normalize edilmiş adresin SHA-256 özeti (ham adres saklanmaz)
+-- limit_override_by: limiti aşarak gişeden satış yapan admin kullanıcı
+ALTER TABLE tickets
+    ADD COLUMN card_fingerprint VARCHAR(128) NULL AFTER price,
+    ADD COLUMN address_hash CHAR(64) NULL AFTER card_fingerprint,
+    ADD COLUMN limit_override_by BIGINT NULL AFTER address_hash,
+    ADD INDEX idx_event_user (event_id, user_id, status),
+    ADD INDEX idx_event_card (event_id, card_fingerprint, status),
+    ADD INDEX idx_event_address (event_id, address_hash, status);
@@ -0,0 +1,61 @@
+-- Create sale_phases table
+-- Bir etkinliğin birden fazla satış aşaması olabilir (ör. fan club presale,
+-- kredi kartı partner presale, genel satış). Her aşamanın kendi zaman penceresi,
+-- erişim yöntemi ve (opsiyonel) envanter kotası vardır.
+CREATE TABLE IF NOT EXISTS sale_phases (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    access_type VARCHAR(50) NOT NULL DEFAULT 'public', -- public, code, segment, allow_list
+    segment VARCHAR(100) NULL,                          -- access_type = segment için
+    allocation INT NULL,                                -- NULL = kota yok (etkinlik kapasitesi geçerli)
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    sort_order INT NOT NULL DEFAULT 0,                  -- Çakışan aşamalarda öncelik (küçük önce)
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    INDEX idx_event_window (event_id, starts_at, ends_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create sale_phase_codes table (access_type = code)
+-- Kod ilk kullanımda kullanıcıya bağlanır; max_uses aktif (rezerve + satılmış) bilet sayısıdır.
+CREATE TABLE IF NOT EXISTS sale_phase_codes (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    sale_phase_id BIGINT NOT NULL,
+    code VARCHAR(64) NOT NULL,
+    max_uses INT NOT NULL DEFAULT 1,
+    user_id BIGINT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (sale_phase_id) REFERENCES sale_phases(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_phase_code (sale_phase_id, code)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create sale_phase_allow_list table (access_type = allow_list)
+CREATE TABLE IF NOT EXISTS sale_phase_allow_list (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    sale_phase_id BIGINT NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (sale_phase_id) REFERENCES sale_phases(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_phase_email (sale_phase_id, email)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create user_segments table (access_type = segment)
+-- Ör. fan_club, card_partner
+CREATE TABLE IF NOT EXISTS user_segments (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    segment VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY unique_user_segment (user_id, segment),
+    INDEX idx_segment (segment)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Biletin hangi satış aşamasından (ve hangi kodla) alındığı
+ALTER TABLE tickets
+    ADD COLUMN sale_phase_id BIGINT NULL AFTER limit_override_by,
+    ADD COLUMN sale_phase_code_id BIGINT NULL AFTER sale_phase_id,
+    ADD INDEX idx_sale_phase (sale_phase_id, status),
+    ADD INDEX idx_sale_phase_code (sale_phase_code_id, status);
@@ -0,0 +1,62 @@
+-- Create season_packages table
+-- Sezon kombinesi: aynı mekandaki birden fazla etkinliği (ör. bir kulübün iç saha
+-- maçları) tek paket fiyatıyla satar. previous_package_id + renewal_deadline ile
+-- önceki sezonun kombine sahiplerine yenileme süresi boyunca koltuk önceliği verilir.
+CREATE TABLE IF NOT EXISTS season_packages (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    venue_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    season VARCHAR(50) NOT NULL,                       -- ör. 2025-2026
+    price DECIMAL(10, 2) NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'draft',       -- draft, on_sale, closed
+    sale_starts_at TIMESTAMP NOT NULL,
+    sale_ends_at TIMESTAMP NOT NULL,
+    previous_package_id BIGINT NULL,                   -- Yenilenen önceki sezon paketi
+    renewal_deadline TIMESTAMP NULL,                   -- Bu tarihe kadar koltuklar önceki sahiplere ayrılır
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE RESTRICT,
+    FOREIGN KEY (previous_package_id) REFERENCES season_packages(id) ON DELETE SET NULL,
+    INDEX idx_venue_season (venue_id, season),
+    INDEX idx_previous_package (previous_package_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create season_package_events table
+CREATE TABLE IF NOT EXISTS season_package_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    season_package_id BIGINT NOT NULL,
+    event_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (season_package_id) REFERENCES season_packages(id) ON DELETE CASCADE,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_package_event (season_package_id, event_id),
+    INDEX idx_event (event_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create season_memberships table
+-- Satın alınan kombine; paketteki her etkinlik için ayrı giriş bileti üretilir
+-- (tickets.season_membership_id). renewed_from_id yenilenen önceki üyeliktir.
+CREATE TABLE IF NOT EXISTS season_memberships (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    season_package_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    section_id BIGINT NOT NULL,
+    seat_id BIGINT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'active',      -- active, cancelled
+    renewed_from_id BIGINT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (season_package_id) REFERENCES season_packages(id) ON DELETE RESTRICT,
+    FOREIGN KEY (section_id) REFERENCES sections(id) ON DELETE RESTRICT,
+    FOREIGN KEY (seat_id) REFERENCES seats(id) ON DELETE SET NULL,
+    FOREIGN KEY (renewed_from_id) REFERENCES season_memberships(id) ON DELETE SET NULL,
+    UNIQUE KEY unique_renewed_from (renewed_from_id),
+    INDEX idx_package_seat (season_package_id, seat_id, status),
+    INDEX idx_user (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Kombine kapsamında üretilen etkinlik bileti
+ALTER TABLE tickets
+    ADD COLUMN season_membership_id BIGINT NULL AFTER sale_phase_code_id,
+    ADD INDEX idx_season_membership (season_membership_id);
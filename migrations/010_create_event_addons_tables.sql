@@ -0,0 +1,51 @@
+-- Create event_addons table
+-- Etkinlik bazında ek ürünler (otopark, merch, hospitality). Her ürünün kendi
+-- stoğu ve fiyatı vardır. scope = order: sipariş (kullanıcı + etkinlik) başına
+-- limit (ör. sipariş başına 1 otopark); scope = ticket: bilet başına limit
+-- (ör. bilet başına 1 hospitality upgrade).
+CREATE TABLE IF NOT EXISTS event_addons (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    type VARCHAR(50) NOT NULL,                          -- parking, merchandise, hospitality, other
+    price DECIMAL(10, 2) NOT NULL,
+    inventory INT NOT NULL,                             -- Toplam stok
+    scope VARCHAR(20) NOT NULL DEFAULT 'order',         -- order, ticket
+    max_quantity INT NULL,                              -- Sipariş / bilet başına üst sınır (NULL = yok)
+    scannable BOOLEAN NOT NULL DEFAULT FALSE,           -- Girişte okutulan voucher (ör. otopark)
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    INDEX idx_event_active (event_id, is_active)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create addon_items table
+-- Satın alınan ek ürün satırı. Her satır bir bilete bağlıdır ve biletle birlikte
+-- satılır, iptal edilir ve iade edilir. Scannable ürünler için voucher + QR üretilir.
+CREATE TABLE IF NOT EXISTS addon_items (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    addon_id BIGINT NOT NULL,
+    event_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    ticket_id BIGINT NOT NULL,
+    quantity INT NOT NULL DEFAULT 1,
+    unit_price DECIMAL(10, 2) NOT NULL,
+    total_price DECIMAL(10, 2) NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'reserved',     -- reserved, sold, used, cancelled, refunded
+    voucher_code VARCHAR(50) NULL,
+    verification_code VARCHAR(10) NULL,
+    qr_code_data TEXT,
+    qr_code_image MEDIUMBLOB,
+    used_at TIMESTAMP NULL,
+    refunded_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (addon_id) REFERENCES event_addons(id) ON DELETE RESTRICT,
+    FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_voucher_code (voucher_code),
+    INDEX idx_addon_status (addon_id, status),
+    INDEX idx_ticket (ticket_id),
+    INDEX idx_user_event (user_id, event_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
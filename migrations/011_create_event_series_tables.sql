@@ -0,0 +1,38 @@
+-- Create event_series table
+-- Tekrarlayan etkinlik serisi (ör. bir tiyatro oyununun sezon boyunca gösterimleri).
+-- Gösterimler (events) recurrence_rule'dan üretilir ve serinin açıklama, mekan,
+-- tip ve fiyat ayarlarını paylaşır.
+CREATE TABLE IF NOT EXISTS event_series (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    type VARCHAR(50) NOT NULL,
+    venue_id BIGINT NOT NULL,
+    base_price DECIMAL(10, 2) NOT NULL,
+    image_url VARCHAR(500),
+    duration_minutes INT NOT NULL,
+    recurrence_rule VARCHAR(500) NOT NULL,         -- ör. FREQ=WEEKLY;BYDAY=TU,WE,FR,SA;UNTIL=20250630
+    first_start_time TIMESTAMP NOT NULL,           -- İlk gösterim (saat bu değerden alınır)
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Europe/Istanbul',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE RESTRICT,
+    INDEX idx_venue (venue_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create event_series_exceptions table
+-- Gösterim yapılmayacak günler (tatil, bakım vb.)
+CREATE TABLE IF NOT EXISTS event_series_exceptions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    series_id BIGINT NOT NULL,
+    exception_date DATE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (series_id) REFERENCES event_series(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_series_date (series_id, exception_date)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Gösterimin bağlı olduğu seri
+ALTER TABLE events
+    ADD COLUMN series_id BIGINT NULL AFTER venue_id,
+    ADD INDEX idx_series_start (series_id, start_time),
+    ADD CONSTRAINT fk_events_series FOREIGN KEY (series_id) REFERENCES event_series(id) ON DELETE SET NULL;
@@ -0,0 +1,36 @@
+-- Create event_sessions table
+-- Çok günlü etkinliklerin (festival) gün / oturumları. Her oturumun kendi
+-- kapasitesi ve giriş kuralları vardır; check-in etkinliğin tamamına değil o
+-- anda açık olan oturuma göre yapılır.
+CREATE TABLE IF NOT EXISTS event_sessions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,                         -- ör. "1. Gün - Cuma"
+    doors_open_at TIMESTAMP NULL,                       -- Girişin açıldığı zaman (NULL = starts_at)
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    capacity INT NOT NULL,                              -- Oturum bazında giriş hakkı üst sınırı
+    allow_reentry BOOLEAN NOT NULL DEFAULT FALSE,       -- Aynı gün çıkıp tekrar girilebilir mi
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    INDEX idx_event_start (event_id, starts_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create ticket_session_entitlements table
+-- Biletin giriş hakkı verdiği oturumlar (ör. 3 günlük kombine = 3 satır).
+-- Kapasite sayımında sadece rezerve / satılmış / kullanılmış biletlerin hakları sayılır.
+CREATE TABLE IF NOT EXISTS ticket_session_entitlements (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    ticket_id BIGINT NOT NULL,
+    session_id BIGINT NOT NULL,
+    entry_count INT NOT NULL DEFAULT 0,                 -- Bu oturumdaki giriş sayısı
+    first_entry_at TIMESTAMP NULL,
+    last_entry_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE CASCADE,
+    FOREIGN KEY (session_id) REFERENCES event_sessions(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_ticket_session (ticket_id, session_id),
+    INDEX idx_session (session_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
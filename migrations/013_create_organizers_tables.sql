@@ -0,0 +1,47 @@
+-- Create organizers table
+-- Organizatör (promoter) hesapları. Etkinlikler ve mekanlar bir organizatöre
+-- aittir; organizatör kullanıcıları sadece kendi organizatörlerinin verisini görür.
+CREATE TABLE IF NOT EXISTS organizers (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    contact_email VARCHAR(255),
+    status VARCHAR(20) NOT NULL DEFAULT 'active',      -- active, suspended
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY unique_slug (slug)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create organizer_members table
+-- Organizatör üyeleri ve rolleri:
+-- owner: her şey + üye yönetimi, manager: etkinlik / mekan / rapor,
+-- box_office: gişe satışı ve bilet sorgulama, scanner: sadece girişte bilet okutma
+CREATE TABLE IF NOT EXISTS organizer_members (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    organizer_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    role VARCHAR(20) NOT NULL,                          -- owner, manager, box_office, scanner
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (organizer_id) REFERENCES organizers(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_organizer_user (organizer_id, user_id),
+    INDEX idx_user (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Mevcut etkinlik ve mekanlar platform organizatörüne atanır
+INSERT INTO organizers (name, slug, status) VALUES ('Platform', 'platform', 'active');
+
+ALTER TABLE events ADD COLUMN organizer_id BIGINT NULL AFTER venue_id;
+UPDATE events SET organizer_id = (SELECT id FROM organizers WHERE slug = 'platform');
+ALTER TABLE events
+    MODIFY organizer_id BIGINT NOT NULL,
+    ADD INDEX idx_organizer_start (organizer_id, start_time),
+    ADD CONSTRAINT fk_events_organizer FOREIGN KEY (organizer_id) REFERENCES organizers(id) ON DELETE RESTRICT;
+
+ALTER TABLE venues ADD COLUMN organizer_id BIGINT NULL AFTER id;
+UPDATE venues SET organizer_id = (SELECT id FROM organizers WHERE slug = 'platform');
+ALTER TABLE venues
+    MODIFY organizer_id BIGINT NOT NULL,
+    ADD INDEX idx_organizer (organizer_id),
+    ADD CONSTRAINT fk_venues_organizer FOREIGN KEY (organizer_id) REFERENCES organizers(id) ON DELETE RESTRICT;
@@ -0,0 +1,56 @@
+-- Ödeme durum zamanları
+-- processed_at her durum değişikliğinde güncellenir; mutabakat için satışın,
+-- iadenin ve ters ibrazın (chargeback) ne zaman gerçekleştiği ayrı tutulur.
+ALTER TABLE payments
+    ADD COLUMN paid_at TIMESTAMP NULL AFTER processed_at,
+    ADD COLUMN refunded_at TIMESTAMP NULL AFTER paid_at,
+    ADD COLUMN charged_back_at TIMESTAMP NULL AFTER refunded_at,
+    ADD INDEX idx_paid_at (paid_at),
+    ADD INDEX idx_refunded_at (refunded_at),
+    ADD INDEX idx_charged_back_at (charged_back_at);
+
+UPDATE payments SET paid_at = processed_at WHERE status IN ('completed', 'refunded');
+UPDATE payments SET refunded_at = processed_at WHERE status = 'refunded';
+
+-- Create settlement_statements table
+-- Organizatöre dönem bazında yapılacak ödemenin ekstresi. Oluşturulduktan sonra
+-- tutarları değişmez; sadece ödeme bilgisi (paid) işlenir.
+CREATE TABLE IF NOT EXISTS settlement_statements (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    organizer_id BIGINT NOT NULL,
+    period_start TIMESTAMP NOT NULL,
+    period_end TIMESTAMP NOT NULL,                      -- Hariç (period_start <= t < period_end)
+    currency VARCHAR(10) NOT NULL DEFAULT 'TRY',
+    gross_amount DECIMAL(12, 2) NOT NULL DEFAULT 0,     -- Tamamlanan satışlar
+    refund_amount DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    chargeback_amount DECIMAL(12, 2) NOT NULL DEFAULT 0,
+    fee_amount DECIMAL(12, 2) NOT NULL DEFAULT 0,       -- Platform komisyonu + ters ibraz ücretleri
+    net_amount DECIMAL(12, 2) NOT NULL DEFAULT 0,       -- Organizatöre ödenecek tutar
+    line_count INT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'issued',       -- issued, paid
+    paid_at TIMESTAMP NULL,
+    payout_reference VARCHAR(255),                      -- Banka transfer referansı
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (organizer_id) REFERENCES organizers(id) ON DELETE RESTRICT,
+    UNIQUE KEY unique_organizer_period (organizer_id, currency, period_start, period_end),
+    INDEX idx_organizer_status (organizer_id, status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Create settlement_lines table
+-- Ekstre kalemleri. Her ödeme hareketi (satış, iade, ters ibraz ve ücretleri)
+-- sadece bir ekstrede yer alabilir.
+CREATE TABLE IF NOT EXISTS settlement_lines (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    statement_id BIGINT NOT NULL,
+    payment_id BIGINT NOT NULL,
+    event_id BIGINT NOT NULL,
+    line_type VARCHAR(20) NOT NULL,                     -- sale, refund, chargeback, fee, chargeback_fee
+    amount DECIMAL(12, 2) NOT NULL,                     -- İşaretli: satış +, diğerleri -
+    occurred_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (statement_id) REFERENCES settlement_statements(id) ON DELETE RESTRICT,
+    FOREIGN KEY (payment_id) REFERENCES payments(id) ON DELETE RESTRICT,
+    UNIQUE KEY unique_payment_line (payment_id, line_type),
+    INDEX idx_statement (statement_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,22 @@
+-- Create analytics_events table
+-- Satın alma, iptal, check-in ve sayfa görüntüleme olayları. Bilet olayları
+-- kayıt anındaki bölüm, bilet tipi, presale kodu, kanal ve tutarla birlikte
+-- saklanır; raporlar bilet sonradan değişse de olay anını yansıtır.
+CREATE TABLE IF NOT EXISTS analytics_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    activity VARCHAR(20) NOT NULL,                      -- purchase, cancellation, check_in, page_view
+    event_id BIGINT NOT NULL,
+    ticket_id BIGINT NULL,
+    user_id BIGINT NULL,
+    section_id BIGINT NULL,
+    ticket_type VARCHAR(50) NULL,
+    promo_code VARCHAR(64) NULL,                        -- sale_phase_codes.code
+    channel VARCHAR(20) NOT NULL DEFAULT 'online',      -- online, box_office, season
+    amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    dedup_key VARCHAR(100) NULL,                        -- Aynı bilet olayının iki kez sayılmaması için
+    occurred_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    UNIQUE KEY unique_dedup_key (dedup_key),
+    INDEX idx_event_activity_time (event_id, activity, occurred_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
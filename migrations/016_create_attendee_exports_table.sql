@@ -0,0 +1,22 @@
+-- Create attendee_exports table
+-- Etkinlik katılımcı listesi dışa aktarımları. Dosya kuyruktaki job tarafından
+-- üretilip storage'a yazılır; isteyen kişiye süreli, imzalı bir indirme
+-- bağlantısı e-postalanır.
+CREATE TABLE IF NOT EXISTS attendee_exports (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id BIGINT NOT NULL,
+    requested_by BIGINT NOT NULL,
+    email VARCHAR(255) NOT NULL,                        -- İndirme bağlantısının gönderileceği adres
+    format VARCHAR(10) NOT NULL,                        -- csv, xlsx
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',      -- pending, processing, completed, failed
+    file_path VARCHAR(500) NULL,
+    row_count INT NOT NULL DEFAULT 0,
+    error_message TEXT NULL,
+    completed_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NULL,                          -- İndirme bağlantısının son geçerlilik zamanı
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    INDEX idx_event_created (event_id, created_at),
+    INDEX idx_status_expires (status, expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,5 @@
+-- Seat attributes
+-- Koltuk özellikleri virgülle ayrılmış etiketler olarak tutulur (örn. "aisle,obstructed").
+-- Toplu mekan düzeni içe aktarımı (venue layout import) bu kolonu doldurur.
+ALTER TABLE seats
+    ADD COLUMN attributes VARCHAR(255) NOT NULL DEFAULT '' AFTER number;
@@ -0,0 +1,9 @@
+-- Companion seats
+-- Tekerlekli sandalye alanı ile refakatçi koltuğu karşılıklı olarak bağlanır.
+-- Erişilebilir koltuk rezerve edildiğinde bağlı refakatçi koltuğu aynı alıcı için tutulur.
+ALTER TABLE seats
+    ADD COLUMN companion_seat_id BIGINT NULL AFTER attributes,
+    ADD CONSTRAINT fk_seats_companion FOREIGN KEY (companion_seat_id) REFERENCES seats(id) ON DELETE SET NULL;
+
+-- Koltuk haritası sorguları
+CREATE INDEX idx_tickets_event_seat ON tickets (event_id, seat_id, status);
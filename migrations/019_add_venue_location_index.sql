@@ -0,0 +1,3 @@
+-- Venue geo search
+-- Yarıçap / sınır kutusu aramasında haversine hesabından önce kutu ön filtresi bu indeksi kullanır.
+CREATE INDEX idx_venues_location ON venues (latitude, longitude);
@@ -0,0 +1,34 @@
+-- Event full-text search
+-- Metin kolonları search.Normalize ile Türkçe duyarlı normalize edilmiş olarak yazılır
+-- (İ/ı → i, ş → s, ğ → g ...); sorgular da aynı normalizasyondan geçer.
+-- FULLTEXT indeksleri 2 harfli kelimeleri de indekslemeli ve İngilizce stopword listesi
+-- Türkçe metinde ("an", "be" vb.) eşleşmeleri düşürmemeli:
+--   innodb_ft_min_token_size = 2
+--   innodb_ft_enable_stopword = OFF
+-- Bu ayarlar değiştirilirse indeksler yeniden oluşturulmalıdır (OPTIMIZE TABLE yetmez).
+
+CREATE TABLE IF NOT EXISTS event_search_documents (
+    event_id BIGINT PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    venue VARCHAR(255) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL DEFAULT '',
+    city_name VARCHAR(100) NOT NULL DEFAULT '' COMMENT 'Facet etiketi (orijinal yazım)',
+    type VARCHAR(50) NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    price DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    INDEX idx_search_start_time (start_time),
+    INDEX idx_search_type (type),
+    INDEX idx_search_city (city),
+    FULLTEXT INDEX ft_search_title (title),
+    FULLTEXT INDEX ft_search_all (title, body, venue, city)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Yazım hatası toleransı için sözlük: indekslenen normalize kelimeler.
+-- Sorgu kelimesinin ilk iki harfiyle aday kelimeler çekilir, mesafe uygulamada hesaplanır.
+CREATE TABLE IF NOT EXISTS search_terms (
+    term VARCHAR(64) PRIMARY KEY
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
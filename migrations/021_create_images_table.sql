@@ -0,0 +1,21 @@
+-- Create images table
+-- Etkinlik ve mekan görsellerinin boyutlandırılmış varyantları (thumbnail,
+-- card, hero). Dosyalar storage'da içerik adresli yollarda durur
+-- (images/<sha256[:2]>/<sha256>.<ext>); aynı içerik tek kez saklanır ve
+-- birden fazla kayıt aynı dosyayı gösterebilir.
+CREATE TABLE IF NOT EXISTS images (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    entity_type VARCHAR(20) NOT NULL,                   -- event, venue
+    entity_id BIGINT NOT NULL,
+    variant VARCHAR(20) NOT NULL,                       -- thumbnail, card, hero
+    path VARCHAR(255) NOT NULL,                         -- Storage yolu (içerik adresli)
+    url VARCHAR(500) NOT NULL,
+    content_type VARCHAR(50) NOT NULL,                  -- image/jpeg, image/png
+    width INT NOT NULL,
+    height INT NOT NULL,
+    size_bytes INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_entity_variant (entity_type, entity_id, variant),
+    INDEX idx_path (path)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,24 @@
+-- Create audit_logs table
+-- Etkinlik, bilet, ödeme ve bekleme listesi üzerindeki her değişikliğin kim
+-- tarafından (kullanıcı veya sistem job'ı), hangi istekle ve hangi alan
+-- farklarıyla yapıldığını saklar. Tablo append-only'dir: kayıtlar
+-- güncellenemez, sadece saklama süresi dolanlar toplu olarak silinir.
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    actor_type VARCHAR(20) NOT NULL,                    -- user, system
+    actor_id VARCHAR(100) NOT NULL,                     -- Kullanıcı ID'si veya job adı
+    action VARCHAR(30) NOT NULL,                        -- create, update, delete, status_change
+    entity_type VARCHAR(30) NOT NULL,                   -- event, ticket, payment, waiting_list
+    entity_id BIGINT NOT NULL,
+    changes JSON NOT NULL,                              -- {"alan": {"from": ..., "to": ...}}
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    request_id VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX idx_entity (entity_type, entity_id, id),
+    INDEX idx_actor (actor_type, actor_id, id),
+    INDEX idx_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Kayıtların sonradan değiştirilmesini engelle
+CREATE TRIGGER audit_logs_no_update BEFORE UPDATE ON audit_logs
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append-only';
@@ -0,0 +1,12 @@
+-- Optimistic concurrency control
+-- Her yazmada bir artan version kolonu. Güncellemeler "WHERE version = ?" ile
+-- koşullu yapılır; araya başka bir yazma girdiyse güncelleme uygulanmaz ve
+-- API 409 Conflict döner. ETag / If-Match header'ları bu değeri taşır.
+ALTER TABLE events
+    ADD COLUMN version INT UNSIGNED NOT NULL DEFAULT 1 AFTER metadata;
+
+ALTER TABLE tickets
+    ADD COLUMN version INT UNSIGNED NOT NULL DEFAULT 1 AFTER status;
+
+ALTER TABLE payments
+    ADD COLUMN version INT UNSIGNED NOT NULL DEFAULT 1 AFTER status;
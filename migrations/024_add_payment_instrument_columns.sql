@@ -0,0 +1,9 @@
+-- Ödemeyi bilete bağlar ve ödeme sağlayıcısının bildirdiği kart / adres bilgisini saklar.
+-- card_fingerprint ve address_hash payment.completed webhook'u ile gelir; kart ve adres
+-- alım limitleri satın alma anında istemcinin gönderdiği değerlere değil bunlara göre sayılır.
+-- address_hash: normalize edilmiş fatura adresinin SHA-256 özeti (ham adres saklanmaz)
+ALTER TABLE payments
+    ADD COLUMN ticket_id BIGINT NULL AFTER event_id,
+    ADD COLUMN card_fingerprint VARCHAR(128) NULL AFTER payment_method,
+    ADD COLUMN address_hash CHAR(64) NULL AFTER card_fingerprint,
+    ADD INDEX idx_ticket_id (ticket_id);
@@ -0,0 +1,101 @@
+// -----------------------------------------------------------------------------
+// Audit Diff
+// -----------------------------------------------------------------------------
+// Denetim kayıtları için bir varlığın önceki ve sonraki hali arasındaki
+// alan farklarını çıkarır. Karşılaştırma JSON gösterimi üzerinden yapılır;
+// böylece alan adları API'deki adlarla aynıdır ve `json:"-"` ile gizlenen
+// alanlar (kart parmak izi vb.) denetim kaydına hiç girmez.
+//
+// Kullanım:
+//
+//	before := *ticket
+//	ticket.Status = models.TicketStatusCancelled
+//	changes, _ := audit.Diff(&before, ticket)
+//	// {"status": {"from": "sold", "to": "cancelled"}, "cancelled_at": {...}}
+//
+// Notlar:
+// - before nil ise (oluşturma) tüm alanlar from: null ile döner
+// - after nil ise (silme) tüm alanlar to: null ile döner
+// - Sayılar JSON'dan float64 olarak okunur; 1 ile 1.0 aynı kabul edilir
+// -----------------------------------------------------------------------------
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Change, tek bir alanın önceki ve sonraki değeridir
+type Change struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// Changes, alan adı → değişiklik eşlemesidir
+type Changes map[string]Change
+
+// Diff, before ve after değerlerinin JSON alanlarını karşılaştırır ve
+// değişen alanları döndürür. Değerler JSON nesnesine dönüşmelidir (struct, map).
+func Diff(before, after interface{}) (Changes, error) {
+	beforeFields, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(Changes)
+	for key, to := range afterFields {
+		from, existed := beforeFields[key]
+		if !existed || !reflect.DeepEqual(from, to) {
+			changes[key] = Change{From: from, To: to}
+		}
+	}
+
+	for key, from := range beforeFields {
+		if _, exists := afterFields[key]; !exists {
+			changes[key] = Change{From: from, To: nil}
+		}
+	}
+
+	return changes, nil
+}
+
+// fields, değeri JSON üzerinden alan eşlemesine çevirir (nil → boş eşleme)
+func fields(value interface{}) (map[string]interface{}, error) {
+	if isNil(value) {
+		return map[string]interface{}{}, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("audit: failed to encode value: %w", err)
+	}
+
+	result := make(map[string]interface{})
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("audit: value is not a JSON object: %w", err)
+	}
+
+	return result, nil
+}
+
+// isNil, arayüz değerinin nil veya nil pointer olup olmadığını kontrol eder
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+
+	return false
+}
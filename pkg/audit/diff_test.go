@@ -0,0 +1,81 @@
+// -----------------------------------------------------------------------------
+// Audit Diff Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Sadece değişen alanların dönmesi
+// - Oluşturma (before nil) ve silme (after nil) farkları
+// - `json:"-"` alanlarının farka girmemesi
+// - JSON nesnesi olmayan değerlerin reddedilmesi
+// -----------------------------------------------------------------------------
+
+package audit
+
+import (
+	"testing"
+)
+
+type ticket struct {
+	ID          int64   `json:"id"`
+	Status      string  `json:"status"`
+	Price       float64 `json:"price"`
+	CancelledAt *string `json:"cancelled_at,omitempty"`
+	Fingerprint string  `json:"-"`
+}
+
+func TestDiff_ReturnsOnlyChangedFields(t *testing.T) {
+	before := &ticket{ID: 7, Status: "sold", Price: 150, Fingerprint: "a"}
+	cancelledAt := "2025-06-01T10:00:00Z"
+	after := &ticket{ID: 7, Status: "cancelled", Price: 150, CancelledAt: &cancelledAt, Fingerprint: "b"}
+
+	changes, err := Diff(before, after)
+	if err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %v", changes)
+	}
+	if changes["status"].From != "sold" || changes["status"].To != "cancelled" {
+		t.Fatalf("unexpected status change: %+v", changes["status"])
+	}
+	if changes["cancelled_at"].From != nil || changes["cancelled_at"].To != cancelledAt {
+		t.Fatalf("unexpected cancelled_at change: %+v", changes["cancelled_at"])
+	}
+}
+
+func TestDiff_CreateAndDelete(t *testing.T) {
+	var none *ticket
+	current := &ticket{ID: 3, Status: "reserved", Price: 99.5}
+
+	created, err := Diff(none, current)
+	if err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+	if len(created) != 3 || created["id"].From != nil || created["price"].To != 99.5 {
+		t.Fatalf("unexpected create diff: %v", created)
+	}
+
+	deleted, err := Diff(current, nil)
+	if err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+	if len(deleted) != 3 || deleted["status"].From != "reserved" || deleted["status"].To != nil {
+		t.Fatalf("unexpected delete diff: %v", deleted)
+	}
+}
+
+func TestDiff_NoChanges(t *testing.T) {
+	changes, err := Diff(map[string]interface{}{"status": "sold"}, map[string]interface{}{"status": "sold"})
+	if err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no changes, got %v", changes)
+	}
+}
+
+func TestDiff_RejectsNonObjects(t *testing.T) {
+	if _, err := Diff("sold", "cancelled"); err == nil {
+		t.Fatalf("expected error for non-object values")
+	}
+}
@@ -237,3 +237,44 @@ type Stats interface {
 	//   }
 	Stats() map[string]interface{}
 }
+
+// Locker, atomic "yoksa yaz" operasyonu sağlayan interface.
+//
+// Distributed lock ve idempotency gibi senaryolarda kullanılır.
+// Laravel'deki Cache::add() karşılığıdır. Tüm driver'lar optional olarak
+// implement edebilir.
+type Locker interface {
+	// Add, key yoksa değeri yazar ve true döner.
+	// Key zaten varsa (ve expire olmamışsa) hiçbir şey yapmaz, false döner.
+	//
+	// Parametreler:
+	//   - key: Cache anahtarı
+	//   - value: Saklanacak değer
+	//   - ttl: Geçerlilik süresi (lock'larda mutlaka belirtilmeli)
+	//
+	// Döndürür:
+	//   - bool: Değer yazıldıysa true
+	//   - error: Yazma hatası
+	//
+	// Örnek:
+	//   if l, ok := cache.(Locker); ok {
+	//       token := uniqueToken()
+	//       acquired, _ := l.Add("lock:order:42", token, 30*time.Second)
+	//       if acquired {
+	//           defer l.Release("lock:order:42", token)
+	//       }
+	//   }
+	Add(key string, value interface{}, ttl time.Duration) (bool, error)
+
+	// Release, key sadece hâlâ verilen değeri taşıyorsa siler (compare-and-delete).
+	// TTL dolduktan sonra başka bir sahibin aldığı lock silinmez.
+	//
+	// Parametreler:
+	//   - key: Cache anahtarı
+	//   - value: Add ile yazılan değer (lock sahibinin token'ı)
+	//
+	// Döndürür:
+	//   - bool: Key silindiyse true
+	//   - error: Okuma / silme hatası
+	Release(key string, value interface{}) (bool, error)
+}
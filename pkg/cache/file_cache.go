@@ -196,6 +196,51 @@ func (f *FileCache) Set(key string, value interface{}, ttl time.Duration) error
 	return nil
 }
 
+// Release, key hâlâ verilen değeri taşıyorsa siler.
+// Değerler JSON karşılıklarıyla karşılaştırılır (dosyada JSON olarak saklanırlar).
+// Sadece process içinde atomic'tir; birden fazla instance için Redis kullanılmalı.
+func (f *FileCache) Release(key string, value interface{}) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := f.filePath(key)
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("file cache read failed: %w", err)
+	}
+
+	var entry FileCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return false, nil
+	}
+	if entry.ExpiresAt != 0 && time.Now().Unix() > entry.ExpiresAt {
+		return false, nil
+	}
+
+	stored, err := json.Marshal(entry.Value)
+	if err != nil {
+		return false, fmt.Errorf("json encode failed: %w", err)
+	}
+	expected, err := json.Marshal(value)
+	if err != nil {
+		return false, fmt.Errorf("json encode failed: %w", err)
+	}
+	if string(stored) != string(expected) {
+		return false, nil
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		f.logger.Printf("❌ File cache silme hatası [%s]: %v", key, err)
+		return false, fmt.Errorf("file cache delete failed: %w", err)
+	}
+
+	return true, nil
+}
+
 // Delete, cache'den veri siler.
 func (f *FileCache) Delete(key string) error {
 	f.mu.Lock()
@@ -269,6 +314,41 @@ func (f *FileCache) Increment(key string, value int64) (int64, error) {
 	return newVal, nil
 }
 
+// Add, key yoksa değeri yazar.
+// Sadece process içinde atomic'tir; birden fazla instance için Redis kullanılmalı.
+func (f *FileCache) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := f.filePath(key)
+
+	// Mevcut ve geçerli bir kayıt var mı? (Get kullanılmaz, lock zaten tutuluyor)
+	if data, err := os.ReadFile(path); err == nil {
+		var entry FileCacheEntry
+		if json.Unmarshal(data, &entry) == nil && (entry.ExpiresAt == 0 || time.Now().Unix() <= entry.ExpiresAt) {
+			return false, nil
+		}
+	}
+
+	var expiresAt int64 = 0
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl).Unix()
+	}
+
+	data, err := json.Marshal(FileCacheEntry{Value: value, ExpiresAt: expiresAt})
+	if err != nil {
+		f.logger.Printf("❌ JSON encode hatası [%s]: %v", key, err)
+		return false, fmt.Errorf("json encode failed: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		f.logger.Printf("❌ File cache yazma hatası [%s]: %v", key, err)
+		return false, fmt.Errorf("file cache write failed: %w", err)
+	}
+
+	return true, nil
+}
+
 // Decrement, sayısal değeri azaltır.
 func (f *FileCache) Decrement(key string, value int64) (int64, error) {
 	return f.Increment(key, -value)
@@ -23,6 +23,7 @@ package cache
 
 import (
 	"log"
+	"reflect"
 	"sync"
 	"time"
 )
@@ -117,6 +118,20 @@ func (m *MemoryCache) Set(key string, value interface{}, ttl time.Duration) erro
 	return nil
 }
 
+// Release, key hâlâ verilen değeri taşıyorsa siler (atomic, thread-safe).
+func (m *MemoryCache) Release(key string, value interface{}) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, exists := m.store[key]
+	if !exists || entry.IsExpired() || !reflect.DeepEqual(entry.Value, value) {
+		return false, nil
+	}
+
+	delete(m.store, key)
+	return true, nil
+}
+
 // Delete, cache'den veri siler.
 func (m *MemoryCache) Delete(key string) error {
 	m.mu.Lock()
@@ -194,6 +209,28 @@ func (m *MemoryCache) Increment(key string, value int64) (int64, error) {
 	return newVal, nil
 }
 
+// Add, key yoksa değeri yazar (atomic, thread-safe).
+func (m *MemoryCache) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if entry, exists := m.store[key]; exists && !entry.IsExpired() {
+		return false, nil
+	}
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	m.store[key] = &MemoryCacheEntry{
+		Value:     value,
+		ExpiresAt: expiresAt,
+	}
+
+	return true, nil
+}
+
 // Decrement, sayısal değeri azaltır.
 func (m *MemoryCache) Decrement(key string, value int64) (int64, error) {
 	return m.Increment(key, -value)
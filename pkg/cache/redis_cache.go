@@ -115,6 +115,37 @@ func (r *RedisCache) Set(key string, value interface{}, ttl time.Duration) error
 	return nil
 }
 
+// releaseScript, key hâlâ beklenen değeri taşıyorsa siler (GET + DEL tek atomic adım).
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Release, key hâlâ verilen değeri taşıyorsa siler (atomic, Lua script).
+func (r *RedisCache) Release(key string, value interface{}) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// JSON encode (Add ile aynı encoding)
+	data, err := json.Marshal(value)
+	if err != nil {
+		r.logger.Printf("❌ JSON encode hatası [%s]: %v", key, err)
+		return false, fmt.Errorf("json encode failed: %w", err)
+	}
+
+	prefixedKey := r.prefixKey(key)
+
+	deleted, err := releaseScript.Run(ctx, r.client, []string{prefixedKey}, data).Int()
+	if err != nil {
+		r.logger.Printf("❌ Redis Release hatası [%s]: %v", prefixedKey, err)
+		return false, fmt.Errorf("redis release failed: %w", err)
+	}
+
+	return deleted > 0, nil
+}
+
 // Delete, cache'den veri siler.
 func (r *RedisCache) Delete(key string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
@@ -189,6 +220,29 @@ func (r *RedisCache) Increment(key string, value int64) (int64, error) {
 	return newVal, nil
 }
 
+// Add, key yoksa değeri yazar (atomic, SET NX).
+func (r *RedisCache) Add(key string, value interface{}, ttl time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	// JSON encode
+	data, err := json.Marshal(value)
+	if err != nil {
+		r.logger.Printf("❌ JSON encode hatası [%s]: %v", key, err)
+		return false, fmt.Errorf("json encode failed: %w", err)
+	}
+
+	prefixedKey := r.prefixKey(key)
+
+	added, err := r.client.SetNX(ctx, prefixedKey, data, ttl).Result()
+	if err != nil {
+		r.logger.Printf("❌ Redis SetNX hatası [%s]: %v", prefixedKey, err)
+		return false, fmt.Errorf("redis setnx failed: %w", err)
+	}
+
+	return added, nil
+}
+
 // Decrement, sayısal değeri azaltır (atomic).
 func (r *RedisCache) Decrement(key string, value int64) (int64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
@@ -25,6 +25,10 @@ import (
 // Sadece alphanumeric, underscore ve nokta (table.column için) kabul eder.
 var validIdentifierRegex = regexp.MustCompile(`^[a-zA-Z0-9_\.]+$`)
 
+// selectFunctionRegex, Select'te izin verilen fonksiyon ifadesini tanımlar.
+// Örn: "COUNT(*)", "SUM(price)", "COUNT(DISTINCT user_id) as buyers"
+var selectFunctionRegex = regexp.MustCompile(`^(?i)[a-z_]+\((\*|(distinct\s+)?[a-z0-9_]+(\.[a-z0-9_]+)?)\)(\s+as\s+[a-z_][a-z0-9_]*)?$`)
+
 type QueryBuilder struct {
 	executor QueryExecutor
 	grammar  Grammar
@@ -34,6 +38,9 @@ type QueryBuilder struct {
 	orders   []OrderClause
 	limit    int
 	offset   int
+
+	softDeleteColumn string       // Boş değilse soft delete kapsamı aktif (bkz. soft_delete.go)
+	trashed          trashedScope // Silinmiş kayıtların kapsamı
 }
 
 // NewBuilder NewBuilder, veritabanı bağlantısını alarak yeni QueryBuilder üretir.
@@ -146,13 +153,10 @@ func (qb *QueryBuilder) Select(columns ...string) *QueryBuilder {
 	// Her column'u validate et
 	for _, col := range columns {
 		// SQL fonksiyonları için özel durum (COUNT(*), SUM(price), vb.)
-		// Bu durumda parantez içeriğini kontrol etmiyoruz
-		if strings.Contains(col, "(") && strings.Contains(col, ")") {
-			// SQL fonksiyonları için daha esnek validation
-			// Örn: "COUNT(*) as total", "SUM(price)", "MAX(id)"
-			// Bu tür kullanımlar genelde developer tarafından yazılır, user input değildir
-			// Yine de basic bir check yapalım
-			if strings.Contains(col, ";") || strings.Contains(col, "--") {
+		// Sadece tek bir fonksiyon çağrısı kabul edilir: FONKSİYON(kolon | *) [as alias]
+		// Alt sorgu, virgülle ayrılmış ek ifade veya iç içe parantez reddedilir
+		if strings.Contains(col, "(") || strings.Contains(col, ")") {
+			if !selectFunctionRegex.MatchString(col) {
 				panic(fmt.Sprintf("Invalid column expression: '%s' (suspicious content)", col))
 			}
 			continue
@@ -287,6 +291,36 @@ func (qb *QueryBuilder) WhereNotIn(column string, values []interface{}) *QueryBu
 	return qb
 }
 
+// WhereInSub, belirtilen kolonun değerinin bir alt sorgunun sonucunda olup olmadığını kontrol eder.
+// Alt sorgu ana sorguyla birlikte derlenir; ID listesi önceden çekilmez.
+//
+// Parametreler:
+//   - column: Kontrol edilecek kolon adı
+//   - sub: Tek kolon seçen alt sorgu (Table ve Select ile kurulmuş QueryBuilder)
+//
+// Döndürür:
+//   - *QueryBuilder: Zincirleme için kendi instance'ını döner
+//
+// Örnek:
+//
+//	events := NewBuilder(db, grammar).Table("events").Select("id").Where("organizer_id", "=", 3)
+//	qb.Table("tickets").WhereInSub("event_id", events)
+//	→ SQL: WHERE `event_id` IN (SELECT `id` FROM `events` WHERE `organizer_id` = ?)
+//
+// Güvenlik Notu:
+// Alt sorgunun değerleri de prepared statement ile bağlanır.
+func (qb *QueryBuilder) WhereInSub(column string, sub *QueryBuilder) *QueryBuilder {
+	validateIdentifier(column, "column")
+
+	qb.wheres = append(qb.wheres, WhereClause{
+		Column:   column,
+		Operator: "IN",
+		Value:    sub,
+		Boolean:  "AND",
+	})
+	return qb
+}
+
 // WhereBetween, belirtilen kolonun değerinin iki değer arasında olup olmadığını kontrol eder.
 //
 // Parametreler:
@@ -413,7 +447,8 @@ func (qb *QueryBuilder) WhereDate(column string, date string) *QueryBuilder {
 	validateIdentifier(column, "column")
 
 	qb.wheres = append(qb.wheres, WhereClause{
-		Column:   "DATE(" + column + ")",
+		Column:   column,
+		Function: "DATE",
 		Operator: "=",
 		Value:    date,
 		Boolean:  "AND",
@@ -438,7 +473,8 @@ func (qb *QueryBuilder) WhereYear(column string, year int) *QueryBuilder {
 	validateIdentifier(column, "column")
 
 	qb.wheres = append(qb.wheres, WhereClause{
-		Column:   "YEAR(" + column + ")",
+		Column:   column,
+		Function: "YEAR",
 		Operator: "=",
 		Value:    year,
 		Boolean:  "AND",
@@ -463,7 +499,8 @@ func (qb *QueryBuilder) WhereMonth(column string, month int) *QueryBuilder {
 	validateIdentifier(column, "column")
 
 	qb.wheres = append(qb.wheres, WhereClause{
-		Column:   "MONTH(" + column + ")",
+		Column:   column,
+		Function: "MONTH",
 		Operator: "=",
 		Value:    month,
 		Boolean:  "AND",
@@ -488,7 +525,8 @@ func (qb *QueryBuilder) WhereDay(column string, day int) *QueryBuilder {
 	validateIdentifier(column, "column")
 
 	qb.wheres = append(qb.wheres, WhereClause{
-		Column:   "DAY(" + column + ")",
+		Column:   column,
+		Function: "DAY",
 		Operator: "=",
 		Value:    day,
 		Boolean:  "AND",
@@ -663,7 +701,7 @@ func (qb *QueryBuilder) First(dest any) error {
 //	// sql: "SELECT `id`, `name` FROM `users` WHERE `status` = ? ORDER BY `created_at` DESC LIMIT 10"
 //	// args: ["active"]
 func (qb *QueryBuilder) ToSQL() (string, []interface{}, error) {
-	return qb.grammar.CompileSelect(qb)
+	return qb.grammar.CompileSelect(qb.withSoftDeleteScope())
 }
 
 // ExecInsert, INSERT sorgusunu çalıştırır.
@@ -720,7 +758,7 @@ func (qb *QueryBuilder) ExecUpdate(data map[string]interface{}) (sql.Result, err
 		validateIdentifier(column, "column")
 	}
 
-	sqlStr, args, err := qb.grammar.CompileUpdate(qb.table, data, qb.wheres)
+	sqlStr, args, err := qb.grammar.CompileUpdate(qb.table, data, qb.scopedWheres())
 	if err != nil {
 		return nil, fmt.Errorf("update compilation failed: %w", err)
 	}
@@ -740,10 +778,18 @@ func (qb *QueryBuilder) ExecUpdate(data map[string]interface{}) (sql.Result, err
 //	    ExecDelete()
 //	affected, _ := result.RowsAffected()
 //
+// Soft delete kapsamlı builder'larda (SoftDeletes) kayıt fiziksel olarak
+// silinmez, ExecSoftDelete çağrılır. Fiziksel silme için ExecForceDelete
+// kullanılmalıdır.
+//
 // GÜVENLİK UYARISI:
 // WHERE clause olmadan DELETE çalıştırmak TÜM TABLONUN SİLİNMESİNE sebep olur!
 // Production'da mutlaka WHERE kontrolü eklenmelidir.
 func (qb *QueryBuilder) ExecDelete() (sql.Result, error) {
+	if qb.softDeleteColumn != "" {
+		return qb.ExecSoftDelete()
+	}
+
 	sqlStr, args, err := qb.grammar.CompileDelete(qb.table, qb.wheres)
 	if err != nil {
 		return nil, fmt.Errorf("delete compilation failed: %w", err)
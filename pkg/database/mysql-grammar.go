@@ -23,6 +23,14 @@ func NewMySQLGrammar() *MySQLGrammar {
 
 var validIdentifierPattern = regexp.MustCompile(`^[a-zA-Z0-9_\.]+$`)
 
+// allowedWhereFunctions, where kolonlarına uygulanabilecek SQL fonksiyonlarıdır (WhereDate, WhereYear vb.)
+var allowedWhereFunctions = map[string]bool{
+	"DATE":  true,
+	"YEAR":  true,
+	"MONTH": true,
+	"DAY":   true,
+}
+
 var allowedOperators = map[string]bool{
 	"=":           true,
 	"!=":          true,
@@ -119,71 +127,12 @@ func (g *MySQLGrammar) CompileSelect(qb *QueryBuilder) (string, []interface{}, e
 	var args []interface{}
 
 	// WHERE clause'ları ekle
-	if len(qb.wheres) > 0 {
-		sql += " WHERE "
-		for i, w := range qb.wheres {
-			// Operatörü validate et
-			if err := g.validateOperator(w.Operator); err != nil {
-				return "", nil, fmt.Errorf("where clause error: %w", err)
-			}
-
-			// Kolon adını wrap et (SQL fonksiyonları için özel durum)
-			wrappedCol := w.Column
-			if !strings.Contains(w.Column, "(") {
-				var err error
-				wrappedCol, err = g.Wrap(w.Column)
-				if err != nil {
-					return "", nil, fmt.Errorf("where column wrap error: %w", err)
-				}
-			}
-
-			// AND/OR ekle
-			if i > 0 {
-				sql += fmt.Sprintf(" %s ", w.Boolean)
-			}
-
-			operator := strings.ToUpper(w.Operator)
-
-			// Operatör tipine göre SQL oluştur
-			switch operator {
-			case "IN", "NOT IN":
-				// IN ve NOT IN için değerler dizisi
-				values, ok := w.Value.([]interface{})
-				if !ok {
-					return "", nil, fmt.Errorf("IN/NOT IN operator requires []interface{} value")
-				}
-				placeholders := make([]string, len(values))
-				for j := range values {
-					placeholders[j] = "?"
-				}
-				sql += fmt.Sprintf("%s %s (%s)", wrappedCol, operator, strings.Join(placeholders, ", "))
-				args = append(args, values...)
-
-			case "BETWEEN", "NOT BETWEEN":
-				// BETWEEN için iki değer gerekli
-				values, ok := w.Value.([]interface{})
-				if !ok || len(values) != 2 {
-					return "", nil, fmt.Errorf("BETWEEN operator requires exactly 2 values")
-				}
-				sql += fmt.Sprintf("%s %s ? AND ?", wrappedCol, operator)
-				args = append(args, values[0], values[1])
-
-			case "IS", "IS NOT":
-				// NULL kontrolü için
-				if w.Value == nil {
-					sql += fmt.Sprintf("%s %s NULL", wrappedCol, operator)
-				} else {
-					sql += fmt.Sprintf("%s %s ?", wrappedCol, operator)
-					args = append(args, w.Value)
-				}
-
-			default:
-				// Standart operatörler (=, !=, <, >, LIKE, vb.)
-				sql += fmt.Sprintf("%s %s ?", wrappedCol, operator)
-				args = append(args, w.Value)
-			}
-		}
+	whereSQL, whereArgs, err := g.compileWheres(qb.wheres)
+	if err != nil {
+		return "", nil, err
 	}
+	sql += whereSQL
+	args = append(args, whereArgs...)
 
 	// ORDER BY clause'ları ekle
 	if len(qb.orders) > 0 {
@@ -211,6 +160,125 @@ func (g *MySQLGrammar) CompileSelect(qb *QueryBuilder) (string, []interface{}, e
 	return sql, args, nil
 }
 
+// compileWheres, WHERE clause'larını SQL'e ve parametrelere dönüştürür.
+// SELECT, UPDATE ve DELETE aynı kuralları kullanır (IN, BETWEEN, IS NULL vb.).
+func (g *MySQLGrammar) compileWheres(wheres []WhereClause) (string, []interface{}, error) {
+	if len(wheres) == 0 {
+		return "", nil, nil
+	}
+
+	conditions, args, err := g.compileConditions(wheres)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return " WHERE " + conditions, args, nil
+}
+
+// compileConditions, where listesini WHERE anahtar kelimesi olmadan derler.
+// Gruplar (Nested) parantez içinde özyinelemeli olarak derlenir.
+func (g *MySQLGrammar) compileConditions(wheres []WhereClause) (string, []interface{}, error) {
+	sql := ""
+	var args []interface{}
+
+	for i, w := range wheres {
+		// Koşul grubu: parantez içinde derlenir, kolon/operatör kullanılmaz
+		if len(w.Nested) > 0 {
+			groupSQL, groupArgs, err := g.compileConditions(w.Nested)
+			if err != nil {
+				return "", nil, err
+			}
+			if i > 0 {
+				sql += fmt.Sprintf(" %s ", w.Boolean)
+			}
+			sql += "(" + groupSQL + ")"
+			args = append(args, groupArgs...)
+			continue
+		}
+
+		// Operatörü validate et
+		if err := g.validateOperator(w.Operator); err != nil {
+			return "", nil, fmt.Errorf("where clause error: %w", err)
+		}
+
+		// Kolon adını her zaman wrap et; ham SQL ifadesi kabul edilmez.
+		// Tarih fonksiyonları (WhereDate vb.) whitelist'ten uygulanır.
+		wrappedCol, err := g.Wrap(w.Column)
+		if err != nil {
+			return "", nil, fmt.Errorf("where column wrap error: %w", err)
+		}
+		if w.Function != "" {
+			function := strings.ToUpper(w.Function)
+			if !allowedWhereFunctions[function] {
+				return "", nil, fmt.Errorf("invalid where function: %s (not in whitelist)", w.Function)
+			}
+			wrappedCol = fmt.Sprintf("%s(%s)", function, wrappedCol)
+		}
+
+		// AND/OR ekle
+		if i > 0 {
+			sql += fmt.Sprintf(" %s ", w.Boolean)
+		}
+
+		operator := strings.ToUpper(w.Operator)
+
+		// Operatör tipine göre SQL oluştur
+		switch operator {
+		case "IN", "NOT IN":
+			// Alt sorgu (WhereInSub): tek kolon seçmeli, parametreleri sırayla eklenir
+			if sub, ok := w.Value.(*QueryBuilder); ok {
+				if len(sub.columns) != 1 || sub.columns[0] == "*" {
+					return "", nil, fmt.Errorf("IN subquery must select exactly one column")
+				}
+				subSQL, subArgs, err := g.CompileSelect(sub.withSoftDeleteScope())
+				if err != nil {
+					return "", nil, fmt.Errorf("IN subquery error: %w", err)
+				}
+				sql += fmt.Sprintf("%s %s (%s)", wrappedCol, operator, subSQL)
+				args = append(args, subArgs...)
+				break
+			}
+
+			// IN ve NOT IN için değerler dizisi
+			values, ok := w.Value.([]interface{})
+			if !ok {
+				return "", nil, fmt.Errorf("IN/NOT IN operator requires []interface{} value")
+			}
+			placeholders := make([]string, len(values))
+			for j := range values {
+				placeholders[j] = "?"
+			}
+			sql += fmt.Sprintf("%s %s (%s)", wrappedCol, operator, strings.Join(placeholders, ", "))
+			args = append(args, values...)
+
+		case "BETWEEN", "NOT BETWEEN":
+			// BETWEEN için iki değer gerekli
+			values, ok := w.Value.([]interface{})
+			if !ok || len(values) != 2 {
+				return "", nil, fmt.Errorf("BETWEEN operator requires exactly 2 values")
+			}
+			sql += fmt.Sprintf("%s %s ? AND ?", wrappedCol, operator)
+			args = append(args, values[0], values[1])
+
+		case "IS", "IS NOT":
+			// NULL kontrolü için
+			if w.Value == nil {
+				sql += fmt.Sprintf("%s %s NULL", wrappedCol, operator)
+			} else {
+				sql += fmt.Sprintf("%s %s ?", wrappedCol, operator)
+				args = append(args, w.Value)
+			}
+
+		default:
+			// Standart operatörler (=, !=, <, >, LIKE, vb.)
+			sql += fmt.Sprintf("%s %s ?", wrappedCol, operator)
+			args = append(args, w.Value)
+		}
+	}
+
+	return sql, args, nil
+}
+
 // CompileInsert, INSERT sorgusu üretir.
 func (g *MySQLGrammar) CompileInsert(table string, data map[string]interface{}) (string, []interface{}, error) {
 	// Tablo adını wrap et
@@ -259,6 +327,14 @@ func (g *MySQLGrammar) CompileUpdate(table string, data map[string]interface{},
 		if err != nil {
 			return "", nil, fmt.Errorf("column wrap error: %w", err)
 		}
+
+		// Increment: kolon kendi değeri üzerinden güncellenir
+		if increment, ok := v.(Increment); ok {
+			sets = append(sets, fmt.Sprintf("%s = %s + ?", wrappedCol, wrappedCol))
+			args = append(args, int64(increment))
+			continue
+		}
+
 		sets = append(sets, fmt.Sprintf("%s = ?", wrappedCol))
 		args = append(args, v)
 	}
@@ -266,27 +342,12 @@ func (g *MySQLGrammar) CompileUpdate(table string, data map[string]interface{},
 	sql := fmt.Sprintf("UPDATE %s SET %s", wrappedTable, strings.Join(sets, ", "))
 
 	// WHERE clause'ları ekle
-	if len(wheres) > 0 {
-		sql += " WHERE "
-		for i, w := range wheres {
-			// Operatörü validate et
-			if err := g.validateOperator(w.Operator); err != nil {
-				return "", nil, fmt.Errorf("where operator error: %w", err)
-			}
-
-			// Kolon adını wrap et
-			wrappedCol, err := g.Wrap(w.Column)
-			if err != nil {
-				return "", nil, fmt.Errorf("where column wrap error: %w", err)
-			}
-
-			if i > 0 {
-				sql += fmt.Sprintf(" %s ", w.Boolean)
-			}
-			sql += fmt.Sprintf("%s %s ?", wrappedCol, strings.ToUpper(w.Operator))
-			args = append(args, w.Value)
-		}
+	whereSQL, whereArgs, err := g.compileWheres(wheres)
+	if err != nil {
+		return "", nil, err
 	}
+	sql += whereSQL
+	args = append(args, whereArgs...)
 
 	return sql, args, nil
 }
@@ -303,27 +364,12 @@ func (g *MySQLGrammar) CompileDelete(table string, wheres []WhereClause) (string
 	var args []interface{}
 
 	// WHERE clause'ları ekle
-	if len(wheres) > 0 {
-		sql += " WHERE "
-		for i, w := range wheres {
-			// Operatörü validate et
-			if err := g.validateOperator(w.Operator); err != nil {
-				return "", nil, fmt.Errorf("where operator error: %w", err)
-			}
-
-			// Kolon adını wrap et
-			wrappedCol, err := g.Wrap(w.Column)
-			if err != nil {
-				return "", nil, fmt.Errorf("where column wrap error: %w", err)
-			}
-
-			if i > 0 {
-				sql += fmt.Sprintf(" %s ", w.Boolean)
-			}
-			sql += fmt.Sprintf("%s %s ?", wrappedCol, strings.ToUpper(w.Operator))
-			args = append(args, w.Value)
-		}
+	whereSQL, whereArgs, err := g.compileWheres(wheres)
+	if err != nil {
+		return "", nil, err
 	}
+	sql += whereSQL
+	args = append(args, whereArgs...)
 
 	return sql, args, nil
 }
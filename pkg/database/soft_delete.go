@@ -0,0 +1,214 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// SOFT DELETE SCOPE
+// -----------------------------------------------------------------------------
+// Bu dosya, QueryBuilder için soft delete (çöp kutusu) desteğini içerir.
+// SoftDeletes() ile işaretlenen builder'larda silinmiş (deleted_at dolu)
+// kayıtlar varsayılan olarak tüm SELECT / UPDATE sorgularından çıkarılır ve
+// ExecDelete kaydı fiziksel olarak silmek yerine deleted_at'i doldurur.
+//
+// Kapsam, sorgu derlenirken eklenir; builder'ın where listesi değiştirilmez.
+// Bu sayede ToSQL() tekrar tekrar çağrılsa da koşul tekrarlanmaz.
+//
+// Örnek:
+//
+//	qb.Table("events").SoftDeletes().Where("id", "=", 1)
+//	→ SQL: WHERE `id` = ? AND `deleted_at` IS NULL
+//
+//	qb.Table("events").SoftDeletes().OnlyTrashed()
+//	→ SQL: WHERE `deleted_at` IS NOT NULL
+//
+//	qb.Table("events").SoftDeletes().Where("a", "=", 1).OrWhere("b", "=", 2)
+//	→ SQL: WHERE (`a` = ? OR `b` = ?) AND `deleted_at` IS NULL
+//
+// OR içeren koşullar kapsamdan önce parantez içinde gruplanır; böylece kapsam
+// her OR dalına uygulanır ve dallara ayrıca WhereNull(...) eklemek gerekmez.
+// -----------------------------------------------------------------------------
+
+// DefaultSoftDeleteColumn, soft delete kolonunun varsayılan adıdır.
+const DefaultSoftDeleteColumn = "deleted_at"
+
+// trashedScope, silinmiş kayıtların sorguya nasıl dahil edileceğini belirler.
+type trashedScope int
+
+const (
+	withoutTrashed trashedScope = iota // Varsayılan: silinmiş kayıtlar hariç
+	withTrashed                        // Silinmiş kayıtlar dahil
+	onlyTrashed                        // Sadece silinmiş kayıtlar
+)
+
+// SoftDeletes, builder için soft delete kapsamını etkinleştirir.
+//
+// Parametre:
+//   - column: Soft delete kolonu (opsiyonel, varsayılan "deleted_at")
+//
+// Döndürür:
+//   - *QueryBuilder: Zincirleme için kendi instance'ını döner
+//
+// Örnek:
+//
+//	qb.Table("venues").SoftDeletes()
+//	qb.Table("archives").SoftDeletes("archived_at")
+func (qb *QueryBuilder) SoftDeletes(column ...string) *QueryBuilder {
+	name := DefaultSoftDeleteColumn
+	if len(column) > 0 && column[0] != "" {
+		name = column[0]
+	}
+
+	validateIdentifier(name, "column")
+
+	qb.softDeleteColumn = name
+	return qb
+}
+
+// WithTrashed, silinmiş kayıtları da sorguya dahil eder.
+//
+// Döndürür:
+//   - *QueryBuilder: Zincirleme için kendi instance'ını döner
+func (qb *QueryBuilder) WithTrashed() *QueryBuilder {
+	qb.trashed = withTrashed
+	return qb
+}
+
+// OnlyTrashed, sorguyu sadece silinmiş kayıtlarla sınırlar.
+//
+// Döndürür:
+//   - *QueryBuilder: Zincirleme için kendi instance'ını döner
+//
+// Kullanım Senaryosu:
+// Admin çöp kutusu listeleri ve geri yükleme işlemleri.
+func (qb *QueryBuilder) OnlyTrashed() *QueryBuilder {
+	qb.trashed = onlyTrashed
+	return qb
+}
+
+// ExecSoftDelete, eşleşen aktif kayıtların soft delete kolonunu şimdiki
+// zamanla doldurur. Zaten silinmiş kayıtlara dokunulmaz.
+//
+// Döndürür:
+//   - sql.Result: RowsAffected() metodunu içerir
+//   - error: Builder soft delete kapsamlı değilse veya sorgu hatası varsa
+//
+// Örnek:
+//
+//	result, err := qb.Table("events").SoftDeletes().
+//	    Where("id", "=", 1).
+//	    ExecSoftDelete()
+func (qb *QueryBuilder) ExecSoftDelete() (sql.Result, error) {
+	if qb.softDeleteColumn == "" {
+		return nil, fmt.Errorf("soft delete is not enabled for table %s", qb.table)
+	}
+
+	scoped := *qb
+	scoped.trashed = withoutTrashed
+
+	return scoped.ExecUpdate(map[string]interface{}{
+		qb.softDeleteColumn: time.Now(),
+	})
+}
+
+// ExecRestore, eşleşen silinmiş kayıtların soft delete kolonunu temizler.
+// Aktif kayıtlara dokunulmaz.
+//
+// Döndürür:
+//   - sql.Result: RowsAffected() metodunu içerir (0 = geri yüklenecek kayıt yok)
+//   - error: Builder soft delete kapsamlı değilse veya sorgu hatası varsa
+//
+// Örnek:
+//
+//	result, err := qb.Table("events").SoftDeletes().
+//	    Where("id", "=", 1).
+//	    ExecRestore()
+func (qb *QueryBuilder) ExecRestore() (sql.Result, error) {
+	if qb.softDeleteColumn == "" {
+		return nil, fmt.Errorf("soft delete is not enabled for table %s", qb.table)
+	}
+
+	scoped := *qb
+	scoped.trashed = onlyTrashed
+
+	return scoped.ExecUpdate(map[string]interface{}{
+		qb.softDeleteColumn: nil,
+	})
+}
+
+// ExecForceDelete, kayıtları soft delete kapsamını dikkate alarak fiziksel
+// olarak siler. Kapsam WithTrashed / OnlyTrashed ile genişletilmedikçe
+// sadece aktif kayıtlar silinir.
+//
+// Döndürür:
+//   - sql.Result: RowsAffected() metodunu içerir
+//   - error: Sorgu hatası varsa
+//
+// Örnek (çöp kutusunu boşaltma):
+//
+//	result, err := qb.Table("events").SoftDeletes().
+//	    OnlyTrashed().
+//	    Where("deleted_at", "<", cutoff).
+//	    ExecForceDelete()
+//
+// GÜVENLİK UYARISI:
+// Bu işlem geri alınamaz; ExecDelete ile aynı WHERE kuralları geçerlidir.
+func (qb *QueryBuilder) ExecForceDelete() (sql.Result, error) {
+	sqlStr, args, err := qb.grammar.CompileDelete(qb.table, qb.scopedWheres())
+	if err != nil {
+		return nil, fmt.Errorf("delete compilation failed: %w", err)
+	}
+	return qb.executor.Exec(sqlStr, args...)
+}
+
+// scopedWheres, soft delete kapsam koşulu eklenmiş where listesini döndürür.
+// Builder'ın kendi where listesi değiştirilmez.
+func (qb *QueryBuilder) scopedWheres() []WhereClause {
+	if qb.softDeleteColumn == "" || qb.trashed == withTrashed {
+		return qb.wheres
+	}
+
+	operator := "IS"
+	if qb.trashed == onlyTrashed {
+		operator = "IS NOT"
+	}
+
+	wheres := make([]WhereClause, 0, len(qb.wheres)+1)
+	if hasOrWhere(qb.wheres) {
+		// OR dalları gruplanmazsa kapsam sadece son dala bağlanır
+		wheres = append(wheres, WhereClause{Nested: qb.wheres, Boolean: "AND"})
+	} else {
+		wheres = append(wheres, qb.wheres...)
+	}
+
+	return append(wheres, WhereClause{
+		Column:   qb.softDeleteColumn,
+		Operator: operator,
+		Value:    nil,
+		Boolean:  "AND",
+	})
+}
+
+// hasOrWhere, listede OR ile bağlanan bir koşul olup olmadığını bildirir.
+func hasOrWhere(wheres []WhereClause) bool {
+	for i, w := range wheres {
+		if i > 0 && w.Boolean == "OR" {
+			return true
+		}
+	}
+	return false
+}
+
+// withSoftDeleteScope, SELECT derlemesi için kapsam uygulanmış bir kopya döndürür.
+func (qb *QueryBuilder) withSoftDeleteScope() *QueryBuilder {
+	if qb.softDeleteColumn == "" {
+		return qb
+	}
+
+	scoped := *qb
+	scoped.wheres = qb.scopedWheres()
+	return &scoped
+}
@@ -0,0 +1,327 @@
+package database
+
+import (
+	"database/sql"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// SOFT DELETE SCOPE TESTLERİ
+// -----------------------------------------------------------------------------
+// Bu testler, SoftDeletes kapsamının SELECT / UPDATE / DELETE sorgularına
+// doğru uygulandığını ve builder state'ini değiştirmediğini doğrular.
+// -----------------------------------------------------------------------------
+
+// recordingExecutor, çalıştırılan son Exec sorgusunu kaydeder
+type recordingExecutor struct {
+	query string
+	args  []interface{}
+}
+
+func (e *recordingExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	e.query = query
+	e.args = args
+	return driverResult(0), nil
+}
+
+func (e *recordingExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, sql.ErrConnDone
+}
+
+func (e *recordingExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+type driverResult int64
+
+func (r driverResult) LastInsertId() (int64, error) { return int64(r), nil }
+func (r driverResult) RowsAffected() (int64, error) { return int64(r), nil }
+
+// TestSoftDeletes_ExcludesTrashedByDefault tests the default scope
+func TestSoftDeletes_ExcludesTrashedByDefault(t *testing.T) {
+	qb := NewBuilder(nil, NewMySQLGrammar())
+
+	qb.Table("events").
+		Select("id").
+		SoftDeletes().
+		Where("status", "=", "published")
+
+	expected := "SELECT `id` FROM `events` WHERE `status` = ? AND `deleted_at` IS NULL"
+
+	// ToSQL birden fazla çağrıldığında koşul tekrarlanmamalı
+	for i := 0; i < 2; i++ {
+		sql, args, err := qb.ToSQL()
+		if err != nil {
+			t.Fatalf("Failed to compile SQL: %v", err)
+		}
+		if sql != expected {
+			t.Errorf("Expected:\n%s\nGot:\n%s", expected, sql)
+		}
+		if len(args) != 1 {
+			t.Errorf("Expected 1 arg, got %d", len(args))
+		}
+	}
+}
+
+// TestSoftDeletes_WithTrashed tests that WithTrashed removes the scope
+func TestSoftDeletes_WithTrashed(t *testing.T) {
+	qb := NewBuilder(nil, NewMySQLGrammar())
+
+	qb.Table("events").
+		Select("id").
+		SoftDeletes().
+		WithTrashed().
+		Where("id", "=", 1)
+
+	sql, _, err := qb.ToSQL()
+	if err != nil {
+		t.Fatalf("Failed to compile SQL: %v", err)
+	}
+
+	expected := "SELECT `id` FROM `events` WHERE `id` = ?"
+	if sql != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, sql)
+	}
+}
+
+// TestSoftDeletes_OnlyTrashed tests trash listings
+func TestSoftDeletes_OnlyTrashed(t *testing.T) {
+	qb := NewBuilder(nil, NewMySQLGrammar())
+
+	qb.Table("venues").
+		Select("id").
+		SoftDeletes().
+		OnlyTrashed()
+
+	sql, args, err := qb.ToSQL()
+	if err != nil {
+		t.Fatalf("Failed to compile SQL: %v", err)
+	}
+
+	expected := "SELECT `id` FROM `venues` WHERE `deleted_at` IS NOT NULL"
+	if sql != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, sql)
+	}
+	if len(args) != 0 {
+		t.Errorf("Expected no args, got %d", len(args))
+	}
+}
+
+// TestSoftDeletes_CustomColumn tests a non-default soft delete column
+func TestSoftDeletes_CustomColumn(t *testing.T) {
+	qb := NewBuilder(nil, NewMySQLGrammar())
+
+	qb.Table("archives").
+		Select("id").
+		SoftDeletes("archived_at")
+
+	sql, _, err := qb.ToSQL()
+	if err != nil {
+		t.Fatalf("Failed to compile SQL: %v", err)
+	}
+
+	expected := "SELECT `id` FROM `archives` WHERE `archived_at` IS NULL"
+	if sql != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, sql)
+	}
+}
+
+// TestSoftDeletes_GroupsOrWheres tests that the scope applies to every OR branch
+func TestSoftDeletes_GroupsOrWheres(t *testing.T) {
+	qb := NewBuilder(nil, NewMySQLGrammar())
+
+	qb.Table("events").
+		Select("id").
+		SoftDeletes().
+		Where("name", "LIKE", "%rock%").
+		OrWhere("description", "LIKE", "%rock%")
+
+	sql, args, err := qb.ToSQL()
+	if err != nil {
+		t.Fatalf("Failed to compile SQL: %v", err)
+	}
+
+	expected := "SELECT `id` FROM `events` WHERE (`name` LIKE ? OR `description` LIKE ?) AND `deleted_at` IS NULL"
+	if sql != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, sql)
+	}
+	if len(args) != 2 {
+		t.Errorf("Expected 2 args, got %d", len(args))
+	}
+
+	// UPDATE da aynı gruplamayı kullanmalı
+	exec := &recordingExecutor{}
+	qb = NewBuilder(exec, NewMySQLGrammar())
+
+	_, err = qb.Table("events").
+		SoftDeletes().
+		Where("id", "=", 1).
+		OrWhere("id", "=", 2).
+		ExecUpdate(map[string]interface{}{"status": "cancelled"})
+	if err != nil {
+		t.Fatalf("ExecUpdate failed: %v", err)
+	}
+
+	expected = "UPDATE `events` SET `status` = ? WHERE (`id` = ? OR `id` = ?) AND `deleted_at` IS NULL"
+	if exec.query != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, exec.query)
+	}
+}
+
+// TestSoftDeletes_ExecDeleteSoftDeletes tests that ExecDelete does not remove rows
+func TestSoftDeletes_ExecDeleteSoftDeletes(t *testing.T) {
+	executor := &recordingExecutor{}
+
+	_, err := NewBuilder(executor, NewMySQLGrammar()).
+		Table("events").
+		SoftDeletes().
+		Where("id", "=", 7).
+		ExecDelete()
+	if err != nil {
+		t.Fatalf("ExecDelete failed: %v", err)
+	}
+
+	expected := "UPDATE `events` SET `deleted_at` = ? WHERE `id` = ? AND `deleted_at` IS NULL"
+	if executor.query != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, executor.query)
+	}
+	if len(executor.args) != 2 {
+		t.Errorf("Expected 2 args, got %d", len(executor.args))
+	}
+}
+
+// TestSoftDeletes_ExecRestore tests that restore only touches trashed rows
+func TestSoftDeletes_ExecRestore(t *testing.T) {
+	executor := &recordingExecutor{}
+
+	_, err := NewBuilder(executor, NewMySQLGrammar()).
+		Table("events").
+		SoftDeletes().
+		Where("id", "=", 7).
+		ExecRestore()
+	if err != nil {
+		t.Fatalf("ExecRestore failed: %v", err)
+	}
+
+	expected := "UPDATE `events` SET `deleted_at` = ? WHERE `id` = ? AND `deleted_at` IS NOT NULL"
+	if executor.query != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, executor.query)
+	}
+	if executor.args[0] != nil {
+		t.Errorf("Expected deleted_at to be cleared, got %v", executor.args[0])
+	}
+}
+
+// TestSoftDeletes_ExecForceDelete tests physical deletion of trashed rows
+func TestSoftDeletes_ExecForceDelete(t *testing.T) {
+	executor := &recordingExecutor{}
+
+	_, err := NewBuilder(executor, NewMySQLGrammar()).
+		Table("events").
+		SoftDeletes().
+		OnlyTrashed().
+		Where("deleted_at", "<", "2024-01-01").
+		ExecForceDelete()
+	if err != nil {
+		t.Fatalf("ExecForceDelete failed: %v", err)
+	}
+
+	expected := "DELETE FROM `events` WHERE `deleted_at` < ? AND `deleted_at` IS NOT NULL"
+	if executor.query != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, executor.query)
+	}
+	if len(executor.args) != 1 {
+		t.Errorf("Expected 1 arg, got %d", len(executor.args))
+	}
+}
+
+// TestSoftDeletes_RequiresScope tests that soft delete helpers need SoftDeletes()
+func TestSoftDeletes_RequiresScope(t *testing.T) {
+	executor := &recordingExecutor{}
+	qb := NewBuilder(executor, NewMySQLGrammar()).Table("users").Where("id", "=", 1)
+
+	if _, err := qb.ExecSoftDelete(); err == nil {
+		t.Error("Expected error for ExecSoftDelete without SoftDeletes()")
+	}
+	if _, err := qb.ExecRestore(); err == nil {
+		t.Error("Expected error for ExecRestore without SoftDeletes()")
+	}
+	if executor.query != "" {
+		t.Errorf("Expected no query to run, got %s", executor.query)
+	}
+}
+
+// TestCompileWheres_RejectsRawColumns tests that UPDATE / DELETE wheres never pass
+// a column through unwrapped (the soft delete scope only adds a plain column)
+func TestCompileWheres_RejectsRawColumns(t *testing.T) {
+	grammar := NewMySQLGrammar()
+	data := map[string]interface{}{"status": "cancelled"}
+
+	tests := []struct {
+		name  string
+		where WhereClause
+	}{
+		{"subquery", WhereClause{Column: "(SELECT 1)", Operator: "=", Value: 1, Boolean: "AND"}},
+		{"expression", WhereClause{Column: "(1=1) OR (id", Operator: "=", Value: 1, Boolean: "AND"}},
+		{"function in column", WhereClause{Column: "SLEEP(5)", Operator: "=", Value: 0, Boolean: "AND"}},
+		{"unknown function", WhereClause{Column: "id", Function: "SLEEP", Operator: "=", Value: 0, Boolean: "AND"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := grammar.CompileUpdate("events", data, []WhereClause{tt.where}); err == nil {
+				t.Error("Expected CompileUpdate to reject the where column")
+			}
+			if _, _, err := grammar.CompileDelete("events", []WhereClause{tt.where}); err == nil {
+				t.Error("Expected CompileDelete to reject the where column")
+			}
+		})
+	}
+}
+
+// TestCompileWheres_DateFunctions tests that whitelisted date functions wrap the column
+func TestCompileWheres_DateFunctions(t *testing.T) {
+	executor := &recordingExecutor{}
+
+	_, err := NewBuilder(executor, NewMySQLGrammar()).
+		Table("events").
+		SoftDeletes().
+		WhereDate("deleted_at", "2024-01-15").
+		ExecForceDelete()
+	if err != nil {
+		t.Fatalf("ExecForceDelete failed: %v", err)
+	}
+
+	expected := "DELETE FROM `events` WHERE DATE(`deleted_at`) = ? AND `deleted_at` IS NULL"
+	if executor.query != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, executor.query)
+	}
+}
+
+// TestCompileWheres_Bindings tests that NULL checks bind no value and date
+// functions bind exactly one
+func TestCompileWheres_Bindings(t *testing.T) {
+	grammar := NewMySQLGrammar()
+
+	tests := []struct {
+		name     string
+		builder  *QueryBuilder
+		expected int
+	}{
+		{"where not null", NewBuilder(nil, grammar).Table("users").WhereNotNull("email_verified_at"), 0},
+		{"where month", NewBuilder(nil, grammar).Table("sales").WhereMonth("sale_date", 12), 1},
+		{"where day", NewBuilder(nil, grammar).Table("appointments").WhereDay("scheduled_at", 15), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, args, err := tt.builder.ToSQL()
+			if err != nil {
+				t.Fatalf("Failed to compile SQL: %v", err)
+			}
+			if len(args) != tt.expected {
+				t.Errorf("Expected %d args, got %d", tt.expected, len(args))
+			}
+		})
+	}
+}
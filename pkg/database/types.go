@@ -55,7 +55,9 @@ type WhereClause struct {
 	Column   string
 	Operator string
 	Value    interface{}
-	Boolean  string // "AND" veya "OR"
+	Boolean  string        // "AND" veya "OR"
+	Function string        // Kolona uygulanan tarih fonksiyonu (DATE, YEAR, MONTH, DAY); boşsa sadece kolon
+	Nested   []WhereClause // Parantez içinde derlenen koşul grubu; doluysa Column/Operator kullanılmaz
 }
 
 // JoinType, JOIN tiplerini temsil eden enum-like yapıdır.
@@ -95,3 +97,13 @@ type JoinClause struct {
 	Operator string
 	Second   string
 }
+
+// Increment, UPDATE verisinde kolonun mevcut değerine göre artırılacağını belirtir.
+// Okunan değerin geri yazılması (blind write) yerine atomik güncelleme sağlar;
+// negatif değer azaltır. Artış miktarı prepared statement ile bağlanır.
+//
+// Örnek Kullanım:
+//
+//	qb.ExecUpdate(map[string]interface{}{"version": Increment(1)})
+//	→ SQL: UPDATE ... SET `version` = `version` + ?
+type Increment int64
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"database/sql"
+)
+
+// -----------------------------------------------------------------------------
+// OPTIMISTIC LOCKING (VERSION KOLONU)
+// -----------------------------------------------------------------------------
+// Bu dosya, version kolonu ile iyimser eşzamanlılık kontrolü (optimistic
+// concurrency control) desteğini içerir. Kayıt okunurken version değeri de
+// okunur; güncelleme sadece version değişmemişse uygulanır ve version bir
+// artırılır. Araya başka bir yazma girdiyse güncelleme hiçbir satırı
+// etkilemez (RowsAffected = 0) ve çağıran çakışmayı raporlar.
+//
+// Örnek:
+//
+//	result, err := qb.Table("events").
+//	    Where("id", "=", event.ID).
+//	    ExecUpdateIfVersion(map[string]interface{}{"name": event.Name}, event.Version)
+//	→ SQL: UPDATE `events` SET `name` = ?, `version` = `version` + ? WHERE `id` = ? AND `version` = ?
+//
+// Koşulsuz güncellemeler (durum geçişleri vb.) da version'ı artırmalıdır;
+// aksi halde eski version ile yapılan güncelleme çakışmayı fark edemez:
+//
+//	qb.ExecUpdate(map[string]interface{}{"status": "sold", "version": Increment(1)})
+//
+// İstisna - atomik sayaçlar: sadece Increment ile (veya satır kilidi altında)
+// değişen ve version koşullu güncellemelerin hiç yazmadığı sayaç kolonları
+// (ör. events.available_seats) version'ı artırmaz. Böyle bir kolonu eski
+// değerle ezen bir yazma olmadığından çakışma kaçırılmaz; satış sırasında
+// yapılan kayıt düzenlemeleri de her bilet satışında gereksiz yere çakışmaz.
+// -----------------------------------------------------------------------------
+
+// VersionColumn, iyimser kilitleme için kullanılan kolonun adıdır.
+const VersionColumn = "version"
+
+// WhereVersion, sorguyu belirtilen version değerine sahip kayıtlarla sınırlar.
+//
+// Parametre:
+//   - version: Kaydın okunduğu andaki version değeri
+//
+// Döndürür:
+//   - *QueryBuilder: Zincirleme için kendi instance'ını döner
+func (qb *QueryBuilder) WhereVersion(version int64) *QueryBuilder {
+	return qb.Where(VersionColumn, "=", version)
+}
+
+// ExecUpdateIfVersion, UPDATE sorgusunu sadece kaydın version değeri
+// beklenen değere eşitse çalıştırır ve version'ı bir artırır.
+//
+// Parametreler:
+//   - data: Güncellenecek veri (kolon adı -> değer mapping)
+//   - version: Kaydın okunduğu andaki version değeri
+//
+// Döndürür:
+//   - sql.Result: RowsAffected() = 0 ise kayıt yok veya başka bir işlem tarafından değiştirilmiş
+//   - error: Sorgu hatası varsa
+//
+// Not: Builder'ın where listesi ve data map'i değiştirilmez.
+func (qb *QueryBuilder) ExecUpdateIfVersion(data map[string]interface{}, version int64) (sql.Result, error) {
+	values := make(map[string]interface{}, len(data)+1)
+	for column, value := range data {
+		values[column] = value
+	}
+	values[VersionColumn] = Increment(1)
+
+	scoped := *qb
+	scoped.wheres = append(make([]WhereClause, 0, len(qb.wheres)+1), qb.wheres...)
+	scoped.WhereVersion(version)
+
+	return scoped.ExecUpdate(values)
+}
@@ -0,0 +1,90 @@
+package database
+
+import (
+	"strings"
+	"testing"
+)
+
+// -----------------------------------------------------------------------------
+// OPTIMISTIC LOCKING TESTLERİ
+// -----------------------------------------------------------------------------
+// Bu testler, Increment değerinin ve ExecUpdateIfVersion'ın doğru UPDATE
+// sorgusunu ürettiğini doğrular.
+// -----------------------------------------------------------------------------
+
+// TestIncrement_CompilesRelativeUpdate tests that Increment is not a blind write
+func TestIncrement_CompilesRelativeUpdate(t *testing.T) {
+	executor := &recordingExecutor{}
+
+	_, err := NewBuilder(executor, NewMySQLGrammar()).
+		Table("events").
+		Where("id", "=", 1).
+		ExecUpdate(map[string]interface{}{
+			"available_seats": Increment(-2),
+		})
+	if err != nil {
+		t.Fatalf("ExecUpdate failed: %v", err)
+	}
+
+	expected := "UPDATE `events` SET `available_seats` = `available_seats` + ? WHERE `id` = ?"
+	if executor.query != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, executor.query)
+	}
+	if len(executor.args) != 2 || executor.args[0] != int64(-2) {
+		t.Errorf("Expected args [-2 1], got %v", executor.args)
+	}
+}
+
+// TestExecUpdateIfVersion_AddsVersionCondition tests the conditional update
+func TestExecUpdateIfVersion_AddsVersionCondition(t *testing.T) {
+	executor := &recordingExecutor{}
+	qb := NewBuilder(executor, NewMySQLGrammar()).
+		Table("events").
+		Where("id", "=", 5)
+
+	data := map[string]interface{}{"name": "Konser"}
+
+	if _, err := qb.ExecUpdateIfVersion(data, 3); err != nil {
+		t.Fatalf("ExecUpdateIfVersion failed: %v", err)
+	}
+
+	// SET sırası map'ten geldiği için sabit değil
+	if !strings.HasPrefix(executor.query, "UPDATE `events` SET ") {
+		t.Errorf("Unexpected query: %s", executor.query)
+	}
+	if !strings.Contains(executor.query, "`version` = `version` + ?") {
+		t.Errorf("Expected version increment, got: %s", executor.query)
+	}
+	if !strings.HasSuffix(executor.query, " WHERE `id` = ? AND `version` = ?") {
+		t.Errorf("Expected version condition, got: %s", executor.query)
+	}
+	if len(executor.args) != 4 || executor.args[3] != int64(3) {
+		t.Errorf("Expected expected version as last arg, got %v", executor.args)
+	}
+
+	// Builder ve data değişmemeli
+	if len(data) != 1 {
+		t.Errorf("Expected data to be left untouched, got %v", data)
+	}
+	if len(qb.wheres) != 1 {
+		t.Errorf("Expected builder wheres to be left untouched, got %d", len(qb.wheres))
+	}
+}
+
+// TestExecUpdateIfVersion_WithSoftDeletes tests versioned updates on soft delete builders
+func TestExecUpdateIfVersion_WithSoftDeletes(t *testing.T) {
+	executor := &recordingExecutor{}
+
+	_, err := NewBuilder(executor, NewMySQLGrammar()).
+		Table("events").
+		SoftDeletes().
+		Where("id", "=", 5).
+		ExecUpdateIfVersion(map[string]interface{}{"name": "Konser"}, 3)
+	if err != nil {
+		t.Fatalf("ExecUpdateIfVersion failed: %v", err)
+	}
+
+	if !strings.HasSuffix(executor.query, " WHERE `id` = ? AND `version` = ? AND `deleted_at` IS NULL") {
+		t.Errorf("Expected version and soft delete conditions, got: %s", executor.query)
+	}
+}
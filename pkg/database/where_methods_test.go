@@ -6,6 +6,7 @@
 //
 // Test edilen metodlar:
 // - WhereIn / WhereNotIn
+// - WhereInSub
 // - WhereBetween / WhereNotBetween
 // - WhereNull / WhereNotNull
 // - WhereDate, WhereYear, WhereMonth, WhereDay
@@ -201,7 +202,7 @@ func TestWhereNotNull_BasicUsage(t *testing.T) {
 		Select("id", "name").
 		WhereNotNull("email_verified_at")
 
-	sql, args, err := qb.ToSQL()
+	sql, _, err := qb.ToSQL()
 	if err != nil {
 		t.Fatalf("Failed to compile SQL: %v", err)
 	}
@@ -275,7 +276,7 @@ func TestWhereMonth_BasicUsage(t *testing.T) {
 		Select("id", "amount").
 		WhereMonth("sale_date", 12)
 
-	sql, args, err := qb.ToSQL()
+	sql, _, err := qb.ToSQL()
 	if err != nil {
 		t.Fatalf("Failed to compile SQL: %v", err)
 	}
@@ -295,7 +296,7 @@ func TestWhereDay_BasicUsage(t *testing.T) {
 		Select("id", "time").
 		WhereDay("scheduled_at", 15)
 
-	sql, args, err := qb.ToSQL()
+	sql, _, err := qb.ToSQL()
 	if err != nil {
 		t.Fatalf("Failed to compile SQL: %v", err)
 	}
@@ -417,6 +418,57 @@ func TestWhereMethods_EmptyArrays(t *testing.T) {
 	}
 }
 
+// TestWhereInSub_BasicUsage tests that a subquery is compiled inline with its bindings.
+func TestWhereInSub_BasicUsage(t *testing.T) {
+	grammar := NewMySQLGrammar()
+	events := NewBuilder(nil, grammar).Table("events").Select("id").Where("organizer_id", "=", 3)
+
+	qb := NewBuilder(nil, grammar)
+	qb.Table("tickets").
+		Where("status", "=", "sold").
+		WhereInSub("event_id", events)
+
+	sql, args, err := qb.ToSQL()
+	if err != nil {
+		t.Fatalf("Failed to compile SQL: %v", err)
+	}
+
+	expected := "SELECT * FROM `tickets` WHERE `status` = ? AND `event_id` IN (SELECT `id` FROM `events` WHERE `organizer_id` = ?)"
+	if sql != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, sql)
+	}
+
+	if len(args) != 2 || args[0] != "sold" || args[1] != 3 {
+		t.Errorf("Expected args [sold 3], got %v", args)
+	}
+}
+
+// TestWhereInSub_SoftDeletes tests that the subquery keeps its own soft delete scope.
+func TestWhereInSub_SoftDeletes(t *testing.T) {
+	grammar := NewMySQLGrammar()
+	events := NewBuilder(nil, grammar).Table("events").SoftDeletes().Select("id")
+
+	sql, _, err := NewBuilder(nil, grammar).Table("tickets").WhereInSub("event_id", events).ToSQL()
+	if err != nil {
+		t.Fatalf("Failed to compile SQL: %v", err)
+	}
+
+	expected := "SELECT * FROM `tickets` WHERE `event_id` IN (SELECT `id` FROM `events` WHERE `deleted_at` IS NULL)"
+	if sql != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, sql)
+	}
+}
+
+// TestWhereInSub_RequiresSingleColumn tests that a subquery selecting all columns is rejected.
+func TestWhereInSub_RequiresSingleColumn(t *testing.T) {
+	grammar := NewMySQLGrammar()
+	events := NewBuilder(nil, grammar).Table("events")
+
+	if _, _, err := NewBuilder(nil, grammar).Table("tickets").WhereInSub("event_id", events).ToSQL(); err == nil {
+		t.Error("Expected an error for a subquery selecting *")
+	}
+}
+
 // BenchmarkWhereIn benchmarks WhereIn performance.
 func BenchmarkWhereIn(b *testing.B) {
 	grammar := NewMySQLGrammar()
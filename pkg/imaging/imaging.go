@@ -0,0 +1,217 @@
+// -----------------------------------------------------------------------------
+// Imaging Package
+// -----------------------------------------------------------------------------
+// Yüklenen görsellerden boyutlandırılmış varyantlar (thumbnail, card, hero)
+// üretir. Sadece standart kütüphane kullanılır (image, image/jpeg, image/png,
+// image/gif).
+//
+// Güvenlik ve gizlilik:
+// - Biçim uzantıdan değil içerikten (magic bytes) belirlenir
+// - Decode öncesi boyut kontrolü yapılır (decompression bomb koruması)
+// - Çıktılar piksellerden yeniden kodlanır; EXIF (GPS konumu, cihaz bilgisi),
+//   XMP, ICC ve yorum blokları çıktıya taşınmaz
+// - JPEG EXIF yönlendirmesi (Orientation) silinmeden önce piksellere uygulanır,
+//   böylece telefon fotoğrafları yan dönmez
+//
+// Kullanım:
+//
+//	result, err := imaging.Process(data, imaging.DefaultVariants, imaging.DefaultMaxPixels)
+//	for _, out := range result.Variants {
+//	    store.Put("images/"+out.Name+out.Extension, out.Data)
+//	}
+// -----------------------------------------------------------------------------
+
+package imaging
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxPixels, kabul edilen en büyük görsel (genişlik × yükseklik)
+const DefaultMaxPixels = 40_000_000
+
+// JPEGQuality, JPEG çıktılarının kalitesidir
+const JPEGQuality = 85
+
+var (
+	ErrUnsupportedFormat = errors.New("imaging: unsupported image format")
+	ErrImageTooLarge     = errors.New("imaging: image dimensions too large")
+	ErrInvalidImage      = errors.New("imaging: invalid image")
+)
+
+// Mode, hedef kutuya sığdırma biçimidir
+type Mode string
+
+const (
+	// ModeCover, kutuyu tamamen doldurur; taşan kısım ortadan kırpılır
+	ModeCover Mode = "cover"
+	// ModeFit, en boy oranını koruyarak kutunun içine sığdırır (kırpma yok)
+	ModeFit Mode = "fit"
+)
+
+// Variant, üretilecek boyut tanımıdır. Görseller hiçbir zaman büyütülmez.
+type Variant struct {
+	Name   string
+	Width  int
+	Height int
+	Mode   Mode
+}
+
+// Varsayılan varyant adları
+const (
+	VariantThumbnail = "thumbnail"
+	VariantCard      = "card"
+	VariantHero      = "hero"
+)
+
+// DefaultVariants, etkinlik ve mekan görsellerinin varyantlarıdır
+var DefaultVariants = []Variant{
+	{Name: VariantThumbnail, Width: 320, Height: 320, Mode: ModeCover},
+	{Name: VariantCard, Width: 800, Height: 450, Mode: ModeCover},
+	{Name: VariantHero, Width: 1920, Height: 1080, Mode: ModeFit},
+}
+
+// Output, kodlanmış bir varyanttır
+type Output struct {
+	Name        string
+	Data        []byte
+	Width       int
+	Height      int
+	ContentType string // image/jpeg veya image/png
+	Extension   string // .jpg veya .png
+}
+
+// Result, işlenmiş görselin bilgileri ve varyantlarıdır
+type Result struct {
+	Format   string // Kaynak biçim: jpeg, png, gif
+	Width    int    // Yönlendirme uygulanmış kaynak genişliği
+	Height   int
+	Variants []*Output
+}
+
+// Process, görseli doğrular, yönlendirmesini düzeltir ve her varyantı üretir.
+// Saydamlık içeren görseller PNG, diğerleri JPEG olarak kodlanır.
+func Process(data []byte, variants []Variant, maxPixels int) (*Result, error) {
+	// 1. Detect format from content
+	format, err := detectFormat(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Check dimensions before decoding the pixels
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, ErrInvalidImage
+	}
+	if maxPixels > 0 && config.Width*config.Height > maxPixels {
+		return nil, ErrImageTooLarge
+	}
+
+	// 3. Decode (GIF: first frame) and apply EXIF orientation
+	decoded, err := decode(format, data)
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	src := toRGBA(decoded)
+	if format == "jpeg" {
+		src = applyOrientation(src, exifOrientation(data))
+	}
+
+	result := &Result{
+		Format: format,
+		Width:  src.Bounds().Dx(),
+		Height: src.Bounds().Dy(),
+	}
+
+	// 4. Resize and re-encode every variant (metadata is not carried over)
+	for _, variant := range variants {
+		resized := resize(src, variant)
+
+		out, err := encode(resized)
+		if err != nil {
+			return nil, fmt.Errorf("imaging: failed to encode %s: %w", variant.Name, err)
+		}
+		out.Name = variant.Name
+		result.Variants = append(result.Variants, out)
+	}
+
+	return result, nil
+}
+
+// ContentPath, içeriğin SHA-256 özetinden depolama yolu üretir:
+// <prefix>/<ilk 2 hex>/<özet><uzantı>. Aynı içerik her zaman aynı yola düşer,
+// böylece tekrar yüklenen görseller yeniden yazılmaz ve URL'ler kalıcı cache'lenebilir.
+func ContentPath(prefix string, data []byte, extension string) string {
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+	return fmt.Sprintf("%s/%s/%s%s", strings.TrimSuffix(prefix, "/"), digest[:2], digest, extension)
+}
+
+// detectFormat sniffs the content type; only formats the standard library decodes are accepted
+func detectFormat(data []byte) (string, error) {
+	switch http.DetectContentType(data) {
+	case "image/jpeg":
+		return "jpeg", nil
+	case "image/png":
+		return "png", nil
+	case "image/gif":
+		return "gif", nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+func decode(format string, data []byte) (image.Image, error) {
+	reader := bytes.NewReader(data)
+	switch format {
+	case "jpeg":
+		return jpeg.Decode(reader)
+	case "png":
+		return png.Decode(reader)
+	default:
+		return gif.Decode(reader)
+	}
+}
+
+// toRGBA converts to premultiplied RGBA with the origin at (0, 0)
+func toRGBA(img image.Image) *image.RGBA {
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Src)
+	return rgba
+}
+
+func encode(img *image.RGBA) (*Output, error) {
+	var buf bytes.Buffer
+	out := &Output{Width: img.Bounds().Dx(), Height: img.Bounds().Dy()}
+
+	if img.Opaque() {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: JPEGQuality}); err != nil {
+			return nil, err
+		}
+		out.ContentType, out.Extension = "image/jpeg", ".jpg"
+	} else {
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, err
+		}
+		out.ContentType, out.Extension = "image/png", ".png"
+	}
+
+	out.Data = buf.Bytes()
+	return out, nil
+}
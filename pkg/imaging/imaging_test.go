@@ -0,0 +1,224 @@
+// -----------------------------------------------------------------------------
+// Imaging Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Varyant boyutları (cover kırpma, fit, büyütmeme)
+// - EXIF yönlendirmesinin uygulanması ve çıktıdan silinmesi
+// - Saydam görsellerin PNG kalması
+// - Desteklenmeyen / bozuk / çok büyük girdilerin reddi
+// - İçerik adresli yol üretimi
+// -----------------------------------------------------------------------------
+
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func solidImage(w, h int, c color.Color) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}); err != nil {
+		t.Fatalf("jpeg encode failed: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png encode failed: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// withOrientation inserts an APP1 EXIF segment carrying the given orientation after SOI
+func withOrientation(data []byte, orientation uint16) []byte {
+	tiff := []byte{'M', 'M', 0, 42, 0, 0, 0, 8}
+	tiff = append(tiff, 0, 1)                         // 1 entry
+	tiff = append(tiff, 0x01, 0x12, 0, 3, 0, 0, 0, 1) // Orientation, SHORT, count 1
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0) // value padding + next IFD
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+func variantByName(t *testing.T, result *Result, name string) *Output {
+	t.Helper()
+	for _, out := range result.Variants {
+		if out.Name == name {
+			return out
+		}
+	}
+	t.Fatalf("variant %s not found", name)
+	return nil
+}
+
+func TestProcess_VariantSizes(t *testing.T) {
+	data := encodeJPEG(t, solidImage(2400, 1600, color.RGBA{200, 40, 40, 255}))
+
+	result, err := Process(data, DefaultVariants, DefaultMaxPixels)
+	if err != nil {
+		t.Fatalf("process failed: %v", err)
+	}
+
+	expected := map[string][2]int{
+		VariantThumbnail: {320, 320},
+		VariantCard:      {800, 450},
+		VariantHero:      {1620, 1080}, // 3:2 oranı korunur
+	}
+	for name, size := range expected {
+		out := variantByName(t, result, name)
+		if out.Width != size[0] || out.Height != size[1] {
+			t.Fatalf("%s: got %dx%d, want %dx%d", name, out.Width, out.Height, size[0], size[1])
+		}
+
+		decoded, err := jpeg.Decode(bytes.NewReader(out.Data))
+		if err != nil {
+			t.Fatalf("%s: output is not a valid jpeg: %v", name, err)
+		}
+		if decoded.Bounds().Dx() != size[0] || decoded.Bounds().Dy() != size[1] {
+			t.Fatalf("%s: encoded size mismatch", name)
+		}
+	}
+}
+
+func TestProcess_NeverUpscales(t *testing.T) {
+	data := encodeJPEG(t, solidImage(400, 300, color.RGBA{10, 10, 10, 255}))
+
+	result, err := Process(data, DefaultVariants, DefaultMaxPixels)
+	if err != nil {
+		t.Fatalf("process failed: %v", err)
+	}
+
+	if hero := variantByName(t, result, VariantHero); hero.Width != 400 || hero.Height != 300 {
+		t.Fatalf("hero should keep source size, got %dx%d", hero.Width, hero.Height)
+	}
+	// 800x450 kutusu için 400x225 kırpılır, büyütülmez
+	if card := variantByName(t, result, VariantCard); card.Width != 400 || card.Height != 225 {
+		t.Fatalf("card should be cropped without upscaling, got %dx%d", card.Width, card.Height)
+	}
+}
+
+func TestProcess_AppliesAndStripsOrientation(t *testing.T) {
+	// Sol yarı kırmızı, sağ yarı mavi; orientation 6 = 90° saat yönünde döndür
+	img := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 400; x++ {
+			if x < 200 {
+				img.Set(x, y, color.RGBA{255, 0, 0, 255})
+			} else {
+				img.Set(x, y, color.RGBA{0, 0, 255, 255})
+			}
+		}
+	}
+	data := withOrientation(encodeJPEG(t, img), 6)
+
+	if got := exifOrientation(data); got != 6 {
+		t.Fatalf("expected orientation 6, got %d", got)
+	}
+
+	result, err := Process(data, []Variant{{Name: "full", Width: 1000, Height: 1000, Mode: ModeFit}}, DefaultMaxPixels)
+	if err != nil {
+		t.Fatalf("process failed: %v", err)
+	}
+	if result.Width != 200 || result.Height != 400 {
+		t.Fatalf("expected rotated 200x400 source, got %dx%d", result.Width, result.Height)
+	}
+
+	out := result.Variants[0]
+	if bytes.Contains(out.Data, []byte("Exif")) {
+		t.Fatalf("output should not contain EXIF data")
+	}
+
+	// Saat yönünde dönünce sol (kırmızı) yarı üste gelir
+	decoded, err := jpeg.Decode(bytes.NewReader(out.Data))
+	if err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+	r, _, b, _ := decoded.At(100, 50).RGBA()
+	if r < b {
+		t.Fatalf("expected red at the top after rotation")
+	}
+	r, _, b, _ = decoded.At(100, 350).RGBA()
+	if b < r {
+		t.Fatalf("expected blue at the bottom after rotation")
+	}
+}
+
+func TestProcess_TransparentStaysPNG(t *testing.T) {
+	data := encodePNG(t, solidImage(600, 600, color.NRGBA{0, 128, 0, 100}))
+
+	result, err := Process(data, DefaultVariants, DefaultMaxPixels)
+	if err != nil {
+		t.Fatalf("process failed: %v", err)
+	}
+
+	thumb := variantByName(t, result, VariantThumbnail)
+	if thumb.ContentType != "image/png" || thumb.Extension != ".png" {
+		t.Fatalf("expected png output, got %s", thumb.ContentType)
+	}
+	if _, err := png.Decode(bytes.NewReader(thumb.Data)); err != nil {
+		t.Fatalf("output is not a valid png: %v", err)
+	}
+}
+
+func TestProcess_RejectsInvalidInput(t *testing.T) {
+	if _, err := Process([]byte("<svg xmlns='http://www.w3.org/2000/svg'></svg>"), DefaultVariants, DefaultMaxPixels); err != ErrUnsupportedFormat {
+		t.Fatalf("expected ErrUnsupportedFormat, got %v", err)
+	}
+
+	// Geçerli imza, bozuk gövde
+	truncated := encodePNG(t, solidImage(50, 50, color.White))[:40]
+	if _, err := Process(truncated, DefaultVariants, DefaultMaxPixels); err != ErrInvalidImage {
+		t.Fatalf("expected ErrInvalidImage, got %v", err)
+	}
+
+	data := encodePNG(t, solidImage(300, 300, color.White))
+	if _, err := Process(data, DefaultVariants, 300*300-1); err != ErrImageTooLarge {
+		t.Fatalf("expected ErrImageTooLarge, got %v", err)
+	}
+}
+
+func TestContentPath(t *testing.T) {
+	a := ContentPath("images/", []byte("same"), ".jpg")
+	b := ContentPath("images", []byte("same"), ".jpg")
+	c := ContentPath("images", []byte("other"), ".jpg")
+
+	if a != b {
+		t.Fatalf("same content should map to the same path: %s vs %s", a, b)
+	}
+	if a == c {
+		t.Fatalf("different content should map to different paths")
+	}
+
+	parts := strings.Split(a, "/")
+	if len(parts) != 3 || parts[0] != "images" || !strings.HasPrefix(parts[2], parts[1]) || !strings.HasSuffix(a, ".jpg") {
+		t.Fatalf("unexpected path layout: %s", a)
+	}
+}
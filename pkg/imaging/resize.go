@@ -0,0 +1,82 @@
+package imaging
+
+import "image"
+
+// resize scales the image into the variant box. Cover crops the centre to the box's
+// aspect ratio first; neither mode enlarges the image.
+func resize(src *image.RGBA, variant Variant) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	crop := src.Bounds()
+
+	var dw, dh int
+	switch variant.Mode {
+	case ModeCover:
+		// Kaynağı hedef en boy oranına ortadan kırp
+		if sw*variant.Height > sh*variant.Width {
+			cw := sh * variant.Width / variant.Height
+			crop = image.Rect((sw-cw)/2, 0, (sw-cw)/2+cw, sh)
+		} else {
+			ch := sw * variant.Height / variant.Width
+			crop = image.Rect(0, (sh-ch)/2, sw, (sh-ch)/2+ch)
+		}
+		dw, dh = variant.Width, variant.Height
+		if crop.Dx() < dw {
+			dw, dh = crop.Dx(), crop.Dy()
+		}
+	default:
+		dw, dh = sw, sh
+		if dw > variant.Width {
+			dw, dh = variant.Width, maxInt(1, sh*variant.Width/sw)
+		}
+		if dh > variant.Height {
+			dw, dh = maxInt(1, sw*variant.Height/sh), variant.Height
+		}
+	}
+
+	return boxResample(src, crop, maxInt(1, dw), maxInt(1, dh))
+}
+
+// boxResample averages the source pixels covered by each destination pixel (area
+// filter). Memory use is bounded by the destination size.
+func boxResample(src *image.RGBA, crop image.Rectangle, dw, dh int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	cw, ch := crop.Dx(), crop.Dy()
+
+	for y := 0; y < dh; y++ {
+		sy0 := crop.Min.Y + y*ch/dh
+		sy1 := maxInt(crop.Min.Y+(y+1)*ch/dh, sy0+1)
+
+		for x := 0; x < dw; x++ {
+			sx0 := crop.Min.X + x*cw/dw
+			sx1 := maxInt(crop.Min.X+(x+1)*cw/dw, sx0+1)
+
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				offset := src.PixOffset(sx0, sy)
+				for sx := sx0; sx < sx1; sx++ {
+					r += uint64(src.Pix[offset])
+					g += uint64(src.Pix[offset+1])
+					b += uint64(src.Pix[offset+2])
+					a += uint64(src.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			d := dst.PixOffset(x, y)
+			dst.Pix[d] = uint8((r + n/2) / n)
+			dst.Pix[d+1] = uint8((g + n/2) / n)
+			dst.Pix[d+2] = uint8((b + n/2) / n)
+			dst.Pix[d+3] = uint8((a + n/2) / n)
+		}
+	}
+
+	return dst
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,82 @@
+// -----------------------------------------------------------------------------
+// Pagination Package
+// -----------------------------------------------------------------------------
+// Keyset (seek) sayfalama için opak cursor'lar. Cursor, son kaydın sıralama
+// değerini ve ID'sini taşır; bir sonraki sayfa "bu değerden sonra gelenler"
+// olarak sorgulanır. OFFSET'in aksine araya yeni kayıt eklense de sayfalar
+// kaymaz ve derin sayfalarda performans düşmez.
+//
+// Cursor istemciye base64url (JSON) olarak verilir ve içeriğine güvenilmez:
+// sıralama anahtarı ve filtre parmak izi sorguyla eşleşmezse reddedilir.
+//
+// Kullanım:
+//
+//	token := pagination.Encode(pagination.Cursor{Sort: "start_time", Value: "2025-06-01T20:00:00Z", ID: 42})
+//	cursor, err := pagination.Decode(token)
+// -----------------------------------------------------------------------------
+
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidCursor, çözülemeyen veya sorguya ait olmayan cursor hatasıdır
+var ErrInvalidCursor = errors.New("pagination: invalid cursor")
+
+// Cursor, keyset sayfalamada son kaydın konumudur
+type Cursor struct {
+	Sort        string `json:"s"`           // Sıralama anahtarı (ör. "-start_time")
+	Value       string `json:"v"`           // Son kaydın sıralama değeri (metin olarak)
+	ID          int64  `json:"id"`          // Son kaydın ID'si (eşit değerlerde sıra belirleyici)
+	Fingerprint string `json:"f,omitempty"` // Filtrelerin parmak izi
+}
+
+// Encode, cursor'ı opak bir metne çevirir
+func Encode(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode, opak metni cursor'a çevirir
+func Decode(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Sort == "" || cursor.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// Matches, cursor'ın verilen sıralama ve filtrelerle üretilip üretilmediğini kontrol eder
+func (c *Cursor) Matches(sort, fingerprint string) bool {
+	return c.Sort == sort && c.Fingerprint == fingerprint
+}
+
+// Fingerprint, filtre değerlerinden kısa bir parmak izi üretir.
+// Cursor farklı filtrelerle tekrar kullanılırsa sayfalar tutarsız olacağından reddedilir.
+func Fingerprint(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x1f")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Meta, cursor sayfalı listelerin yanıt meta bilgisidir
+type Meta struct {
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+	Limit      int    `json:"limit"`
+	Total      *int   `json:"total,omitempty"` // Sadece istenirse hesaplanır
+}
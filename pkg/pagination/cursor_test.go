@@ -0,0 +1,73 @@
+// -----------------------------------------------------------------------------
+// Cursor Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Encode / Decode gidiş-dönüş
+// - Bozuk ve eksik cursor'ların reddi
+// - Sıralama / filtre parmak izi eşleşmesi
+// -----------------------------------------------------------------------------
+
+package pagination
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	original := Cursor{Sort: "-start_time", Value: "2025-06-01T20:00:00Z", ID: 42, Fingerprint: Fingerprint("concert")}
+
+	token := Encode(original)
+	if strings.ContainsAny(token, "+/=") {
+		t.Fatalf("token should be URL safe: %s", token)
+	}
+
+	decoded, err := Decode(token)
+	if err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+	if *decoded != original {
+		t.Fatalf("got %+v, want %+v", *decoded, original)
+	}
+}
+
+func TestDecode_RejectsInvalidTokens(t *testing.T) {
+	tokens := []string{
+		"",
+		"not base64!",
+		base64.RawURLEncoding.EncodeToString([]byte("not json")),
+		base64.RawURLEncoding.EncodeToString([]byte(`{"s":"name","v":"a"}`)),         // ID eksik
+		base64.RawURLEncoding.EncodeToString([]byte(`{"v":"a","id":3}`)),             // sıralama eksik
+		base64.RawURLEncoding.EncodeToString([]byte(`{"s":"name","v":"a","id":-1}`)), // negatif ID
+	}
+
+	for _, token := range tokens {
+		if _, err := Decode(token); err != ErrInvalidCursor {
+			t.Fatalf("Decode(%q): expected ErrInvalidCursor, got %v", token, err)
+		}
+	}
+}
+
+func TestCursor_Matches(t *testing.T) {
+	cursor := Cursor{Sort: "price", Value: "150", ID: 7, Fingerprint: Fingerprint("concert", "istanbul")}
+
+	if !cursor.Matches("price", Fingerprint("concert", "istanbul")) {
+		t.Fatalf("expected cursor to match its own query")
+	}
+	if cursor.Matches("-price", Fingerprint("concert", "istanbul")) {
+		t.Fatalf("cursor should not match a different sort")
+	}
+	if cursor.Matches("price", Fingerprint("theater", "istanbul")) {
+		t.Fatalf("cursor should not match different filters")
+	}
+}
+
+func TestFingerprint_SeparatesParts(t *testing.T) {
+	if Fingerprint("ab", "c") == Fingerprint("a", "bc") {
+		t.Fatalf("fingerprint should depend on part boundaries")
+	}
+	if Fingerprint("a", "b") != Fingerprint("a", "b") {
+		t.Fatalf("fingerprint should be deterministic")
+	}
+}
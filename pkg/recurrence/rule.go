@@ -0,0 +1,306 @@
+// -----------------------------------------------------------------------------
+// Recurrence Rules
+// -----------------------------------------------------------------------------
+// Tekrarlayan etkinlikler (ör. haftada dört akşam oynanan bir tiyatro oyunu)
+// için RFC 5545 RRULE'un sade bir alt kümesini uygular.
+//
+// Desteklenen parçalar:
+//
+//	FREQ=DAILY|WEEKLY   Tekrar sıklığı (zorunlu)
+//	INTERVAL=n          Her n günde / haftada bir (varsayılan 1)
+//	BYDAY=MO,WE,SA      WEEKLY için gösterim günleri, DAILY için gün filtresi
+//	UNTIL=20250630      Son tarih (dahil); 20250630T235959Z biçimi de kabul edilir
+//	COUNT=n             En fazla n tekrar
+//
+// Örnek:
+//
+//	rule, _ := recurrence.Parse("FREQ=WEEKLY;BYDAY=TU,WE,FR,SA;UNTIL=20250630")
+//	starts := rule.Occurrences(firstShow, exceptions, 0)
+//
+// Notlar:
+// - Saat ve saat dilimi başlangıç zamanından (dtstart) alınır; yaz saati
+//   geçişlerinde yerel saat korunur
+// - Haftalar pazartesi başlar (WKST=MO)
+// - İstisnalar (exceptions) takvim günü bazında eşleşir; RFC 5545'teki gibi
+//   COUNT istisnalar çıkarılmadan önce uygulanır
+// - UNTIL veya COUNT zorunludur; sınırsız seriler desteklenmez
+// -----------------------------------------------------------------------------
+
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency, tekrar sıklığıdır
+type Frequency string
+
+const (
+	Daily  Frequency = "DAILY"
+	Weekly Frequency = "WEEKLY"
+)
+
+// MaxOccurrences, tek bir kuralın üretebileceği tekrar sayısının üst sınırıdır
+const MaxOccurrences = 1000
+
+var (
+	ErrInvalidRule = errors.New("recurrence: invalid rule")
+	ErrUnbounded   = errors.New("recurrence: rule needs UNTIL or COUNT")
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule, tekrar kuralıdır
+type Rule struct {
+	Frequency Frequency
+	Interval  int
+	ByDay     []time.Weekday
+	Until     *time.Time // Dahil; sadece tarih kısmı önemlidir
+	Count     int
+}
+
+// Parse, "FREQ=WEEKLY;BYDAY=TU,WE;UNTIL=20250630" biçimindeki kuralı çözümler.
+// Başındaki "RRULE:" öneki kabul edilir.
+func Parse(value string) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+
+	rule := &Rule{Interval: 1}
+
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Frequency = Frequency(strings.ToUpper(val))
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil {
+				return nil, fmt.Errorf("%w: INTERVAL %q", ErrInvalidRule, val)
+			}
+			rule.Interval = interval
+		case "BYDAY":
+			for _, code := range strings.Split(val, ",") {
+				day, ok := weekdayCodes[strings.ToUpper(strings.TrimSpace(code))]
+				if !ok {
+					return nil, fmt.Errorf("%w: BYDAY %q", ErrInvalidRule, code)
+				}
+				rule.ByDay = append(rule.ByDay, day)
+			}
+		case "UNTIL":
+			until, err := parseUntil(val)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil {
+				return nil, fmt.Errorf("%w: COUNT %q", ErrInvalidRule, val)
+			}
+			rule.Count = count
+		default:
+			return nil, fmt.Errorf("%w: unsupported part %q", ErrInvalidRule, key)
+		}
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: UNTIL %q", ErrInvalidRule, value)
+}
+
+// Validate, kuralın tutarlılığını kontrol eder
+func (r *Rule) Validate() error {
+	if r.Frequency != Daily && r.Frequency != Weekly {
+		return fmt.Errorf("%w: FREQ must be DAILY or WEEKLY", ErrInvalidRule)
+	}
+	if r.Interval < 1 {
+		return fmt.Errorf("%w: INTERVAL must be at least 1", ErrInvalidRule)
+	}
+	if r.Count < 0 {
+		return fmt.Errorf("%w: COUNT must be positive", ErrInvalidRule)
+	}
+	if r.Count > MaxOccurrences {
+		return fmt.Errorf("%w: COUNT must be at most %d", ErrInvalidRule, MaxOccurrences)
+	}
+	if r.Until == nil && r.Count == 0 {
+		return ErrUnbounded
+	}
+	return nil
+}
+
+// String, kuralı RRULE biçiminde döndürür
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		codes := make([]string, 0, len(r.ByDay))
+		for _, day := range sortedWeekdays(r.ByDay) {
+			for code, weekday := range weekdayCodes {
+				if weekday == day {
+					codes = append(codes, code)
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102"))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Occurrences, dtstart'tan itibaren kurala uyan başlangıç zamanlarını döndürür.
+// exceptions ile aynı takvim gününe düşen tekrarlar atlanır (istisnaların
+// saat dilimi dönüştürülmez; tarih kısmı olduğu gibi kullanılır). limit > 0 ise
+// en fazla limit sonuç döner.
+func (r *Rule) Occurrences(dtstart time.Time, exceptions []time.Time, limit int) []time.Time {
+	loc := dtstart.Location()
+
+	excluded := make(map[string]bool, len(exceptions))
+	for _, exception := range exceptions {
+		excluded[dateKey(exception)] = true
+	}
+
+	var untilKey string
+	if r.Until != nil {
+		untilKey = r.Until.Format("20060102")
+	}
+
+	byDay := sortedWeekdays(r.ByDay)
+	if r.Frequency == Weekly && len(byDay) == 0 {
+		byDay = []time.Weekday{dtstart.Weekday()}
+	}
+
+	allowed := make(map[time.Weekday]bool, len(byDay))
+	for _, day := range byDay {
+		allowed[day] = true
+	}
+
+	var result []time.Time
+	generated := 0
+
+	// emit, aday günü sonuçlara ekler; false dönerse üretim biter
+	emit := func(year int, month time.Month, day int) bool {
+		candidate := time.Date(year, month, day, dtstart.Hour(), dtstart.Minute(), dtstart.Second(), 0, loc)
+		if candidate.Before(dtstart) {
+			return true
+		}
+		if untilKey != "" && candidate.Format("20060102") > untilKey {
+			return false
+		}
+		if r.Count > 0 && generated >= r.Count {
+			return false
+		}
+		if generated >= MaxOccurrences {
+			return false
+		}
+
+		generated++
+		if !excluded[dateKey(candidate)] {
+			result = append(result, candidate)
+		}
+
+		return limit <= 0 || len(result) < limit
+	}
+
+	year, month, day := dtstart.Date()
+
+	switch r.Frequency {
+	case Daily:
+		for i := 0; ; i += r.Interval {
+			date := time.Date(year, month, day+i, 0, 0, 0, 0, loc)
+			if len(allowed) > 0 && !allowed[date.Weekday()] {
+				if untilKey != "" && date.Format("20060102") > untilKey {
+					return result
+				}
+				if i > 7*MaxOccurrences {
+					return result
+				}
+				continue
+			}
+			if !emit(date.Date()) {
+				return result
+			}
+		}
+	case Weekly:
+		// Haftanın pazartesi gününden başla
+		offset := (int(dtstart.Weekday()) + 6) % 7
+		for week := 0; ; week += r.Interval {
+			for _, weekday := range byDay {
+				dayOffset := (int(weekday) + 6) % 7
+				date := time.Date(year, month, day-offset+week*7+dayOffset, 0, 0, 0, 0, loc)
+				if !emit(date.Date()) {
+					return result
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// sortedWeekdays, günleri pazartesiden başlayarak sıralar ve tekrarları atar
+func sortedWeekdays(days []time.Weekday) []time.Weekday {
+	seen := make(map[time.Weekday]bool, len(days))
+	sorted := make([]time.Weekday, 0, len(days))
+	for _, day := range days {
+		if !seen[day] {
+			seen[day] = true
+			sorted = append(sorted, day)
+		}
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return (int(sorted[i])+6)%7 < (int(sorted[j])+6)%7
+	})
+
+	return sorted
+}
+
+func dateKey(t time.Time) string {
+	return t.Format("2006-01-02")
+}
@@ -0,0 +1,155 @@
+// -----------------------------------------------------------------------------
+// Recurrence Rule Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - RRULE çözümleme ve geri yazma
+// - Haftalık çoklu gün, INTERVAL, UNTIL ve COUNT sınırları
+// - İstisna günlerinin atlanması
+// - Yaz saati geçişinde yerel saatin korunması
+// - Geçersiz / sınırsız kuralların reddedilmesi
+// -----------------------------------------------------------------------------
+
+package recurrence
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func mustParse(t *testing.T, value string) *Rule {
+	t.Helper()
+	rule, err := Parse(value)
+	if err != nil {
+		t.Fatalf("parse %q failed: %v", value, err)
+	}
+	return rule
+}
+
+func dates(times []time.Time) []string {
+	out := make([]string, len(times))
+	for i, t := range times {
+		out[i] = t.Format("2006-01-02 15:04 Mon")
+	}
+	return out
+}
+
+func assertDates(t *testing.T, got []time.Time, want []string) {
+	t.Helper()
+	gotDates := dates(got)
+	if len(gotDates) != len(want) {
+		t.Fatalf("expected %d occurrences, got %d: %v", len(want), len(gotDates), gotDates)
+	}
+	for i := range want {
+		if gotDates[i] != want[i] {
+			t.Fatalf("occurrence %d: expected %s, got %s (all: %v)", i, want[i], gotDates[i], gotDates)
+		}
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	rule := mustParse(t, "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=SA,TU;UNTIL=20250630")
+
+	if rule.Frequency != Weekly || rule.Interval != 2 || len(rule.ByDay) != 2 {
+		t.Fatalf("unexpected rule: %+v", rule)
+	}
+
+	if got := rule.String(); got != "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,SA;UNTIL=20250630" {
+		t.Fatalf("unexpected string: %s", got)
+	}
+}
+
+func TestParse_RejectsInvalidRules(t *testing.T) {
+	cases := []string{
+		"",
+		"FREQ=MONTHLY;COUNT=3",
+		"FREQ=WEEKLY;BYDAY=XX;COUNT=3",
+		"FREQ=WEEKLY;INTERVAL=0;COUNT=3",
+		"FREQ=WEEKLY;UNTIL=2025-06-30",
+		"FREQ=WEEKLY;BYSETPOS=1;COUNT=3",
+	}
+
+	for _, value := range cases {
+		if _, err := Parse(value); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("%q: expected ErrInvalidRule, got %v", value, err)
+		}
+	}
+
+	if _, err := Parse("FREQ=WEEKLY;BYDAY=MO"); !errors.Is(err, ErrUnbounded) {
+		t.Fatalf("expected ErrUnbounded, got %v", err)
+	}
+}
+
+func TestOccurrences_WeeklyOnGivenDaysUntilEndDate(t *testing.T) {
+	// 2025-06-03 is a Tuesday
+	dtstart := time.Date(2025, 6, 3, 20, 0, 0, 0, time.UTC)
+	rule := mustParse(t, "FREQ=WEEKLY;BYDAY=TU,FR;UNTIL=20250613")
+
+	assertDates(t, rule.Occurrences(dtstart, nil, 0), []string{
+		"2025-06-03 20:00 Tue",
+		"2025-06-06 20:00 Fri",
+		"2025-06-10 20:00 Tue",
+		"2025-06-13 20:00 Fri",
+	})
+}
+
+func TestOccurrences_SkipsDaysBeforeStart(t *testing.T) {
+	// Starts on a Thursday; the Monday of the same week must not be generated
+	dtstart := time.Date(2025, 6, 5, 19, 30, 0, 0, time.UTC)
+	rule := mustParse(t, "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=3")
+
+	assertDates(t, rule.Occurrences(dtstart, nil, 0), []string{
+		"2025-06-05 19:30 Thu",
+		"2025-06-09 19:30 Mon",
+		"2025-06-12 19:30 Thu",
+	})
+}
+
+func TestOccurrences_IntervalAndExceptions(t *testing.T) {
+	dtstart := time.Date(2025, 6, 7, 15, 0, 0, 0, time.UTC) // Saturday
+	rule := mustParse(t, "FREQ=WEEKLY;INTERVAL=2;COUNT=4")
+
+	exceptions := []time.Time{time.Date(2025, 6, 21, 0, 0, 0, 0, time.UTC)}
+
+	// COUNT applies before exceptions (RFC 5545), so one of four is dropped
+	assertDates(t, rule.Occurrences(dtstart, exceptions, 0), []string{
+		"2025-06-07 15:00 Sat",
+		"2025-07-05 15:00 Sat",
+		"2025-07-19 15:00 Sat",
+	})
+}
+
+func TestOccurrences_DailyWithDayFilterAndLimit(t *testing.T) {
+	dtstart := time.Date(2025, 6, 2, 11, 0, 0, 0, time.UTC) // Monday
+	rule := mustParse(t, "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;UNTIL=20250630")
+
+	assertDates(t, rule.Occurrences(dtstart, nil, 6), []string{
+		"2025-06-02 11:00 Mon",
+		"2025-06-03 11:00 Tue",
+		"2025-06-04 11:00 Wed",
+		"2025-06-05 11:00 Thu",
+		"2025-06-06 11:00 Fri",
+		"2025-06-09 11:00 Mon",
+	})
+}
+
+func TestOccurrences_KeepsLocalTimeAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("timezone database not available")
+	}
+
+	// DST ends on 2025-10-26 in Europe/Berlin
+	dtstart := time.Date(2025, 10, 21, 19, 30, 0, 0, loc)
+	rule := mustParse(t, "FREQ=WEEKLY;COUNT=2")
+
+	got := rule.Occurrences(dtstart, nil, 0)
+	assertDates(t, got, []string{
+		"2025-10-21 19:30 Tue",
+		"2025-10-28 19:30 Tue",
+	})
+
+	if got[1].Sub(got[0]) != 7*24*time.Hour+time.Hour {
+		t.Fatalf("expected one extra hour across DST end, got %v", got[1].Sub(got[0]))
+	}
+}
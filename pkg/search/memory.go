@@ -0,0 +1,286 @@
+package search
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryIndex, bellek içi ters indekstir (kelime → belge → alan ağırlığı).
+// Testler ve küçük kurulumlar içindir; MySQL indeksiyle aynı eşleşme kurallarını uygular.
+type MemoryIndex struct {
+	mu       sync.RWMutex
+	docs     map[int64]*Document
+	postings map[string]map[int64]float64
+}
+
+// NewMemoryIndex, boş bir bellek içi indeks oluşturur
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		docs:     make(map[int64]*Document),
+		postings: make(map[string]map[int64]float64),
+	}
+}
+
+// Upsert, belgeyi ekler veya günceller
+func (m *MemoryIndex) Upsert(doc *Document) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(doc.ID)
+
+	stored := *doc
+	m.docs[doc.ID] = &stored
+
+	fields := []struct {
+		text   string
+		weight float64
+	}{
+		{doc.Title, WeightTitle},
+		{doc.Venue, WeightVenue},
+		{doc.City, WeightCity},
+		{doc.Body, WeightBody},
+	}
+
+	for _, field := range fields {
+		for _, term := range Tokenize(field.text) {
+			posting, ok := m.postings[term]
+			if !ok {
+				posting = make(map[int64]float64)
+				m.postings[term] = posting
+			}
+			if field.weight > posting[doc.ID] {
+				posting[doc.ID] = field.weight
+			}
+		}
+	}
+
+	return nil
+}
+
+// Delete, belgeyi indeksten çıkarır
+func (m *MemoryIndex) Delete(id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(id)
+	return nil
+}
+
+func (m *MemoryIndex) remove(id int64) {
+	if _, ok := m.docs[id]; !ok {
+		return
+	}
+
+	delete(m.docs, id)
+	for term, posting := range m.postings {
+		delete(posting, id)
+		if len(posting) == 0 {
+			delete(m.postings, term)
+		}
+	}
+}
+
+// Search, sorguya uyan belgeleri alaka puanına göre döndürür
+func (m *MemoryIndex) Search(query *Query) (*Result, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	now := query.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// 1. Text match: every query token must match at least one term of the document
+	scores := m.textScores(Tokenize(query.Text))
+
+	// 2. Filters and facets
+	result := &Result{}
+	facets := map[string]map[string]int{
+		DimensionType:  {},
+		DimensionCity:  {},
+		DimensionDate:  {},
+		DimensionPrice: {},
+	}
+	cityLabels := make(map[string]string)
+
+	var hits []Hit
+	for id, doc := range m.docs {
+		if doc.StartTime.Before(now) {
+			continue
+		}
+		score, ok := scores[id]
+		if scores != nil && !ok {
+			continue
+		}
+
+		values := map[string]string{
+			DimensionType:  doc.Type,
+			DimensionCity:  Normalize(doc.City),
+			DimensionDate:  DateBucketOf(doc.StartTime, now),
+			DimensionPrice: PriceRangeOf(doc.Price),
+		}
+		failed := failedDimensions(query, values)
+
+		// Facet: sadece kendi boyutundaki filtreye takılan belgeler de sayılır
+		for dimension, value := range values {
+			if len(failed) == 0 || (len(failed) == 1 && failed[0] == dimension) {
+				facets[dimension][value]++
+			}
+		}
+		if _, ok := cityLabels[values[DimensionCity]]; !ok || doc.City < cityLabels[values[DimensionCity]] {
+			cityLabels[values[DimensionCity]] = doc.City
+		}
+
+		if len(failed) == 0 {
+			hits = append(hits, Hit{ID: id, Score: score})
+		}
+	}
+
+	// 3. Order by score, then start time, then ID
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		a, b := m.docs[hits[i].ID], m.docs[hits[j].ID]
+		if !a.StartTime.Equal(b.StartTime) {
+			return a.StartTime.Before(b.StartTime)
+		}
+		return a.ID < b.ID
+	})
+
+	result.Total = len(hits)
+	result.Hits = paginate(hits, query.Offset, query.Limit)
+	result.Facets = Facets{
+		Types:  CountFacet(facets[DimensionType], nil),
+		Cities: CountFacet(facets[DimensionCity], cityLabels),
+		Dates:  orderedFacet(facets[DimensionDate], dateBucketKeys(now)),
+		Prices: orderedFacet(facets[DimensionPrice], priceRangeKeys()),
+	}
+
+	return result, nil
+}
+
+// textScores returns the relevance per document, or nil when there is no text query
+func (m *MemoryIndex) textScores(tokens []string) map[int64]float64 {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	var scores map[int64]float64
+	for _, token := range tokens {
+		tokenScores := make(map[int64]float64)
+		for term, posting := range m.postings {
+			match := Match(token, term)
+			if match == 0 {
+				continue
+			}
+			for id, weight := range posting {
+				if s := match * weight; s > tokenScores[id] {
+					tokenScores[id] = s
+				}
+			}
+		}
+
+		if scores == nil {
+			scores = tokenScores
+			continue
+		}
+		for id := range scores {
+			if s, ok := tokenScores[id]; ok {
+				scores[id] += s
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	return scores
+}
+
+// failedDimensions returns the filter dimensions the document does not satisfy
+func failedDimensions(query *Query, values map[string]string) []string {
+	var failed []string
+	if query.Type != "" && values[DimensionType] != query.Type {
+		failed = append(failed, DimensionType)
+	}
+	if query.City != "" && values[DimensionCity] != Normalize(query.City) {
+		failed = append(failed, DimensionCity)
+	}
+	if query.DateBucket != "" && values[DimensionDate] != query.DateBucket {
+		failed = append(failed, DimensionDate)
+	}
+	if query.PriceRange != "" && values[DimensionPrice] != query.PriceRange {
+		failed = append(failed, DimensionPrice)
+	}
+	return failed
+}
+
+func paginate(hits []Hit, offset, limit int) []Hit {
+	if offset >= len(hits) {
+		return []Hit{}
+	}
+	hits = hits[offset:]
+	if limit > 0 && limit < len(hits) {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// CountFacet, facet değerlerini sayıya (azalan) sonra değere göre sıralar.
+// labels verilirse anahtarın yerine etiket yazılır (ör. normalize şehir → "İstanbul").
+func CountFacet(counts map[string]int, labels map[string]string) []FacetCount {
+	facet := make([]FacetCount, 0, len(counts))
+	for value, count := range counts {
+		if label, ok := labels[value]; ok {
+			value = label
+		}
+		facet = append(facet, FacetCount{Value: value, Count: count})
+	}
+	sort.Slice(facet, func(i, j int) bool {
+		if facet[i].Count != facet[j].Count {
+			return facet[i].Count > facet[j].Count
+		}
+		return facet[i].Value < facet[j].Value
+	})
+	return facet
+}
+
+// orderedFacet lists every key in its fixed order, including zero counts
+func orderedFacet(counts map[string]int, keys []string) []FacetCount {
+	facet := make([]FacetCount, len(keys))
+	for i, key := range keys {
+		facet[i] = FacetCount{Value: key, Count: counts[key]}
+	}
+	return facet
+}
+
+func dateBucketKeys(now time.Time) []string {
+	buckets := DateBuckets(now)
+	keys := make([]string, len(buckets))
+	for i, bucket := range buckets {
+		keys[i] = bucket.Key
+	}
+	return keys
+}
+
+func priceRangeKeys() []string {
+	keys := make([]string, len(PriceRanges))
+	for i, r := range PriceRanges {
+		keys[i] = r.Key
+	}
+	return keys
+}
+
+// OrderedFacet, sabit sıralı facet'leri (tarih, fiyat) sıfır sayılarla birlikte döndürür.
+// MySQL indeksi gibi sayıları kendisi hesaplayan uygulamalar için dışa açıktır.
+func OrderedFacet(dimension string, counts map[string]int, now time.Time) []FacetCount {
+	if dimension == DimensionDate {
+		return orderedFacet(counts, dateBucketKeys(now))
+	}
+	return orderedFacet(counts, priceRangeKeys())
+}
@@ -0,0 +1,176 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MinTokenLength, indekslenen en kısa kelime uzunluğudur (rune).
+// MySQL'de innodb_ft_min_token_size bu değerle aynı olmalıdır.
+const MinTokenLength = 2
+
+// turkishFold, Türkçe harfleri aramada eşdeğer sayılan ASCII karşılıklarına çevirir.
+// "I" Türkçede "ı" olarak küçülür; her ikisi de "i" ile eşleşsin diye birleştirilir.
+var turkishFold = map[rune]rune{
+	'İ': 'i', 'I': 'i', 'ı': 'i', 'Î': 'i', 'î': 'i',
+	'Ş': 's', 'ş': 's',
+	'Ğ': 'g', 'ğ': 'g',
+	'Ü': 'u', 'ü': 'u', 'Û': 'u', 'û': 'u',
+	'Ö': 'o', 'ö': 'o',
+	'Ç': 'c', 'ç': 'c',
+	'Â': 'a', 'â': 'a',
+}
+
+// Normalize, metni Türkçe duyarlı olarak küçük harfe çevirir ve aksanları katlar.
+// "İSTANBUL", "Istanbul" ve "istanbul" aynı sonucu verir.
+func Normalize(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+
+	for _, r := range text {
+		if folded, ok := turkishFold[r]; ok {
+			b.WriteRune(folded)
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// Tokenize, metni normalize edip harf / rakam dışındaki karakterlerden böler.
+// MinTokenLength'ten kısa kelimeler atlanır.
+func Tokenize(text string) []string {
+	fields := strings.FieldsFunc(Normalize(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := fields[:0]
+	for _, field := range fields {
+		if utf8.RuneCountInString(field) >= MinTokenLength {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// MaxEdits, kelime uzunluğuna göre tolere edilen yazım hatası sayısıdır
+func MaxEdits(token string) int {
+	switch n := utf8.RuneCountInString(token); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Eşleşme puanları
+const (
+	ScoreExact  = 1.0
+	ScorePrefix = 0.8
+	ScoreFuzzy  = 0.5
+)
+
+// Match, sorgu kelimesinin indeks kelimesiyle eşleşme puanını döndürür (0 = eşleşmez).
+// Tam eşleşme, önek eşleşmesi ("kons" → "konser") ve MaxEdits kadar yazım hatası
+// ("konsre" → "konser", önek üzerinde de: "konesr" → "konserler") kabul edilir.
+func Match(token, term string) float64 {
+	if token == term {
+		return ScoreExact
+	}
+	if strings.HasPrefix(term, token) {
+		return ScorePrefix
+	}
+
+	maxEdits := MaxEdits(token)
+	if maxEdits == 0 {
+		return 0
+	}
+
+	tokenRunes, termRunes := []rune(token), []rune(term)
+	if editDistance(tokenRunes, termRunes, maxEdits) <= maxEdits {
+		return ScoreFuzzy
+	}
+
+	// Yazım hatalı önek: kelimenin sorgu uzunluğuna yakın önekleriyle karşılaştır
+	for n := len(tokenRunes) - maxEdits; n <= len(tokenRunes)+maxEdits && n < len(termRunes); n++ {
+		if n >= MinTokenLength && editDistance(tokenRunes, termRunes[:n], maxEdits) <= maxEdits {
+			return ScoreFuzzy
+		}
+	}
+
+	return 0
+}
+
+// VocabularyPrefix, yazım hatası adaylarını sözlükten çekmek için kullanılan öndür.
+// İlk harflerin doğru yazıldığı varsayılır.
+func VocabularyPrefix(token string) string {
+	runes := []rune(token)
+	if len(runes) > 2 {
+		runes = runes[:2]
+	}
+	return string(runes)
+}
+
+// Expand, sözlükteki kelimelerden sorgu kelimesiyle eşleşenleri döndürür
+func Expand(token string, vocabulary []string) []string {
+	var terms []string
+	for _, term := range vocabulary {
+		if Match(token, term) > 0 {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// editDistance, Damerau (bitişik harf yer değiştirme dahil) Levenshtein mesafesidir.
+// max aşıldığında erken çıkar ve max+1 döndürür.
+func editDistance(a, b []rune, max int) int {
+	if diff := len(a) - len(b); diff > max || -diff > max {
+		return max + 1
+	}
+
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = minInt(curr[j], prev2[j-2]+1)
+			}
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,210 @@
+// -----------------------------------------------------------------------------
+// Search Package
+// -----------------------------------------------------------------------------
+// Etkinlik araması için arama indeksi soyutlaması. Uygulama MySQL FULLTEXT
+// üzerinde çalışan indeksi kullanır; testler aynı arayüzü uygulayan bellek içi
+// ters indeksle (MemoryIndex) çalışır. Her iki indeks de aynı normalizasyon,
+// eşleştirme ve facet kurallarını bu paketten alır.
+//
+// Özellikler:
+// - Türkçe duyarlı normalizasyon (İ/ı/I → i, ş → s, ğ → g, ü → u, ö → o, ç → c)
+// - Önek eşleşmesi ("kons" → "konser") ve yazım hatası toleransı ("konsre" → "konser")
+// - Alan ağırlıklı alaka puanı (ad > mekan / şehir > açıklama)
+// - Tip, şehir, tarih dilimi ve fiyat aralığı facet sayıları
+//
+// Kullanım:
+//
+//	index := search.NewMemoryIndex()
+//	index.Upsert(&search.Document{ID: 1, Title: "Tarkan Konseri", City: "İstanbul", ...})
+//	result, _ := index.Search(&search.Query{Text: "tarkan konser", City: "istanbul"})
+// -----------------------------------------------------------------------------
+
+package search
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidQuery, geçersiz arama sorgusu hatasıdır
+var ErrInvalidQuery = errors.New("search: invalid query")
+
+// Alan ağırlıkları (alaka puanı)
+const (
+	WeightTitle = 3.0
+	WeightVenue = 1.5
+	WeightCity  = 1.5
+	WeightBody  = 1.0
+)
+
+// Document, indekslenen bir etkinliktir
+type Document struct {
+	ID        int64
+	Title     string
+	Body      string
+	Venue     string
+	City      string
+	Type      string
+	StartTime time.Time
+	Price     float64
+}
+
+// Query, bir arama isteğidir. Text boşsa sadece filtreler uygulanır ve sonuçlar
+// başlangıç zamanına göre sıralanır. Başlangıcı Now'dan önce olan belgeler dönmez.
+type Query struct {
+	Text       string
+	Type       string // Etkinlik tipi
+	City       string // Şehir (normalize edilerek karşılaştırılır)
+	DateBucket string // today, this_week, this_month, later
+	PriceRange string // PriceRanges anahtarlarından biri
+	Now        time.Time
+	Limit      int
+	Offset     int
+}
+
+// Validate, filtre değerlerini kontrol eder
+func (q *Query) Validate() error {
+	if q.DateBucket != "" && !isDateBucket(q.DateBucket) {
+		return ErrInvalidQuery
+	}
+	if q.PriceRange != "" && FindPriceRange(q.PriceRange) == nil {
+		return ErrInvalidQuery
+	}
+	if q.Limit < 0 || q.Offset < 0 {
+		return ErrInvalidQuery
+	}
+	return nil
+}
+
+// Hit, bir arama sonucudur
+type Hit struct {
+	ID    int64   `json:"id"`
+	Score float64 `json:"score"`
+}
+
+// FacetCount, bir facet değerinin sonuç sayısıdır
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// Facets, sonuçların kırılımlarıdır. Her facet kendi filtresi hariç diğer tüm filtreler
+// uygulanarak sayılır; böylece seçili tipten başka bir tipe geçildiğinde kaç sonuç
+// olacağı görünür.
+type Facets struct {
+	Types  []FacetCount `json:"types"`
+	Cities []FacetCount `json:"cities"`
+	Dates  []FacetCount `json:"dates"`
+	Prices []FacetCount `json:"prices"`
+}
+
+// Result, arama sonucudur
+type Result struct {
+	Hits   []Hit  `json:"hits"`
+	Total  int    `json:"total"`
+	Facets Facets `json:"facets"`
+}
+
+// Index, arama indeksi arayüzüdür
+type Index interface {
+	// Upsert, belgeyi ekler veya günceller
+	Upsert(doc *Document) error
+	// Delete, belgeyi indeksten çıkarır (yoksa hata vermez)
+	Delete(id int64) error
+	// Search, sorguya uyan belgeleri alaka sırasıyla ve facet sayılarıyla döndürür
+	Search(query *Query) (*Result, error)
+}
+
+// Facet boyutları (bir facet sayılırken kendi filtresi atlanır)
+const (
+	DimensionType  = "type"
+	DimensionCity  = "city"
+	DimensionDate  = "date"
+	DimensionPrice = "price"
+)
+
+// Tarih dilimleri
+const (
+	DateToday     = "today"
+	DateThisWeek  = "this_week"
+	DateThisMonth = "this_month"
+	DateLater     = "later"
+)
+
+// DateBucket, bir tarih diliminin üst sınırıdır (Until hariç; sıfırsa sınırsız)
+type DateBucket struct {
+	Key   string
+	Until time.Time
+}
+
+// DateBuckets, now'a göre tarih dilimlerini sırasıyla döndürür: bugün (gün sonuna kadar),
+// 7 gün, 30 gün ve sonrası. Sınırlar now'ın saat diliminde gün başına hizalanır.
+func DateBuckets(now time.Time) []DateBucket {
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return []DateBucket{
+		{Key: DateToday, Until: startOfDay.AddDate(0, 0, 1)},
+		{Key: DateThisWeek, Until: startOfDay.AddDate(0, 0, 7)},
+		{Key: DateThisMonth, Until: startOfDay.AddDate(0, 0, 30)},
+		{Key: DateLater},
+	}
+}
+
+// DateBucketOf, başlangıç zamanının düştüğü tarih dilimini döndürür
+func DateBucketOf(start, now time.Time) string {
+	for _, bucket := range DateBuckets(now) {
+		if bucket.Until.IsZero() || start.Before(bucket.Until) {
+			return bucket.Key
+		}
+	}
+	return DateLater
+}
+
+func isDateBucket(key string) bool {
+	for _, bucket := range DateBuckets(time.Now()) {
+		if bucket.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// PriceRange, fiyat aralığıdır (Min dahil, Max hariç; Max sıfırsa sınırsız)
+type PriceRange struct {
+	Key string
+	Min float64
+	Max float64
+}
+
+// PriceRanges, fiyat facet aralıklarıdır
+var PriceRanges = []PriceRange{
+	{Key: "0-100", Min: 0, Max: 100},
+	{Key: "100-250", Min: 100, Max: 250},
+	{Key: "250-500", Min: 250, Max: 500},
+	{Key: "500-1000", Min: 500, Max: 1000},
+	{Key: "1000+", Min: 1000},
+}
+
+// Contains, fiyatın aralıkta olup olmadığını kontrol eder
+func (r PriceRange) Contains(price float64) bool {
+	return price >= r.Min && (r.Max == 0 || price < r.Max)
+}
+
+// FindPriceRange, anahtara göre fiyat aralığını döndürür
+func FindPriceRange(key string) *PriceRange {
+	for i := range PriceRanges {
+		if PriceRanges[i].Key == key {
+			return &PriceRanges[i]
+		}
+	}
+	return nil
+}
+
+// PriceRangeOf, fiyatın düştüğü aralığın anahtarını döndürür
+func PriceRangeOf(price float64) string {
+	for _, r := range PriceRanges {
+		if r.Contains(price) {
+			return r.Key
+		}
+	}
+	return PriceRanges[0].Key
+}
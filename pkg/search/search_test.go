@@ -0,0 +1,237 @@
+// -----------------------------------------------------------------------------
+// Search Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Türkçe normalizasyon (İ/ı/I, ş, ğ)
+// - Önek ve yazım hatası eşleşmesi
+// - Alaka sıralaması (ad > açıklama)
+// - Filtreler ve facet sayıları (kendi filtresi hariç)
+// - Geçmiş etkinliklerin elenmesi, güncelleme ve silme
+// -----------------------------------------------------------------------------
+
+package search
+
+import (
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2026, 5, 4, 12, 0, 0, 0, time.UTC)
+
+func TestNormalize_Turkish(t *testing.T) {
+	cases := map[string]string{
+		"İSTANBUL":     "istanbul",
+		"Istanbul":     "istanbul",
+		"ıspanak":      "ispanak",
+		"Şarkı Gecesi": "sarki gecesi",
+		"DAĞ KONSERİ":  "dag konseri",
+		"Çocuk Oyunu":  "cocuk oyunu",
+		"Müzikal":      "muzikal",
+	}
+
+	for input, want := range cases {
+		if got := Normalize(input); got != want {
+			t.Fatalf("Normalize(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestTokenize_SkipsShortTokensAndPunctuation(t *testing.T) {
+	tokens := Tokenize("Rock'n Roll: A Gece, 2026!")
+	want := []string{"rock", "roll", "gece", "2026"}
+
+	if len(tokens) != len(want) {
+		t.Fatalf("got %v, want %v", tokens, want)
+	}
+	for i := range want {
+		if tokens[i] != want[i] {
+			t.Fatalf("got %v, want %v", tokens, want)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		token, term string
+		want        float64
+	}{
+		{"konser", "konser", ScoreExact},
+		{"kons", "konser", ScorePrefix},
+		{"konsre", "konser", ScoreFuzzy},    // yer değiştirme
+		{"konsr", "konser", ScoreFuzzy},     // eksik harf
+		{"konesr", "konserler", ScoreFuzzy}, // hatalı önek
+		{"caz", "cez", 0},                   // kısa kelimede hata toleransı yok
+		{"tiyatro", "futbol", 0},
+	}
+
+	for _, c := range cases {
+		if got := Match(c.token, c.term); got != c.want {
+			t.Fatalf("Match(%q, %q) = %v, want %v", c.token, c.term, got, c.want)
+		}
+	}
+}
+
+func TestExpand_UsesVocabulary(t *testing.T) {
+	terms := Expand("konsre", []string{"konser", "konserler", "komedi", "tiyatro"})
+	if len(terms) != 2 || terms[0] != "konser" || terms[1] != "konserler" {
+		t.Fatalf("unexpected expansion: %v", terms)
+	}
+}
+
+func newTestIndex(t *testing.T) *MemoryIndex {
+	t.Helper()
+
+	index := NewMemoryIndex()
+	docs := []*Document{
+		{ID: 1, Title: "Tarkan Konseri", Body: "Yaz turnesi", Venue: "Harbiye Açıkhava", City: "İstanbul", Type: "concert", StartTime: testNow.Add(3 * time.Hour), Price: 750},
+		{ID: 2, Title: "Hamlet", Body: "Şehir tiyatrolarından konser eşliğinde oyun", Venue: "AKM", City: "Istanbul", Type: "theater", StartTime: testNow.AddDate(0, 0, 3), Price: 150},
+		{ID: 3, Title: "Caz Gecesi Konser", Body: "", Venue: "CSO Ada", City: "Ankara", Type: "concert", StartTime: testNow.AddDate(0, 0, 20), Price: 300},
+		{ID: 4, Title: "Eski Konser", Body: "", Venue: "Volkswagen Arena", City: "İstanbul", Type: "concert", StartTime: testNow.Add(-time.Hour), Price: 100},
+		{ID: 5, Title: "Derbi", Body: "", Venue: "Stadyum", City: "İzmir", Type: "sports", StartTime: testNow.AddDate(0, 2, 0), Price: 1200},
+	}
+	for _, doc := range docs {
+		if err := index.Upsert(doc); err != nil {
+			t.Fatalf("upsert failed: %v", err)
+		}
+	}
+	return index
+}
+
+func hitIDs(result *Result) []int64 {
+	ids := make([]int64, len(result.Hits))
+	for i, hit := range result.Hits {
+		ids[i] = hit.ID
+	}
+	return ids
+}
+
+func facetCount(facet []FacetCount, value string) int {
+	for _, f := range facet {
+		if f.Value == value {
+			return f.Count
+		}
+	}
+	return 0
+}
+
+func TestMemoryIndex_RanksTitleAboveBody(t *testing.T) {
+	index := newTestIndex(t)
+
+	result, err := index.Search(&Query{Text: "KONSER", Now: testNow})
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+
+	// 4 geçmişte; 3 adda tam, 1 adda önek, 2 açıklamada tam eşleşir
+	ids := hitIDs(result)
+	if len(ids) != 3 || ids[0] != 3 || ids[1] != 1 || ids[2] != 2 {
+		t.Fatalf("unexpected order: %v", ids)
+	}
+}
+
+func TestMemoryIndex_TypoAndPrefix(t *testing.T) {
+	index := newTestIndex(t)
+
+	for _, text := range []string{"tarkn", "tark", "konsrei tarkan"} {
+		result, err := index.Search(&Query{Text: text, Now: testNow})
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+		if ids := hitIDs(result); len(ids) != 1 || ids[0] != 1 {
+			t.Fatalf("%q: expected [1], got %v", text, ids)
+		}
+	}
+}
+
+func TestMemoryIndex_AllTokensMustMatch(t *testing.T) {
+	index := newTestIndex(t)
+
+	result, _ := index.Search(&Query{Text: "konser ankara", Now: testNow})
+	if ids := hitIDs(result); len(ids) != 1 || ids[0] != 3 {
+		t.Fatalf("expected [3], got %v", ids)
+	}
+}
+
+func TestMemoryIndex_CityFilterIsTurkishAware(t *testing.T) {
+	index := newTestIndex(t)
+
+	result, _ := index.Search(&Query{City: "ISTANBUL", Now: testNow})
+	if ids := hitIDs(result); len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+		t.Fatalf("expected [1 2], got %v", ids)
+	}
+}
+
+func TestMemoryIndex_FacetsExcludeOwnFilter(t *testing.T) {
+	index := newTestIndex(t)
+
+	result, err := index.Search(&Query{Type: "concert", PriceRange: "500-1000", Now: testNow})
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+
+	if result.Total != 1 || result.Hits[0].ID != 1 {
+		t.Fatalf("expected only event 1, got %v", hitIDs(result))
+	}
+
+	// Tip facet'i fiyat filtresini uygular ama tip filtresini uygulamaz
+	if facetCount(result.Facets.Types, "concert") != 1 || facetCount(result.Facets.Types, "theater") != 0 {
+		t.Fatalf("unexpected type facet: %+v", result.Facets.Types)
+	}
+
+	// Fiyat facet'i tip filtresini uygular ama fiyat filtresini uygulamaz
+	if facetCount(result.Facets.Prices, "250-500") != 1 || facetCount(result.Facets.Prices, "500-1000") != 1 {
+		t.Fatalf("unexpected price facet: %+v", result.Facets.Prices)
+	}
+	if len(result.Facets.Prices) != len(PriceRanges) {
+		t.Fatalf("price facet should list all ranges: %+v", result.Facets.Prices)
+	}
+
+	if facetCount(result.Facets.Dates, DateToday) != 1 || facetCount(result.Facets.Dates, DateThisMonth) != 0 {
+		t.Fatalf("unexpected date facet: %+v", result.Facets.Dates)
+	}
+}
+
+func TestMemoryIndex_DateBucketFilter(t *testing.T) {
+	index := newTestIndex(t)
+
+	result, _ := index.Search(&Query{DateBucket: DateThisWeek, Now: testNow})
+	if ids := hitIDs(result); len(ids) != 1 || ids[0] != 2 {
+		t.Fatalf("expected [2], got %v", ids)
+	}
+
+	// Şehir facet'i normalize edilmiş değerlere göre birleşir
+	result, _ = index.Search(&Query{Now: testNow})
+	if len(result.Facets.Cities) != 3 || result.Facets.Cities[0].Count != 2 {
+		t.Fatalf("unexpected city facet: %+v", result.Facets.Cities)
+	}
+}
+
+func TestMemoryIndex_UpsertReplacesAndDeleteRemoves(t *testing.T) {
+	index := newTestIndex(t)
+
+	index.Upsert(&Document{ID: 1, Title: "Sezen Aksu", City: "İstanbul", Type: "concert", StartTime: testNow.Add(time.Hour)})
+
+	result, _ := index.Search(&Query{Text: "tarkan", Now: testNow})
+	if result.Total != 0 {
+		t.Fatalf("stale terms should be removed, got %v", hitIDs(result))
+	}
+
+	index.Delete(1)
+	result, _ = index.Search(&Query{Text: "sezen", Now: testNow})
+	if result.Total != 0 {
+		t.Fatalf("deleted document should not match, got %v", hitIDs(result))
+	}
+}
+
+func TestMemoryIndex_Pagination(t *testing.T) {
+	index := newTestIndex(t)
+
+	result, _ := index.Search(&Query{Now: testNow, Limit: 2, Offset: 2})
+	if result.Total != 4 || len(result.Hits) != 2 {
+		t.Fatalf("expected total 4 and 2 hits, got %d / %d", result.Total, len(result.Hits))
+	}
+
+	if _, err := index.Search(&Query{PriceRange: "cheap", Now: testNow}); err != ErrInvalidQuery {
+		t.Fatalf("expected ErrInvalidQuery, got %v", err)
+	}
+}
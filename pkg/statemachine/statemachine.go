@@ -0,0 +1,199 @@
+// -----------------------------------------------------------------------------
+// State Machine Package
+// -----------------------------------------------------------------------------
+// Durum alanı olan modeller için generic, açık tanımlı durum makinesi.
+// İzin verilen geçişler tek yerde tanımlanır; tanımlanmamış bir geçiş
+// (ör. completed → sale_active) hiçbir yoldan yapılamaz.
+//
+// Bir geçiş (Fire) sırasıyla:
+// 1. Geçişin tanımlı olduğunu kontrol eder
+// 2. Hedef durumun guard'larını çalıştırır (iş kuralları)
+// 3. Durumu değiştirir ve OnEnter hook'larını çalıştırır (zaman damgaları vb.)
+// 4. persist fonksiyonunu çağırır; hata dönerse durum geri alınır
+// 5. Dinleyicilere geçiş olayını (Transition) iletir
+//
+// Kullanım:
+//
+//	machine := statemachine.New("ticket", getStatus, setStatus).
+//	    Permit("reserved", "sold", "cancelled").
+//	    Permit("sold", "used", "cancelled")
+//	err := machine.Fire(ticket, "sold", func() error { return repo.Update(ticket) })
+// -----------------------------------------------------------------------------
+
+package statemachine
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrIllegalTransition, tanımlanmamış bir geçiş denendiğinde döner (TransitionError ile sarılı)
+	ErrIllegalTransition = errors.New("statemachine: illegal transition")
+
+	// ErrAborted, persist fonksiyonunun geçişi sessizce iptal etmek için döndürebileceği hatadır
+	// (ör. koşullu UPDATE başka bir istek tarafından önce uygulandı). Olay yayınlanmaz.
+	ErrAborted = errors.New("statemachine: transition aborted")
+)
+
+// TransitionError, tanımlanmamış bir geçişi açıklar
+type TransitionError struct {
+	Machine string
+	From    string
+	To      string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("statemachine: %s cannot move from %s to %s", e.Machine, e.From, e.To)
+}
+
+// Unwrap, errors.Is(err, ErrIllegalTransition) kontrolünü sağlar
+func (e *TransitionError) Unwrap() error {
+	return ErrIllegalTransition
+}
+
+// Guard, hedef duruma geçişe izin veren iş kuralıdır; hata dönerse geçiş yapılmaz
+type Guard[S comparable, T any] func(subject T, from S) error
+
+// Hook, hedef duruma girildiğinde çalışan yan etkidir
+type Hook[S comparable, T any] func(subject T, from S)
+
+// Transition, tamamlanmış bir geçiş olayıdır
+type Transition[S comparable, T any] struct {
+	Machine string
+	Subject T
+	From    S
+	To      S
+	At      time.Time
+}
+
+// Listener, geçiş olaylarını alır
+type Listener[S comparable, T any] func(transition Transition[S, T])
+
+// Machine, S durum tipi ve T model tipi için durum makinesidir.
+// Tanım (Permit / Guard / OnEnter / OnTransition) kurulum sırasında yapılmalıdır;
+// kurulumdan sonra makine eşzamanlı kullanıma uygundur.
+type Machine[S comparable, T any] struct {
+	name        string
+	state       func(T) S
+	setState    func(T, S)
+	transitions map[S]map[S]bool
+	guards      map[S][]Guard[S, T]
+	hooks       map[S][]Hook[S, T]
+	listeners   []Listener[S, T]
+}
+
+// New, modelin durumunu okuyan ve yazan fonksiyonlarla boş bir makine oluşturur
+func New[S comparable, T any](name string, state func(T) S, setState func(T, S)) *Machine[S, T] {
+	return &Machine[S, T]{
+		name:        name,
+		state:       state,
+		setState:    setState,
+		transitions: make(map[S]map[S]bool),
+		guards:      make(map[S][]Guard[S, T]),
+		hooks:       make(map[S][]Hook[S, T]),
+	}
+}
+
+// Name, makinenin adını döndürür
+func (m *Machine[S, T]) Name() string {
+	return m.name
+}
+
+// Permit, from durumundan verilen hedeflere geçişe izin verir
+func (m *Machine[S, T]) Permit(from S, to ...S) *Machine[S, T] {
+	if m.transitions[from] == nil {
+		m.transitions[from] = make(map[S]bool)
+	}
+	for _, target := range to {
+		m.transitions[from][target] = true
+	}
+	return m
+}
+
+// Guard, to durumuna her geçişte çalışacak bir iş kuralı ekler
+func (m *Machine[S, T]) Guard(to S, guard Guard[S, T]) *Machine[S, T] {
+	m.guards[to] = append(m.guards[to], guard)
+	return m
+}
+
+// OnEnter, to durumuna girildiğinde (persist'ten önce) çalışacak bir hook ekler
+func (m *Machine[S, T]) OnEnter(to S, hook Hook[S, T]) *Machine[S, T] {
+	m.hooks[to] = append(m.hooks[to], hook)
+	return m
+}
+
+// OnTransition, başarılı her geçişten sonra çağrılacak bir dinleyici ekler
+func (m *Machine[S, T]) OnTransition(listener Listener[S, T]) *Machine[S, T] {
+	m.listeners = append(m.listeners, listener)
+	return m
+}
+
+// Can, from → to geçişinin tanımlı olup olmadığını döndürür (guard'lar çalışmaz)
+func (m *Machine[S, T]) Can(from, to S) bool {
+	return m.transitions[from][to]
+}
+
+// Sources, to durumuna geçilebilen durumları döndürür (koşullu UPDATE'ler için)
+func (m *Machine[S, T]) Sources(to S) []S {
+	var sources []S
+	for from, targets := range m.transitions {
+		if targets[to] {
+			sources = append(sources, from)
+		}
+	}
+	return sources
+}
+
+// Check, modelin şu anki durumundan to durumuna geçilip geçilemeyeceğini
+// tanım ve guard'larla kontrol eder; modeli değiştirmez
+func (m *Machine[S, T]) Check(subject T, to S) error {
+	from := m.state(subject)
+	if !m.Can(from, to) {
+		return &TransitionError{Machine: m.name, From: fmt.Sprint(from), To: fmt.Sprint(to)}
+	}
+
+	for _, guard := range m.guards[to] {
+		if err := guard(subject, from); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Fire, modeli to durumuna geçirir. persist nil değilse durum ve hook'lar
+// uygulandıktan sonra çağrılır; hata dönerse durum eski haline getirilir ve
+// hata aynen döner. Dinleyiciler sadece başarılı geçişlerde çağrılır.
+func (m *Machine[S, T]) Fire(subject T, to S, persist func() error) error {
+	if err := m.Check(subject, to); err != nil {
+		return err
+	}
+
+	from := m.state(subject)
+	m.setState(subject, to)
+	for _, hook := range m.hooks[to] {
+		hook(subject, from)
+	}
+
+	if persist != nil {
+		if err := persist(); err != nil {
+			m.setState(subject, from)
+			return err
+		}
+	}
+
+	transition := Transition[S, T]{
+		Machine: m.name,
+		Subject: subject,
+		From:    from,
+		To:      to,
+		At:      time.Now(),
+	}
+	for _, listener := range m.listeners {
+		listener(transition)
+	}
+
+	return nil
+}
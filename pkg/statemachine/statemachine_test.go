@@ -0,0 +1,150 @@
+// -----------------------------------------------------------------------------
+// State Machine Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Tanımlı / tanımsız geçişler
+// - Guard'ların geçişi engellemesi
+// - OnEnter hook'ları ve geçiş olayları
+// - persist hatasında durumun geri alınması, ErrAborted
+// -----------------------------------------------------------------------------
+
+package statemachine
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+type order struct {
+	status  string
+	paid    bool
+	balance int
+}
+
+func newOrderMachine() *Machine[string, *order] {
+	return New("order",
+		func(o *order) string { return o.status },
+		func(o *order, s string) { o.status = s },
+	).
+		Permit("pending", "paid", "cancelled").
+		Permit("paid", "shipped", "cancelled").
+		Permit("failed", "paid")
+}
+
+func TestFire_AllowsDeclaredTransitions(t *testing.T) {
+	machine := newOrderMachine()
+	o := &order{status: "pending"}
+
+	if err := machine.Fire(o, "paid", nil); err != nil {
+		t.Fatalf("pending → paid should be allowed: %v", err)
+	}
+	if err := machine.Fire(o, "shipped", nil); err != nil {
+		t.Fatalf("paid → shipped should be allowed: %v", err)
+	}
+	if o.status != "shipped" {
+		t.Fatalf("expected shipped, got %s", o.status)
+	}
+}
+
+func TestFire_RejectsUndeclaredTransitions(t *testing.T) {
+	machine := newOrderMachine()
+	o := &order{status: "shipped"}
+
+	err := machine.Fire(o, "pending", nil)
+	if !errors.Is(err, ErrIllegalTransition) {
+		t.Fatalf("expected ErrIllegalTransition, got %v", err)
+	}
+
+	var transitionErr *TransitionError
+	if !errors.As(err, &transitionErr) || transitionErr.From != "shipped" || transitionErr.To != "pending" {
+		t.Fatalf("expected TransitionError shipped → pending, got %v", err)
+	}
+	if o.status != "shipped" {
+		t.Fatalf("status should not change, got %s", o.status)
+	}
+}
+
+func TestGuard_BlocksTransition(t *testing.T) {
+	errNoBalance := errors.New("insufficient balance")
+	machine := newOrderMachine().Guard("paid", func(o *order, from string) error {
+		if o.balance <= 0 {
+			return errNoBalance
+		}
+		return nil
+	})
+
+	o := &order{status: "pending"}
+	if err := machine.Fire(o, "paid", nil); err != errNoBalance {
+		t.Fatalf("expected guard error, got %v", err)
+	}
+	if o.status != "pending" {
+		t.Fatalf("status should not change when a guard fails")
+	}
+
+	o.balance = 10
+	if err := machine.Check(o, "paid"); err != nil {
+		t.Fatalf("guard should pass now: %v", err)
+	}
+}
+
+func TestHooksAndListeners(t *testing.T) {
+	var events []Transition[string, *order]
+	machine := newOrderMachine().
+		OnEnter("paid", func(o *order, from string) { o.paid = true }).
+		OnTransition(func(tr Transition[string, *order]) { events = append(events, tr) })
+
+	o := &order{status: "pending"}
+	if err := machine.Fire(o, "paid", nil); err != nil {
+		t.Fatalf("fire failed: %v", err)
+	}
+
+	if !o.paid {
+		t.Fatalf("OnEnter hook should run")
+	}
+	if len(events) != 1 || events[0].From != "pending" || events[0].To != "paid" || events[0].Machine != "order" || events[0].Subject != o {
+		t.Fatalf("unexpected transition events: %+v", events)
+	}
+}
+
+func TestFire_PersistFailureRevertsState(t *testing.T) {
+	notified := false
+	machine := newOrderMachine().OnTransition(func(Transition[string, *order]) { notified = true })
+	o := &order{status: "pending"}
+
+	persistErr := errors.New("db down")
+	var persistedStatus string
+	err := machine.Fire(o, "paid", func() error {
+		persistedStatus = o.status
+		return persistErr
+	})
+
+	if err != persistErr {
+		t.Fatalf("expected persist error, got %v", err)
+	}
+	if persistedStatus != "paid" {
+		t.Fatalf("persist should see the new status, got %s", persistedStatus)
+	}
+	if o.status != "pending" {
+		t.Fatalf("status should be reverted, got %s", o.status)
+	}
+	if notified {
+		t.Fatalf("listeners should not run for failed transitions")
+	}
+
+	if err := machine.Fire(o, "paid", func() error { return ErrAborted }); !errors.Is(err, ErrAborted) {
+		t.Fatalf("expected ErrAborted, got %v", err)
+	}
+	if notified {
+		t.Fatalf("listeners should not run for aborted transitions")
+	}
+}
+
+func TestSources(t *testing.T) {
+	sources := newOrderMachine().Sources("paid")
+	sort.Strings(sources)
+
+	if len(sources) != 2 || sources[0] != "failed" || sources[1] != "pending" {
+		t.Fatalf("unexpected sources: %v", sources)
+	}
+}
@@ -0,0 +1,156 @@
+package waitingroom
+
+import (
+	"sort"
+	"sync"
+)
+
+// memoryQueue, tek bir odanın bellek içi kuyruğudur.
+type memoryQueue struct {
+	room     *Room
+	sequence int64
+	scores   map[string]float64
+	ordered  []memoryEntry // Skora göre sıralı
+}
+
+type memoryEntry struct {
+	score     float64
+	visitorID string
+}
+
+// MemoryStore, Store interface'inin bellek içi implementasyonu.
+//
+// Tek instance deployment'larda, testlerde ve Redis erişilemediğinde
+// fallback olarak kullanılır. Thread-safe'dir.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	queues map[string]*memoryQueue
+}
+
+// NewMemoryStore, yeni bir MemoryStore oluşturur.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		queues: make(map[string]*memoryQueue),
+	}
+}
+
+// queue, oda kuyruğunu döndürür; yoksa oluşturur. Çağıran write lock tutmalı.
+func (m *MemoryStore) queue(roomID string) *memoryQueue {
+	q, exists := m.queues[roomID]
+	if !exists {
+		q = &memoryQueue{scores: make(map[string]float64)}
+		m.queues[roomID] = q
+	}
+	return q
+}
+
+// SaveRoom, oda ayarlarını kaydeder.
+func (m *MemoryStore) SaveRoom(room *Room) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	copied := *room
+	m.queue(room.ID).room = &copied
+	return nil
+}
+
+// GetRoom, oda ayarlarını döndürür.
+func (m *MemoryStore) GetRoom(roomID string) (*Room, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	q, exists := m.queues[roomID]
+	if !exists || q.room == nil {
+		return nil, ErrRoomNotFound
+	}
+
+	copied := *q.room
+	return &copied, nil
+}
+
+// DeleteRoom, odayı ve kuyruğunu siler.
+func (m *MemoryStore) DeleteRoom(roomID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.queues, roomID)
+	return nil
+}
+
+// NextSequence, artan sıra numarası üretir.
+func (m *MemoryStore) NextSequence(roomID string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	q := m.queue(roomID)
+	q.sequence++
+	return q.sequence, nil
+}
+
+// Enqueue, ziyaretçiyi sıralı konumuna ekler (binary search).
+func (m *MemoryStore) Enqueue(roomID, visitorID string, score float64) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	q := m.queue(roomID)
+	if _, exists := q.scores[visitorID]; exists {
+		return false, nil
+	}
+
+	entry := memoryEntry{score: score, visitorID: visitorID}
+	idx := sort.Search(len(q.ordered), func(i int) bool {
+		return entryLess(entry, q.ordered[i])
+	})
+
+	q.ordered = append(q.ordered, memoryEntry{})
+	copy(q.ordered[idx+1:], q.ordered[idx:])
+	q.ordered[idx] = entry
+	q.scores[visitorID] = score
+
+	return true, nil
+}
+
+// Rank, ziyaretçinin 0 tabanlı sırasını döndürür.
+func (m *MemoryStore) Rank(roomID, visitorID string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	q, exists := m.queues[roomID]
+	if !exists {
+		return 0, ErrNotInQueue
+	}
+
+	score, exists := q.scores[visitorID]
+	if !exists {
+		return 0, ErrNotInQueue
+	}
+
+	entry := memoryEntry{score: score, visitorID: visitorID}
+	idx := sort.Search(len(q.ordered), func(i int) bool {
+		return !entryLess(q.ordered[i], entry)
+	})
+
+	return int64(idx), nil
+}
+
+// Size, kuyruktaki ziyaretçi sayısını döndürür.
+func (m *MemoryStore) Size(roomID string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	q, exists := m.queues[roomID]
+	if !exists {
+		return 0, nil
+	}
+
+	return int64(len(q.ordered)), nil
+}
+
+// entryLess, Redis sorted set sıralamasıyla aynı kuralı uygular:
+// önce skor, eşitlikte üye adı (lexicographic).
+func entryLess(a, b memoryEntry) bool {
+	if a.score != b.score {
+		return a.score < b.score
+	}
+	return a.visitorID < b.visitorID
+}
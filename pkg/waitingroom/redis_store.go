@@ -0,0 +1,170 @@
+package waitingroom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore, Store interface'inin Redis implementasyonu.
+//
+// Key yapısı:
+//   - {prefix}waitingroom:{room}:config → oda ayarları (JSON)
+//   - {prefix}waitingroom:{room}:queue  → sorted set (visitor → skor)
+//   - {prefix}waitingroom:{room}:seq    → sıra sayacı (INCR)
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisStore, yeni bir RedisStore oluşturur.
+//
+// Örnek:
+//
+//	store := waitingroom.NewRedisStore(redisClient.Client(), cfg.Cache.Prefix)
+func NewRedisStore(client *redis.Client, prefix string) *RedisStore {
+	return &RedisStore{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *RedisStore) key(roomID, suffix string) string {
+	return fmt.Sprintf("%swaitingroom:%s:%s", r.prefix, roomID, suffix)
+}
+
+// SaveRoom, oda ayarlarını kaydeder.
+func (r *RedisStore) SaveRoom(room *Room) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	data, err := json.Marshal(room)
+	if err != nil {
+		return fmt.Errorf("json encode failed: %w", err)
+	}
+
+	if err := r.client.Set(ctx, r.key(room.ID, "config"), data, 0).Err(); err != nil {
+		return fmt.Errorf("redis set failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetRoom, oda ayarlarını döndürür.
+func (r *RedisStore) GetRoom(roomID string) (*Room, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	data, err := r.client.Get(ctx, r.key(roomID, "config")).Bytes()
+	if err == redis.Nil {
+		return nil, ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis get failed: %w", err)
+	}
+
+	var room Room
+	if err := json.Unmarshal(data, &room); err != nil {
+		return nil, fmt.Errorf("json decode failed: %w", err)
+	}
+
+	return &room, nil
+}
+
+// DeleteRoom, odayı ve kuyruğunu siler.
+func (r *RedisStore) DeleteRoom(roomID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	err := r.client.Del(ctx,
+		r.key(roomID, "config"),
+		r.key(roomID, "queue"),
+		r.key(roomID, "seq"),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("redis delete failed: %w", err)
+	}
+
+	return nil
+}
+
+// NextSequence, atomic sıra numarası üretir (INCR).
+func (r *RedisStore) NextSequence(roomID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	seq, err := r.client.Incr(ctx, r.key(roomID, "seq")).Result()
+	if err != nil {
+		return 0, fmt.Errorf("redis incr failed: %w", err)
+	}
+
+	return seq, nil
+}
+
+// Enqueue, ziyaretçiyi kuyruğa ekler (ZADD NX - mevcut skor korunur).
+func (r *RedisStore) Enqueue(roomID, visitorID string, score float64) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	added, err := r.client.ZAddNX(ctx, r.key(roomID, "queue"), redis.Z{
+		Score:  score,
+		Member: visitorID,
+	}).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis zadd failed: %w", err)
+	}
+
+	return added > 0, nil
+}
+
+// Rank, ziyaretçinin 0 tabanlı sırasını döndürür (ZRANK).
+func (r *RedisStore) Rank(roomID, visitorID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rank, err := r.client.ZRank(ctx, r.key(roomID, "queue"), visitorID).Result()
+	if err == redis.Nil {
+		return 0, ErrNotInQueue
+	}
+	if err != nil {
+		return 0, fmt.Errorf("redis zrank failed: %w", err)
+	}
+
+	return rank, nil
+}
+
+// Size, kuyruktaki ziyaretçi sayısını döndürür (ZCARD).
+func (r *RedisStore) Size(roomID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	size, err := r.client.ZCard(ctx, r.key(roomID, "queue")).Result()
+	if err != nil {
+		return 0, fmt.Errorf("redis zcard failed: %w", err)
+	}
+
+	return size, nil
+}
+
+// NewStoreWithFallback, Redis erişilebilirse RedisStore, değilse MemoryStore döndürür.
+// İkinci dönüş değeri fallback kullanıldıysa true olur (çağıran loglayabilir).
+//
+// Not: MemoryStore process-local'dir; birden fazla instance varsa her instance
+// kendi kuyruğunu tutar. Sadece Redis kesintilerinde geçici çözüm olarak kullanılmalı.
+func NewStoreWithFallback(client *redis.Client, prefix string) (Store, bool) {
+	if client == nil {
+		return NewMemoryStore(), true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return NewMemoryStore(), true
+	}
+
+	return NewRedisStore(client, prefix), false
+}
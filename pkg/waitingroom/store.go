@@ -0,0 +1,60 @@
+// -----------------------------------------------------------------------------
+// Waiting Room Store
+// -----------------------------------------------------------------------------
+// Sanal bekleme odası için kalıcılık katmanı.
+//
+// Kuyruk, sıralı bir küme (sorted set) olarak modellenir:
+// - Satış açılmadan önce gelenler [0, 1) aralığında rastgele skor alır
+//   (erken gelenler arasında adil kura)
+// - Satış açıldıktan sonra gelenler 1 + sıra numarası skorunu alır (FIFO)
+//
+// Driver'lar:
+// - RedisStore: Birden fazla API instance'ı için (ZADD NX / ZRANK)
+// - MemoryStore: Tek instance / test / Redis erişilemediğinde fallback
+// -----------------------------------------------------------------------------
+
+package waitingroom
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrRoomNotFound, oda tanımlı değilse döner.
+var ErrRoomNotFound = errors.New("waiting room not found")
+
+// ErrNotInQueue, ziyaretçi kuyrukta değilse döner.
+var ErrNotInQueue = errors.New("visitor is not in the queue")
+
+// Room, bir bekleme odasının ayarlarını temsil eder.
+type Room struct {
+	ID            string    `json:"id"`
+	OpensAt       time.Time `json:"opens_at"`        // Satışın açıldığı an; öncesinde gelenler kuraya girer
+	RatePerMinute int       `json:"rate_per_minute"` // Dakikada içeri alınan ziyaretçi sayısı
+	Burst         int       `json:"burst"`           // Açılışta tek seferde içeri alınan ziyaretçi sayısı
+}
+
+// Store, bekleme odası verilerini saklayan interface.
+type Store interface {
+	// SaveRoom, oda ayarlarını kaydeder (varsa üzerine yazar).
+	SaveRoom(room *Room) error
+
+	// GetRoom, oda ayarlarını döndürür. Oda yoksa ErrRoomNotFound döner.
+	GetRoom(roomID string) (*Room, error)
+
+	// DeleteRoom, odayı ve kuyruğunu siler.
+	DeleteRoom(roomID string) error
+
+	// NextSequence, oda için artan bir sıra numarası üretir (atomic).
+	NextSequence(roomID string) (int64, error)
+
+	// Enqueue, ziyaretçiyi verilen skorla kuyruğa ekler.
+	// Ziyaretçi zaten kuyruktaysa skoru değiştirilmez ve false döner.
+	Enqueue(roomID, visitorID string, score float64) (bool, error)
+
+	// Rank, ziyaretçinin 0 tabanlı sırasını döndürür. Kuyrukta değilse ErrNotInQueue döner.
+	Rank(roomID, visitorID string) (int64, error)
+
+	// Size, kuyruktaki toplam ziyaretçi sayısını döndürür.
+	Size(roomID string) (int64, error)
+}
@@ -0,0 +1,105 @@
+package waitingroom
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidToken, token parse edilemediğinde veya imza uyuşmadığında döner.
+	ErrInvalidToken = errors.New("admission token is invalid")
+
+	// ErrTokenExpired, token süresi dolduğunda döner.
+	ErrTokenExpired = errors.New("admission token has expired")
+
+	// ErrTokenMismatch, token başka bir oda veya ziyaretçi için üretildiyse döner.
+	ErrTokenMismatch = errors.New("admission token does not match room or visitor")
+)
+
+// AdmissionClaims, admission token içinde taşınan bilgiler.
+type AdmissionClaims struct {
+	RoomID    string `json:"r"`
+	VisitorID string `json:"v"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// TokenSigner, HMAC-SHA256 ile imzalı admission token üretir ve doğrular.
+//
+// Format: base64url(claims JSON) + "." + base64url(HMAC(claims))
+// Token stateless'tır; doğrulama için store'a gidilmez.
+type TokenSigner struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewTokenSigner, yeni bir TokenSigner oluşturur.
+func NewTokenSigner(secret string, ttl time.Duration) *TokenSigner {
+	return &TokenSigner{
+		secret: []byte(secret),
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+// Issue, ziyaretçi için imzalı admission token üretir.
+func (s *TokenSigner) Issue(roomID, visitorID string) (string, time.Time, error) {
+	expiresAt := s.now().Add(s.ttl)
+
+	payload, err := json.Marshal(AdmissionClaims{
+		RoomID:    roomID,
+		VisitorID: visitorID,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("json encode failed: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + s.sign(encoded), expiresAt, nil
+}
+
+// Verify, token imzasını, süresini ve oda/ziyaretçi eşleşmesini doğrular.
+func (s *TokenSigner) Verify(token, roomID, visitorID string) (*AdmissionClaims, error) {
+	encoded, signature, found := strings.Cut(token, ".")
+	if !found || encoded == "" || signature == "" {
+		return nil, ErrInvalidToken
+	}
+
+	// Constant-time karşılaştırma (timing attack koruması)
+	if !hmac.Equal([]byte(signature), []byte(s.sign(encoded))) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var claims AdmissionClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if s.now().Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	if claims.RoomID != roomID || claims.VisitorID != visitorID {
+		return nil, ErrTokenMismatch
+	}
+
+	return &claims, nil
+}
+
+func (s *TokenSigner) sign(encoded string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,238 @@
+// -----------------------------------------------------------------------------
+// Virtual Waiting Room
+// -----------------------------------------------------------------------------
+// Yüksek talepli satış açılışlarında kullanıcıları sıraya alır ve
+// yapılandırılabilir bir hızla rezervasyon sayfasına kabul eder.
+//
+// Kabul modeli:
+// - Satış açıldıktan t saniye sonra kabul edilen kişi sayısı:
+//       admitted = burst + floor(t * rate_per_minute / 60)
+// - Sırası (rank) admitted'dan küçük olan ziyaretçi içeri alınır
+// - Kabul sayısı zamana bağlı hesaplandığı için ayrı bir "admit" worker'ı
+//   gerekmez; tüm instance'lar aynı sonucu üretir
+//
+// Adalet:
+// - Açılıştan önce gelenler rastgele sıralanır (erken gelip sayfayı
+//   yenileyenler veya botlar avantaj kazanmaz)
+// - Açılıştan sonra gelenler, erken gelenlerin arkasına FIFO eklenir
+// - Tekrar katılım sırayı değiştirmez (ZADD NX)
+//
+// Kullanım:
+//
+//	manager := waitingroom.NewManager(store, waitingroom.NewTokenSigner(secret, 10*time.Minute))
+//	status, err := manager.Join("event:42", "user:7")
+//	if status.Admitted {
+//	    // status.Token → X-Waiting-Room-Token header'ı ile reserve endpoint'ine
+//	}
+// -----------------------------------------------------------------------------
+
+package waitingroom
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// EventRoomID, etkinlik için oda kimliğini döndürür.
+func EventRoomID(eventID int64) string {
+	return fmt.Sprintf("event:%d", eventID)
+}
+
+// UserVisitorID, kullanıcı için ziyaretçi kimliğini döndürür.
+func UserVisitorID(userID int64) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// Status, ziyaretçinin kuyruktaki durumunu temsil eder.
+type Status struct {
+	RoomID         string     `json:"room_id"`
+	Position       int64      `json:"position"`    // 1 tabanlı sıra (kabul edildiyse 0)
+	QueueSize      int64      `json:"queue_size"`  // Kuyruktaki toplam ziyaretçi
+	Admitted       bool       `json:"admitted"`    // Rezervasyon yapabilir mi?
+	EstimatedWait  int64      `json:"eta_seconds"` // Tahmini bekleme süresi (saniye)
+	OpensAt        time.Time  `json:"opens_at"`    // Satış açılış zamanı
+	Token          string     `json:"token,omitempty"`
+	TokenExpiresAt *time.Time `json:"token_expires_at,omitempty"`
+}
+
+// Manager, bekleme odası iş mantığını yönetir.
+type Manager struct {
+	store  Store
+	signer *TokenSigner
+	now    func() time.Time
+}
+
+// NewManager, yeni bir Manager oluşturur.
+func NewManager(store Store, signer *TokenSigner) *Manager {
+	return &Manager{
+		store:  store,
+		signer: signer,
+		now:    time.Now,
+	}
+}
+
+// Open, bir oda için bekleme odasını açar veya ayarlarını günceller.
+// Ayar güncellemesi mevcut kuyruğu etkilemez.
+func (m *Manager) Open(room *Room) error {
+	if room.ID == "" {
+		return fmt.Errorf("room id is required")
+	}
+	if room.RatePerMinute <= 0 {
+		return fmt.Errorf("rate per minute must be positive")
+	}
+	if room.Burst < 0 {
+		return fmt.Errorf("burst cannot be negative")
+	}
+	if room.OpensAt.IsZero() {
+		room.OpensAt = m.now()
+	}
+
+	return m.store.SaveRoom(room)
+}
+
+// Close, bekleme odasını kaldırır. Sonrasında middleware istekleri serbest bırakır.
+func (m *Manager) Close(roomID string) error {
+	return m.store.DeleteRoom(roomID)
+}
+
+// Room, oda ayarlarını döndürür. Oda yoksa ErrRoomNotFound döner.
+func (m *Manager) Room(roomID string) (*Room, error) {
+	return m.store.GetRoom(roomID)
+}
+
+// IsActive, oda için bekleme odası tanımlı mı kontrol eder.
+func (m *Manager) IsActive(roomID string) (bool, error) {
+	_, err := m.store.GetRoom(roomID)
+	if err == ErrRoomNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Join, ziyaretçiyi kuyruğa ekler ve güncel durumunu döndürür.
+// Zaten kuyruktaysa mevcut sırası korunur.
+func (m *Manager) Join(roomID, visitorID string) (*Status, error) {
+	room, err := m.store.GetRoom(roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	score, err := m.arrivalScore(room)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := m.store.Enqueue(roomID, visitorID, score); err != nil {
+		return nil, fmt.Errorf("failed to enqueue visitor: %w", err)
+	}
+
+	return m.status(room, visitorID)
+}
+
+// Status, ziyaretçinin güncel sırasını ve tahmini bekleme süresini döndürür.
+// Kabul edildiyse imzalı admission token da üretilir (polling endpoint'i).
+func (m *Manager) Status(roomID, visitorID string) (*Status, error) {
+	room, err := m.store.GetRoom(roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.status(room, visitorID)
+}
+
+// VerifyToken, admission token'ı doğrular.
+func (m *Manager) VerifyToken(token, roomID, visitorID string) error {
+	_, err := m.signer.Verify(token, roomID, visitorID)
+	return err
+}
+
+// AdmittedCount, şu ana kadar kabul edilen toplam ziyaretçi sayısını hesaplar.
+func (m *Manager) AdmittedCount(room *Room) int64 {
+	elapsed := m.now().Sub(room.OpensAt)
+	if elapsed < 0 {
+		return 0
+	}
+
+	perSecond := float64(room.RatePerMinute) / 60
+	return int64(room.Burst) + int64(math.Floor(elapsed.Seconds()*perSecond))
+}
+
+func (m *Manager) status(room *Room, visitorID string) (*Status, error) {
+	rank, err := m.store.Rank(room.ID, visitorID)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := m.store.Size(room.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{
+		RoomID:    room.ID,
+		QueueSize: size,
+		OpensAt:   room.OpensAt,
+	}
+
+	admitted := m.AdmittedCount(room)
+	if rank < admitted {
+		token, expiresAt, err := m.signer.Issue(room.ID, visitorID)
+		if err != nil {
+			return nil, err
+		}
+
+		status.Admitted = true
+		status.Token = token
+		status.TokenExpiresAt = &expiresAt
+		return status, nil
+	}
+
+	status.Position = rank - admitted + 1
+	status.EstimatedWait = m.estimateWait(room, rank)
+
+	return status, nil
+}
+
+// estimateWait, verilen sıradaki ziyaretçinin kabul edilmesine kalan süreyi hesaplar.
+func (m *Manager) estimateWait(room *Room, rank int64) int64 {
+	// Ziyaretçinin kabul edileceği an: burst + t*rate/60 > rank
+	perSecond := float64(room.RatePerMinute) / 60
+	needed := float64(rank-int64(room.Burst)+1) / perSecond
+	if needed < 0 {
+		needed = 0
+	}
+
+	admitAt := room.OpensAt.Add(time.Duration(math.Ceil(needed)) * time.Second)
+	wait := admitAt.Sub(m.now())
+	if wait < 0 {
+		return 0
+	}
+
+	return int64(math.Ceil(wait.Seconds()))
+}
+
+// arrivalScore, varış anına göre kuyruk skorunu belirler.
+// Açılış öncesi: [0, 1) rastgele; açılış sonrası: 1 + sıra numarası.
+func (m *Manager) arrivalScore(room *Room) (float64, error) {
+	if m.now().Before(room.OpensAt) {
+		var buf [8]byte
+		if _, err := rand.Read(buf[:]); err != nil {
+			return 0, fmt.Errorf("failed to generate random score: %w", err)
+		}
+		// 53 bit → float64 mantissa, [0, 1) aralığında
+		return float64(binary.BigEndian.Uint64(buf[:])>>11) / (1 << 53), nil
+	}
+
+	seq, err := m.store.NextSequence(room.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sequence: %w", err)
+	}
+
+	return 1 + float64(seq), nil
+}
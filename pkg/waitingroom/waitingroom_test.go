@@ -0,0 +1,226 @@
+// -----------------------------------------------------------------------------
+// Waiting Room Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Zamana bağlı kabul hızı (burst + rate)
+// - Açılış sonrası FIFO, açılış öncesi gelenlerin önde olması
+// - Tekrar katılımın sırayı değiştirmemesi
+// - Pozisyon / ETA hesabı
+// - Admission token imza, süre ve eşleşme kontrolleri
+// -----------------------------------------------------------------------------
+
+package waitingroom
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func newTestManager(clock *testClock) *Manager {
+	signer := NewTokenSigner("wr_secret", 10*time.Minute)
+	signer.now = clock.Now
+
+	m := NewManager(NewMemoryStore(), signer)
+	m.now = clock.Now
+	return m
+}
+
+func TestManager_AdmitsAtConfiguredRate(t *testing.T) {
+	opens := time.Unix(1700000000, 0)
+	clock := &testClock{now: opens}
+	m := newTestManager(clock)
+
+	if err := m.Open(&Room{ID: "event:1", OpensAt: opens, RatePerMinute: 60, Burst: 2}); err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := m.Join("event:1", fmt.Sprintf("user:%d", i)); err != nil {
+			t.Fatalf("join failed: %v", err)
+		}
+	}
+
+	// t=0: sadece burst (ilk 2) içeride
+	assertAdmitted(t, m, "user:1", true)
+	assertAdmitted(t, m, "user:2", false)
+
+	// t=1s: rate 60/dk → +1
+	clock.now = opens.Add(time.Second)
+	assertAdmitted(t, m, "user:2", true)
+	assertAdmitted(t, m, "user:3", false)
+
+	clock.now = opens.Add(3 * time.Second)
+	assertAdmitted(t, m, "user:4", true)
+}
+
+func TestManager_FIFOAfterOpenAndEarlyArrivalsFirst(t *testing.T) {
+	opens := time.Unix(1700000000, 0)
+	clock := &testClock{now: opens.Add(-time.Minute)}
+	m := newTestManager(clock)
+
+	if err := m.Open(&Room{ID: "event:1", OpensAt: opens, RatePerMinute: 60}); err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+
+	// Açılış öncesi gelenler (kura)
+	for i := 0; i < 10; i++ {
+		if _, err := m.Join("event:1", fmt.Sprintf("early:%d", i)); err != nil {
+			t.Fatalf("join failed: %v", err)
+		}
+	}
+
+	// Açılış sonrası gelenler (FIFO)
+	clock.now = opens
+	for _, id := range []string{"late:z", "late:a", "late:m"} {
+		if _, err := m.Join("event:1", id); err != nil {
+			t.Fatalf("join failed: %v", err)
+		}
+	}
+
+	for i, id := range []string{"late:z", "late:a", "late:m"} {
+		rank, err := m.store.Rank("event:1", id)
+		if err != nil {
+			t.Fatalf("rank failed: %v", err)
+		}
+		if rank != int64(10+i) {
+			t.Errorf("expected %s at rank %d, got %d", id, 10+i, rank)
+		}
+	}
+}
+
+func TestManager_RejoinKeepsPosition(t *testing.T) {
+	opens := time.Unix(1700000000, 0)
+	clock := &testClock{now: opens}
+	m := newTestManager(clock)
+
+	_ = m.Open(&Room{ID: "event:1", OpensAt: opens, RatePerMinute: 1})
+	_, _ = m.Join("event:1", "user:a")
+	_, _ = m.Join("event:1", "user:b")
+
+	first, _ := m.Join("event:1", "user:a")
+	second, _ := m.Join("event:1", "user:b")
+
+	if first.Position != 1 || second.Position != 2 {
+		t.Fatalf("expected positions 1 and 2, got %d and %d", first.Position, second.Position)
+	}
+
+	size, _ := m.store.Size("event:1")
+	if size != 2 {
+		t.Errorf("expected queue size 2, got %d", size)
+	}
+}
+
+func TestManager_PositionAndETA(t *testing.T) {
+	opens := time.Unix(1700000000, 0)
+	clock := &testClock{now: opens}
+	m := newTestManager(clock)
+
+	_ = m.Open(&Room{ID: "event:1", OpensAt: opens, RatePerMinute: 30, Burst: 1})
+	for i := 0; i < 4; i++ {
+		_, _ = m.Join("event:1", fmt.Sprintf("user:%d", i))
+	}
+
+	// rank 3, burst 1, 0.5 kişi/sn → (3-1+1)/0.5 = 6 sn
+	status, err := m.Status("event:1", "user:3")
+	if err != nil {
+		t.Fatalf("status failed: %v", err)
+	}
+	if status.Admitted {
+		t.Fatal("expected user:3 to be waiting")
+	}
+	if status.Position != 3 {
+		t.Errorf("expected position 3, got %d", status.Position)
+	}
+	if status.EstimatedWait != 6 {
+		t.Errorf("expected eta 6s, got %d", status.EstimatedWait)
+	}
+
+	clock.now = opens.Add(6 * time.Second)
+	assertAdmitted(t, m, "user:3", true)
+}
+
+func TestManager_UnknownRoomAndVisitor(t *testing.T) {
+	clock := &testClock{now: time.Unix(1700000000, 0)}
+	m := newTestManager(clock)
+
+	if _, err := m.Join("missing", "user:1"); !errors.Is(err, ErrRoomNotFound) {
+		t.Fatalf("expected ErrRoomNotFound, got %v", err)
+	}
+
+	_ = m.Open(&Room{ID: "event:1", RatePerMinute: 10})
+	if _, err := m.Status("event:1", "user:1"); !errors.Is(err, ErrNotInQueue) {
+		t.Fatalf("expected ErrNotInQueue, got %v", err)
+	}
+
+	active, _ := m.IsActive("event:1")
+	if !active {
+		t.Error("expected room to be active")
+	}
+
+	_ = m.Close("event:1")
+	active, _ = m.IsActive("event:1")
+	if active {
+		t.Error("expected room to be inactive after close")
+	}
+}
+
+func TestTokenSigner_Verify(t *testing.T) {
+	clock := &testClock{now: time.Unix(1700000000, 0)}
+	signer := NewTokenSigner("wr_secret", time.Minute)
+	signer.now = clock.Now
+
+	token, _, err := signer.Issue("event:1", "user:1")
+	if err != nil {
+		t.Fatalf("issue failed: %v", err)
+	}
+
+	if _, err := signer.Verify(token, "event:1", "user:1"); err != nil {
+		t.Fatalf("expected valid token, got %v", err)
+	}
+
+	if _, err := signer.Verify(token, "event:2", "user:1"); !errors.Is(err, ErrTokenMismatch) {
+		t.Errorf("expected ErrTokenMismatch for other room, got %v", err)
+	}
+
+	if _, err := signer.Verify(token, "event:1", "user:2"); !errors.Is(err, ErrTokenMismatch) {
+		t.Errorf("expected ErrTokenMismatch for other visitor, got %v", err)
+	}
+
+	other := NewTokenSigner("other_secret", time.Minute)
+	other.now = clock.Now
+	if _, err := other.Verify(token, "event:1", "user:1"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected ErrInvalidToken for wrong secret, got %v", err)
+	}
+
+	if _, err := signer.Verify("garbage", "event:1", "user:1"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected ErrInvalidToken for malformed token, got %v", err)
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	if _, err := signer.Verify(token, "event:1", "user:1"); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("expected ErrTokenExpired, got %v", err)
+	}
+}
+
+func assertAdmitted(t *testing.T, m *Manager, visitorID string, want bool) {
+	t.Helper()
+
+	status, err := m.Status("event:1", visitorID)
+	if err != nil {
+		t.Fatalf("status for %s failed: %v", visitorID, err)
+	}
+	if status.Admitted != want {
+		t.Errorf("%s admitted = %v, want %v", visitorID, status.Admitted, want)
+	}
+	if want && status.Token == "" {
+		t.Errorf("%s expected admission token", visitorID)
+	}
+}
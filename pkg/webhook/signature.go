@@ -0,0 +1,186 @@
+// -----------------------------------------------------------------------------
+// Webhook Signature Verification
+// -----------------------------------------------------------------------------
+// Ödeme sağlayıcılarından gelen webhook isteklerinin imzasını doğrular.
+//
+// İmza formatı (header):
+//
+//	X-Webhook-Signature: t=1700000000,v1=5257a869e7ecebeda32affa62cdca3fa51cad7e7
+//
+// İmzalanan içerik "<timestamp>.<raw body>" şeklindedir ve HMAC-SHA256 ile
+// paylaşılan secret kullanılarak hesaplanır.
+//
+// Güvenlik:
+// - Karşılaştırma hmac.Equal ile constant-time yapılır (timing attack koruması)
+// - Timestamp tolerance ile replay saldırıları engellenir
+// - Birden fazla v1 imzası desteklenir (secret rotation)
+// -----------------------------------------------------------------------------
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTolerance, imza timestamp'i için varsayılan kabul penceresidir.
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	// ErrMissingSignature, imza header'ı boş veya eksik olduğunda döner.
+	ErrMissingSignature = errors.New("webhook signature missing")
+
+	// ErrInvalidSignatureHeader, imza header'ı parse edilemediğinde döner.
+	ErrInvalidSignatureHeader = errors.New("webhook signature header is malformed")
+
+	// ErrSignatureMismatch, hesaplanan imza ile gelen imza eşleşmediğinde döner.
+	ErrSignatureMismatch = errors.New("webhook signature mismatch")
+
+	// ErrTimestampOutOfTolerance, timestamp tolerance dışında kaldığında döner.
+	ErrTimestampOutOfTolerance = errors.New("webhook timestamp outside of tolerance")
+
+	// ErrEmptySecret, Verifier boş secret ile oluşturulmak istendiğinde döner.
+	// Boş secret ile hesaplanan imzayı herkes üretebilir.
+	ErrEmptySecret = errors.New("webhook secret is empty")
+)
+
+// Verifier, webhook imzalarını doğrulayan yapıdır.
+type Verifier struct {
+	secret    []byte
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+// NewVerifier, yeni bir Verifier oluşturur.
+//
+// Parametreler:
+//   - secret: Sağlayıcı ile paylaşılan imza secret'ı
+//   - tolerance: Timestamp kabul penceresi (0 ise DefaultTolerance)
+//
+// Döndürür:
+//   - *Verifier: Doğrulayıcı
+//   - error: Secret boşsa ErrEmptySecret
+//
+// Örnek:
+//
+//	verifier, err := webhook.NewVerifier(cfg.Payment.WebhookSecret, 5*time.Minute)
+//	if err != nil {
+//	    // webhook route'u kaydedilmez / 503
+//	}
+//	if err := verifier.Verify(body, r.Header.Get("X-Webhook-Signature")); err != nil {
+//	    // 401
+//	}
+func NewVerifier(secret string, tolerance time.Duration) (*Verifier, error) {
+	if secret == "" {
+		return nil, ErrEmptySecret
+	}
+
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+
+	return &Verifier{
+		secret:    []byte(secret),
+		tolerance: tolerance,
+		now:       time.Now,
+	}, nil
+}
+
+// Verify, payload ve imza header'ını doğrular.
+//
+// Parametreler:
+//   - payload: Ham request body (parse edilmemiş)
+//   - header: İmza header değeri ("t=...,v1=...")
+//
+// Döndürür:
+//   - error: Doğrulama başarısızsa ilgili hata
+func (v *Verifier) Verify(payload []byte, header string) error {
+	timestamp, signatures, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	// Replay koruması: timestamp tolerance içinde olmalı
+	diff := v.now().Sub(time.Unix(timestamp, 0))
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > v.tolerance {
+		return ErrTimestampOutOfTolerance
+	}
+
+	expected := v.compute(timestamp, payload)
+	for _, sig := range signatures {
+		if hmac.Equal(expected, sig) {
+			return nil
+		}
+	}
+
+	return ErrSignatureMismatch
+}
+
+// Sign, verilen payload için imza header'ı üretir.
+//
+// Testlerde ve sağlayıcı simülasyonunda kullanılır.
+func (v *Verifier) Sign(payload []byte, timestamp time.Time) string {
+	ts := timestamp.Unix()
+	return fmt.Sprintf("t=%d,v1=%s", ts, hex.EncodeToString(v.compute(ts, payload)))
+}
+
+// compute, "<timestamp>.<payload>" için HMAC-SHA256 hesaplar.
+func (v *Verifier) compute(timestamp int64, payload []byte) []byte {
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// parseHeader, "t=...,v1=...,v1=..." formatındaki header'ı ayrıştırır.
+func parseHeader(header string) (int64, [][]byte, error) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0, nil, ErrMissingSignature
+	}
+
+	var (
+		timestamp  int64
+		hasTime    bool
+		signatures [][]byte
+	)
+
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			return 0, nil, ErrInvalidSignatureHeader
+		}
+
+		switch kv[0] {
+		case "t":
+			ts, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return 0, nil, ErrInvalidSignatureHeader
+			}
+			timestamp = ts
+			hasTime = true
+		case "v1":
+			sig, err := hex.DecodeString(kv[1])
+			if err != nil {
+				continue // Bilinmeyen/bozuk imzaları atla
+			}
+			signatures = append(signatures, sig)
+		}
+	}
+
+	if !hasTime || len(signatures) == 0 {
+		return 0, nil, ErrInvalidSignatureHeader
+	}
+
+	return timestamp, signatures, nil
+}
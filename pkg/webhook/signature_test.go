@@ -0,0 +1,131 @@
+// -----------------------------------------------------------------------------
+// Webhook Signature Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Geçerli imza kabulü
+// - Değiştirilmiş payload / yanlış secret reddi
+// - Timestamp tolerance (replay koruması)
+// - Bozuk header formatları
+// - Boş secret reddi
+// -----------------------------------------------------------------------------
+
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func fixedVerifier(secret string, now time.Time) *Verifier {
+	v, err := NewVerifier(secret, 5*time.Minute)
+	if err != nil {
+		panic(err)
+	}
+	v.now = func() time.Time { return now }
+	return v
+}
+
+func TestVerify_ValidSignature(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	v := fixedVerifier("whsec_test", now)
+	payload := []byte(`{"id":"evt_1","type":"payment.completed"}`)
+
+	header := v.Sign(payload, now)
+	if err := v.Verify(payload, header); err != nil {
+		t.Fatalf("expected valid signature, got %v", err)
+	}
+}
+
+func TestVerify_TamperedPayload(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	v := fixedVerifier("whsec_test", now)
+
+	header := v.Sign([]byte(`{"amount":100}`), now)
+	err := v.Verify([]byte(`{"amount":1}`), header)
+	if !errors.Is(err, ErrSignatureMismatch) {
+		t.Fatalf("expected ErrSignatureMismatch, got %v", err)
+	}
+}
+
+func TestVerify_WrongSecret(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	payload := []byte(`{}`)
+
+	header := fixedVerifier("other", now).Sign(payload, now)
+	err := fixedVerifier("whsec_test", now).Verify(payload, header)
+	if !errors.Is(err, ErrSignatureMismatch) {
+		t.Fatalf("expected ErrSignatureMismatch, got %v", err)
+	}
+}
+
+func TestVerify_Tolerance(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	v := fixedVerifier("whsec_test", now)
+	payload := []byte(`{}`)
+
+	tests := []struct {
+		name    string
+		signed  time.Time
+		wantErr error
+	}{
+		{"within tolerance (past)", now.Add(-4 * time.Minute), nil},
+		{"within tolerance (future)", now.Add(4 * time.Minute), nil},
+		{"too old", now.Add(-6 * time.Minute), ErrTimestampOutOfTolerance},
+		{"too far in future", now.Add(6 * time.Minute), ErrTimestampOutOfTolerance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Verify(payload, v.Sign(payload, tt.signed))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVerify_MalformedHeaders(t *testing.T) {
+	v := fixedVerifier("whsec_test", time.Unix(1700000000, 0))
+
+	tests := []struct {
+		header  string
+		wantErr error
+	}{
+		{"", ErrMissingSignature},
+		{"garbage", ErrInvalidSignatureHeader},
+		{"t=abc,v1=00", ErrInvalidSignatureHeader},
+		{"t=1700000000", ErrInvalidSignatureHeader},
+		{"v1=deadbeef", ErrInvalidSignatureHeader},
+		{"t=1700000000,v1=zz", ErrInvalidSignatureHeader},
+	}
+
+	for _, tt := range tests {
+		err := v.Verify([]byte(`{}`), tt.header)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("header %q: expected %v, got %v", tt.header, tt.wantErr, err)
+		}
+	}
+}
+
+func TestVerify_MultipleSignatures(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	v := fixedVerifier("whsec_test", now)
+	payload := []byte(`{}`)
+
+	valid := v.Sign(payload, now)
+	header := valid + ",v1=0000"
+	if err := v.Verify(payload, header); err != nil {
+		t.Fatalf("expected rotation header to verify, got %v", err)
+	}
+}
+
+func TestNewVerifier_EmptySecret(t *testing.T) {
+	v, err := NewVerifier("", 5*time.Minute)
+	if !errors.Is(err, ErrEmptySecret) {
+		t.Fatalf("expected ErrEmptySecret, got %v", err)
+	}
+	if v != nil {
+		t.Fatalf("expected no verifier for an empty secret")
+	}
+}
@@ -0,0 +1,269 @@
+// -----------------------------------------------------------------------------
+// XLSX Writer
+// -----------------------------------------------------------------------------
+// Harici bağımlılık olmadan, tek sayfalık (single sheet) Office Open XML
+// çalışma kitabı üretir. Satırlar doğrudan zip akışına yazılır; büyük
+// listelerde tüm tablo bellekte tutulmaz.
+//
+// Örnek:
+//
+//	w, err := xlsx.NewWriter(file, "Katılımcılar")
+//	w.WriteHeader([]string{"ticket_number", "holder_name"})
+//	w.WriteRow([]string{"TKT-1", "Ayşe Yılmaz"})
+//	err = w.Close()
+//
+// Notlar:
+// - Tüm hücreler metin (inline string) olarak yazılır; formül çalıştırılmaz
+// - Başlık satırı kalın yazılır
+// - XML'de geçersiz kontrol karakterleri U+FFFD ile değiştirilir
+// - Close çağrılmadan üretilen dosya geçerli değildir
+// -----------------------------------------------------------------------------
+
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxRows, bir sayfanın alabileceği satır sayısıdır (Excel sınırı)
+	MaxRows = 1048576
+
+	// MaxCellLength, bir hücrenin alabileceği karakter sayısıdır (Excel sınırı)
+	MaxCellLength = 32767
+
+	// maxSheetNameLength, sayfa adının en fazla uzunluğudur
+	maxSheetNameLength = 31
+)
+
+var (
+	ErrTooManyRows      = errors.New("xlsx: sheet row limit exceeded")
+	ErrInvalidSheetName = errors.New("xlsx: invalid sheet name")
+	ErrClosed           = errors.New("xlsx: writer is closed")
+	ErrHeaderAfterRows  = errors.New("xlsx: header must be the first row")
+)
+
+// Writer, tek sayfalık bir XLSX dosyasını akış olarak yazar
+type Writer struct {
+	zip    *zip.Writer
+	sheet  *bufio.Writer
+	rows   int
+	closed bool
+}
+
+// NewWriter, verilen çıktıya sayfa adıyla yeni bir çalışma kitabı başlatır.
+// Paket parçaları (content types, ilişkiler, workbook, stiller) hemen yazılır;
+// sayfa verisi WriteRow ile eklenir.
+func NewWriter(out io.Writer, sheetName string) (*Writer, error) {
+	if err := validateSheetName(sheetName); err != nil {
+		return nil, err
+	}
+
+	w := &Writer{zip: zip.NewWriter(out)}
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", rootRelsXML},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXML, escape(sheetName))},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+		{"xl/styles.xml", stylesXML},
+	}
+
+	for _, part := range parts {
+		entry, err := w.zip.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("xlsx: failed to create %s: %w", part.name, err)
+		}
+		if _, err := io.WriteString(entry, part.content); err != nil {
+			return nil, fmt.Errorf("xlsx: failed to write %s: %w", part.name, err)
+		}
+	}
+
+	// Sayfa en son parça olarak açılır ve Close'a kadar akış halinde yazılır
+	entry, err := w.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("xlsx: failed to create sheet: %w", err)
+	}
+	w.sheet = bufio.NewWriter(entry)
+
+	if _, err := w.sheet.WriteString(sheetHeaderXML); err != nil {
+		return nil, fmt.Errorf("xlsx: failed to write sheet: %w", err)
+	}
+
+	return w, nil
+}
+
+// WriteHeader, kalın başlık satırını yazar; ilk satır olmalıdır
+func (w *Writer) WriteHeader(cells []string) error {
+	if w.rows > 0 {
+		return ErrHeaderAfterRows
+	}
+	return w.writeRow(cells, styleBold)
+}
+
+// WriteRow, bir veri satırı yazar
+func (w *Writer) WriteRow(cells []string) error {
+	return w.writeRow(cells, styleDefault)
+}
+
+// Rows, yazılan satır sayısını (başlık dahil) döndürür
+func (w *Writer) Rows() int {
+	return w.rows
+}
+
+// Close, sayfayı ve zip arşivini kapatır. Alttaki io.Writer kapatılmaz.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if _, err := w.sheet.WriteString(sheetFooterXML); err != nil {
+		return fmt.Errorf("xlsx: failed to write sheet: %w", err)
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return fmt.Errorf("xlsx: failed to flush sheet: %w", err)
+	}
+	if err := w.zip.Close(); err != nil {
+		return fmt.Errorf("xlsx: failed to close archive: %w", err)
+	}
+
+	return nil
+}
+
+// writeRow, satırı inline string hücreleriyle yazar
+func (w *Writer) writeRow(cells []string, style int) error {
+	if w.closed {
+		return ErrClosed
+	}
+	if w.rows == MaxRows {
+		return ErrTooManyRows
+	}
+	w.rows++
+
+	var b strings.Builder
+	rowNumber := strconv.Itoa(w.rows)
+
+	b.WriteString(`<row r="`)
+	b.WriteString(rowNumber)
+	b.WriteString(`">`)
+
+	for i, value := range cells {
+		if value == "" {
+			continue
+		}
+
+		b.WriteString(`<c r="`)
+		b.WriteString(ColumnName(i))
+		b.WriteString(rowNumber)
+		b.WriteString(`" t="inlineStr"`)
+		if style != styleDefault {
+			b.WriteString(` s="`)
+			b.WriteString(strconv.Itoa(style))
+			b.WriteString(`"`)
+		}
+		b.WriteString(`><is><t xml:space="preserve">`)
+		b.WriteString(escape(truncate(value)))
+		b.WriteString(`</t></is></c>`)
+	}
+
+	b.WriteString(`</row>`)
+
+	if _, err := w.sheet.WriteString(b.String()); err != nil {
+		return fmt.Errorf("xlsx: failed to write row: %w", err)
+	}
+
+	return nil
+}
+
+// ColumnName, sıfır tabanlı sütun indeksini harf adına çevirir (0 → A, 26 → AA)
+func ColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+// validateSheetName, Excel'in sayfa adı kurallarını kontrol eder
+func validateSheetName(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > maxSheetNameLength {
+		return ErrInvalidSheetName
+	}
+	if strings.ContainsAny(name, `[]:*?/\`) || strings.HasPrefix(name, "'") || strings.HasSuffix(name, "'") {
+		return ErrInvalidSheetName
+	}
+	return nil
+}
+
+// truncate, hücreyi Excel'in karakter sınırına indirir
+func truncate(value string) string {
+	if utf8.RuneCountInString(value) <= MaxCellLength {
+		return value
+	}
+	return string([]rune(value)[:MaxCellLength])
+}
+
+// escape, metni XML'e güvenli şekilde yazar
+func escape(value string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
+
+// Stil indeksleri (styles.xml içindeki cellXfs sırası)
+const (
+	styleDefault = 0
+	styleBold    = 1
+)
+
+const contentTypesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+</Types>`
+
+const rootRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+
+const workbookXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`
+
+const workbookRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+</Relationships>`
+
+const stylesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>
+<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>
+<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>
+<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>
+</styleSheet>`
+
+const sheetHeaderXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const sheetFooterXML = `</sheetData></worksheet>`
@@ -0,0 +1,144 @@
+// -----------------------------------------------------------------------------
+// XLSX Writer Tests
+// -----------------------------------------------------------------------------
+// Testler:
+// - Üretilen arşivin zorunlu paket parçalarını içermesi
+// - Hücrelerin inline string olarak, XML kaçışıyla yazılması
+// - Başlık satırının kalın stil alması ve sadece ilk satır olabilmesi
+// - Sütun adlarının (A, Z, AA, ...) doğru üretilmesi
+// - Geçersiz sayfa adlarının reddedilmesi
+// -----------------------------------------------------------------------------
+
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// readPart, arşivden bir parçanın içeriğini okur
+func readPart(t *testing.T, archive []byte, name string) string {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("archive is not a valid zip: %v", err)
+	}
+
+	for _, file := range reader.File {
+		if file.Name != name {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("failed to open %s: %v", name, err)
+		}
+		defer rc.Close()
+		content, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", name, err)
+		}
+		return string(content)
+	}
+
+	t.Fatalf("part %s not found in archive", name)
+	return ""
+}
+
+func TestWriter_ProducesWellFormedPackage(t *testing.T) {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(&buf, "Katılımcılar")
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+	if err := w.WriteHeader([]string{"ticket_number", "holder_name"}); err != nil {
+		t.Fatalf("WriteHeader failed: %v", err)
+	}
+	if err := w.WriteRow([]string{"TKT-1", `Ayşe <"Yılmaz"> & Co`}); err != nil {
+		t.Fatalf("WriteRow failed: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	for _, part := range []string{
+		"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml",
+	} {
+		content := readPart(t, buf.Bytes(), part)
+
+		decoder := xml.NewDecoder(strings.NewReader(content))
+		for {
+			if _, err := decoder.Token(); err != nil {
+				if err == io.EOF {
+					break
+				}
+				t.Fatalf("%s is not well-formed XML: %v", part, err)
+			}
+		}
+	}
+
+	if workbook := readPart(t, buf.Bytes(), "xl/workbook.xml"); !strings.Contains(workbook, `name="Katılımcılar"`) {
+		t.Fatalf("workbook does not contain sheet name: %s", workbook)
+	}
+
+	sheet := readPart(t, buf.Bytes(), "xl/worksheets/sheet1.xml")
+	if !strings.Contains(sheet, `<c r="A1" t="inlineStr" s="1">`) {
+		t.Fatalf("header cell is not bold: %s", sheet)
+	}
+	if !strings.Contains(sheet, `<c r="B2" t="inlineStr"><is><t xml:space="preserve">Ayşe &lt;&#34;Yılmaz&#34;&gt; &amp; Co</t></is></c>`) {
+		t.Fatalf("data cell is not escaped inline string: %s", sheet)
+	}
+	if w.Rows() != 2 {
+		t.Fatalf("expected 2 rows, got %d", w.Rows())
+	}
+}
+
+func TestWriter_HeaderMustBeFirst(t *testing.T) {
+	w, err := NewWriter(io.Discard, "Sheet1")
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+	if err := w.WriteRow([]string{"a"}); err != nil {
+		t.Fatalf("WriteRow failed: %v", err)
+	}
+	if err := w.WriteHeader([]string{"h"}); !errors.Is(err, ErrHeaderAfterRows) {
+		t.Fatalf("expected ErrHeaderAfterRows, got %v", err)
+	}
+}
+
+func TestWriter_RejectsWritesAfterClose(t *testing.T) {
+	w, err := NewWriter(io.Discard, "Sheet1")
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if err := w.WriteRow([]string{"a"}); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	cases := map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"}
+	for index, want := range cases {
+		if got := ColumnName(index); got != want {
+			t.Fatalf("ColumnName(%d): expected %s, got %s", index, want, got)
+		}
+	}
+}
+
+func TestNewWriter_InvalidSheetName(t *testing.T) {
+	for _, name := range []string{"", "a/b", "[x]", "'quoted'", strings.Repeat("x", 32)} {
+		if _, err := NewWriter(io.Discard, name); !errors.Is(err, ErrInvalidSheetName) {
+			t.Fatalf("sheet name %q: expected ErrInvalidSheetName, got %v", name, err)
+		}
+	}
+}